@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"math/rand"
 	"net"
 	"strconv"
 	"strings"
@@ -145,26 +144,37 @@ var (
 	}
 )
 
-// DNSClient queries for DNS records
+// DNSClient queries for DNS records. Each lookup also returns the DNSSEC
+// status of the answer it was based on.
 type DNSClient interface {
-	LookupTXT(context.Context, string) (txts []string, err error)
-	LookupHost(context.Context, string) ([]net.IP, error)
-	LookupCAA(context.Context, string) ([]*dns.CAA, error)
+	LookupTXT(context.Context, string) (txts []string, dnssec DNSSECStatus, err error)
+	LookupHost(context.Context, string) ([]net.IP, DNSSECStatus, error)
+	LookupCAA(context.Context, string) ([]*dns.CAA, DNSSECStatus, error)
 }
 
 // DNSClientImpl represents a client that talks to an external resolver
 type DNSClientImpl struct {
 	dnsClient                exchanger
-	servers                  []string
+	servers                  *serverPool
+	readTimeout              time.Duration
 	allowRestrictedAddresses bool
 	maxTries                 int
 	clk                      clock.Clock
 	log                      blog.Logger
+	// dnssec is nil unless EnableDNSSEC has been called, in which case
+	// answers are validated in-process instead of trusting the resolvers.
+	dnssec *dnssecValidator
+	// iterative is nil unless EnableIterativeResolution has been called, in
+	// which case TXT and CAA lookups are resolved from the root servers
+	// instead of by the resolvers.
+	iterative *iterativeResolver
 
 	queryTime         *prometheus.HistogramVec
 	totalLookupTime   *prometheus.HistogramVec
 	timeoutCounter    *prometheus.CounterVec
 	idMismatchCounter *prometheus.CounterVec
+	dnssecCounter     *prometheus.CounterVec
+	iterativeCounter  *prometheus.CounterVec
 }
 
 var _ DNSClient = &DNSClientImpl{}
@@ -219,11 +229,26 @@ func NewDNSClientImpl(
 		},
 		[]string{"qtype", "resolver"},
 	)
-	stats.MustRegister(queryTime, totalLookupTime, timeoutCounter, idMismatchCounter)
+	dnssecCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_dnssec_status",
+			Help: "Counter of DNSSEC validation results sliced by query type and status",
+		},
+		[]string{"qtype", "status"},
+	)
+	iterativeCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_iterative_lookups",
+			Help: "Counter of iterative lookups sliced by query type and result",
+		},
+		[]string{"qtype", "result"},
+	)
+	stats.MustRegister(queryTime, totalLookupTime, timeoutCounter, idMismatchCounter, dnssecCounter, iterativeCounter)
 
 	return &DNSClientImpl{
 		dnsClient:                dnsClient,
-		servers:                  servers,
+		servers:                  newServerPool(servers, stats, clk, log),
+		readTimeout:              readTimeout,
 		allowRestrictedAddresses: false,
 		maxTries:                 maxTries,
 		clk:                      clk,
@@ -231,6 +256,8 @@ func NewDNSClientImpl(
 		totalLookupTime:          totalLookupTime,
 		timeoutCounter:           timeoutCounter,
 		idMismatchCounter:        idMismatchCounter,
+		dnssecCounter:            dnssecCounter,
+		iterativeCounter:         iterativeCounter,
 		log:                      log,
 	}
 }
@@ -250,10 +277,11 @@ func NewTestDNSClientImpl(
 	return resolver
 }
 
-// exchangeOne performs a single DNS exchange with a randomly chosen server
-// out of the server list, returning the response, time, and error (if any).
-// We assume that the upstream resolver requests and validates DNSSEC records
-// itself.
+// exchangeOne performs a single DNS exchange with a server chosen from the
+// server pool, preferring fast and healthy servers, returning the response,
+// time, and error (if any).
+// Unless EnableDNSSEC has been called we assume that the upstream resolver
+// requests and validates DNSSEC records itself.
 func (dnsClient *DNSClientImpl) exchangeOne(ctx context.Context, hostname string, qtype uint16) (resp *dns.Msg, err error) {
 	m := new(dns.Msg)
 	// Set question type
@@ -268,19 +296,41 @@ func (dnsClient *DNSClientImpl) exchangeOne(ctx context.Context, hostname string
 	// Tell the resolver that we're willing to receive responses up to 4096 bytes.
 	// This happens sometimes when there are a very large number of CAA records
 	// present.
-	m.SetEdns0(4096, false)
+	//
+	// If we validate DNSSEC ourselves, set the DO bit to ask for the RRSIGs and
+	// NSEC records needed to validate the answer, and the CD bit to ask the
+	// resolver to return the answer even if it fails validation so that we can
+	// tell a bogus answer apart from a server failure.
+	m.SetEdns0(4096, dnsClient.dnssec != nil)
+	m.CheckingDisabled = dnsClient.dnssec != nil
+
+	return dnsClient.exchange(ctx, dnsClient.dnsClient, dnsClient.servers, hostname, m)
+}
 
-	if len(dnsClient.servers) < 1 {
-		return nil, fmt.Errorf("Not configured with at least one DNS Server")
-	}
+// serverSet is a set of servers that a query may be sent to.
+type serverSet interface {
+	// choose picks a server to send a query to, preferring those not in
+	// tried.
+	choose(tried map[string]bool) (string, error)
+	// record is told the result of each query sent to server.
+	record(server string, rtt time.Duration, err error)
+	// label is the value of the resolver label of the metrics of queries sent
+	// to server.
+	label(server string) string
+}
 
-	// Randomly pick a server
-	chosenServerIndex := rand.Intn(len(dnsClient.servers))
-	chosenServer := dnsClient.servers[chosenServerIndex]
+// exchange sends m, a query for hostname, to one of servers using client,
+// retrying with another of them after temporary errors up to maxTries times,
+// and records the time taken and any timeouts.
+func (dnsClient *DNSClientImpl) exchange(ctx context.Context, client exchanger, servers serverSet, hostname string, m *dns.Msg) (resp *dns.Msg, err error) {
+	chosenServer, err := servers.choose(nil)
+	if err != nil {
+		return nil, err
+	}
+	tried := map[string]bool{chosenServer: true}
 
 	start := dnsClient.clk.Now()
-	client := dnsClient.dnsClient
-	qtypeStr := dns.TypeToString[qtype]
+	qtypeStr := dns.TypeToString[m.Question[0].Qtype]
 	tries := 1
 	defer func() {
 		result, authenticated := "failed", ""
@@ -293,14 +343,15 @@ func (dnsClient *DNSClientImpl) exchangeOne(ctx context.Context, hostname string
 			"result":             result,
 			"authenticated_data": authenticated,
 			"retries":            strconv.Itoa(tries),
-			"resolver":           chosenServer,
+			"resolver":           servers.label(chosenServer),
 		}).Observe(dnsClient.clk.Since(start).Seconds())
 	}()
 	for {
 		ch := make(chan dnsResp, 1)
 
-		go func() {
+		go func(chosenServer string) {
 			rsp, rtt, err := client.Exchange(m, chosenServer)
+			servers.record(chosenServer, rtt, err)
 			result, authenticated := "failed", ""
 			if rsp != nil {
 				result = dns.RcodeToString[rsp.Rcode]
@@ -311,7 +362,7 @@ func (dnsClient *DNSClientImpl) exchangeOne(ctx context.Context, hostname string
 				if err == dns.ErrId {
 					dnsClient.idMismatchCounter.With(prometheus.Labels{
 						"qtype":    qtypeStr,
-						"resolver": chosenServer,
+						"resolver": servers.label(chosenServer),
 					}).Inc()
 				}
 			}
@@ -319,29 +370,29 @@ func (dnsClient *DNSClientImpl) exchangeOne(ctx context.Context, hostname string
 				"qtype":              qtypeStr,
 				"result":             result,
 				"authenticated_data": authenticated,
-				"resolver":           chosenServer,
+				"resolver":           servers.label(chosenServer),
 			}).Observe(rtt.Seconds())
 			ch <- dnsResp{m: rsp, err: err}
-		}()
+		}(chosenServer)
 		select {
 		case <-ctx.Done():
 			if ctx.Err() == context.DeadlineExceeded {
 				dnsClient.timeoutCounter.With(prometheus.Labels{
 					"qtype":    qtypeStr,
 					"type":     "deadline exceeded",
-					"resolver": chosenServer,
+					"resolver": servers.label(chosenServer),
 				}).Inc()
 			} else if ctx.Err() == context.Canceled {
 				dnsClient.timeoutCounter.With(prometheus.Labels{
 					"qtype":    qtypeStr,
 					"type":     "canceled",
-					"resolver": chosenServer,
+					"resolver": servers.label(chosenServer),
 				}).Inc()
 			} else {
 				dnsClient.timeoutCounter.With(prometheus.Labels{
 					"qtype":    qtypeStr,
 					"type":     "unknown",
-					"resolver": chosenServer,
+					"resolver": servers.label(chosenServer),
 				}).Inc()
 			}
 			err = ctx.Err()
@@ -353,18 +404,20 @@ func (dnsClient *DNSClientImpl) exchangeOne(ctx context.Context, hostname string
 				hasRetriesLeft := tries < dnsClient.maxTries
 				if isRetryable && hasRetriesLeft {
 					tries++
-					// Chose a new server to retry the query with by incrementing the
-					// chosen server index modulo the number of servers. This ensures that
-					// if one dns server isn't available we retry with the next in the
-					// list.
-					chosenServerIndex = (chosenServerIndex + 1) % len(dnsClient.servers)
-					chosenServer = dnsClient.servers[chosenServerIndex]
+					// Choose a new server to retry the query with, avoiding those
+					// already tried. This ensures that if one dns server isn't
+					// available we retry with another.
+					chosenServer, err = servers.choose(tried)
+					if err != nil {
+						return
+					}
+					tried[chosenServer] = true
 					continue
 				} else if isRetryable && !hasRetriesLeft {
 					dnsClient.timeoutCounter.With(prometheus.Labels{
 						"qtype":    qtypeStr,
 						"type":     "out of retries",
-						"resolver": chosenServer,
+						"resolver": servers.label(chosenServer),
 					}).Inc()
 				}
 			}
@@ -380,18 +433,39 @@ type dnsResp struct {
 	err error
 }
 
+// validate classifies the DNSSEC status of the response to a query and
+// counts the result.
+func (dnsClient *DNSClientImpl) validate(ctx context.Context, hostname string, qtype uint16, resp *dns.Msg) (DNSSECStatus, error) {
+	status, err := dnsClient.validateResponse(ctx, hostname, qtype, resp)
+	if dnsClient.dnssec != nil {
+		dnsClient.dnssecCounter.With(prometheus.Labels{
+			"qtype":  dns.TypeToString[qtype],
+			"status": string(status),
+		}).Inc()
+	}
+	if err != nil {
+		dnsClient.log.Infof("DNSSEC validation of %s %s failed: %s", dns.TypeToString[qtype], hostname, err)
+	}
+	return status, err
+}
+
 // LookupTXT sends a DNS query to find all TXT records associated with
-// the provided hostname which it returns along with the returned
-// DNS authority section.
-func (dnsClient *DNSClientImpl) LookupTXT(ctx context.Context, hostname string) ([]string, error) {
+// the provided hostname which it returns along with the DNSSEC status of
+// the answer. An answer that fails DNSSEC validation is returned as an
+// error.
+func (dnsClient *DNSClientImpl) LookupTXT(ctx context.Context, hostname string) ([]string, DNSSECStatus, error) {
 	var txt []string
 	dnsType := dns.TypeTXT
-	r, err := dnsClient.exchangeOne(ctx, hostname, dnsType)
+	r, err := dnsClient.lookup(ctx, hostname, dnsType)
 	if err != nil {
-		return nil, &DNSError{dnsType, hostname, err, -1}
+		return nil, DNSSECUnchecked, &DNSError{dnsType, hostname, err, -1}
 	}
 	if r.Rcode != dns.RcodeSuccess {
-		return nil, &DNSError{dnsType, hostname, nil, r.Rcode}
+		return nil, DNSSECUnchecked, &DNSError{dnsType, hostname, nil, r.Rcode}
+	}
+	status, err := dnsClient.validate(ctx, hostname, dnsType, r)
+	if err != nil {
+		return nil, status, &DNSError{dnsType, hostname, err, -1}
 	}
 
 	for _, answer := range r.Answer {
@@ -402,7 +476,7 @@ func (dnsClient *DNSClientImpl) LookupTXT(ctx context.Context, hostname string)
 		}
 	}
 
-	return txt, err
+	return txt, status, nil
 }
 
 func isPrivateV4(ip net.IP) bool {
@@ -423,15 +497,28 @@ func isPrivateV6(ip net.IP) bool {
 	return false
 }
 
-func (dnsClient *DNSClientImpl) lookupIP(ctx context.Context, hostname string, ipType uint16) ([]dns.RR, error) {
+// IsReservedIP returns true if the given IPv4 or IPv6 address is in one of the
+// private or reserved ranges that the VA refuses to contact.
+func IsReservedIP(ip net.IP) bool {
+	if ip.To4() != nil {
+		return isPrivateV4(ip)
+	}
+	return isPrivateV6(ip)
+}
+
+// lookupIP returns the answer to a query for the A or AAAA records for
+// hostname, along with its DNSSEC status. Unlike for TXT and CAA lookups, an
+// answer that fails DNSSEC validation isn't an error.
+func (dnsClient *DNSClientImpl) lookupIP(ctx context.Context, hostname string, ipType uint16) ([]dns.RR, DNSSECStatus, error) {
 	resp, err := dnsClient.exchangeOne(ctx, hostname, ipType)
 	if err != nil {
-		return nil, &DNSError{ipType, hostname, err, -1}
+		return nil, DNSSECUnchecked, &DNSError{ipType, hostname, err, -1}
 	}
 	if resp.Rcode != dns.RcodeSuccess {
-		return nil, &DNSError{ipType, hostname, nil, resp.Rcode}
+		return nil, DNSSECUnchecked, &DNSError{ipType, hostname, nil, resp.Rcode}
 	}
-	return resp.Answer, nil
+	status, _ := dnsClient.validate(ctx, hostname, ipType, resp)
+	return resp.Answer, status, nil
 }
 
 // LookupHost sends a DNS query to find all A and AAAA records associated with
@@ -439,26 +526,28 @@ func (dnsClient *DNSClientImpl) lookupIP(ctx context.Context, hostname string, i
 // chase CNAME/DNAME aliases and return relevant records.  It will retry
 // requests in the case of temporary network errors. It can return net package,
 // context.Canceled, and context.DeadlineExceeded errors, all wrapped in the
-// DNSError type.
-func (dnsClient *DNSClientImpl) LookupHost(ctx context.Context, hostname string) ([]net.IP, error) {
+// DNSError type. The returned DNSSEC status is the weaker of the statuses of
+// the A and AAAA answers.
+func (dnsClient *DNSClientImpl) LookupHost(ctx context.Context, hostname string) ([]net.IP, DNSSECStatus, error) {
 	var recordsA, recordsAAAA []dns.RR
+	var statusA, statusAAAA DNSSECStatus
 	var errA, errAAAA error
 	var wg sync.WaitGroup
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		recordsA, errA = dnsClient.lookupIP(ctx, hostname, dns.TypeA)
+		recordsA, statusA, errA = dnsClient.lookupIP(ctx, hostname, dns.TypeA)
 	}()
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		recordsAAAA, errAAAA = dnsClient.lookupIP(ctx, hostname, dns.TypeAAAA)
+		recordsAAAA, statusAAAA, errAAAA = dnsClient.lookupIP(ctx, hostname, dns.TypeAAAA)
 	}()
 	wg.Wait()
 
 	if errA != nil && errAAAA != nil {
-		return nil, errA
+		return nil, DNSSECUnchecked, errA
 	}
 
 	var addrs []net.IP
@@ -478,20 +567,26 @@ func (dnsClient *DNSClientImpl) LookupHost(ctx context.Context, hostname string)
 		}
 	}
 
-	return addrs, nil
+	return addrs, WorseDNSSECStatus(statusA, statusAAAA), nil
 }
 
 // LookupCAA sends a DNS query to find all CAA records associated with
-// the provided hostname.
-func (dnsClient *DNSClientImpl) LookupCAA(ctx context.Context, hostname string) ([]*dns.CAA, error) {
+// the provided hostname, and returns them along with the DNSSEC status of the
+// answer. An answer that fails DNSSEC validation is returned as an error.
+func (dnsClient *DNSClientImpl) LookupCAA(ctx context.Context, hostname string) ([]*dns.CAA, DNSSECStatus, error) {
 	dnsType := dns.TypeCAA
-	r, err := dnsClient.exchangeOne(ctx, hostname, dnsType)
+	r, err := dnsClient.lookup(ctx, hostname, dnsType)
 	if err != nil {
-		return nil, &DNSError{dnsType, hostname, err, -1}
+		return nil, DNSSECUnchecked, &DNSError{dnsType, hostname, err, -1}
 	}
 
 	if r.Rcode == dns.RcodeServerFailure {
-		return nil, &DNSError{dnsType, hostname, nil, r.Rcode}
+		return nil, DNSSECUnchecked, &DNSError{dnsType, hostname, nil, r.Rcode}
+	}
+
+	status, err := dnsClient.validate(ctx, hostname, dnsType, r)
+	if err != nil {
+		return nil, status, &DNSError{dnsType, hostname, err, -1}
 	}
 
 	var CAAs []*dns.CAA
@@ -500,7 +595,7 @@ func (dnsClient *DNSClientImpl) LookupCAA(ctx context.Context, hostname string)
 			CAAs = append(CAAs, caaR)
 		}
 	}
-	return CAAs, nil
+	return CAAs, status, nil
 }
 
 // logDNSError logs the provided err result from making a query for hostname to
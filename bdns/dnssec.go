@@ -0,0 +1,748 @@
+package bdns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// DNSSECStatus is the result of validating the DNSSEC chain of trust for a DNS
+// answer, as described in RFC 4033 Section 5.
+type DNSSECStatus string
+
+const (
+	// DNSSECUnchecked means that no DNSSEC validation was performed because
+	// the client isn't configured with any trust anchors.
+	DNSSECUnchecked = DNSSECStatus("")
+	// DNSSECSecure means there is an unbroken chain of signed DNSKEY and DS
+	// records from a trust anchor to the signed answer.
+	DNSSECSecure = DNSSECStatus("secure")
+	// DNSSECInsecure means there is a signed proof that the answer is in a zone
+	// delegated without DS records, or that it is outside of every trust
+	// anchor.
+	DNSSECInsecure = DNSSECStatus("insecure")
+	// DNSSECBogus means the answer should have been signed, but it, or the
+	// chain of trust to it, could not be validated.
+	DNSSECBogus = DNSSECStatus("bogus")
+)
+
+// dnssecStatusRank orders statuses from most to least trustworthy so that the
+// status of an answer made up of several RRsets is that of the weakest one.
+var dnssecStatusRank = map[DNSSECStatus]int{
+	DNSSECUnchecked: 0,
+	DNSSECSecure:    1,
+	DNSSECInsecure:  2,
+	DNSSECBogus:     3,
+}
+
+// WorseDNSSECStatus returns the less trustworthy of two statuses.
+func WorseDNSSECStatus(a, b DNSSECStatus) DNSSECStatus {
+	if dnssecStatusRank[b] > dnssecStatusRank[a] {
+		return b
+	}
+	return a
+}
+
+// maxDNSSECCacheTTL caps how long validated delegations are cached for,
+// regardless of the TTLs of the records involved.
+const maxDNSSECCacheTTL = time.Hour
+
+// bogusError describes why an answer failed DNSSEC validation.
+type bogusError struct {
+	reason string
+}
+
+func (e bogusError) Error() string {
+	return fmt.Sprintf("DNSSEC validation failure (%s)", e.reason)
+}
+
+func bogusf(format string, args ...interface{}) error {
+	return bogusError{reason: fmt.Sprintf(format, args...)}
+}
+
+// delegationKind is what a DS lookup tells us about a name below a signed
+// zone.
+type delegationKind int
+
+const (
+	// notZoneCut means the name is part of its parent's zone (or doesn't
+	// exist), so the parent's keys still apply below it.
+	notZoneCut = delegationKind(iota)
+	// secureZoneCut means the name is the apex of a zone whose DNSKEYs were
+	// validated using DS records in its parent.
+	secureZoneCut
+	// insecureZoneCut means the name is delegated without DS records, so
+	// nothing at or below it can be validated.
+	insecureZoneCut
+)
+
+// delegation is a cached result of looking up the DS records for a name.
+type delegation struct {
+	kind    delegationKind
+	keys    []*dns.DNSKEY
+	expires time.Time
+}
+
+// dnssecValidator holds the trust anchors and the cache of validated
+// delegations used by a DNSClientImpl to validate DNSSEC itself, rather than
+// trusting the AD bit set by its recursive resolvers.
+type dnssecValidator struct {
+	// anchors maps zone names in canonical form to the DS records of their
+	// trusted keys.
+	anchors map[string][]dns.RR
+
+	mu          sync.Mutex
+	delegations map[string]delegation
+}
+
+// EnableDNSSEC configures the client to validate the DNSSEC chain of trust for
+// the answers to its queries, starting from the given trust anchors. Each
+// trust anchor is a DS record in presentation format, e.g. the root zone's
+// ". IN DS 20326 8 2 E06D44B8...". The responses to CAA and TXT lookups that
+// fail validation are returned as errors.
+func (dnsClient *DNSClientImpl) EnableDNSSEC(trustAnchors []string) error {
+	if len(trustAnchors) == 0 {
+		return fmt.Errorf("no DNSSEC trust anchors provided")
+	}
+	anchors := make(map[string][]dns.RR)
+	for _, anchor := range trustAnchors {
+		rr, err := dns.NewRR(anchor)
+		if err != nil {
+			return fmt.Errorf("parsing DNSSEC trust anchor %q: %s", anchor, err)
+		}
+		ds, ok := rr.(*dns.DS)
+		if !ok {
+			return fmt.Errorf("DNSSEC trust anchor %q is not a DS record", anchor)
+		}
+		zone := dns.CanonicalName(ds.Hdr.Name)
+		anchors[zone] = append(anchors[zone], ds)
+	}
+	dnsClient.dnssec = &dnssecValidator{
+		anchors:     anchors,
+		delegations: make(map[string]delegation),
+	}
+	return nil
+}
+
+// anchorFor returns the closest zone enclosing name that has a trust anchor,
+// or "" if there isn't one.
+func (v *dnssecValidator) anchorFor(name string) string {
+	labels := dns.SplitDomainName(name)
+	for i := 0; i <= len(labels); i++ {
+		zone := dns.Fqdn(strings.Join(labels[i:], "."))
+		if _, ok := v.anchors[zone]; ok {
+			return zone
+		}
+	}
+	return ""
+}
+
+func (v *dnssecValidator) cached(name string, now time.Time) (delegation, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	d, ok := v.delegations[name]
+	if !ok || now.After(d.expires) {
+		return delegation{}, false
+	}
+	return d, true
+}
+
+func (v *dnssecValidator) store(name string, d delegation) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.delegations[name] = d
+}
+
+// rrSet is a set of records with the same owner name and type, along with the
+// RRSIGs covering them.
+type rrSet struct {
+	name   string
+	rrtype uint16
+	rrs    []dns.RR
+	sigs   []*dns.RRSIG
+}
+
+func (set *rrSet) String() string {
+	return fmt.Sprintf("%s %s", set.name, dns.TypeToString[set.rrtype])
+}
+
+// ttl returns the lowest TTL of the records in the set.
+func (set *rrSet) ttl() time.Duration {
+	ttl := maxDNSSECCacheTTL
+	for _, rr := range set.rrs {
+		if t := time.Duration(rr.Header().Ttl) * time.Second; t < ttl {
+			ttl = t
+		}
+	}
+	return ttl
+}
+
+// splitRRSets groups records into RRsets, attaching the RRSIGs that cover
+// each one. The order in which RRsets first appear is preserved.
+func splitRRSets(rrs []dns.RR) []*rrSet {
+	var sets []*rrSet
+	find := func(name string, rrtype uint16) *rrSet {
+		for _, set := range sets {
+			if set.name == name && set.rrtype == rrtype {
+				return set
+			}
+		}
+		set := &rrSet{name: name, rrtype: rrtype}
+		sets = append(sets, set)
+		return set
+	}
+	for _, rr := range rrs {
+		name := dns.CanonicalName(rr.Header().Name)
+		if sig, ok := rr.(*dns.RRSIG); ok {
+			set := find(name, sig.TypeCovered)
+			set.sigs = append(set.sigs, sig)
+			continue
+		}
+		set := find(name, rr.Header().Rrtype)
+		set.rrs = append(set.rrs, rr)
+	}
+	// Drop the sets that only had signatures.
+	var result []*rrSet
+	for _, set := range sets {
+		if len(set.rrs) > 0 {
+			result = append(result, set)
+		}
+	}
+	return result
+}
+
+func findRRSet(sets []*rrSet, name string, rrtype uint16) *rrSet {
+	for _, set := range sets {
+		if set.name == name && set.rrtype == rrtype {
+			return set
+		}
+	}
+	return nil
+}
+
+// verifyRRSet checks that at least one of the RRSIGs made by the signer zone
+// over the set is currently valid and verifies with one of the zone's keys,
+// and returns that RRSIG.
+func (dnsClient *DNSClientImpl) verifyRRSet(set *rrSet, signer string, keys []*dns.DNSKEY) (*dns.RRSIG, error) {
+	now := dnsClient.clk.Now()
+	for _, sig := range set.sigs {
+		if dns.CanonicalName(sig.SignerName) != signer || !sig.ValidityPeriod(now) {
+			continue
+		}
+		for _, key := range keys {
+			if key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm {
+				continue
+			}
+			if sig.Verify(key, set.rrs) == nil {
+				return sig, nil
+			}
+		}
+	}
+	return nil, bogusf("no valid signature by %s over %s", signer, set)
+}
+
+// fetchKeys looks up the DNSKEY records for zone and returns them if they are
+// signed by a key that matches one of the given DS records, along with how
+// long they can be cached for.
+func (dnsClient *DNSClientImpl) fetchKeys(ctx context.Context, zone string, dsRRs []dns.RR) ([]*dns.DNSKEY, time.Duration, error) {
+	resp, err := dnsClient.exchangeOne(ctx, zone, dns.TypeDNSKEY)
+	if err != nil {
+		return nil, 0, bogusf("looking up DNSKEY for %s: %s", zone, err)
+	}
+	set := findRRSet(splitRRSets(resp.Answer), zone, dns.TypeDNSKEY)
+	if set == nil {
+		return nil, 0, bogusf("no DNSKEY records for %s", zone)
+	}
+	var keys, trusted []*dns.DNSKEY
+	for _, rr := range set.rrs {
+		key, ok := rr.(*dns.DNSKEY)
+		if !ok || key.Flags&dns.ZONE == 0 {
+			continue
+		}
+		keys = append(keys, key)
+		for _, rr := range dsRRs {
+			ds := rr.(*dns.DS)
+			if key.KeyTag() != ds.KeyTag || key.Algorithm != ds.Algorithm {
+				continue
+			}
+			if digest := key.ToDS(ds.DigestType); digest != nil && strings.EqualFold(digest.Digest, ds.Digest) {
+				trusted = append(trusted, key)
+			}
+		}
+	}
+	if len(trusted) == 0 {
+		return nil, 0, bogusf("no DNSKEY for %s matches its DS records", zone)
+	}
+	if _, err := dnsClient.verifyRRSet(set, zone, trusted); err != nil {
+		return nil, 0, err
+	}
+	return keys, set.ttl(), nil
+}
+
+// lookupDelegation looks up the DS records for name, which is below the
+// signed zone parent, to find out whether name is the apex of a signed zone,
+// the apex of an unsigned zone, or not a zone cut at all.
+func (dnsClient *DNSClientImpl) lookupDelegation(ctx context.Context, parent string, parentKeys []*dns.DNSKEY, name string) (delegation, error) {
+	now := dnsClient.clk.Now()
+	if d, ok := dnsClient.dnssec.cached(name, now); ok {
+		return d, nil
+	}
+
+	resp, err := dnsClient.exchangeOne(ctx, name, dns.TypeDS)
+	if err != nil {
+		return delegation{}, bogusf("looking up DS for %s: %s", name, err)
+	}
+	if resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError {
+		return delegation{}, bogusf("looking up DS for %s: %s", name, dns.RcodeToString[resp.Rcode])
+	}
+
+	if ds := findRRSet(splitRRSets(resp.Answer), name, dns.TypeDS); ds != nil {
+		if _, err := dnsClient.verifyRRSet(ds, parent, parentKeys); err != nil {
+			return delegation{}, err
+		}
+		keys, ttl, err := dnsClient.fetchKeys(ctx, name, ds.rrs)
+		if err != nil {
+			return delegation{}, err
+		}
+		if dsTTL := ds.ttl(); dsTTL < ttl {
+			ttl = dsTTL
+		}
+		d := delegation{kind: secureZoneCut, keys: keys, expires: now.Add(ttl)}
+		dnsClient.dnssec.store(name, d)
+		return d, nil
+	}
+
+	// There are no DS records, so the parent must have signed a proof of that
+	// which also says whether name is delegated.
+	ttl := maxDNSSECCacheTTL
+	for _, set := range splitRRSets(resp.Ns) {
+		if set.rrtype != dns.TypeNSEC && set.rrtype != dns.TypeNSEC3 {
+			continue
+		}
+		if _, err := dnsClient.verifyRRSet(set, parent, parentKeys); err != nil {
+			return delegation{}, err
+		}
+		if setTTL := set.ttl(); setTTL < ttl {
+			ttl = setTTL
+		}
+		for _, rr := range set.rrs {
+			kind, ok := dsDenial(rr, name)
+			if !ok {
+				continue
+			}
+			if kind == secureZoneCut {
+				return delegation{}, bogusf("DS records for %s are denied by a proof that says they exist", name)
+			}
+			d := delegation{kind: kind, expires: now.Add(ttl)}
+			dnsClient.dnssec.store(name, d)
+			return d, nil
+		}
+	}
+	return delegation{}, bogusf("no proof that %s has no DS records", name)
+}
+
+// dsDenial interprets an NSEC or NSEC3 record as proof about the DS records
+// for name. The returned bool is false if the record says nothing about name.
+func dsDenial(rr dns.RR, name string) (delegationKind, bool) {
+	var bitmap []uint16
+	switch proof := rr.(type) {
+	case *dns.NSEC:
+		if dns.CanonicalName(proof.Hdr.Name) != name {
+			// A name covered by an NSEC record doesn't exist, and neither does
+			// anything below it, so it isn't a zone cut.
+			return notZoneCut, nsecCovers(proof, name)
+		}
+		bitmap = proof.TypeBitMap
+	case *dns.NSEC3:
+		if !proof.Match(name) {
+			if !proof.Cover(name) {
+				return notZoneCut, false
+			}
+			// With opt-out, a covered name may be an unsigned delegation.
+			if proof.Flags&1 == 1 {
+				return insecureZoneCut, true
+			}
+			return notZoneCut, true
+		}
+		bitmap = proof.TypeBitMap
+	default:
+		return notZoneCut, false
+	}
+	switch {
+	case hasType(bitmap, dns.TypeDS):
+		return secureZoneCut, true
+	case hasType(bitmap, dns.TypeNS) && !hasType(bitmap, dns.TypeSOA):
+		return insecureZoneCut, true
+	default:
+		return notZoneCut, true
+	}
+}
+
+func hasType(bitmap []uint16, rrtype uint16) bool {
+	for _, t := range bitmap {
+		if t == rrtype {
+			return true
+		}
+	}
+	return false
+}
+
+// canonicalCompare compares two names in canonical form using the DNSSEC
+// canonical ordering from RFC 4034 Section 6.1, returning -1, 0 or 1.
+func canonicalCompare(a, b string) int {
+	aLabels, bLabels := dns.SplitDomainName(a), dns.SplitDomainName(b)
+	for i, j := len(aLabels)-1, len(bLabels)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if c := strings.Compare(aLabels[i], bLabels[j]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(aLabels) < len(bLabels):
+		return -1
+	case len(aLabels) > len(bLabels):
+		return 1
+	}
+	return 0
+}
+
+// nsecCovers returns true if name falls strictly between the owner and next
+// names of an NSEC record.
+func nsecCovers(nsec *dns.NSEC, name string) bool {
+	owner, next := dns.CanonicalName(nsec.Hdr.Name), dns.CanonicalName(nsec.NextDomain)
+	if canonicalCompare(owner, next) < 0 {
+		return canonicalCompare(owner, name) < 0 && canonicalCompare(name, next) < 0
+	}
+	// The last NSEC record in a zone points back to the apex.
+	return canonicalCompare(owner, name) < 0
+}
+
+// closestZone walks down from the closest trust anchor towards name, one
+// label at a time, following signed delegations. It returns the deepest
+// signed zone enclosing name along with its validated keys. If it finds a
+// delegation without DS records on the way, or there is no trust anchor
+// enclosing name, the returned status is DNSSECInsecure.
+func (dnsClient *DNSClientImpl) closestZone(ctx context.Context, name string) (string, []*dns.DNSKEY, DNSSECStatus, error) {
+	zone := dnsClient.dnssec.anchorFor(name)
+	if zone == "" {
+		return "", nil, DNSSECInsecure, nil
+	}
+
+	now := dnsClient.clk.Now()
+	d, ok := dnsClient.dnssec.cached(zone, now)
+	if !ok {
+		keys, ttl, err := dnsClient.fetchKeys(ctx, zone, dnsClient.dnssec.anchors[zone])
+		if err != nil {
+			return "", nil, DNSSECBogus, err
+		}
+		d = delegation{kind: secureZoneCut, keys: keys, expires: now.Add(ttl)}
+		dnsClient.dnssec.store(zone, d)
+	}
+	keys := d.keys
+
+	labels := dns.SplitDomainName(name)
+	for i := len(labels) - dns.CountLabel(zone) - 1; i >= 0; i-- {
+		child := dns.Fqdn(strings.Join(labels[i:], "."))
+		d, err := dnsClient.lookupDelegation(ctx, zone, keys, child)
+		if err != nil {
+			return "", nil, DNSSECBogus, err
+		}
+		switch d.kind {
+		case secureZoneCut:
+			zone, keys = child, d.keys
+		case insecureZoneCut:
+			return "", nil, DNSSECInsecure, nil
+		}
+	}
+	return zone, keys, DNSSECSecure, nil
+}
+
+// validateRRSet validates a single RRset from the answer of resp. If the
+// RRset was expanded from a wildcard, the authority section of resp must also
+// prove that there was no exact match for its name (RFC 4035 Section 5.3.4).
+func (dnsClient *DNSClientImpl) validateRRSet(ctx context.Context, set *rrSet, resp *dns.Msg) (DNSSECStatus, error) {
+	zone, keys, status, err := dnsClient.closestZone(ctx, set.name)
+	if err != nil || status == DNSSECInsecure {
+		return status, err
+	}
+	if len(set.sigs) == 0 {
+		return DNSSECBogus, bogusf("%s is unsigned in the signed zone %s", set, zone)
+	}
+	sig, err := dnsClient.verifyRRSet(set, zone, keys)
+	if err != nil {
+		return DNSSECBogus, err
+	}
+	if int(sig.Labels) < dns.CountLabel(set.name) {
+		nsecs, nsec3s, err := dnsClient.denialProofs(zone, keys, resp)
+		if err != nil {
+			return DNSSECBogus, err
+		}
+		if !nsecNoExactMatch(nsecs, set.name) && !nsec3NoExactMatch(nsec3s, set.name, int(sig.Labels)) {
+			return DNSSECBogus, bogusf("no proof that there is no exact match for the wildcard expansion %s in the signed zone %s",
+				set, zone)
+		}
+	}
+	return DNSSECSecure, nil
+}
+
+// denialProofs returns the NSEC and NSEC3 records from the authority section
+// of resp, after checking that they are signed by zone.
+func (dnsClient *DNSClientImpl) denialProofs(zone string, keys []*dns.DNSKEY, resp *dns.Msg) ([]*dns.NSEC, []*dns.NSEC3, error) {
+	var nsecs []*dns.NSEC
+	var nsec3s []*dns.NSEC3
+	for _, set := range splitRRSets(resp.Ns) {
+		if set.rrtype != dns.TypeNSEC && set.rrtype != dns.TypeNSEC3 {
+			continue
+		}
+		if _, err := dnsClient.verifyRRSet(set, zone, keys); err != nil {
+			return nil, nil, err
+		}
+		for _, rr := range set.rrs {
+			switch proof := rr.(type) {
+			case *dns.NSEC:
+				nsecs = append(nsecs, proof)
+			case *dns.NSEC3:
+				nsec3s = append(nsec3s, proof)
+			}
+		}
+	}
+	return nsecs, nsec3s, nil
+}
+
+// validateDenial validates that the authority section of resp proves that
+// name has no records of type qtype, with the NSEC or NSEC3 records required
+// by RFC 4035 Section 5.4 or RFC 5155 Section 8 respectively.
+func (dnsClient *DNSClientImpl) validateDenial(ctx context.Context, name string, qtype uint16, resp *dns.Msg) (DNSSECStatus, error) {
+	zone, keys, status, err := dnsClient.closestZone(ctx, name)
+	if err != nil || status == DNSSECInsecure {
+		return status, err
+	}
+	nsecs, nsec3s, err := dnsClient.denialProofs(zone, keys, resp)
+	if err != nil {
+		return DNSSECBogus, err
+	}
+	if nsecDenies(nsecs, name, qtype) || nsec3Denies(nsec3s, zone, name, qtype) {
+		return DNSSECSecure, nil
+	}
+	return DNSSECBogus, bogusf("no proof that %s %s does not exist in the signed zone %s",
+		name, dns.TypeToString[qtype], zone)
+}
+
+// bitmapDenies returns true if a type bitmap for a name includes neither
+// qtype nor a CNAME that could lead to records of qtype.
+func bitmapDenies(bitmap []uint16, qtype uint16) bool {
+	return !hasType(bitmap, qtype) && !hasType(bitmap, dns.TypeCNAME)
+}
+
+// wildcardAt returns the wildcard name directly below encloser.
+func wildcardAt(encloser string) string {
+	if encloser == "." {
+		return "*."
+	}
+	return "*." + encloser
+}
+
+// nsecDenies returns true if nsecs prove that name has no records of type
+// qtype. Either name exists and its NSEC record's bitmap doesn't include
+// qtype, or an NSEC record covers name and another (or the same) one proves
+// that the wildcard at its closest encloser doesn't exist, or exists without
+// qtype.
+func nsecDenies(nsecs []*dns.NSEC, name string, qtype uint16) bool {
+	for _, nsec := range nsecs {
+		if dns.CanonicalName(nsec.Hdr.Name) == name {
+			return bitmapDenies(nsec.TypeBitMap, qtype)
+		}
+	}
+	for _, nsec := range nsecs {
+		if !nsecCovers(nsec, name) || nsecAtDelegationAbove(nsec, name) {
+			continue
+		}
+		wildcard := wildcardAt(nsecClosestEncloser(nsec, name))
+		for _, w := range nsecs {
+			if dns.CanonicalName(w.Hdr.Name) == wildcard {
+				if bitmapDenies(w.TypeBitMap, qtype) {
+					return true
+				}
+			} else if nsecCovers(w, wildcard) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nsecAtDelegationAbove returns true if nsec is from a delegation point above
+// name. The parent zone is only authoritative for the delegation itself, so
+// such a record can't prove that a name below it doesn't exist.
+func nsecAtDelegationAbove(nsec *dns.NSEC, name string) bool {
+	owner := dns.CanonicalName(nsec.Hdr.Name)
+	if !dns.IsSubDomain(owner, name) {
+		return false
+	}
+	return hasType(nsec.TypeBitMap, dns.TypeDNAME) ||
+		(hasType(nsec.TypeBitMap, dns.TypeNS) && !hasType(nsec.TypeBitMap, dns.TypeSOA))
+}
+
+// nsecClosestEncloser returns the closest encloser of name, a name covered by
+// nsec: the longest ancestor of name that exists, which is the longest one
+// shared with either the owner or the next name of nsec.
+func nsecClosestEncloser(nsec *dns.NSEC, name string) string {
+	common := dns.CompareDomainName(name, nsec.Hdr.Name)
+	if n := dns.CompareDomainName(name, nsec.NextDomain); n > common {
+		common = n
+	}
+	labels := dns.SplitDomainName(name)
+	if common == 0 {
+		return "."
+	}
+	return dns.Fqdn(strings.Join(labels[len(labels)-common:], "."))
+}
+
+// nsecNoExactMatch returns true if one of nsecs proves that name, the owner
+// of an RRset expanded from a wildcard, doesn't exist.
+func nsecNoExactMatch(nsecs []*dns.NSEC, name string) bool {
+	for _, nsec := range nsecs {
+		if nsecCovers(nsec, name) && !nsecAtDelegationAbove(nsec, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// nsec3Covers returns true if an NSEC3 record covers name without matching it.
+func nsec3Covers(nsec3 *dns.NSEC3, name string) bool {
+	return nsec3.Cover(name) && !nsec3.Match(name)
+}
+
+// nsec3Denies returns true if nsec3s prove that name, in zone, has no records
+// of type qtype. Either an NSEC3 record matches name and its bitmap doesn't
+// include qtype, or there is a closest encloser proof for name (RFC 5155
+// Section 8.3) along with an NSEC3 record that either covers the wildcard at
+// the closest encloser or matches it without qtype in its bitmap.
+func nsec3Denies(nsec3s []*dns.NSEC3, zone, name string, qtype uint16) bool {
+	for _, nsec3 := range nsec3s {
+		if nsec3.Match(name) {
+			return bitmapDenies(nsec3.TypeBitMap, qtype)
+		}
+	}
+	encloser, ok := nsec3ClosestEncloser(nsec3s, zone, name)
+	if !ok {
+		return false
+	}
+	wildcard := wildcardAt(encloser)
+	for _, nsec3 := range nsec3s {
+		if nsec3.Match(wildcard) {
+			return bitmapDenies(nsec3.TypeBitMap, qtype)
+		}
+	}
+	for _, nsec3 := range nsec3s {
+		if nsec3Covers(nsec3, wildcard) {
+			return true
+		}
+	}
+	return false
+}
+
+// nsec3ClosestEncloser finds the closest encloser of name: its longest
+// ancestor, no higher than zone, matched by one of nsec3s. The proof is only
+// complete if the next closer name, the ancestor of name one label longer
+// than the closest encloser, is covered by one of nsec3s.
+func nsec3ClosestEncloser(nsec3s []*dns.NSEC3, zone, name string) (string, bool) {
+	labels := dns.SplitDomainName(name)
+	for i := 1; i <= len(labels)-dns.CountLabel(zone); i++ {
+		candidate := dns.Fqdn(strings.Join(labels[i:], "."))
+		matched := false
+		for _, nsec3 := range nsec3s {
+			if nsec3.Match(candidate) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		nextCloser := dns.Fqdn(strings.Join(labels[i-1:], "."))
+		for _, nsec3 := range nsec3s {
+			if nsec3Covers(nsec3, nextCloser) {
+				return candidate, true
+			}
+		}
+		return "", false
+	}
+	return "", false
+}
+
+// nsec3NoExactMatch returns true if one of nsec3s proves that name, the owner
+// of an RRset expanded from a wildcard with the given RRSIG label count,
+// doesn't exist. The closest encloser is the wildcard's parent, so only the
+// next closer name needs to be covered (RFC 5155 Section 8.8).
+func nsec3NoExactMatch(nsec3s []*dns.NSEC3, name string, sigLabels int) bool {
+	labels := dns.SplitDomainName(name)
+	if sigLabels >= len(labels) {
+		return false
+	}
+	nextCloser := dns.Fqdn(strings.Join(labels[len(labels)-sigLabels-1:], "."))
+	for _, nsec3 := range nsec3s {
+		if nsec3Covers(nsec3, nextCloser) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateResponse classifies the DNSSEC status of resp, the response to a
+// query for qname and qtype. Each RRset in the answer section is validated,
+// and if following any CNAMEs from qname doesn't lead to records of qtype the
+// authority section must prove that there are none. If the status is
+// DNSSECBogus the returned error says why.
+func (dnsClient *DNSClientImpl) validateResponse(ctx context.Context, qname string, qtype uint16, resp *dns.Msg) (DNSSECStatus, error) {
+	if dnsClient.dnssec == nil {
+		return DNSSECUnchecked, nil
+	}
+
+	status := DNSSECSecure
+	answers := splitRRSets(resp.Answer)
+	for _, set := range answers {
+		if set.rrtype == dns.TypeCNAME && len(set.sigs) == 0 && synthesizedFromDNAME(answers, set.name) {
+			// CNAMEs synthesized from a DNAME are never signed; the DNAME is.
+			continue
+		}
+		s, err := dnsClient.validateRRSet(ctx, set, resp)
+		if err != nil {
+			return DNSSECBogus, err
+		}
+		status = WorseDNSSECStatus(status, s)
+	}
+
+	target := dns.CanonicalName(qname)
+	for range answers {
+		cname := findRRSet(answers, target, dns.TypeCNAME)
+		if cname == nil || qtype == dns.TypeCNAME {
+			break
+		}
+		target = dns.CanonicalName(cname.rrs[0].(*dns.CNAME).Target)
+	}
+	if findRRSet(answers, target, qtype) != nil {
+		return status, nil
+	}
+
+	s, err := dnsClient.validateDenial(ctx, target, qtype, resp)
+	if err != nil {
+		return DNSSECBogus, err
+	}
+	return WorseDNSSECStatus(status, s), nil
+}
+
+// synthesizedFromDNAME returns true if there is a DNAME in the answer for an
+// ancestor of name.
+func synthesizedFromDNAME(answers []*rrSet, name string) bool {
+	for _, set := range answers {
+		if set.rrtype == dns.TypeDNAME && set.name != name && dns.IsSubDomain(set.name, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,425 @@
+package bdns
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/miekg/dns"
+)
+
+// testZone is a DNSSEC signed zone with a single key.
+type testZone struct {
+	name string
+	key  *dns.DNSKEY
+	priv crypto.Signer
+	clk  clock.Clock
+}
+
+func newTestZone(t *testing.T, name string, clk clock.Clock) *testZone {
+	t.Helper()
+	key := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: name, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     dns.ZONE | dns.SEP,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	priv, err := key.Generate(256)
+	test.AssertNotError(t, err, "generating zone key")
+	return &testZone{name: name, key: key, priv: priv.(crypto.Signer), clk: clk}
+}
+
+// ds returns the DS record for the zone's key, to be served by its parent.
+func (z *testZone) ds() *dns.DS {
+	return z.key.ToDS(dns.SHA256)
+}
+
+// sign returns the records followed by the zone's RRSIG over them.
+func (z *testZone) sign(t *testing.T, rrs ...dns.RR) []dns.RR {
+	t.Helper()
+	hdr := rrs[0].Header()
+	sig := &dns.RRSIG{
+		Hdr:        dns.RR_Header{Name: hdr.Name, Rrtype: dns.TypeRRSIG, Class: dns.ClassINET, Ttl: hdr.Ttl},
+		Algorithm:  z.key.Algorithm,
+		Inception:  uint32(z.clk.Now().Add(-24 * time.Hour).Unix()),
+		Expiration: uint32(z.clk.Now().Add(24 * time.Hour).Unix()),
+		KeyTag:     z.key.KeyTag(),
+		SignerName: z.name,
+	}
+	err := sig.Sign(z.priv, rrs)
+	test.AssertNotError(t, err, "signing RRset")
+	return append(rrs, sig)
+}
+
+// nsec3Chain returns the NSEC3 records, without salt or extra iterations, of
+// a zone whose names each have the given types, keyed by those names.
+func nsec3Chain(zone string, names map[string][]uint16) map[string]*dns.NSEC3 {
+	hashes := make(map[string]string, len(names))
+	var sorted []string
+	for name := range names {
+		hash := dns.HashName(name, dns.SHA1, 0, "")
+		hashes[hash] = name
+		sorted = append(sorted, hash)
+	}
+	sort.Strings(sorted)
+	chain := make(map[string]*dns.NSEC3, len(names))
+	for i, hash := range sorted {
+		chain[hashes[hash]] = &dns.NSEC3{
+			Hdr:        dns.RR_Header{Name: hash + "." + zone, Rrtype: dns.TypeNSEC3, Class: dns.ClassINET, Ttl: 300},
+			Hash:       dns.SHA1,
+			HashLength: 20,
+			NextDomain: sorted[(i+1)%len(sorted)],
+			TypeBitMap: names[hashes[hash]],
+		}
+	}
+	return chain
+}
+
+func mustRR(t *testing.T, s string) dns.RR {
+	t.Helper()
+	rr, err := dns.NewRR(s)
+	test.AssertNotError(t, err, fmt.Sprintf("parsing %q", s))
+	return rr
+}
+
+// zoneExchanger is an authoritative stand-in that serves fixed responses
+// keyed by "name TYPE". Queries without a response get a SERVFAIL.
+type zoneExchanger struct {
+	t         *testing.T
+	responses map[string]*dns.Msg
+}
+
+func (e *zoneExchanger) Exchange(m *dns.Msg, _ string) (*dns.Msg, time.Duration, error) {
+	if opt := m.IsEdns0(); opt == nil || !opt.Do() || !m.CheckingDisabled {
+		e.t.Errorf("query for %s wasn't sent with the DO and CD bits set", m.Question[0].Name)
+	}
+	q := m.Question[0]
+	r := new(dns.Msg)
+	r.SetReply(m)
+	resp, ok := e.responses[fmt.Sprintf("%s %s", q.Name, dns.TypeToString[q.Qtype])]
+	if !ok {
+		r.Rcode = dns.RcodeServerFailure
+		return r, time.Millisecond, nil
+	}
+	r.Answer = resp.Answer
+	r.Ns = resp.Ns
+	r.Rcode = resp.Rcode
+	return r, time.Millisecond, nil
+}
+
+func setupDNSSEC(t *testing.T) *DNSClientImpl {
+	clk := clock.NewFake()
+	clk.Set(time.Date(2020, 10, 18, 0, 0, 0, 0, time.UTC))
+	example := newTestZone(t, "example.", clk)
+	secure := newTestZone(t, "secure.example.", clk)
+	bogus := newTestZone(t, "bogus.example.", clk)
+	hashed := newTestZone(t, "nsec3.example.", clk)
+
+	answer := func(rrs ...dns.RR) *dns.Msg {
+		return &dns.Msg{Answer: rrs}
+	}
+	denial := func(rrs ...dns.RR) *dns.Msg {
+		return &dns.Msg{Ns: rrs}
+	}
+	nxdomain := func(rrs ...dns.RR) *dns.Msg {
+		return &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeNameError}, Ns: rrs}
+	}
+
+	// The NSEC records of secure.example., in canonical order.
+	apexNSEC := secure.sign(t,
+		mustRR(t, "secure.example. 300 IN NSEC _acme-challenge.secure.example. NS SOA RRSIG NSEC DNSKEY"))
+	challengeNSEC := secure.sign(t,
+		mustRR(t, "_acme-challenge.secure.example. 300 IN NSEC stripped.secure.example. TXT RRSIG NSEC"))
+
+	// The NSEC3 records of nsec3.example. The hashes of the names looked up
+	// in it fall between them so that:
+	//   - gone.nsec3.example. is covered by the record for mail,
+	//   - nowildcard.nsec3.example. is covered by the record for _acme-challenge,
+	//   - *.nsec3.example. is covered by the record for www.
+	chain := nsec3Chain("nsec3.example.", map[string][]uint16{
+		"nsec3.example.":                 {dns.TypeNS, dns.TypeSOA, dns.TypeRRSIG, dns.TypeDNSKEY, dns.TypeNSEC3PARAM},
+		"_acme-challenge.nsec3.example.": {dns.TypeTXT, dns.TypeRRSIG},
+		"mail.nsec3.example.":            {dns.TypeMX, dns.TypeRRSIG},
+		"www.nsec3.example.":             {dns.TypeA, dns.TypeRRSIG},
+	})
+	nsec3 := func(name string) []dns.RR {
+		return hashed.sign(t, chain[name])
+	}
+	concat := func(sets ...[]dns.RR) []dns.RR {
+		var rrs []dns.RR
+		for _, set := range sets {
+			rrs = append(rrs, set...)
+		}
+		return rrs
+	}
+
+	// covering returns the signed NSEC3 record that covers name.
+	covering := func(name string) []dns.RR {
+		for _, rr := range chain {
+			if nsec3Covers(rr, name) {
+				return hashed.sign(t, rr)
+			}
+		}
+		t.Fatalf("no NSEC3 record covers %s", name)
+		return nil
+	}
+	// expand returns a copy of a signed RRset owned by a wildcard, renamed to
+	// name as if it had been expanded from the wildcard to answer a query.
+	expand := func(name string, rrs []dns.RR) []dns.RR {
+		var expanded []dns.RR
+		for _, rr := range rrs {
+			rr = dns.Copy(rr)
+			rr.Header().Name = name
+			expanded = append(expanded, rr)
+		}
+		return expanded
+	}
+	wildcardTXT := secure.sign(t, mustRR(t, "*.secure.example. 300 IN TXT \"wildcard\""))
+	hashedWildcardTXT := hashed.sign(t, mustRR(t, "*.nsec3.example. 300 IN TXT \"wildcard\""))
+	strippedNSEC := secure.sign(t,
+		mustRR(t, "stripped.secure.example. 300 IN NSEC secure.example. TXT RRSIG NSEC"))
+
+	tamperedTXT := bogus.sign(t, mustRR(t, "_acme-challenge.bogus.example. 300 IN TXT \"original\""))
+	tamperedTXT[0].(*dns.TXT).Txt = []string{"tampered"}
+
+	exchanger := &zoneExchanger{
+		t: t,
+		responses: map[string]*dns.Msg{
+			// The trust anchor zone.
+			"example. DNSKEY": answer(example.sign(t, example.key)...),
+
+			// A signed zone.
+			"secure.example. DS":                 answer(example.sign(t, secure.ds())...),
+			"secure.example. DNSKEY":             answer(secure.sign(t, secure.key)...),
+			"secure.example. CAA":                denial(apexNSEC...),
+			"_acme-challenge.secure.example. DS": denial(challengeNSEC...),
+			"_acme-challenge.secure.example. TXT": answer(secure.sign(t,
+				mustRR(t, "_acme-challenge.secure.example. 300 IN TXT \"secure\""))...),
+			// A name in the signed zone whose answers have had their signatures or
+			// denial of existence stripped.
+			"stripped.secure.example. DS": denial(strippedNSEC...),
+			"stripped.secure.example. TXT": answer(
+				mustRR(t, "stripped.secure.example. 300 IN TXT \"stripped\"")),
+			"nodenial.secure.example. DS":  denial(challengeNSEC...),
+			"nodenial.secure.example. CAA": {},
+			// A name that doesn't exist, proven by an NSEC record covering it and
+			// one covering the wildcard *.secure.example.
+			"missing.secure.example. DS":  denial(challengeNSEC...),
+			"missing.secure.example. CAA": nxdomain(concat(challengeNSEC, apexNSEC)...),
+			// The same, but with the wildcard's NSEC record stripped.
+			"nowildcard.secure.example. DS":  denial(challengeNSEC...),
+			"nowildcard.secure.example. CAA": nxdomain(challengeNSEC...),
+			// An answer expanded from the wildcard *.secure.example., along with
+			// the NSEC record proving there was no exact match.
+			"wild.secure.example. DS": denial(strippedNSEC...),
+			"wild.secure.example. TXT": {
+				Answer: expand("wild.secure.example.", wildcardTXT),
+				Ns:     strippedNSEC,
+			},
+			// The same, but with the proof stripped.
+			"unproven.secure.example. DS":  denial(strippedNSEC...),
+			"unproven.secure.example. TXT": answer(expand("unproven.secure.example.", wildcardTXT)...),
+
+			// A signed zone using NSEC3.
+			"nsec3.example. DS":     answer(example.sign(t, hashed.ds())...),
+			"nsec3.example. DNSKEY": answer(hashed.sign(t, hashed.key)...),
+			// A name that doesn't exist, proven by the closest encloser (the apex),
+			// the next closer name and the wildcard at the closest encloser.
+			"gone.nsec3.example. DS": denial(nsec3("mail.nsec3.example.")...),
+			"gone.nsec3.example. CAA": nxdomain(concat(
+				nsec3("nsec3.example."), nsec3("mail.nsec3.example."), nsec3("www.nsec3.example."))...),
+			// The same, but with the wildcard's NSEC3 record stripped.
+			"nowildcard.nsec3.example. DS": denial(nsec3("_acme-challenge.nsec3.example.")...),
+			"nowildcard.nsec3.example. CAA": nxdomain(concat(
+				nsec3("nsec3.example."), nsec3("_acme-challenge.nsec3.example."))...),
+			// The same, but without the closest encloser.
+			"other.nsec3.example. DS": denial(nsec3("mail.nsec3.example.")...),
+			"other.nsec3.example. CAA": nxdomain(concat(
+				nsec3("mail.nsec3.example."), nsec3("www.nsec3.example."))...),
+			// An answer expanded from the wildcard *.nsec3.example., along with
+			// the NSEC3 record covering the next closer name.
+			"wild.nsec3.example. DS": denial(covering("wild.nsec3.example.")...),
+			"wild.nsec3.example. TXT": {
+				Answer: expand("wild.nsec3.example.", hashedWildcardTXT),
+				Ns:     covering("wild.nsec3.example."),
+			},
+			// The same, but with the proof stripped.
+			"unproven.nsec3.example. DS":  denial(covering("unproven.nsec3.example.")...),
+			"unproven.nsec3.example. TXT": answer(expand("unproven.nsec3.example.", hashedWildcardTXT)...),
+			// A name that exists without CAA records.
+			"www.nsec3.example. DS":  denial(nsec3("www.nsec3.example.")...),
+			"www.nsec3.example. CAA": denial(nsec3("www.nsec3.example.")...),
+
+			// A zone delegated without DS records.
+			"insecure.example. DS": denial(example.sign(t,
+				mustRR(t, "insecure.example. 300 IN NSEC secure.example. NS RRSIG NSEC"))...),
+			"insecure.example. CAA": answer(
+				mustRR(t, "insecure.example. 300 IN CAA 0 issue \"letsencrypt.org\"")),
+
+			// A signed zone serving a record that doesn't match its signature.
+			"bogus.example. DS":     answer(example.sign(t, bogus.ds())...),
+			"bogus.example. DNSKEY": answer(bogus.sign(t, bogus.key)...),
+			"_acme-challenge.bogus.example. DS": denial(bogus.sign(t,
+				mustRR(t, "_acme-challenge.bogus.example. 300 IN NSEC bogus.example. TXT RRSIG NSEC"))...),
+			"_acme-challenge.bogus.example. TXT": answer(tamperedTXT...),
+		},
+	}
+
+	client := NewTestDNSClientImpl(time.Second, []string{"stand-in"}, metrics.NoopRegisterer, clk, 1, blog.UseMock())
+	client.dnsClient = exchanger
+	anchor := example.ds()
+	err := client.EnableDNSSEC([]string{anchor.String()})
+	test.AssertNotError(t, err, "enabling DNSSEC")
+	return client
+}
+
+func TestDNSSECLookupTXT(t *testing.T) {
+	client := setupDNSSEC(t)
+
+	testCases := []struct {
+		name           string
+		expectedStatus DNSSECStatus
+		expectedTXT    []string
+		expectedErr    string
+	}{
+		{
+			name:           "_acme-challenge.secure.example",
+			expectedStatus: DNSSECSecure,
+			expectedTXT:    []string{"secure"},
+		},
+		{
+			name:           "stripped.secure.example",
+			expectedStatus: DNSSECBogus,
+			expectedErr:    "DNS problem: DNSSEC validation failure (stripped.secure.example. TXT is unsigned in the signed zone secure.example.) looking up TXT for stripped.secure.example",
+		},
+		{
+			name:           "_acme-challenge.bogus.example",
+			expectedStatus: DNSSECBogus,
+			expectedErr:    "DNS problem: DNSSEC validation failure (no valid signature by bogus.example. over _acme-challenge.bogus.example. TXT) looking up TXT for _acme-challenge.bogus.example",
+		},
+		{
+			name:           "wild.secure.example",
+			expectedStatus: DNSSECSecure,
+			expectedTXT:    []string{"wildcard"},
+		},
+		{
+			name:           "unproven.secure.example",
+			expectedStatus: DNSSECBogus,
+			expectedErr:    "DNS problem: DNSSEC validation failure (no proof that there is no exact match for the wildcard expansion unproven.secure.example. TXT in the signed zone secure.example.) looking up TXT for unproven.secure.example",
+		},
+		{
+			name:           "wild.nsec3.example",
+			expectedStatus: DNSSECSecure,
+			expectedTXT:    []string{"wildcard"},
+		},
+		{
+			name:           "unproven.nsec3.example",
+			expectedStatus: DNSSECBogus,
+			expectedErr:    "DNS problem: DNSSEC validation failure (no proof that there is no exact match for the wildcard expansion unproven.nsec3.example. TXT in the signed zone nsec3.example.) looking up TXT for unproven.nsec3.example",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txts, status, err := client.LookupTXT(context.Background(), tc.name)
+			test.AssertEquals(t, status, tc.expectedStatus)
+			if tc.expectedErr != "" {
+				test.AssertError(t, err, "expected a DNSSEC validation error")
+				test.AssertEquals(t, err.Error(), tc.expectedErr)
+				return
+			}
+			test.AssertNotError(t, err, "unexpected error")
+			test.AssertDeepEquals(t, txts, tc.expectedTXT)
+		})
+	}
+}
+
+func TestDNSSECLookupCAA(t *testing.T) {
+	client := setupDNSSEC(t)
+
+	testCases := []struct {
+		name           string
+		expectedStatus DNSSECStatus
+		expectedCount  int
+		expectedErr    string
+	}{
+		{
+			name:           "secure.example",
+			expectedStatus: DNSSECSecure,
+		},
+		{
+			name:           "insecure.example",
+			expectedStatus: DNSSECInsecure,
+			expectedCount:  1,
+		},
+		{
+			name:           "nodenial.secure.example",
+			expectedStatus: DNSSECBogus,
+			expectedErr:    "no proof that nodenial.secure.example. CAA does not exist",
+		},
+		{
+			name:           "missing.secure.example",
+			expectedStatus: DNSSECSecure,
+		},
+		{
+			name:           "nowildcard.secure.example",
+			expectedStatus: DNSSECBogus,
+			expectedErr:    "no proof that nowildcard.secure.example. CAA does not exist",
+		},
+		{
+			name:           "gone.nsec3.example",
+			expectedStatus: DNSSECSecure,
+		},
+		{
+			name:           "nowildcard.nsec3.example",
+			expectedStatus: DNSSECBogus,
+			expectedErr:    "no proof that nowildcard.nsec3.example. CAA does not exist",
+		},
+		{
+			name:           "other.nsec3.example",
+			expectedStatus: DNSSECBogus,
+			expectedErr:    "no proof that other.nsec3.example. CAA does not exist",
+		},
+		{
+			name:           "www.nsec3.example",
+			expectedStatus: DNSSECSecure,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			caas, status, err := client.LookupCAA(context.Background(), tc.name)
+			test.AssertEquals(t, status, tc.expectedStatus)
+			if tc.expectedErr != "" {
+				test.AssertError(t, err, "expected a DNSSEC validation error")
+				test.Assert(t, strings.Contains(err.Error(), tc.expectedErr), fmt.Sprintf("unexpected error: %s", err))
+				return
+			}
+			test.AssertNotError(t, err, "unexpected error")
+			test.AssertEquals(t, len(caas), tc.expectedCount)
+		})
+	}
+}
+
+func TestEnableDNSSEC(t *testing.T) {
+	client := NewTestDNSClientImpl(time.Second, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+
+	err := client.EnableDNSSEC(nil)
+	test.AssertError(t, err, "expected an error for no trust anchors")
+
+	err = client.EnableDNSSEC([]string{"not a record"})
+	test.AssertError(t, err, "expected an error for an unparseable trust anchor")
+
+	err = client.EnableDNSSEC([]string{"example. 300 IN TXT \"hello\""})
+	test.AssertError(t, err, "expected an error for a trust anchor that isn't a DS record")
+
+	err = client.EnableDNSSEC([]string{". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"})
+	test.AssertNotError(t, err, "unexpected error for the root trust anchor")
+	test.AssertEquals(t, client.dnssec.anchorFor("letsencrypt.org."), ".")
+}
@@ -0,0 +1,611 @@
+package bdns
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// maxCNAMEs is the longest chain of CNAMEs followed by an iterative lookup.
+	maxCNAMEs = 8
+	// maxReferrals is the most referrals followed while looking for the
+	// authoritative servers for a name.
+	maxReferrals = 16
+	// maxGluelessDepth is how deeply an iterative lookup will nest lookups of
+	// the addresses of nameservers that were referred to without glue.
+	maxGluelessDepth = 3
+	// maxIterativeQueries bounds the total number of queries sent to
+	// authoritative servers by a single iterative lookup.
+	maxIterativeQueries = 64
+	// maxDelegationTTL is the longest a delegation is cached for, whatever the
+	// TTLs of its records.
+	maxDelegationTTL = time.Hour
+	// maxCachedDelegations bounds the number of delegations cached.
+	maxCachedDelegations = 10000
+)
+
+// iterativeError is an error resolving a name iteratively, caused by the
+// behaviour of the name's authoritative servers rather than our own.
+type iterativeError struct {
+	reason string
+}
+
+func (e iterativeError) Error() string {
+	return e.reason
+}
+
+func iterativef(format string, args ...interface{}) error {
+	return iterativeError{fmt.Sprintf(format, args...)}
+}
+
+// iterativeResolver resolves names by walking the delegation chain from the
+// root servers, querying each zone's authoritative servers directly. Answers
+// are never cached, so every lookup sees the current contents of the zone,
+// but delegations are, so that lookups don't all start from the root.
+type iterativeResolver struct {
+	roots       []string
+	delegations *delegationCache
+	// tcp exchanges queries with authoritative servers when their response
+	// over the client's usual exchanger is truncated.
+	tcp exchanger
+	// allowRestrictedAddresses permits querying nameservers at private
+	// addresses. It's only set by NewTestDNSClientImpl.
+	allowRestrictedAddresses bool
+}
+
+// EnableIterativeResolution configures the client to resolve TXT and CAA
+// lookups itself, starting from the given root hints, instead of sending them
+// to its recursive resolvers. Each root hint is the IP address of a root
+// server, optionally with a port. Other lookups, including any needed to
+// validate DNSSEC, still use the recursive resolvers.
+func (dnsClient *DNSClientImpl) EnableIterativeResolution(rootHints []string) error {
+	if len(rootHints) == 0 {
+		return fmt.Errorf("no root hints provided")
+	}
+	var roots []string
+	for _, hint := range rootHints {
+		host, port, err := net.SplitHostPort(hint)
+		if err != nil {
+			host, port = hint, "53"
+		}
+		if net.ParseIP(host) == nil {
+			return fmt.Errorf("root hint %q is not an IP address", hint)
+		}
+		roots = append(roots, net.JoinHostPort(host, port))
+	}
+	dnsClient.iterative = &iterativeResolver{
+		roots:                    roots,
+		delegations:              newDelegationCache(dnsClient.clk),
+		tcp:                      &dns.Client{Net: "tcp", ReadTimeout: dnsClient.readTimeout},
+		allowRestrictedAddresses: dnsClient.allowRestrictedAddresses,
+	}
+	return nil
+}
+
+// lookup sends a query for hostname, resolving it iteratively if
+// EnableIterativeResolution has been called, and exchanging it with one of
+// the recursive resolvers otherwise.
+func (dnsClient *DNSClientImpl) lookup(ctx context.Context, hostname string, qtype uint16) (*dns.Msg, error) {
+	if dnsClient.iterative == nil {
+		return dnsClient.exchangeOne(ctx, hostname, qtype)
+	}
+	resp, err := dnsClient.iterative.resolve(ctx, dnsClient, hostname, qtype)
+	result := "failed"
+	if err == nil {
+		result = dns.RcodeToString[resp.Rcode]
+	} else {
+		dnsClient.log.Infof("Iterative lookup of %s %s failed: %s", dns.TypeToString[qtype], hostname, err)
+	}
+	dnsClient.iterativeCounter.With(prometheus.Labels{
+		"qtype":  dns.TypeToString[qtype],
+		"result": result,
+	}).Inc()
+	return resp, err
+}
+
+// iterativeLookup is the state of a single iterative lookup, including any
+// nested lookups of nameserver addresses.
+type iterativeLookup struct {
+	*iterativeResolver
+	// client sends the lookup's queries, in the same way as those to its
+	// recursive resolvers.
+	client  *DNSClientImpl
+	queries int
+}
+
+func (r *iterativeResolver) resolve(ctx context.Context, client *DNSClientImpl, hostname string, qtype uint16) (*dns.Msg, error) {
+	l := &iterativeLookup{iterativeResolver: r, client: client}
+	return l.resolve(ctx, dns.CanonicalName(hostname), qtype, 0)
+}
+
+// resolve looks up name, following any CNAMEs, and returns a response in the
+// form a recursive resolver would: the answer section holds the whole CNAME
+// chain followed by the records found, and the rcode and authority section
+// are those of the final response.
+func (l *iterativeLookup) resolve(ctx context.Context, name string, qtype uint16, depth int) (*dns.Msg, error) {
+	result := new(dns.Msg)
+	result.SetQuestion(name, qtype)
+	result.Response = true
+	target := name
+	cnames := 0
+	for {
+		resp, zone, err := l.queryAuthoritative(ctx, target, qtype, depth)
+		if err != nil {
+			return nil, err
+		}
+		// Only accept answers for names that the servers are authoritative for.
+		answers := inZone(resp.Answer, zone)
+		result.Answer = append(result.Answer, answers...)
+		result.Ns = resp.Ns
+		result.Rcode = resp.Rcode
+
+		// Follow the CNAME chain as far as this response goes.
+		for {
+			if hasRRSet(answers, target, qtype) {
+				return result, nil
+			}
+			next, synthesized, err := nextTarget(answers, target)
+			if err != nil {
+				return nil, err
+			}
+			if next == "" {
+				// There's no answer, so this is a NODATA or NXDOMAIN response.
+				return result, nil
+			}
+			if synthesized != nil {
+				result.Answer = append(withoutCNAME(result.Answer, target), synthesized)
+				answers = append(withoutCNAME(answers, target), synthesized)
+			}
+			cnames++
+			if cnames > maxCNAMEs {
+				return nil, iterativef("more than %d CNAMEs following %s", maxCNAMEs, name)
+			}
+			target = next
+			if !hasOwner(answers, target) {
+				break
+			}
+		}
+	}
+}
+
+// queryAuthoritative follows referrals from the closest cached delegation
+// enclosing name, or from the root servers, until it finds the servers
+// authoritative for name, and returns their response to the query along with
+// the zone they're authoritative for.
+func (l *iterativeLookup) queryAuthoritative(ctx context.Context, name string, qtype uint16, depth int) (*dns.Msg, string, error) {
+	zone, servers := l.delegations.closest(name)
+	if servers == nil {
+		return l.followReferrals(ctx, ".", l.roots, nil, name, qtype, depth)
+	}
+	resp, err := l.ask(ctx, servers, zone, name, qtype)
+	if err == nil && resp.Rcode != dns.RcodeServerFailure && resp.Rcode != dns.RcodeRefused {
+		return l.followReferrals(ctx, zone, servers, resp, name, qtype, depth)
+	}
+	if ctx.Err() != nil {
+		return nil, "", err
+	}
+	// The delegation may have changed since it was cached, so forget it and
+	// start again from the root.
+	l.delegations.remove(zone)
+	return l.followReferrals(ctx, ".", l.roots, nil, name, qtype, depth)
+}
+
+// followReferrals queries servers, which are authoritative for zone, for
+// name, and follows the referrals they return, caching each delegation, until
+// it gets an authoritative response, or a SERVFAIL or REFUSED one. resp, if
+// not nil, is the servers' response to the query, which has already been sent.
+func (l *iterativeLookup) followReferrals(ctx context.Context, zone string, servers []string, resp *dns.Msg, name string, qtype uint16, depth int) (*dns.Msg, string, error) {
+	for referrals := 0; ; referrals++ {
+		if resp == nil {
+			var err error
+			resp, err = l.ask(ctx, servers, zone, name, qtype)
+			if err != nil {
+				return nil, "", err
+			}
+		}
+		// ask only returns responses that aren't authoritative if they're a
+		// referral, or a SERVFAIL or REFUSED.
+		if resp.Rcode != dns.RcodeSuccess || resp.Authoritative {
+			return resp, zone, nil
+		}
+
+		if referrals >= maxReferrals {
+			return nil, "", iterativef("more than %d referrals resolving %s", maxReferrals, name)
+		}
+		cut, nameservers, nsTTL := findReferral(resp, zone, name)
+		if cut == "" {
+			return nil, "", lameError(zone, name)
+		}
+		addrs, addrTTL, err := l.nameserverAddresses(ctx, resp, zone, nameservers, depth)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(addrs) == 0 {
+			return nil, "", iterativef("no usable addresses for the nameservers of %s", cut)
+		}
+		if addrTTL < nsTTL {
+			nsTTL = addrTTL
+		}
+		l.delegations.add(cut, addrs, nsTTL)
+		zone, servers, resp = cut, addrs, nil
+	}
+}
+
+// nameserverAddresses returns the addresses of the nameservers a referral
+// from the servers for zone pointed to, and the lowest TTL of the address
+// records they came from. Glue records are only trusted if they're within
+// zone. If there's no usable glue the nameservers' A and AAAA records are
+// looked up, up to maxGluelessDepth lookups deep.
+func (l *iterativeLookup) nameserverAddresses(ctx context.Context, resp *dns.Msg, zone string, nameservers []string, depth int) ([]string, time.Duration, error) {
+	isNameserver := make(map[string]bool, len(nameservers))
+	for _, ns := range nameservers {
+		isNameserver[ns] = true
+	}
+	addrs := &nameserverAddrs{lookup: l}
+	for _, rr := range resp.Extra {
+		name := dns.CanonicalName(rr.Header().Name)
+		if !isNameserver[name] || !dns.IsSubDomain(zone, name) {
+			continue
+		}
+		addrs.add(rr)
+	}
+	if len(addrs.addrs) > 0 {
+		return addrs.addrs, addrs.ttl, nil
+	}
+
+	if depth >= maxGluelessDepth {
+		return nil, 0, iterativef("nameservers for %s nested more than %d deep without glue", zone, maxGluelessDepth)
+	}
+	var firstErr error
+	for _, ns := range nameservers {
+		for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+			nsResp, err := l.resolve(ctx, ns, qtype, depth+1)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, 0, err
+				}
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			for _, rr := range nsResp.Answer {
+				addrs.add(rr)
+			}
+		}
+		if len(addrs.addrs) > 0 {
+			return addrs.addrs, addrs.ttl, nil
+		}
+	}
+	return nil, 0, firstErr
+}
+
+// nameserverAddrs collects the addresses of nameservers from address records.
+type nameserverAddrs struct {
+	lookup *iterativeLookup
+	addrs  []string
+	// ttl is the lowest TTL of the records addrs came from.
+	ttl time.Duration
+}
+
+// add adds the port 53 address of a nameserver in rr, if it's an A or AAAA
+// record, unless it's a reserved address that we shouldn't be sending queries
+// to.
+func (a *nameserverAddrs) add(rr dns.RR) {
+	var ip net.IP
+	switch addr := rr.(type) {
+	case *dns.A:
+		ip = addr.A
+	case *dns.AAAA:
+		ip = addr.AAAA
+	default:
+		return
+	}
+	if IsReservedIP(ip) && !a.lookup.allowRestrictedAddresses {
+		return
+	}
+	ttl := time.Duration(rr.Header().Ttl) * time.Second
+	if len(a.addrs) == 0 || ttl < a.ttl {
+		a.ttl = ttl
+	}
+	a.addrs = append(a.addrs, net.JoinHostPort(ip.String(), "53"))
+}
+
+// ask sends a query for name to servers, which are authoritative for zone,
+// trying each in turn starting from a random one, until one of them gives an
+// authoritative response or a referral. Servers that answer without authority
+// and don't refer the query further down are lame, and are skipped like those
+// that fail. If none of them answer the last error or SERVFAIL/REFUSED
+// response is returned.
+func (l *iterativeLookup) ask(ctx context.Context, servers []string, zone, name string, qtype uint16) (*dns.Msg, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	m.RecursionDesired = false
+	// Ask for RRSIGs and NSEC records if we'll be validating DNSSEC, as for
+	// queries to the recursive resolvers.
+	m.SetEdns0(4096, l.client.dnssec != nil)
+
+	var lastResp *dns.Msg
+	var lastErr error
+	start := rand.Intn(len(servers))
+	for i := range servers {
+		if l.queries >= maxIterativeQueries {
+			return nil, iterativef("more than %d queries resolving %s", maxIterativeQueries, name)
+		}
+		l.queries++
+		resp, err := l.exchange(ctx, m, servers[(start+i)%len(servers)])
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			lastErr = err
+			continue
+		}
+		if resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused {
+			lastResp = resp
+			continue
+		}
+		if !resp.Authoritative && !isReferral(resp, zone, name) {
+			lastErr = lameError(zone, name)
+			continue
+		}
+		return resp, nil
+	}
+	if lastResp != nil {
+		return lastResp, nil
+	}
+	return nil, lastErr
+}
+
+// exchange sends m to server with the client's usual exchanger, retrying
+// over TCP if the response was truncated. Both go through the client's
+// exchange, so they're retried and counted like queries to the recursive
+// resolvers.
+func (l *iterativeLookup) exchange(ctx context.Context, m *dns.Msg, server string) (*dns.Msg, error) {
+	hostname := m.Question[0].Name
+	resp, err := l.client.exchange(ctx, l.client.dnsClient, authoritativeServer(server), hostname, m)
+	if err == nil && resp.Truncated {
+		resp, err = l.client.exchange(ctx, l.tcp, authoritativeServer(server), hostname, m)
+	}
+	return resp, err
+}
+
+// authoritativeServer is the serverSet of a query to a single authoritative
+// server. Retries are sent to the same server, as ask tries the zone's other
+// servers itself. There are far too many authoritative servers to label
+// metrics with individually, so they share a label.
+type authoritativeServer string
+
+func (s authoritativeServer) choose(map[string]bool) (string, error) {
+	return string(s), nil
+}
+
+func (authoritativeServer) record(string, time.Duration, error) {}
+
+func (authoritativeServer) label(string) string {
+	return "authoritative"
+}
+
+// lameError is the error for a response from the servers for zone that's
+// neither authoritative nor a referral.
+func lameError(zone, name string) error {
+	return iterativef("nameservers for %s returned neither an authoritative answer nor a referral for %s", zone, name)
+}
+
+// isReferral returns true if resp, from the servers for zone, is a referral to
+// the servers for a zone below it enclosing name.
+func isReferral(resp *dns.Msg, zone, name string) bool {
+	if resp.Rcode != dns.RcodeSuccess || len(resp.Answer) > 0 {
+		return false
+	}
+	cut, _, _ := findReferral(resp, zone, name)
+	return cut != ""
+}
+
+// findReferral returns the zone cut and nameservers of a referral in resp from
+// the servers for zone, and the lowest TTL of its NS records. The cut must be
+// below zone and enclose name.
+func findReferral(resp *dns.Msg, zone, name string) (string, []string, time.Duration) {
+	cut := ""
+	var nameservers []string
+	var ttl time.Duration
+	for _, rr := range resp.Ns {
+		ns, ok := rr.(*dns.NS)
+		if !ok {
+			continue
+		}
+		owner := dns.CanonicalName(ns.Hdr.Name)
+		if owner == zone || !dns.IsSubDomain(zone, owner) || !dns.IsSubDomain(owner, name) {
+			continue
+		}
+		if cut == "" {
+			cut = owner
+		} else if owner != cut {
+			continue
+		}
+		nsTTL := time.Duration(ns.Hdr.Ttl) * time.Second
+		if len(nameservers) == 0 || nsTTL < ttl {
+			ttl = nsTTL
+		}
+		nameservers = append(nameservers, dns.CanonicalName(ns.Ns))
+	}
+	return cut, nameservers, ttl
+}
+
+// cachedDelegation is a cached referral to the servers authoritative for a
+// zone.
+type cachedDelegation struct {
+	servers []string
+	expires time.Time
+}
+
+// delegationCache holds the addresses of the servers authoritative for zones
+// that referrals have been followed to, for as long as the lowest TTL of the
+// NS and address records they came from, up to maxDelegationTTL.
+type delegationCache struct {
+	sync.Mutex
+	clk   clock.Clock
+	zones map[string]cachedDelegation
+}
+
+func newDelegationCache(clk clock.Clock) *delegationCache {
+	return &delegationCache{
+		clk:   clk,
+		zones: make(map[string]cachedDelegation),
+	}
+}
+
+// closest returns the closest zone enclosing name that has an unexpired
+// delegation cached, and the addresses of its servers. If there is none, the
+// servers are nil.
+func (c *delegationCache) closest(name string) (string, []string) {
+	c.Lock()
+	defer c.Unlock()
+	now := c.clk.Now()
+	for off, end := 0, false; !end; off, end = dns.NextLabel(name, off) {
+		d, ok := c.zones[name[off:]]
+		if ok && now.Before(d.expires) {
+			return name[off:], d.servers
+		}
+	}
+	return ".", nil
+}
+
+// add caches the servers authoritative for zone for ttl. If the cache is
+// full, expired delegations are dropped to make room, and if there are none
+// the delegation isn't cached.
+func (c *delegationCache) add(zone string, servers []string, ttl time.Duration) {
+	if ttl > maxDelegationTTL {
+		ttl = maxDelegationTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	now := c.clk.Now()
+	if _, ok := c.zones[zone]; !ok && len(c.zones) >= maxCachedDelegations {
+		for cached, d := range c.zones {
+			if !now.Before(d.expires) {
+				delete(c.zones, cached)
+			}
+		}
+		if len(c.zones) >= maxCachedDelegations {
+			return
+		}
+	}
+	c.zones[zone] = cachedDelegation{servers: servers, expires: now.Add(ttl)}
+}
+
+// remove drops the cached delegation for zone.
+func (c *delegationCache) remove(zone string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.zones, zone)
+}
+
+// inZone returns the records in rrs that are within zone.
+func inZone(rrs []dns.RR, zone string) []dns.RR {
+	var result []dns.RR
+	for _, rr := range rrs {
+		if dns.IsSubDomain(zone, dns.CanonicalName(rr.Header().Name)) {
+			result = append(result, rr)
+		}
+	}
+	return result
+}
+
+func hasRRSet(rrs []dns.RR, name string, qtype uint16) bool {
+	for _, rr := range rrs {
+		if rr.Header().Rrtype == qtype && dns.CanonicalName(rr.Header().Name) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasOwner(rrs []dns.RR, name string) bool {
+	for _, rr := range rrs {
+		if dns.CanonicalName(rr.Header().Name) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// nextTarget returns the name that a CNAME or DNAME in rrs redirects name to,
+// or "" if there is neither. A DNAME above name takes precedence over any
+// CNAME for it, as RFC 6672 Section 3.4 has resolvers use the DNAME rather
+// than trust the CNAME synthesized from it. If the CNAME wasn't included, or
+// doesn't match the DNAME, it's synthesized from the DNAME and returned too,
+// so that the answer holds the whole chain, as a recursive resolver's would.
+func nextTarget(rrs []dns.RR, name string) (string, *dns.CNAME, error) {
+	dname := findDNAME(rrs, name)
+	cname := findCNAME(rrs, name)
+	if dname == nil {
+		if cname == nil {
+			return "", nil, nil
+		}
+		return dns.CanonicalName(cname.Target), nil, nil
+	}
+	owner := dns.CanonicalName(dname.Hdr.Name)
+	next := strings.TrimSuffix(name, owner)
+	if target := dns.CanonicalName(dname.Target); target != "." {
+		next += target
+	}
+	if _, ok := dns.IsDomainName(next); !ok || len(next) > 255 {
+		return "", nil, iterativef("DNAME %s for %s leads to an invalid name", owner, name)
+	}
+	if cname != nil && dns.CanonicalName(cname.Target) == next {
+		return next, nil, nil
+	}
+	return next, &dns.CNAME{
+		Hdr:    dns.RR_Header{Name: name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: dname.Hdr.Ttl},
+		Target: next,
+	}, nil
+}
+
+// findDNAME returns a DNAME in rrs owned by a name above name.
+func findDNAME(rrs []dns.RR, name string) *dns.DNAME {
+	for _, rr := range rrs {
+		dname, ok := rr.(*dns.DNAME)
+		if !ok {
+			continue
+		}
+		owner := dns.CanonicalName(dname.Hdr.Name)
+		if owner != name && dns.IsSubDomain(owner, name) {
+			return dname
+		}
+	}
+	return nil
+}
+
+// withoutCNAME returns the records in rrs other than CNAMEs for name.
+func withoutCNAME(rrs []dns.RR, name string) []dns.RR {
+	var result []dns.RR
+	for _, rr := range rrs {
+		if cname, ok := rr.(*dns.CNAME); ok && dns.CanonicalName(cname.Hdr.Name) == name {
+			continue
+		}
+		result = append(result, rr)
+	}
+	return result
+}
+
+func findCNAME(rrs []dns.RR, name string) *dns.CNAME {
+	for _, rr := range rrs {
+		if cname, ok := rr.(*dns.CNAME); ok && dns.CanonicalName(cname.Hdr.Name) == name {
+			return cname
+		}
+	}
+	return nil
+}
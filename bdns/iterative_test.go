@@ -0,0 +1,414 @@
+package bdns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// authoritativeExchanger is a stand-in for a set of authoritative servers.
+// It serves fixed responses keyed by "server name TYPE", and refuses queries
+// without one.
+type authoritativeExchanger struct {
+	sync.Mutex
+	t         *testing.T
+	responses map[string]*dns.Msg
+	queries   []string
+	// deep, if set, is the address of a server that refers every query to a
+	// zone one label further down than it did the last time.
+	deep      string
+	deepCalls int
+	// failures is how many times queries with each key fail with a temporary
+	// error before they're answered.
+	failures map[string]int
+}
+
+func (e *authoritativeExchanger) Exchange(m *dns.Msg, a string) (*dns.Msg, time.Duration, error) {
+	e.Lock()
+	defer e.Unlock()
+	if m.RecursionDesired {
+		e.t.Errorf("query to %s for %s was sent with the RD bit set", a, m.Question[0].Name)
+	}
+	q := m.Question[0]
+	key := fmt.Sprintf("%s %s %s", a, q.Name, dns.TypeToString[q.Qtype])
+	e.queries = append(e.queries, key)
+	if e.failures[key] > 0 {
+		e.failures[key]--
+		return nil, time.Millisecond, &net.OpError{Op: "read", Err: tempError(true)}
+	}
+
+	r := new(dns.Msg)
+	r.SetReply(m)
+	if a == e.deep {
+		labels := dns.SplitDomainName(q.Name)
+		e.deepCalls++
+		cut := dns.Fqdn(strings.Join(labels[len(labels)-2-e.deepCalls:], "."))
+		r.Ns = []dns.RR{mustRR(e.t, fmt.Sprintf("%s 300 IN NS ns.%s", cut, cut))}
+		r.Extra = []dns.RR{mustRR(e.t, fmt.Sprintf("ns.%s 300 IN A 192.0.2.5", cut))}
+		return r, time.Millisecond, nil
+	}
+	resp, ok := e.responses[key]
+	if !ok {
+		r.Rcode = dns.RcodeRefused
+		return r, time.Millisecond, nil
+	}
+	r.Authoritative = resp.Authoritative
+	r.Rcode = resp.Rcode
+	r.Answer = resp.Answer
+	r.Ns = resp.Ns
+	r.Extra = resp.Extra
+	return r, time.Millisecond, nil
+}
+
+func setupIterative(t *testing.T, allowRestricted bool) (*DNSClientImpl, *authoritativeExchanger) {
+	rrs := func(records ...string) []dns.RR {
+		var result []dns.RR
+		for _, record := range records {
+			result = append(result, mustRR(t, record))
+		}
+		return result
+	}
+	answer := func(records ...string) *dns.Msg {
+		return &dns.Msg{MsgHdr: dns.MsgHdr{Authoritative: true}, Answer: rrs(records...)}
+	}
+	noData := &dns.Msg{MsgHdr: dns.MsgHdr{Authoritative: true}}
+	referral := func(ns []string, glue ...string) *dns.Msg {
+		return &dns.Msg{Ns: rrs(ns...), Extra: rrs(glue...)}
+	}
+	const (
+		root    = "192.0.2.1:53"
+		example = "192.0.2.2:53"
+		tld     = "192.0.2.3:53"
+		sub     = "192.0.2.4:53"
+		lame    = "192.0.2.6:53"
+		v6only  = "[2001:db8::7]:53"
+		nonauth = "192.0.2.8:53"
+		good    = "192.0.2.9:53"
+	)
+	exampleReferral := referral(
+		[]string{"example. 300 IN NS ns.example."},
+		"ns.example. 300 IN A 192.0.2.2")
+	testReferral := referral(
+		[]string{"test. 300 IN NS ns.test."},
+		"ns.test. 300 IN A 192.0.2.3")
+
+	exchanger := &authoritativeExchanger{
+		t:    t,
+		deep: "192.0.2.5:53",
+		responses: map[string]*dns.Msg{
+			root + " _acme-challenge.www.example. TXT":                          exampleReferral,
+			root + " www.example. CAA":                                          exampleReferral,
+			root + " _acme-challenge.sub.example. TXT":                          exampleReferral,
+			root + " loop1.example. CAA":                                        exampleReferral,
+			root + " loop2.example. CAA":                                        exampleReferral,
+			root + " missing.example. CAA":                                      exampleReferral,
+			root + " lame.example. CAA":                                         exampleReferral,
+			root + " a.b.c.d.e.f.g.h.i.j.k.l.m.n.o.p.q.r.s.t.deep.example. CAA": exampleReferral,
+			root + " cdn.hosting.test. CAA":                                     testReferral,
+			root + " ns.glueless.test. A":                                       testReferral,
+			root + " ns.glueless.test. AAAA":                                    testReferral,
+			root + " _acme-challenge.v6.example. TXT":                           exampleReferral,
+			root + " ns.v6only.test. A":                                         testReferral,
+			root + " ns.v6only.test. AAAA":                                      testReferral,
+			root + " nonauth.example. CAA":                                      exampleReferral,
+			root + " mixed.example. CAA":                                        exampleReferral,
+			root + " _acme-challenge.www.dname.example. TXT":                    exampleReferral,
+			root + " www.renamed.example. CAA":                                  exampleReferral,
+			root + " www.hosting.test. CAA":                                     testReferral,
+
+			example + " _acme-challenge.www.example. TXT": answer(
+				`_acme-challenge.www.example. 300 IN TXT "iterative"`),
+			example + " www.example. CAA": answer(
+				"www.example. 300 IN CNAME cdn.hosting.test.",
+				// An answer from outside the server's zone is ignored.
+				`cdn.hosting.test. 300 IN CAA 0 issue "evil.example"`),
+			example + " _acme-challenge.sub.example. TXT": referral(
+				[]string{"sub.example. 300 IN NS ns.glueless.test."}),
+			example + " _acme-challenge.v6.example. TXT": referral(
+				[]string{"v6.example. 300 IN NS ns.v6only.test."}),
+			example + " loop1.example. CAA": answer("loop1.example. 300 IN CNAME loop2.example."),
+			example + " loop2.example. CAA": answer("loop2.example. 300 IN CNAME loop1.example."),
+			example + " missing.example. CAA": {
+				MsgHdr: dns.MsgHdr{Authoritative: true, Rcode: dns.RcodeNameError},
+				Ns:     rrs("example. 300 IN SOA ns.example. hostmaster.example. 1 3600 600 86400 300"),
+			},
+			example + " lame.example. CAA": referral(
+				[]string{"lame.example. 300 IN NS ns.lame.example."},
+				"ns.lame.example. 300 IN A 192.0.2.6"),
+			example + " a.b.c.d.e.f.g.h.i.j.k.l.m.n.o.p.q.r.s.t.deep.example. CAA": referral(
+				[]string{"deep.example. 300 IN NS ns.deep.example."},
+				"ns.deep.example. 300 IN A 192.0.2.5"),
+			example + " nonauth.example. CAA": referral(
+				[]string{"nonauth.example. 300 IN NS ns.nonauth.example."},
+				"ns.nonauth.example. 300 IN A 192.0.2.8"),
+			example + " mixed.example. CAA": referral(
+				[]string{
+					"mixed.example. 300 IN NS ns1.mixed.example.",
+					"mixed.example. 300 IN NS ns2.mixed.example.",
+				},
+				"ns1.mixed.example. 300 IN A 192.0.2.6",
+				"ns2.mixed.example. 300 IN A 192.0.2.9"),
+			// A DNAME without the CNAME synthesized from it.
+			example + " _acme-challenge.www.dname.example. TXT": answer(
+				"dname.example. 300 IN DNAME example."),
+			// A DNAME with a CNAME that doesn't match it.
+			example + " www.renamed.example. CAA": answer(
+				"renamed.example. 300 IN DNAME hosting.test.",
+				"www.renamed.example. 300 IN CNAME evil.example."),
+			lame + " lame.example. CAA":  {},
+			lame + " mixed.example. CAA": {},
+			// A non-authoritative answer, as from a server that's recursive
+			// rather than authoritative for the zone.
+			nonauth + " nonauth.example. CAA": {
+				Answer: rrs(`nonauth.example. 300 IN CAA 0 issue "evil.example"`),
+			},
+			good + " mixed.example. CAA": answer(`mixed.example. 300 IN CAA 0 issue "ca.test"`),
+
+			tld + " cdn.hosting.test. CAA":  answer(`cdn.hosting.test. 300 IN CAA 0 issue "ca.test"`),
+			tld + " www.hosting.test. CAA":  answer(`www.hosting.test. 300 IN CAA 0 issue "ca.test"`),
+			tld + " ns.glueless.test. A":    answer("ns.glueless.test. 300 IN A 192.0.2.4"),
+			tld + " ns.glueless.test. AAAA": noData,
+			tld + " ns.v6only.test. A":      noData,
+			tld + " ns.v6only.test. AAAA":   answer("ns.v6only.test. 300 IN AAAA 2001:db8::7"),
+
+			sub + " _acme-challenge.sub.example. TXT": answer(
+				`_acme-challenge.sub.example. 300 IN TXT "glueless"`),
+
+			v6only + " _acme-challenge.v6.example. TXT": answer(
+				`_acme-challenge.v6.example. 300 IN TXT "ipv6"`),
+		},
+	}
+
+	var client *DNSClientImpl
+	if allowRestricted {
+		client = NewTestDNSClientImpl(time.Second, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	} else {
+		client = NewDNSClientImpl(time.Second, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	}
+	err := client.EnableIterativeResolution([]string{"192.0.2.1"})
+	test.AssertNotError(t, err, "enabling iterative resolution")
+	client.dnsClient = exchanger
+	client.iterative.tcp = exchanger
+	return client, exchanger
+}
+
+func TestIterativeLookupTXT(t *testing.T) {
+	client, exchanger := setupIterative(t, true)
+
+	txts, _, err := client.LookupTXT(context.Background(), "_acme-challenge.www.example")
+	test.AssertNotError(t, err, "looking up TXT")
+	test.AssertDeepEquals(t, txts, []string{"iterative"})
+	test.AssertDeepEquals(t, exchanger.queries, []string{
+		"192.0.2.1:53 _acme-challenge.www.example. TXT",
+		"192.0.2.2:53 _acme-challenge.www.example. TXT",
+	})
+
+	// A referral without glue is followed by looking up the nameserver's
+	// addresses. The delegation to example. was cached by the first lookup,
+	// so the root isn't asked about it again.
+	exchanger.queries = nil
+	txts, _, err = client.LookupTXT(context.Background(), "_acme-challenge.sub.example")
+	test.AssertNotError(t, err, "looking up TXT")
+	test.AssertDeepEquals(t, txts, []string{"glueless"})
+	test.AssertDeepEquals(t, exchanger.queries, []string{
+		"192.0.2.2:53 _acme-challenge.sub.example. TXT",
+		"192.0.2.1:53 ns.glueless.test. A",
+		"192.0.2.3:53 ns.glueless.test. A",
+		"192.0.2.3:53 ns.glueless.test. AAAA",
+		"192.0.2.4:53 _acme-challenge.sub.example. TXT",
+	})
+
+	// A nameserver without glue that only has an IPv6 address can be used.
+	exchanger.queries = nil
+	txts, _, err = client.LookupTXT(context.Background(), "_acme-challenge.v6.example")
+	test.AssertNotError(t, err, "looking up TXT")
+	test.AssertDeepEquals(t, txts, []string{"ipv6"})
+	test.AssertDeepEquals(t, exchanger.queries, []string{
+		"192.0.2.2:53 _acme-challenge.v6.example. TXT",
+		"192.0.2.3:53 ns.v6only.test. A",
+		"192.0.2.3:53 ns.v6only.test. AAAA",
+		"[2001:db8::7]:53 _acme-challenge.v6.example. TXT",
+	})
+
+	// A DNAME is followed even if the server didn't synthesize a CNAME from
+	// it, and one is added to the answer.
+	exchanger.queries = nil
+	resp, err := client.iterative.resolve(context.Background(), client, "_acme-challenge.www.dname.example", dns.TypeTXT)
+	test.AssertNotError(t, err, "resolving TXT")
+	test.AssertEquals(t, len(resp.Answer), 3)
+	cname, ok := resp.Answer[1].(*dns.CNAME)
+	test.Assert(t, ok, "expected a synthesized CNAME")
+	test.AssertEquals(t, cname.Hdr.Name, "_acme-challenge.www.dname.example.")
+	test.AssertEquals(t, cname.Target, "_acme-challenge.www.example.")
+	test.AssertEquals(t, resp.Answer[2].(*dns.TXT).Txt[0], "iterative")
+}
+
+func TestIterativeDelegationCache(t *testing.T) {
+	client, exchanger := setupIterative(t, true)
+	fc := client.clk.(clock.FakeClock)
+
+	_, _, err := client.LookupTXT(context.Background(), "_acme-challenge.www.example")
+	test.AssertNotError(t, err, "looking up TXT")
+
+	// Answers aren't cached, but the delegation is, for as long as its TTL.
+	exchanger.queries = nil
+	fc.Add(299 * time.Second)
+	_, _, err = client.LookupTXT(context.Background(), "_acme-challenge.www.example")
+	test.AssertNotError(t, err, "looking up TXT")
+	test.AssertDeepEquals(t, exchanger.queries, []string{
+		"192.0.2.2:53 _acme-challenge.www.example. TXT",
+	})
+
+	exchanger.queries = nil
+	fc.Add(time.Second)
+	_, _, err = client.LookupTXT(context.Background(), "_acme-challenge.www.example")
+	test.AssertNotError(t, err, "looking up TXT")
+	test.AssertDeepEquals(t, exchanger.queries, []string{
+		"192.0.2.1:53 _acme-challenge.www.example. TXT",
+		"192.0.2.2:53 _acme-challenge.www.example. TXT",
+	})
+
+	// If the servers of a cached delegation refuse the query, it's forgotten
+	// and the lookup starts again from the root.
+	exchanger.queries = nil
+	client.iterative.delegations.add("example.", []string{"192.0.2.6:53"}, time.Minute)
+	_, _, err = client.LookupTXT(context.Background(), "_acme-challenge.www.example")
+	test.AssertNotError(t, err, "looking up TXT")
+	test.AssertDeepEquals(t, exchanger.queries, []string{
+		"192.0.2.6:53 _acme-challenge.www.example. TXT",
+		"192.0.2.1:53 _acme-challenge.www.example. TXT",
+		"192.0.2.2:53 _acme-challenge.www.example. TXT",
+	})
+}
+
+func TestIterativeExchange(t *testing.T) {
+	client, exchanger := setupIterative(t, true)
+	client.maxTries = 2
+
+	// Queries to authoritative servers are retried after temporary errors,
+	// like those to the recursive resolvers.
+	exchanger.failures = map[string]int{"192.0.2.2:53 _acme-challenge.www.example. TXT": 1}
+	txts, _, err := client.LookupTXT(context.Background(), "_acme-challenge.www.example")
+	test.AssertNotError(t, err, "looking up TXT")
+	test.AssertDeepEquals(t, txts, []string{"iterative"})
+	test.AssertDeepEquals(t, exchanger.queries, []string{
+		"192.0.2.1:53 _acme-challenge.www.example. TXT",
+		"192.0.2.2:53 _acme-challenge.www.example. TXT",
+		"192.0.2.2:53 _acme-challenge.www.example. TXT",
+	})
+
+	// And their metrics are recorded, under a single resolver label.
+	test.AssertEquals(t, test.CountHistogramSamples(client.queryTime.With(prometheus.Labels{
+		"qtype":              "TXT",
+		"result":             "NOERROR",
+		"authenticated_data": "false",
+		"resolver":           "authoritative",
+	})), 2)
+	test.AssertEquals(t, test.CountHistogramSamples(client.totalLookupTime.With(prometheus.Labels{
+		"qtype":              "TXT",
+		"result":             "NOERROR",
+		"authenticated_data": "false",
+		"retries":            "2",
+		"resolver":           "authoritative",
+	})), 1)
+}
+
+func TestIterativeLookupCAA(t *testing.T) {
+	client, _ := setupIterative(t, true)
+
+	testCases := []struct {
+		name          string
+		expectedValue string
+		expectedErr   string
+	}{
+		{
+			name:          "www.example",
+			expectedValue: "ca.test",
+		},
+		{
+			// NXDOMAIN is returned as an empty set, as it is for the recursive
+			// resolvers.
+			name: "missing.example",
+		},
+		{
+			name:        "loop1.example",
+			expectedErr: "DNS problem: more than 8 CNAMEs following loop1.example. looking up CAA for loop1.example",
+		},
+		{
+			name:        "lame.example",
+			expectedErr: "DNS problem: nameservers for lame.example. returned neither an authoritative answer nor a referral for lame.example. looking up CAA for lame.example",
+		},
+		{
+			name:        "nonauth.example",
+			expectedErr: "DNS problem: nameservers for nonauth.example. returned neither an authoritative answer nor a referral for nonauth.example. looking up CAA for nonauth.example",
+		},
+		{
+			// The lame server is skipped in favour of the other.
+			name:          "mixed.example",
+			expectedValue: "ca.test",
+		},
+		{
+			// The DNAME is followed rather than the CNAME that doesn't
+			// match it.
+			name:          "www.renamed.example",
+			expectedValue: "ca.test",
+		},
+		{
+			name:        "a.b.c.d.e.f.g.h.i.j.k.l.m.n.o.p.q.r.s.t.deep.example",
+			expectedErr: "DNS problem: more than 16 referrals resolving a.b.c.d.e.f.g.h.i.j.k.l.m.n.o.p.q.r.s.t.deep.example. looking up CAA for a.b.c.d.e.f.g.h.i.j.k.l.m.n.o.p.q.r.s.t.deep.example",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			caas, _, err := client.LookupCAA(context.Background(), tc.name)
+			if tc.expectedErr != "" {
+				test.AssertError(t, err, "expected an error")
+				test.AssertEquals(t, err.Error(), tc.expectedErr)
+				return
+			}
+			test.AssertNotError(t, err, "looking up CAA")
+			if tc.expectedValue == "" {
+				test.AssertEquals(t, len(caas), 0)
+				return
+			}
+			test.AssertEquals(t, len(caas), 1)
+			test.AssertEquals(t, caas[0].Value, tc.expectedValue)
+		})
+	}
+}
+
+func TestIterativeRestrictedAddresses(t *testing.T) {
+	client, exchanger := setupIterative(t, false)
+
+	_, _, err := client.LookupTXT(context.Background(), "_acme-challenge.www.example")
+	test.AssertError(t, err, "expected an error for a nameserver at a reserved address")
+	test.AssertEquals(t, err.Error(), "DNS problem: no usable addresses for the nameservers of example. looking up TXT for _acme-challenge.www.example")
+	for _, query := range exchanger.queries {
+		test.Assert(t, strings.HasPrefix(query, "192.0.2.1:53 "), fmt.Sprintf("unexpected query %q", query))
+	}
+}
+
+func TestEnableIterativeResolution(t *testing.T) {
+	client := NewTestDNSClientImpl(time.Second, []string{dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+
+	err := client.EnableIterativeResolution(nil)
+	test.AssertError(t, err, "expected an error for no root hints")
+
+	err = client.EnableIterativeResolution([]string{"a.root-servers.net"})
+	test.AssertError(t, err, "expected an error for a root hint that isn't an IP address")
+
+	err = client.EnableIterativeResolution([]string{"198.41.0.4", "[2001:503:ba3e::2:30]:53"})
+	test.AssertNotError(t, err, "enabling iterative resolution")
+	test.AssertDeepEquals(t, client.iterative.roots, []string{"198.41.0.4:53", "[2001:503:ba3e::2:30]:53"})
+}
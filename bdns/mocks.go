@@ -18,36 +18,46 @@ type MockDNSClient struct {
 }
 
 // LookupTXT is a mock
-func (mock *MockDNSClient) LookupTXT(_ context.Context, hostname string) ([]string, error) {
+func (mock *MockDNSClient) LookupTXT(_ context.Context, hostname string) ([]string, DNSSECStatus, error) {
 	if hostname == "_acme-challenge.servfail.com" {
-		return nil, fmt.Errorf("SERVFAIL")
+		return nil, DNSSECUnchecked, fmt.Errorf("SERVFAIL")
 	}
 	if hostname == "_acme-challenge.good-dns01.com" {
 		// base64(sha256("LoqXcYV8q5ONbJQxbmR7SCTNo3tiAXDfowyjxAjEuX0"
 		//               + "." + "9jg46WB3rR_AHD-EBXdN7cBkH1WOu0tA3M9fm21mqTI"))
 		// expected token + test account jwk thumbprint
-		return []string{"LPsIwTo7o8BoG0-vjCyGQGBWSVIPxI-i_X336eUOQZo"}, nil
+		return []string{"LPsIwTo7o8BoG0-vjCyGQGBWSVIPxI-i_X336eUOQZo"}, DNSSECUnchecked, nil
 	}
 	if hostname == "_acme-challenge.wrong-dns01.com" {
-		return []string{"a"}, nil
+		return []string{"a"}, DNSSECUnchecked, nil
 	}
 	if hostname == "_acme-challenge.wrong-many-dns01.com" {
-		return []string{"a", "b", "c", "d", "e"}, nil
+		return []string{"a", "b", "c", "d", "e"}, DNSSECUnchecked, nil
 	}
 	if hostname == "_acme-challenge.long-dns01.com" {
-		return []string{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}, nil
+		return []string{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}, DNSSECUnchecked, nil
 	}
 	if hostname == "_acme-challenge.no-authority-dns01.com" {
 		// base64(sha256("LoqXcYV8q5ONbJQxbmR7SCTNo3tiAXDfowyjxAjEuX0"
 		//               + "." + "9jg46WB3rR_AHD-EBXdN7cBkH1WOu0tA3M9fm21mqTI"))
 		// expected token + test account jwk thumbprint
-		return []string{"LPsIwTo7o8BoG0-vjCyGQGBWSVIPxI-i_X336eUOQZo"}, nil
+		return []string{"LPsIwTo7o8BoG0-vjCyGQGBWSVIPxI-i_X336eUOQZo"}, DNSSECUnchecked, nil
+	}
+	if hostname == "_o7v76rusep3qjnvt._acme-challenge.good-dns-account01.com" {
+		// The dns-account-01 label for account URI
+		// "http://boulder:4000/acme/reg/1", with the same key authorization
+		// digest as good-dns01.com
+		return []string{"LPsIwTo7o8BoG0-vjCyGQGBWSVIPxI-i_X336eUOQZo"}, DNSSECUnchecked, nil
+	}
+	// dnssec-bogus.com always fails DNSSEC validation
+	if hostname == "_acme-challenge.dnssec-bogus.com" {
+		return nil, DNSSECBogus, &DNSError{dns.TypeTXT, hostname, bogusf("no valid signature by dnssec-bogus.com. over %s. TXT", hostname), -1}
 	}
 	// empty-txts.com always returns zero TXT records
 	if hostname == "_acme-challenge.empty-txts.com" {
-		return []string{}, nil
+		return []string{}, DNSSECUnchecked, nil
 	}
-	return []string{"hostname"}, nil
+	return []string{"hostname"}, DNSSECUnchecked, nil
 }
 
 // MockTimeoutError returns a a net.OpError for which Timeout() returns true.
@@ -67,13 +77,13 @@ func (t timeoutError) Timeout() bool {
 }
 
 // LookupHost is a mock
-func (mock *MockDNSClient) LookupHost(_ context.Context, hostname string) ([]net.IP, error) {
+func (mock *MockDNSClient) LookupHost(_ context.Context, hostname string) ([]net.IP, DNSSECStatus, error) {
 	if hostname == "always.invalid" ||
 		hostname == "invalid.invalid" {
-		return []net.IP{}, nil
+		return []net.IP{}, DNSSECUnchecked, nil
 	}
 	if hostname == "always.timeout" {
-		return []net.IP{}, &DNSError{dns.TypeA, "always.timeout", MockTimeoutError(), -1}
+		return []net.IP{}, DNSSECUnchecked, &DNSError{dns.TypeA, "always.timeout", MockTimeoutError(), -1}
 	}
 	if hostname == "always.error" {
 		err := &net.OpError{
@@ -86,7 +96,7 @@ func (mock *MockDNSClient) LookupHost(_ context.Context, hostname string) ([]net
 		m.AuthenticatedData = true
 		m.SetEdns0(4096, false)
 		logDNSError(mock.Log, "mock.server", hostname, m, nil, err)
-		return []net.IP{}, &DNSError{dns.TypeA, hostname, err, -1}
+		return []net.IP{}, DNSSECUnchecked, &DNSError{dns.TypeA, hostname, err, -1}
 	}
 	if hostname == "id.mismatch" {
 		err := dns.ErrId
@@ -100,25 +110,29 @@ func (mock *MockDNSClient) LookupHost(_ context.Context, hostname string) ([]net
 		record.A = net.ParseIP("127.0.0.1")
 		r.Answer = append(r.Answer, record)
 		logDNSError(mock.Log, "mock.server", hostname, m, r, err)
-		return []net.IP{}, &DNSError{dns.TypeA, hostname, err, -1}
+		return []net.IP{}, DNSSECUnchecked, &DNSError{dns.TypeA, hostname, err, -1}
 	}
 	// dual-homed host with an IPv6 and an IPv4 address
 	if hostname == "ipv4.and.ipv6.localhost" {
 		return []net.IP{
 			net.ParseIP("::1"),
 			net.ParseIP("127.0.0.1"),
-		}, nil
+		}, DNSSECUnchecked, nil
 	}
 	if hostname == "ipv6.localhost" {
 		return []net.IP{
 			net.ParseIP("::1"),
-		}, nil
+		}, DNSSECUnchecked, nil
 	}
 	ip := net.ParseIP("127.0.0.1")
-	return []net.IP{ip}, nil
+	// dnssec-secure.com always has a DNSSEC validated answer
+	if hostname == "dnssec-secure.com" {
+		return []net.IP{ip}, DNSSECSecure, nil
+	}
+	return []net.IP{ip}, DNSSECUnchecked, nil
 }
 
 // LookupCAA returns mock records for use in tests.
-func (mock *MockDNSClient) LookupCAA(_ context.Context, domain string) ([]*dns.CAA, error) {
-	return nil, nil
+func (mock *MockDNSClient) LookupCAA(_ context.Context, domain string) ([]*dns.CAA, DNSSECStatus, error) {
+	return nil, DNSSECUnchecked, nil
 }
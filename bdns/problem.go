@@ -34,6 +34,10 @@ func (d DNSError) Error() string {
 			// happens for `*net.OpError` underlying types!
 		} else if d.underlying == context.Canceled || d.underlying == context.DeadlineExceeded {
 			detail = detailDNSTimeout
+		} else if bogus, ok := d.underlying.(bogusError); ok {
+			detail = bogus.Error()
+		} else if iterErr, ok := d.underlying.(iterativeError); ok {
+			detail = iterErr.Error()
 		} else {
 			detail = detailServerFailure
 		}
@@ -0,0 +1,430 @@
+package bdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+const (
+	// ejectAfterFailures is the number of consecutive failed queries after
+	// which a server is ejected.
+	ejectAfterFailures = 3
+	// ejectErrorRate is the error rate above which a server is ejected, once it
+	// has answered at least minErrorRateSamples queries.
+	ejectErrorRate      = 0.5
+	minErrorRateSamples = 10
+	// ejectCooldown is how long an ejected server is avoided for. Afterwards
+	// it's put back into rotation, but with its error rate unchanged, so that a
+	// server that is still unhealthy is ejected again by its next failure.
+	ejectCooldown = 30 * time.Second
+
+	// latencyWeight and errorWeight are the weights given to each new sample
+	// in the moving averages of a server's latency and error rate.
+	latencyWeight = 0.2
+	errorWeight   = 0.1
+	// minLatency is the floor applied to latencies when weighting servers, so
+	// that a server isn't given an unbounded weight by a suspiciously quick
+	// answer.
+	minLatency = time.Millisecond
+)
+
+var errNoServers = errors.New("Not configured with at least one DNS Server")
+
+// serverHealth tracks the recent behaviour of a single server.
+type serverHealth struct {
+	// latency is a moving average of the RTT of successful queries, or zero if
+	// there haven't been any.
+	latency time.Duration
+	// errorRate is a moving average of the fraction of queries that failed.
+	errorRate    float64
+	samples      int
+	failures     int
+	ejectedUntil time.Time
+}
+
+// serverLookup is the subset of *net.Resolver used to discover servers.
+type serverLookup interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// serverPool is the set of servers a DNSClientImpl sends queries to. It
+// tracks the latency and error rate of each server, preferring the fastest
+// servers and ejecting unhealthy ones for a cooldown period.
+type serverPool struct {
+	clk    clock.Clock
+	log    blog.Logger
+	lookup serverLookup
+
+	mu sync.Mutex
+	// static are the servers from the DNSClientImpl's configuration, and
+	// discovered are the servers most recently found for each discovery source.
+	static     []string
+	discovered map[string][]string
+	// servers is the union of static and discovered.
+	servers []string
+	health  map[string]*serverHealth
+
+	latencyGauge   *prometheus.GaugeVec
+	errorRateGauge *prometheus.GaugeVec
+	healthyGauge   *prometheus.GaugeVec
+	ejections      *prometheus.CounterVec
+}
+
+func newServerPool(servers []string, stats prometheus.Registerer, clk clock.Clock, log blog.Logger) *serverPool {
+	latencyGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dns_resolver_latency_seconds",
+			Help: "Moving average of the time taken by each resolver to answer a query",
+		},
+		[]string{"resolver"},
+	)
+	errorRateGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dns_resolver_error_rate",
+			Help: "Moving average of the fraction of queries to each resolver that failed",
+		},
+		[]string{"resolver"},
+	)
+	healthyGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "dns_resolver_healthy",
+			Help: "Whether each resolver is in rotation (1) or ejected (0)",
+		},
+		[]string{"resolver"},
+	)
+	ejections := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_resolver_ejections",
+			Help: "Counter of times each resolver was ejected for being unhealthy",
+		},
+		[]string{"resolver"},
+	)
+	stats.MustRegister(latencyGauge, errorRateGauge, healthyGauge, ejections)
+
+	p := &serverPool{
+		clk:            clk,
+		log:            log,
+		lookup:         net.DefaultResolver,
+		static:         servers,
+		discovered:     make(map[string][]string),
+		health:         make(map[string]*serverHealth),
+		latencyGauge:   latencyGauge,
+		errorRateGauge: errorRateGauge,
+		healthyGauge:   healthyGauge,
+		ejections:      ejections,
+	}
+	p.rebuild()
+	return p
+}
+
+// rebuild recomputes servers from static and discovered, keeping the health
+// of servers that remain. It must be called with mu held.
+func (p *serverPool) rebuild() {
+	sources := make([]string, 0, len(p.discovered))
+	for source := range p.discovered {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	seen := make(map[string]bool)
+	var servers []string
+	add := func(list []string) {
+		for _, server := range list {
+			if !seen[server] {
+				seen[server] = true
+				servers = append(servers, server)
+			}
+		}
+	}
+	add(p.static)
+	for _, source := range sources {
+		add(p.discovered[source])
+	}
+
+	for server := range p.health {
+		if !seen[server] {
+			delete(p.health, server)
+			p.latencyGauge.DeleteLabelValues(server)
+			p.errorRateGauge.DeleteLabelValues(server)
+			p.healthyGauge.DeleteLabelValues(server)
+		}
+	}
+	for _, server := range servers {
+		if _, ok := p.health[server]; !ok {
+			p.health[server] = &serverHealth{}
+			p.healthyGauge.WithLabelValues(server).Set(1)
+		}
+	}
+	p.servers = servers
+}
+
+// choose picks a server to send a query to, skipping those in tried unless
+// every server has been tried. Healthy servers that haven't answered a query
+// yet are chosen first so that every server's latency is measured, and
+// otherwise healthy servers are chosen at random, weighted towards those with
+// the lowest latency. If every remaining server is ejected, the one whose
+// cooldown ends first is chosen.
+func (p *serverPool) choose(tried map[string]bool) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.servers) == 0 {
+		return "", errNoServers
+	}
+
+	now := p.clk.Now()
+	var healthy, ejected []string
+	for len(healthy) == 0 && len(ejected) == 0 {
+		for _, server := range p.servers {
+			if tried[server] {
+				continue
+			}
+			h := p.health[server]
+			if h.ejectedUntil.After(now) {
+				ejected = append(ejected, server)
+				continue
+			}
+			if !h.ejectedUntil.IsZero() {
+				h.ejectedUntil = time.Time{}
+				p.healthyGauge.WithLabelValues(server).Set(1)
+			}
+			healthy = append(healthy, server)
+		}
+		// If every server has already been tried, start again.
+		tried = nil
+	}
+	if len(healthy) == 0 {
+		soonest := ejected[0]
+		for _, server := range ejected[1:] {
+			if p.health[server].ejectedUntil.Before(p.health[soonest].ejectedUntil) {
+				soonest = server
+			}
+		}
+		return soonest, nil
+	}
+	var unmeasured []string
+	for _, server := range healthy {
+		if p.health[server].samples == 0 {
+			unmeasured = append(unmeasured, server)
+		}
+	}
+	if len(unmeasured) > 0 {
+		return unmeasured[rand.Intn(len(unmeasured))], nil
+	}
+	return p.weighted(healthy), nil
+}
+
+// weighted picks one of servers at random, with a probability proportional
+// to the inverse square of its latency. Servers that have only ever failed
+// are weighted as though they were as fast as the fastest server. It must be
+// called with mu held.
+func (p *serverPool) weighted(servers []string) string {
+	fastest := time.Duration(0)
+	for _, server := range servers {
+		latency := p.health[server].latency
+		if latency != 0 && (fastest == 0 || latency < fastest) {
+			fastest = latency
+		}
+	}
+	weights := make([]float64, len(servers))
+	var total float64
+	for i, server := range servers {
+		latency := p.health[server].latency
+		if latency == 0 {
+			latency = fastest
+		}
+		if latency < minLatency {
+			latency = minLatency
+		}
+		weights[i] = 1 / (latency.Seconds() * latency.Seconds())
+		total += weights[i]
+	}
+	r := rand.Float64() * total
+	for i, weight := range weights {
+		r -= weight
+		if r < 0 {
+			return servers[i]
+		}
+	}
+	return servers[len(servers)-1]
+}
+
+// record updates the health of server with the result of a query to it. Only
+// errors exchanging messages count as failures: a SERVFAIL or NXDOMAIN is as
+// likely to be the queried domain's fault as the server's.
+func (p *serverPool) record(server string, rtt time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	h, ok := p.health[server]
+	if !ok {
+		// The server was removed while the query was in flight.
+		return
+	}
+	h.samples++
+	if err == nil {
+		h.failures = 0
+		h.errorRate *= 1 - errorWeight
+		if h.latency == 0 {
+			h.latency = rtt
+		} else {
+			h.latency = time.Duration(float64(h.latency)*(1-latencyWeight) + float64(rtt)*latencyWeight)
+		}
+		p.latencyGauge.WithLabelValues(server).Set(h.latency.Seconds())
+	} else {
+		h.failures++
+		h.errorRate = h.errorRate*(1-errorWeight) + errorWeight
+		unhealthy := h.failures >= ejectAfterFailures ||
+			(h.samples >= minErrorRateSamples && h.errorRate > ejectErrorRate)
+		now := p.clk.Now()
+		if unhealthy && !h.ejectedUntil.After(now) {
+			h.ejectedUntil = now.Add(ejectCooldown)
+			h.failures = 0
+			p.ejections.WithLabelValues(server).Inc()
+			p.healthyGauge.WithLabelValues(server).Set(0)
+			p.log.Warningf("Ejecting DNS resolver %s for %s: error rate %.2f, last error: %s",
+				server, ejectCooldown, h.errorRate, err)
+		}
+	}
+	p.errorRateGauge.WithLabelValues(server).Set(h.errorRate)
+}
+
+// label returns server, as the resolvers in the pool are few enough to label
+// metrics with individually.
+func (p *serverPool) label(server string) string {
+	return server
+}
+
+// DiscoverServers adds the resolvers found by looking up each of sources to
+// the configured servers, and looks them up again every interval until ctx is
+// done so that the pool of resolvers can change without a restart. A source
+// with a port (e.g. "unbound.service:53") is looked up as a hostname and each
+// of its addresses used with that port. A source without one (e.g.
+// "_dns._udp.resolvers.example") is looked up as an SRV record and each of its
+// targets' addresses used with the target's port. Sources are looked up
+// using the system resolver.
+//
+// The initial lookup happens before DiscoverServers returns, and any error
+// from it is returned. Later errors are logged and the servers previously
+// found for the failing source are kept.
+//
+// Discovered servers are always queried over plain UDP (or TCP for truncated
+// responses). ConfigureTransports only applies to configured servers, since
+// a discovered server's address can't name a DoH endpoint and a DoT
+// configuration is specific to a single resolver.
+func (dnsClient *DNSClientImpl) DiscoverServers(ctx context.Context, sources []string, interval time.Duration) error {
+	err := dnsClient.servers.refresh(ctx, sources)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-dnsClient.clk.After(interval):
+				err := dnsClient.servers.refresh(ctx, sources)
+				if err != nil {
+					dnsClient.log.Warningf("Refreshing DNS resolvers: %s", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// refresh looks up each of sources and replaces the servers discovered from
+// it. Sources whose lookup fails keep their previous servers, and the first
+// error is returned.
+func (p *serverPool) refresh(ctx context.Context, sources []string) error {
+	var firstErr error
+	found := make(map[string][]string, len(sources))
+	for _, source := range sources {
+		servers, err := p.resolve(ctx, source)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("looking up DNS resolvers from %q: %s", source, err)
+			}
+			continue
+		}
+		found[source] = servers
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	changed := false
+	for source, servers := range found {
+		if !equalStrings(p.discovered[source], servers) {
+			p.log.Infof("DNS resolvers from %q changed to %v", source, servers)
+			p.discovered[source] = servers
+			changed = true
+		}
+	}
+	if changed {
+		p.rebuild()
+	}
+	return firstErr
+}
+
+// resolve returns the sorted host:port pairs of the servers named by source.
+func (p *serverPool) resolve(ctx context.Context, source string) ([]string, error) {
+	type target struct {
+		host string
+		port string
+	}
+	var targets []target
+	if host, port, err := net.SplitHostPort(source); err == nil {
+		targets = []target{{host, port}}
+	} else {
+		_, srvs, err := p.lookup.LookupSRV(ctx, "", "", source)
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range srvs {
+			targets = append(targets, target{srv.Target, strconv.Itoa(int(srv.Port))})
+		}
+	}
+
+	var servers []string
+	for _, t := range targets {
+		addrs := []string{t.host}
+		if net.ParseIP(t.host) == nil {
+			var err error
+			addrs, err = p.lookup.LookupHost(ctx, t.host)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, addr := range addrs {
+			servers = append(servers, net.JoinHostPort(addr, t.port))
+		}
+	}
+	if len(servers) == 0 {
+		return nil, errors.New("no resolvers found")
+	}
+	sort.Strings(servers)
+	return servers, nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
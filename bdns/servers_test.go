@@ -0,0 +1,208 @@
+package bdns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func chooseCounts(t *testing.T, p *serverPool, n int) map[string]int {
+	t.Helper()
+	counts := make(map[string]int)
+	for i := 0; i < n; i++ {
+		server, err := p.choose(nil)
+		test.AssertNotError(t, err, "choosing server")
+		counts[server]++
+	}
+	return counts
+}
+
+func TestServerPoolPrefersFastest(t *testing.T) {
+	p := newServerPool([]string{"fast", "slow", "new"}, metrics.NoopRegisterer, clock.NewFake(), blog.UseMock())
+	for i := 0; i < 5; i++ {
+		p.record("fast", 5*time.Millisecond, nil)
+		p.record("slow", 200*time.Millisecond, nil)
+	}
+
+	// A server that hasn't answered a query yet is chosen until it has.
+	test.AssertEquals(t, chooseCounts(t, p, 10)["new"], 10)
+	p.record("new", 5*time.Millisecond, nil)
+
+	counts := chooseCounts(t, p, 1000)
+	test.Assert(t, counts["slow"] < 10, "expected the slow server to be chosen rarely")
+	test.Assert(t, counts["fast"] > 300, "expected the fast server to be chosen often")
+	test.Assert(t, counts["new"] > 300, "expected the new server to be chosen often")
+
+	latency, err := test.GaugeValueWithLabels(p.latencyGauge, prometheus.Labels{"resolver": "slow"})
+	test.AssertNotError(t, err, "reading latency gauge")
+	test.AssertEquals(t, latency, 0)
+}
+
+func TestServerPoolEjection(t *testing.T) {
+	clk := clock.NewFake()
+	p := newServerPool([]string{"a", "b"}, metrics.NoopRegisterer, clk, blog.UseMock())
+	tempErr := &net.OpError{Op: "read", Err: tempError(true)}
+	p.record("a", time.Millisecond, nil)
+	p.record("b", time.Millisecond, nil)
+
+	// Failures below the threshold don't eject a server.
+	for i := 0; i < ejectAfterFailures-1; i++ {
+		p.record("a", 0, tempErr)
+	}
+	test.Assert(t, chooseCounts(t, p, 100)["a"] > 0, "expected a to still be chosen")
+
+	p.record("a", 0, tempErr)
+	test.AssertEquals(t, chooseCounts(t, p, 100)["a"], 0)
+	test.AssertEquals(t, test.CountCounterVec("resolver", "a", p.ejections), 1)
+	healthy, err := test.GaugeValueWithLabels(p.healthyGauge, prometheus.Labels{"resolver": "a"})
+	test.AssertNotError(t, err, "reading healthy gauge")
+	test.AssertEquals(t, healthy, 0)
+
+	// An ejected server is only chosen when every other server has been tried.
+	server, err := p.choose(map[string]bool{"b": true})
+	test.AssertNotError(t, err, "choosing server")
+	test.AssertEquals(t, server, "a")
+
+	// After the cooldown it's back in rotation.
+	clk.Add(ejectCooldown)
+	test.Assert(t, chooseCounts(t, p, 100)["a"] > 0, "expected a to be chosen after its cooldown")
+	healthy, err = test.GaugeValueWithLabels(p.healthyGauge, prometheus.Labels{"resolver": "a"})
+	test.AssertNotError(t, err, "reading healthy gauge")
+	test.AssertEquals(t, healthy, 1)
+
+	// A server that fails too often is ejected even without consecutive
+	// failures.
+	for i := 0; i < 20; i++ {
+		p.record("b", time.Millisecond, nil)
+		p.record("b", 0, tempErr)
+		p.record("b", 0, tempErr)
+	}
+	test.AssertEquals(t, chooseCounts(t, p, 100)["b"], 0)
+
+	// When every server is ejected, the one whose cooldown ends first is used.
+	clk.Add(time.Second)
+	for i := 0; i < ejectAfterFailures; i++ {
+		p.record("a", 0, tempErr)
+	}
+	server, err = p.choose(nil)
+	test.AssertNotError(t, err, "choosing server")
+	test.AssertEquals(t, server, "b")
+}
+
+func TestServerPoolNoServers(t *testing.T) {
+	p := newServerPool(nil, metrics.NoopRegisterer, clock.NewFake(), blog.UseMock())
+	_, err := p.choose(nil)
+	test.AssertEquals(t, err, errNoServers)
+}
+
+type mockServerLookup struct {
+	sync.Mutex
+	hosts map[string][]string
+	srvs  map[string][]*net.SRV
+}
+
+func (m *mockServerLookup) LookupHost(_ context.Context, host string) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	addrs, ok := m.hosts[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func (m *mockServerLookup) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	m.Lock()
+	defer m.Unlock()
+	srvs, ok := m.srvs[name]
+	if !ok {
+		return "", nil, errors.New("no such host")
+	}
+	return name, srvs, nil
+}
+
+func TestDiscoverServers(t *testing.T) {
+	fc := clock.NewFake()
+	obj := NewTestDNSClientImpl(time.Second, []string{"10.0.0.1:53"}, metrics.NoopRegisterer, fc, 1, blog.UseMock())
+	lookup := &mockServerLookup{
+		hosts: map[string][]string{
+			"unbound.service":     {"10.0.0.3", "10.0.0.2"},
+			"unbound-a.resolvers": {"10.0.1.1"},
+			"unbound-b.resolvers": {"10.0.1.2", "10.0.0.1"},
+		},
+		srvs: map[string][]*net.SRV{
+			"_dns._udp.resolvers": {
+				{Target: "unbound-a.resolvers", Port: 53},
+				{Target: "unbound-b.resolvers", Port: 5353},
+			},
+		},
+	}
+	obj.servers.lookup = lookup
+	sources := []string{"unbound.service:53", "_dns._udp.resolvers"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := obj.DiscoverServers(ctx, sources, time.Hour)
+	test.AssertNotError(t, err, "discovering servers")
+	test.AssertDeepEquals(t, obj.servers.servers, []string{
+		"10.0.0.1:53",
+		"10.0.0.1:5353", "10.0.1.1:53", "10.0.1.2:5353",
+		"10.0.0.2:53", "10.0.0.3:53",
+	})
+
+	// A server's health is kept across a refresh that still includes it, and
+	// forgotten once it's removed.
+	obj.servers.record("10.0.0.2:53", 10*time.Millisecond, nil)
+	obj.servers.record("10.0.0.3:53", 10*time.Millisecond, nil)
+	lookup.Lock()
+	lookup.hosts["unbound.service"] = []string{"10.0.0.2"}
+	lookup.Unlock()
+	err = obj.servers.refresh(ctx, sources)
+	test.AssertNotError(t, err, "refreshing servers")
+	test.AssertDeepEquals(t, obj.servers.servers, []string{
+		"10.0.0.1:53",
+		"10.0.0.1:5353", "10.0.1.1:53", "10.0.1.2:5353",
+		"10.0.0.2:53",
+	})
+	test.AssertEquals(t, obj.servers.health["10.0.0.2:53"].latency, 10*time.Millisecond)
+	_, ok := obj.servers.health["10.0.0.3:53"]
+	test.Assert(t, !ok, "expected removed server's health to be forgotten")
+
+	// A source that fails to resolve keeps its previous servers.
+	lookup.Lock()
+	delete(lookup.srvs, "_dns._udp.resolvers")
+	lookup.Unlock()
+	err = obj.servers.refresh(ctx, sources)
+	test.AssertError(t, err, "expected an error refreshing servers")
+	test.AssertEquals(t, len(obj.servers.servers), 5)
+
+	// The sources are looked up again every interval.
+	lookup.Lock()
+	lookup.hosts["unbound.service"] = []string{"10.0.0.4"}
+	lookup.srvs["_dns._udp.resolvers"] = []*net.SRV{{Target: "unbound-a.resolvers", Port: 53}}
+	lookup.Unlock()
+	servers := func() []string {
+		obj.servers.mu.Lock()
+		defer obj.servers.mu.Unlock()
+		return obj.servers.servers
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(servers()) != 3 && time.Now().Before(deadline) {
+		fc.Add(time.Hour)
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.AssertDeepEquals(t, servers(), []string{"10.0.0.1:53", "10.0.1.1:53", "10.0.0.4:53"})
+
+	err = obj.DiscoverServers(ctx, []string{"missing.service:53"}, time.Hour)
+	test.AssertError(t, err, "expected an error for a source that doesn't resolve")
+}
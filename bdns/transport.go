@@ -0,0 +1,334 @@
+package bdns
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// TransportTLS sends queries using DNS over TLS (RFC 7858).
+	TransportTLS = "tls"
+	// TransportHTTPS sends queries using DNS over HTTPS (RFC 8484).
+	TransportHTTPS = "https"
+
+	// dohMediaType is the media type of DNS over HTTPS requests and responses
+	// (RFC 8484 Section 6).
+	dohMediaType = "application/dns-message"
+
+	// maxIdleConns is the number of idle connections kept open to each
+	// resolver using an encrypted transport.
+	maxIdleConns = 8
+)
+
+// TransportConfig configures an encrypted transport to a single resolver.
+// Resolvers without a TransportConfig are queried over plain UDP.
+type TransportConfig struct {
+	// Protocol is either "tls" or "https". For "tls" the resolver's address is
+	// a host:port pair, for "https" it is the URL of its DoH endpoint.
+	Protocol string
+	// ServerName is the name the resolver's certificate is verified against.
+	// Defaults to the host in the resolver's address.
+	ServerName string
+	// CACertFile is a PEM file of the roots used to verify the resolver's
+	// certificate. Defaults to the system roots.
+	CACertFile string
+	// SPKIPins, if set, are base64 encoded SHA-256 hashes of
+	// SubjectPublicKeyInfos. At least one certificate in the resolver's verified
+	// chain must match one of them.
+	SPKIPins []string
+}
+
+// ConfigureTransports sets up encrypted transports for the resolvers named in
+// transports, which must each be one of the configured servers rather than
+// one found by DiscoverServers. Queries to any other server continue to use
+// the default exchanger.
+func (dnsClient *DNSClientImpl) ConfigureTransports(transports map[string]TransportConfig) error {
+	known := make(map[string]bool, len(dnsClient.servers.static))
+	for _, server := range dnsClient.servers.static {
+		known[server] = true
+	}
+	secure := make(map[string]exchanger, len(transports))
+	for server, config := range transports {
+		if !known[server] {
+			return fmt.Errorf("transport configured for %q, which isn't a configured DNS resolver", server)
+		}
+		e, err := newTransportExchanger(server, config, dnsClient.readTimeout)
+		if err != nil {
+			return fmt.Errorf("configuring transport for %q: %s", server, err)
+		}
+		secure[server] = e
+	}
+	dnsClient.dnsClient = &transportExchanger{plain: dnsClient.dnsClient, secure: secure}
+	return nil
+}
+
+// transportExchanger dispatches each query to the exchanger for the transport
+// configured for its server.
+type transportExchanger struct {
+	plain  exchanger
+	secure map[string]exchanger
+}
+
+func (e *transportExchanger) Exchange(m *dns.Msg, a string) (*dns.Msg, time.Duration, error) {
+	if secure, ok := e.secure[a]; ok {
+		return secure.Exchange(m, a)
+	}
+	return e.plain.Exchange(m, a)
+}
+
+func newTransportExchanger(server string, config TransportConfig, readTimeout time.Duration) (exchanger, error) {
+	var host string
+	switch config.Protocol {
+	case TransportTLS:
+		h, _, err := net.SplitHostPort(server)
+		if err != nil {
+			return nil, err
+		}
+		host = h
+	case TransportHTTPS:
+		u, err := url.Parse(server)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return nil, fmt.Errorf("DNS over HTTPS resolver must be an https URL")
+		}
+		host = u.Hostname()
+	default:
+		return nil, fmt.Errorf("unknown protocol %q", config.Protocol)
+	}
+
+	tlsConfig, err := config.tlsConfig(host)
+	if err != nil {
+		return nil, err
+	}
+	if config.Protocol == TransportTLS {
+		return &dotExchanger{tlsConfig: tlsConfig, timeout: readTimeout}, nil
+	}
+	return &dohExchanger{
+		client: &http.Client{
+			Timeout: readTimeout,
+			Transport: &http.Transport{
+				TLSClientConfig:     tlsConfig,
+				ForceAttemptHTTP2:   true,
+				MaxIdleConnsPerHost: maxIdleConns,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+	}, nil
+}
+
+// tlsConfig returns the TLS configuration used to connect to a resolver at
+// host.
+func (config TransportConfig) tlsConfig(host string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName: host,
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.ServerName != "" {
+		tlsConfig.ServerName = config.ServerName
+	}
+	if config.CACertFile != "" {
+		pem, err := ioutil.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA cert from %q: %s", config.CACertFile, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("parsing CA certs from %q failed", config.CACertFile)
+		}
+	}
+	if len(config.SPKIPins) > 0 {
+		var pins [][]byte
+		for _, pin := range config.SPKIPins {
+			hash, err := base64.StdEncoding.DecodeString(pin)
+			if err != nil || len(hash) != sha256.Size {
+				return nil, fmt.Errorf("SPKI pin %q isn't a base64 encoded SHA-256 hash", pin)
+			}
+			pins = append(pins, hash)
+		}
+		tlsConfig.VerifyPeerCertificate = verifyPins(pins)
+	}
+	return tlsConfig, nil
+}
+
+// verifyPins returns a VerifyPeerCertificate callback that requires a
+// certificate in one of the verified chains to have a SubjectPublicKeyInfo
+// whose SHA-256 hash matches one of pins. It's only called after the usual
+// chain verification succeeds, and since no session cache is configured every
+// connection performs a full handshake.
+func verifyPins(pins [][]byte) func([][]byte, [][]*x509.Certificate) error {
+	return func(_ [][]byte, chains [][]*x509.Certificate) error {
+		for _, chain := range chains {
+			for _, cert := range chain {
+				hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+				for _, pin := range pins {
+					if bytes.Equal(hash[:], pin) {
+						return nil
+					}
+				}
+			}
+		}
+		return errors.New("no certificate in the resolver's chain matches a pinned key")
+	}
+}
+
+// dotExchanger sends queries to a single resolver over TLS, reusing idle
+// connections rather than performing a handshake for every query.
+type dotExchanger struct {
+	tlsConfig *tls.Config
+	// timeout bounds each query, including any retries on other connections.
+	// Zero means no timeout.
+	timeout time.Duration
+
+	mu   sync.Mutex
+	idle []*dns.Conn
+}
+
+func (e *dotExchanger) Exchange(m *dns.Msg, a string) (*dns.Msg, time.Duration, error) {
+	start := time.Now()
+	var deadline time.Time
+	if e.timeout > 0 {
+		deadline = start.Add(e.timeout)
+	}
+	for {
+		conn := e.get()
+		reused := conn != nil
+		if !reused {
+			tlsConn, err := tls.DialWithDialer(&net.Dialer{Deadline: deadline}, "tcp", a, e.tlsConfig)
+			if err != nil {
+				return nil, time.Since(start), err
+			}
+			conn = &dns.Conn{Conn: tlsConn}
+		}
+		r, err := exchangeWithDeadline(m, conn, deadline)
+		if err != nil {
+			_ = conn.Close()
+			// The resolver may have closed an idle connection since it was last
+			// used, so retry on another one before reporting an error, in
+			// whatever time the query has left.
+			if reused && (deadline.IsZero() || time.Now().Before(deadline)) {
+				continue
+			}
+			return r, time.Since(start), err
+		}
+		e.put(conn)
+		return r, time.Since(start), nil
+	}
+}
+
+// exchangeWithDeadline sends m on conn and reads the response, giving up at
+// deadline, if it's set.
+func exchangeWithDeadline(m *dns.Msg, conn *dns.Conn, deadline time.Time) (*dns.Msg, error) {
+	err := conn.SetDeadline(deadline)
+	if err != nil {
+		return nil, err
+	}
+	err = conn.WriteMsg(m)
+	if err != nil {
+		return nil, err
+	}
+	r, err := conn.ReadMsg()
+	if err == nil && r.Id != m.Id {
+		err = dns.ErrId
+	}
+	return r, err
+}
+
+func (e *dotExchanger) get() *dns.Conn {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.idle) == 0 {
+		return nil
+	}
+	conn := e.idle[len(e.idle)-1]
+	e.idle = e.idle[:len(e.idle)-1]
+	return conn
+}
+
+func (e *dotExchanger) put(conn *dns.Conn) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.idle) >= maxIdleConns {
+		_ = conn.Close()
+		return
+	}
+	e.idle = append(e.idle, conn)
+}
+
+// dohExchanger sends queries to a single resolver's DoH endpoint. Connections
+// are reused by the http.Client's transport.
+type dohExchanger struct {
+	client *http.Client
+}
+
+func (e *dohExchanger) Exchange(m *dns.Msg, a string) (*dns.Msg, time.Duration, error) {
+	// RFC 8484 Section 4.1 recommends a message ID of 0, since HTTP already
+	// correlates the response with the request.
+	q := m.Copy()
+	q.Id = 0
+	packed, err := q.Pack()
+	if err != nil {
+		return nil, 0, err
+	}
+	req, err := http.NewRequest(http.MethodPost, a, bytes.NewReader(packed))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	start := time.Now()
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, time.Since(start), dohError(err)
+	}
+	defer resp.Body.Close()
+	// Read the whole body, even on error, so that the connection can be reused.
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	rtt := time.Since(start)
+	if err != nil {
+		return nil, rtt, dohError(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, rtt, fmt.Errorf("DNS over HTTPS resolver returned HTTP status %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != dohMediaType {
+		return nil, rtt, fmt.Errorf("DNS over HTTPS resolver returned Content-Type %q", ct)
+	}
+	r := new(dns.Msg)
+	err = r.Unpack(body)
+	if err != nil {
+		return nil, rtt, err
+	}
+	if r.Id != q.Id {
+		return r, rtt, dns.ErrId
+	}
+	r.Id = m.Id
+	return r, rtt, nil
+}
+
+// dohError converts network errors from the http.Client into *net.OpErrors so
+// that they're retried the same way as errors from the UDP exchanger.
+func dohError(err error) error {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && urlErr.Timeout() {
+		return &net.OpError{Op: "read", Net: "https", Err: urlErr.Err}
+	}
+	return err
+}
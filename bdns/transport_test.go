@@ -0,0 +1,288 @@
+package bdns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/test"
+	"github.com/miekg/dns"
+)
+
+// resolverCert returns a self-signed certificate for 127.0.0.1, the path of a
+// PEM file containing it, and its SPKI pin.
+func resolverCert(t *testing.T) (tls.Certificate, string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	test.AssertNotError(t, err, "creating certificate")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "parsing certificate")
+
+	caFile := filepath.Join(t.TempDir(), "resolver.pem")
+	err = ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	test.AssertNotError(t, err, "writing certificate")
+
+	pin := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, caFile, base64.StdEncoding.EncodeToString(pin[:])
+}
+
+// countingListener counts the connections it accepts.
+type countingListener struct {
+	net.Listener
+	accepted int64
+}
+
+func (l *countingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		atomic.AddInt64(&l.accepted, 1)
+	}
+	return conn, err
+}
+
+// dohResponseWriter adapts an http.ResponseWriter so that mockDNSQuery can
+// answer DNS over HTTPS queries.
+type dohResponseWriter struct {
+	dns.ResponseWriter
+	w http.ResponseWriter
+}
+
+func (d dohResponseWriter) WriteMsg(m *dns.Msg) error {
+	packed, err := m.Pack()
+	if err != nil {
+		return err
+	}
+	d.w.Header().Set("Content-Type", dohMediaType)
+	_, err = d.w.Write(packed)
+	return err
+}
+
+func serveDoH(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.Header.Get("Content-Type") != dohMediaType {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	m := new(dns.Msg)
+	err = m.Unpack(body)
+	if err != nil || m.Id != 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	mockDNSQuery(dohResponseWriter{w: w}, m)
+}
+
+func TestDNSOverTLS(t *testing.T) {
+	cert, caFile, pin := resolverCert(t)
+	tcp, err := net.Listen("tcp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "listening")
+	listener := &countingListener{Listener: tcp}
+	started := make(chan struct{})
+	server := &dns.Server{
+		Listener:          tls.NewListener(listener, &tls.Config{Certificates: []tls.Certificate{cert}}),
+		Handler:           dns.HandlerFunc(mockDNSQuery),
+		NotifyStartedFunc: func() { close(started) },
+	}
+	go func() {
+		_ = server.ActivateAndServe()
+	}()
+	defer func() { _ = server.Shutdown() }()
+	<-started
+	addr := tcp.Addr().String()
+
+	obj := NewTestDNSClientImpl(time.Second*10, []string{addr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	err = obj.ConfigureTransports(map[string]TransportConfig{
+		addr: {Protocol: TransportTLS, CACertFile: caFile, SPKIPins: []string{pin}},
+	})
+	test.AssertNotError(t, err, "configuring DNS over TLS")
+	for i := 0; i < 3; i++ {
+		txts, _, err := obj.LookupTXT(context.Background(), "split-txt.letsencrypt.org")
+		test.AssertNotError(t, err, "looking up TXT over TLS")
+		test.AssertDeepEquals(t, txts, []string{"abc"})
+	}
+	test.AssertEquals(t, atomic.LoadInt64(&listener.accepted), int64(1))
+
+	// A resolver whose key doesn't match the pin is rejected.
+	obj = NewTestDNSClientImpl(time.Second*10, []string{addr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	err = obj.ConfigureTransports(map[string]TransportConfig{
+		addr: {Protocol: TransportTLS, CACertFile: caFile, SPKIPins: []string{base64.StdEncoding.EncodeToString(make([]byte, 32))}},
+	})
+	test.AssertNotError(t, err, "configuring DNS over TLS")
+	_, _, err = obj.LookupTXT(context.Background(), "split-txt.letsencrypt.org")
+	test.AssertError(t, err, "expected an error for a resolver not matching the pin")
+
+	// So is one whose certificate isn't trusted.
+	obj = NewTestDNSClientImpl(time.Second*10, []string{addr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	err = obj.ConfigureTransports(map[string]TransportConfig{addr: {Protocol: TransportTLS}})
+	test.AssertNotError(t, err, "configuring DNS over TLS")
+	_, _, err = obj.LookupTXT(context.Background(), "split-txt.letsencrypt.org")
+	test.AssertError(t, err, "expected an error for an untrusted resolver")
+}
+
+func TestDNSOverTLSRetryDeadline(t *testing.T) {
+	cert, _, _ := resolverCert(t)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	test.AssertNotError(t, err, "listening")
+	defer func() { _ = listener.Close() }()
+	// The resolver completes the handshake but never answers.
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(ioutil.Discard, conn)
+			}()
+		}
+	}()
+
+	timeout := time.Second
+	e := &dotExchanger{tlsConfig: &tls.Config{InsecureSkipVerify: true}, timeout: timeout}
+	// An idle connection that the resolver closes after most of the timeout,
+	// without answering the query sent on it.
+	client, server := net.Pipe()
+	go func() {
+		_, _ = server.Read(make([]byte, 512))
+		time.Sleep(timeout * 3 / 4)
+		_ = server.Close()
+	}()
+	e.put(&dns.Conn{Conn: client})
+
+	m := new(dns.Msg)
+	m.SetQuestion("example.com.", dns.TypeTXT)
+	start := time.Now()
+	_, _, err = e.Exchange(m, listener.Addr().String())
+	test.AssertError(t, err, "expected an error from a resolver that doesn't answer")
+	// The retry on a new connection only has the time the query has left.
+	elapsed := time.Since(start)
+	test.Assert(t, elapsed < timeout*3/2, fmt.Sprintf("query took %s, longer than its timeout of %s", elapsed, timeout))
+}
+
+func TestDNSOverHTTPS(t *testing.T) {
+	cert, caFile, pin := resolverCert(t)
+	var connections int64
+	server := httptest.NewUnstartedServer(http.HandlerFunc(serveDoH))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&connections, 1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+	endpoint := server.URL + "/dns-query"
+
+	// Queries to the plain resolver are unaffected by the DoH resolver.
+	obj := NewTestDNSClientImpl(time.Second*10, []string{endpoint, dnsLoopbackAddr}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	err := obj.ConfigureTransports(map[string]TransportConfig{
+		endpoint: {Protocol: TransportHTTPS, CACertFile: caFile, SPKIPins: []string{pin}},
+	})
+	test.AssertNotError(t, err, "configuring DNS over HTTPS")
+	for i := 0; i < 6; i++ {
+		txts, _, err := obj.LookupTXT(context.Background(), "split-txt.letsencrypt.org")
+		test.AssertNotError(t, err, "looking up TXT")
+		test.AssertDeepEquals(t, txts, []string{"abc"})
+	}
+
+	obj = NewTestDNSClientImpl(time.Second*10, []string{endpoint}, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+	err = obj.ConfigureTransports(map[string]TransportConfig{
+		endpoint: {Protocol: TransportHTTPS, CACertFile: caFile, SPKIPins: []string{pin}},
+	})
+	test.AssertNotError(t, err, "configuring DNS over HTTPS")
+	before := atomic.LoadInt64(&connections)
+	for i := 0; i < 3; i++ {
+		_, _, err := obj.LookupTXT(context.Background(), "split-txt.letsencrypt.org")
+		test.AssertNotError(t, err, "looking up TXT over HTTPS")
+	}
+	_, _, err = obj.LookupTXT(context.Background(), "nxdomain.letsencrypt.org")
+	test.AssertError(t, err, "expected an NXDOMAIN error")
+	test.AssertEquals(t, atomic.LoadInt64(&connections)-before, int64(1))
+
+	_, _, err = obj.LookupTXT(context.Background(), "servfail.com")
+	test.AssertError(t, err, "expected a SERVFAIL error")
+	test.AssertEquals(t, err.Error(), "DNS problem: SERVFAIL looking up TXT for servfail.com - the domain's nameservers may be malfunctioning")
+}
+
+func TestConfigureTransports(t *testing.T) {
+	_, caFile, _ := resolverCert(t)
+	servers := []string{"127.0.0.1:853", "https://127.0.0.1/dns-query"}
+
+	testCases := []struct {
+		name        string
+		transports  map[string]TransportConfig
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			transports: map[string]TransportConfig{
+				"127.0.0.1:853":               {Protocol: TransportTLS, ServerName: "resolver.example"},
+				"https://127.0.0.1/dns-query": {Protocol: TransportHTTPS, CACertFile: caFile},
+			},
+		},
+		{
+			name:        "unknown resolver",
+			transports:  map[string]TransportConfig{"127.0.0.2:853": {Protocol: TransportTLS}},
+			expectedErr: `transport configured for "127.0.0.2:853", which isn't a configured DNS resolver`,
+		},
+		{
+			name:        "unknown protocol",
+			transports:  map[string]TransportConfig{"127.0.0.1:853": {Protocol: "quic"}},
+			expectedErr: `configuring transport for "127.0.0.1:853": unknown protocol "quic"`,
+		},
+		{
+			name:        "DoH to a host:port",
+			transports:  map[string]TransportConfig{"127.0.0.1:853": {Protocol: TransportHTTPS}},
+			expectedErr: `configuring transport for "127.0.0.1:853": DNS over HTTPS resolver must be an https URL`,
+		},
+		{
+			name:        "bad pin",
+			transports:  map[string]TransportConfig{"127.0.0.1:853": {Protocol: TransportTLS, SPKIPins: []string{"aGVsbG8="}}},
+			expectedErr: `configuring transport for "127.0.0.1:853": SPKI pin "aGVsbG8=" isn't a base64 encoded SHA-256 hash`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := NewTestDNSClientImpl(time.Second, servers, metrics.NoopRegisterer, clock.NewFake(), 1, blog.UseMock())
+			err := obj.ConfigureTransports(tc.transports)
+			if tc.expectedErr != "" {
+				test.AssertError(t, err, "expected an error")
+				test.AssertEquals(t, err.Error(), tc.expectedErr)
+				return
+			}
+			test.AssertNotError(t, err, "unexpected error")
+		})
+	}
+}
@@ -535,10 +535,33 @@ func (ca *CertificateAuthorityImpl) IssuePrecertificate(ctx context.Context, iss
 		return nil, berrors.InternalServerError("Incomplete issue certificate request")
 	}
 
-	serialBigInt, validity, err := ca.generateSerialNumberAndValidity()
+	validityPeriod, err := ca.validityPeriodForProfile(issueReq.CertProfile)
 	if err != nil {
 		return nil, err
 	}
+	// Certificates for STAR orders (RFC 8739) may be requested with a shorter
+	// lifetime than the profile's, and must not outlive the order.
+	if issueReq.Lifetime != 0 {
+		lifetime := time.Duration(issueReq.Lifetime)
+		if lifetime < 0 || lifetime > validityPeriod {
+			return nil, berrors.MalformedError("certificate lifetime %s is longer than the certificate profile's %s", lifetime, validityPeriod)
+		}
+		validityPeriod = lifetime
+	}
+
+	serialBigInt, validity, err := ca.generateSerialNumberAndValidity(validityPeriod)
+	if err != nil {
+		return nil, err
+	}
+	if issueReq.NotAfter != 0 {
+		notAfter := time.Unix(0, issueReq.NotAfter)
+		if !notAfter.After(validity.NotBefore) {
+			return nil, berrors.MalformedError("certificate NotAfter limit %s is before its NotBefore %s", notAfter, validity.NotBefore)
+		}
+		if validity.NotAfter.After(notAfter) {
+			validity.NotAfter = notAfter
+		}
+	}
 
 	serialHex := core.SerialToString(serialBigInt)
 	regID := issueReq.RegistrationID
@@ -662,6 +685,7 @@ func (ca *CertificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 		if err != nil {
 			return nil, err
 		}
+		issuanceReq.Profile = req.CertProfile
 		certDER, err = ca.defaultIssuer.boulderSigner.Issue(issuanceReq)
 		if err != nil {
 			return nil, err
@@ -681,7 +705,7 @@ func (ca *CertificateAuthorityImpl) IssueCertificateForPrecertificate(ctx contex
 		certDER = block.Bytes
 	}
 	ca.log.AuditInfof("Signing success: serial=[%s] names=[%s] csr=[%s] certificate=[%s]",
-		serialHex, strings.Join(precert.DNSNames, ", "), hex.EncodeToString(req.DER),
+		serialHex, strings.Join(core.UniqueLowerNamesAndIPs(precert.DNSNames, precert.IPAddresses), ", "), hex.EncodeToString(req.DER),
 		hex.EncodeToString(certDER))
 	err = ca.storeCertificate(ctx, req.RegistrationID, req.OrderID, precert.SerialNumber, certDER)
 	if err != nil {
@@ -706,7 +730,28 @@ type validity struct {
 	NotAfter  time.Time
 }
 
-func (ca *CertificateAuthorityImpl) generateSerialNumberAndValidity() (*big.Int, validity, error) {
+// validityPeriodForProfile returns how long certificates issued with the named
+// certificate profile are valid for. The empty name selects the default
+// profile. Profiles other than the default are only supported by the boulder
+// signer.
+func (ca *CertificateAuthorityImpl) validityPeriodForProfile(profile string) (time.Duration, error) {
+	if !features.Enabled(features.NonCFSSLSigner) {
+		if profile != "" {
+			return 0, berrors.MalformedError("certificate profile %q is not supported", profile)
+		}
+		return ca.validityPeriod, nil
+	}
+	validityPeriod, err := ca.defaultIssuer.boulderSigner.ProfileValidity(profile)
+	if err != nil {
+		return 0, berrors.MalformedError("certificate profile %q is not supported", profile)
+	}
+	if validityPeriod == 0 {
+		return ca.validityPeriod, nil
+	}
+	return validityPeriod, nil
+}
+
+func (ca *CertificateAuthorityImpl) generateSerialNumberAndValidity(validityPeriod time.Duration) (*big.Int, validity, error) {
 	// We want 136 bits of random number, plus an 8-bit instance id prefix.
 	const randBits = 136
 	serialBytes := make([]byte, randBits/8+1)
@@ -723,7 +768,7 @@ func (ca *CertificateAuthorityImpl) generateSerialNumberAndValidity() (*big.Int,
 	notBefore := ca.clk.Now().Add(-1 * ca.backdate)
 	validity := validity{
 		NotBefore: notBefore,
-		NotAfter:  notBefore.Add(ca.validityPeriod),
+		NotAfter:  notBefore.Add(validityPeriod),
 	}
 
 	return serialBigInt, validity, nil
@@ -763,20 +808,23 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 	}
 
 	serialHex := core.SerialToString(serialBigInt)
+	names := csrlib.Names(csr)
 
 	var certDER []byte
 	if features.Enabled(features.NonCFSSLSigner) {
 		ca.log.AuditInfof("Signing: serial=[%s] names=[%s] csr=[%s]",
-			serialHex, strings.Join(csr.DNSNames, ", "), hex.EncodeToString(csr.Raw))
+			serialHex, strings.Join(names, ", "), hex.EncodeToString(csr.Raw))
 		certDER, err = issuer.boulderSigner.Issue(&bsigner.IssuanceRequest{
 			PublicKey:         csr.PublicKey,
 			Serial:            serialBigInt.Bytes(),
 			CommonName:        csr.Subject.CommonName,
 			DNSNames:          csr.DNSNames,
+			IPAddresses:       csr.IPAddresses,
 			IncludeCTPoison:   true,
 			IncludeMustStaple: bsigner.ContainsMustStaple(csr.Extensions),
 			NotBefore:         validity.NotBefore,
 			NotAfter:          validity.NotAfter,
+			Profile:           issueReq.CertProfile,
 		})
 		ca.noteSignError(err)
 		if err != nil {
@@ -807,7 +855,7 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 		req := signer.SignRequest{
 			Request: csrPEM,
 			Profile: profile,
-			Hosts:   csr.DNSNames,
+			Hosts:   names,
 			Subject: &signer.Subject{
 				CN: csr.Subject.CommonName,
 			},
@@ -819,7 +867,7 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 		}
 
 		ca.log.AuditInfof("Signing: serial=[%s] names=[%s] csr=[%s]",
-			serialHex, strings.Join(csr.DNSNames, ", "), hex.EncodeToString(csr.Raw))
+			serialHex, strings.Join(names, ", "), hex.EncodeToString(csr.Raw))
 
 		certPEM, err := issuer.cfsslSigner.Sign(req)
 		ca.noteSignError(err)
@@ -858,7 +906,7 @@ func (ca *CertificateAuthorityImpl) issuePrecertificateInner(ctx context.Context
 	ca.signatureCount.WithLabelValues(string(precertType)).Inc()
 
 	ca.log.AuditInfof("Signing success: serial=[%s] names=[%s] csr=[%s] precertificate=[%s]",
-		serialHex, strings.Join(csr.DNSNames, ", "), hex.EncodeToString(csr.Raw),
+		serialHex, strings.Join(names, ", "), hex.EncodeToString(csr.Raw),
 		hex.EncodeToString(certDER))
 
 	return certDER, nil
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/big"
+	"net"
 	"os"
 	"strings"
 	"testing"
@@ -95,6 +96,13 @@ var (
 	// * DNSNames = example.com, example2.com
 	ECDSACSR = mustRead("./testdata/ecdsa.der.csr")
 
+	// CSR generated by Go:
+	// * Random public key
+	// * CN = not-example.com
+	// * DNSNames = not-example.com
+	// * IPAddresses = 2602:80a:6000::1
+	IPAddressesCSR = mustRead("./testdata/ip_addresses.der.csr")
+
 	// OIDExtensionCTPoison is defined in RFC 6962 s3.1.
 	OIDExtensionCTPoison = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}
 
@@ -348,6 +356,7 @@ func TestIssuePrecertificate(t *testing.T) {
 			{"ValidityUsesCAClock", CNandSANCSR, issueCertificateSubTestValidityUsesCAClock},
 			{"ProfileSelectionRSA", CNandSANCSR, issueCertificateSubTestProfileSelectionRSA},
 			{"ProfileSelectionECDSA", ECDSACSR, issueCertificateSubTestProfileSelectionECDSA},
+			{"IPAddresses", IPAddressesCSR, issueCertificateSubTestIPAddresses},
 			{"MustStaple", MustStapleCSR, issueCertificateSubTestMustStaple},
 			{"MustStapleDuplicate", DuplicateMustStapleCSR, issueCertificateSubTestMustStaple},
 			{"UnknownExtension", UnsupportedExtensionCSR, issueCertificateSubTestUnknownExtension},
@@ -699,6 +708,67 @@ func TestRejectValidityTooLong(t *testing.T) {
 	test.Assert(t, berrors.Is(err, berrors.InternalServer), "Incorrect error type returned")
 }
 
+func TestIssuePrecertificateProfiles(t *testing.T) {
+	// The CFSSL signer only supports the default profile
+	ca, _ := issueCertificateSubTestSetup(t, false)
+	_, err := ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID, CertProfile: "short-lived"})
+	test.AssertError(t, err, "CFSSL signer issued with a certificate profile")
+	test.Assert(t, berrors.Is(err, berrors.Malformed), "Incorrect error type returned")
+
+	testCtx := setup(t)
+	shortLived := testCtx.signerConfigs[0].Profile
+	shortLived.ValidityPeriod = cmd.ConfigDuration{Duration: 160 * time.Hour}
+	testCtx.signerConfigs[0].Profiles = map[string]bsigner.ProfileConfig{"short-lived": shortLived}
+	_ = features.Set(map[string]bool{"NonCFSSLSigner": true})
+	defer features.Reset()
+	ca, err = NewCertificateAuthorityImpl(
+		testCtx.caConfig,
+		&mockSA{},
+		testCtx.pa,
+		testCtx.fc,
+		testCtx.stats,
+		nil,
+		testCtx.signerConfigs,
+		testCtx.keyPolicy,
+		testCtx.logger,
+		nil)
+	test.AssertNotError(t, err, "Failed to create CA")
+
+	// Unknown profiles are rejected
+	_, err = ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID, CertProfile: "unknown"})
+	test.AssertError(t, err, "Issued with an unknown certificate profile")
+	test.Assert(t, berrors.Is(err, berrors.Malformed), "Incorrect error type returned")
+
+	// Certificates issued with a profile use its validity period
+	resp, err := ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID, CertProfile: "short-lived"})
+	test.AssertNotError(t, err, "Failed to issue precertificate with a certificate profile")
+	cert, err := x509.ParseCertificate(resp.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.AssertEquals(t, cert.NotAfter.Sub(cert.NotBefore), 160*time.Hour)
+
+	// A shorter lifetime than the profile's may be requested, but not a longer
+	// one
+	resp, err = ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID, CertProfile: "short-lived", Lifetime: int64(100 * time.Hour)})
+	test.AssertNotError(t, err, "Failed to issue precertificate with a shorter lifetime")
+	cert, err = x509.ParseCertificate(resp.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.AssertEquals(t, cert.NotAfter.Sub(cert.NotBefore), 100*time.Hour)
+	_, err = ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID, CertProfile: "short-lived", Lifetime: int64(200 * time.Hour)})
+	test.AssertError(t, err, "Issued with a lifetime longer than the profile's")
+	test.Assert(t, berrors.Is(err, berrors.Malformed), "Incorrect error type returned")
+
+	// The NotAfter limit shortens the validity period further
+	notAfter := testCtx.fc.Now().Add(50 * time.Hour).Truncate(time.Second)
+	resp, err = ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID, CertProfile: "short-lived", NotAfter: notAfter.UnixNano()})
+	test.AssertNotError(t, err, "Failed to issue precertificate with a NotAfter limit")
+	cert, err = x509.ParseCertificate(resp.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.Assert(t, cert.NotAfter.Equal(notAfter), fmt.Sprintf("expected NotAfter %s, got %s", notAfter, cert.NotAfter))
+	_, err = ca.IssuePrecertificate(ctx, &capb.IssueCertificateRequest{Csr: CNandSANCSR, RegistrationID: arbitraryRegID, CertProfile: "short-lived", NotAfter: testCtx.fc.Now().Add(-time.Hour).UnixNano()})
+	test.AssertError(t, err, "Issued with a NotAfter limit before NotBefore")
+	test.Assert(t, berrors.Is(err, berrors.Malformed), "Incorrect error type returned")
+}
+
 func TestSingleAIAEnforcement(t *testing.T) {
 	pa, err := policy.New(nil)
 	test.AssertNotError(t, err, "Couldn't create PA")
@@ -761,6 +831,13 @@ func issueCertificateSubTestProfileSelectionECDSA(t *testing.T, i *TestCertifica
 	test.AssertEquals(t, i.cert.KeyUsage, expectedKeyUsage)
 }
 
+func issueCertificateSubTestIPAddresses(t *testing.T, i *TestCertificateIssuance) {
+	// IP addresses from the CSR should be carried into iPAddress SANs.
+	test.AssertDeepEquals(t, i.cert.DNSNames, []string{"not-example.com"})
+	test.AssertEquals(t, len(i.cert.IPAddresses), 1)
+	test.Assert(t, i.cert.IPAddresses[0].Equal(net.ParseIP("2602:80a:6000::1")), "wrong IP address")
+}
+
 func countMustStaple(t *testing.T, cert *x509.Certificate) (count int) {
 	oidTLSFeature := asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 24}
 	for _, ext := range cert.Extensions {
@@ -28,6 +28,10 @@ type CAConfig struct {
 	// SignerProfile contains the signer issuance profile, if using the boulder
 	// signer rather than the CFSSL signer.
 	SignerProfile signer.ProfileConfig
+	// SignerProfiles contains additional named issuance profiles which orders
+	// may select, if using the boulder signer. Orders that don't select a
+	// profile are issued using SignerProfile.
+	SignerProfiles map[string]signer.ProfileConfig
 	// LifespanOCSP is how long OCSP responses are valid for; It should be longer
 	// than the minTimeToExpiry field for the OCSP Updater.
 	LifespanOCSP cmd.ConfigDuration
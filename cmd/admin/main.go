@@ -0,0 +1,613 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/metrics"
+	"github.com/letsencrypt/boulder/policy"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+)
+
+const usageString = `
+usage:
+admin eab-mint --config <path> [<key-id>]
+admin eab-revoke --config <path> <key-id>
+admin ari-override --config <path> --start <time> --end <time> <serial>...
+admin ratelimit-override-add --config <path> --limit <name> [--key <key> | --registration-id <id>]
+      --threshold <n> --owner <name> --justification <text> --expires <time>
+admin ratelimit-override-list --config <path> [--all]
+admin ratelimit-override-expire --config <path> --owner <name> --justification <text> <id>
+admin validation-attempts --config <path> <authz-id>
+admin held-orders-list --config <path>
+admin held-order-approve --config <path> --owner <name> --justification <text> <order-id>
+admin held-order-reject --config <path> --owner <name> --justification <text> <order-id>
+admin account-scope-add --config <path> --registration-id <id> --owner <name> --justification <text> <domain>...
+admin account-scope-remove --config <path> --registration-id <id> --owner <name> --justification <text> <domain>...
+admin account-scope-clear --config <path> --registration-id <id> --owner <name> --justification <text>
+admin account-scope-show --config <path> --registration-id <id>
+
+command descriptions:
+  eab-mint      Create a new external account binding key, printing its key ID
+                and base64url encoded HMAC key. A random key ID is generated
+                if one is not provided.
+  eab-revoke    Revoke an external account binding key so that it can no longer
+                be used to create new accounts
+  ari-override  Set the suggested renewal window returned by the renewalInfo
+                endpoint for the certificates with the given serials,
+                replacing any previous override
+  ratelimit-override-add
+                Override the threshold of a rate limit for a key (e.g. a
+                domain or IP) or for an account, replacing any unexpired
+                override of the same limit for the same key or account
+  ratelimit-override-list
+                List the rate limit overrides that haven't expired, or all
+                of them with --all
+  ratelimit-override-expire
+                Expire the rate limit override with the given ID immediately
+  validation-attempts
+                Show what the primary and remote VAs saw during each stored
+                validation attempt of the authorization with the given ID
+  held-orders-list
+                List the orders held for review because they contain names
+                the hostname policy requires review for, oldest first
+  held-order-approve
+                Approve the held order with the given ID, issuing its
+                certificate
+  held-order-reject
+                Reject the held order with the given ID, failing it
+  account-scope-add
+                Restrict an account to issuing for the given domains and their
+                subdomains, in addition to any it is already restricted to
+  account-scope-remove
+                Stop an account from issuing for the given domains. At least
+                one domain must be left; use account-scope-clear to lift the
+                restriction entirely
+  account-scope-clear
+                Remove every domain an account is restricted to, letting it
+                issue for any domain again
+  account-scope-show
+                List the domains an account is restricted to
+
+args:
+  config           File path to the configuration file for this service
+  start            Start of the suggested renewal window, in RFC 3339 format
+  end              End of the suggested renewal window, in RFC 3339 format
+  limit            Name of the rate limit, as in the rate limit policy file
+  key              Key the override applies to, e.g. a domain name
+  registration-id  ID of the account the override or scope applies to
+  threshold        Threshold of the limit for the key or account
+  owner            Who is responsible for the change
+  justification    Why the change is being made
+  expires          When the override expires, in RFC 3339 format
+  all              Include expired overrides
+`
+
+// eabHMACKeyLength is the length in bytes of minted external account binding
+// HMAC keys. 32 bytes matches the output size of HS256.
+const eabHMACKeyLength = 32
+
+type config struct {
+	Admin struct {
+		// The admin tool needs a TLSConfig to set up its GRPC client certs,
+		// but doesn't get the TLS field from ServiceConfig, so declares its own.
+		TLS cmd.TLSConfig
+
+		SAService *cmd.GRPCClientConfig
+		RAService *cmd.GRPCClientConfig
+
+		Features map[string]bool
+	}
+
+	Syslog cmd.SyslogConfig
+}
+
+func setupContext(c config) (core.StorageAuthority, blog.Logger, clock.Clock) {
+	logger := cmd.NewLogger(c.Syslog)
+
+	tlsConfig, err := c.Admin.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+
+	clk := cmd.Clock()
+
+	clientMetrics := bgrpc.NewClientMetrics(metrics.NoopRegisterer)
+	saConn, err := bgrpc.ClientSetup(c.Admin.SAService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to SA")
+	sac := bgrpc.NewStorageAuthorityClient(sapb.NewStorageAuthorityClient(saConn))
+
+	return sac, logger, clk
+}
+
+// setupRA returns a client for the RA, which the commands managing rate limit
+// overrides and reviewing held orders use so that the change is validated and
+// audit logged.
+func setupRA(c config, clk clock.Clock) core.RegistrationAuthority {
+	tlsConfig, err := c.Admin.TLS.Load()
+	cmd.FailOnError(err, "TLS config")
+
+	clientMetrics := bgrpc.NewClientMetrics(metrics.NoopRegisterer)
+	raConn, err := bgrpc.ClientSetup(c.Admin.RAService, tlsConfig, clientMetrics, clk)
+	cmd.FailOnError(err, "Failed to load credentials and create gRPC connection to RA")
+	return bgrpc.NewRegistrationAuthorityClient(rapb.NewRegistrationAuthorityClient(raConn))
+}
+
+// mintEABKey generates a new random HMAC key, stores it in the SA under the
+// provided key ID and returns it. If keyID is empty a random one is generated.
+// The key ID used is returned along with the HMAC key.
+func mintEABKey(ctx context.Context, sac core.StorageAuthority, clk clock.Clock, randReader io.Reader, keyID string) (string, []byte, error) {
+	if keyID == "" {
+		keyID = core.RandomString(16)
+	}
+	hmacKey := make([]byte, eabHMACKeyLength)
+	_, err := io.ReadFull(randReader, hmacKey)
+	if err != nil {
+		return "", nil, err
+	}
+	created := clk.Now().UnixNano()
+	_, err = sac.AddExternalAccountKey(ctx, &sapb.ExternalAccountKey{
+		KeyID:   &keyID,
+		HmacKey: hmacKey,
+		Created: &created,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return keyID, hmacKey, nil
+}
+
+// revokeEABKey marks the external account binding key with the given key ID
+// as revoked. Accounts previously created with the key are unaffected.
+func revokeEABKey(ctx context.Context, sac core.StorageAuthority, keyID string) error {
+	_, err := sac.RevokeExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: &keyID})
+	return err
+}
+
+// setRenewalInfoOverride sets the suggested renewal window for the
+// certificate with the given serial, replacing any existing override.
+func setRenewalInfoOverride(ctx context.Context, sac core.StorageAuthority, serial string, start, end time.Time) error {
+	if !core.ValidSerial(serial) {
+		return fmt.Errorf("invalid serial %q", serial)
+	}
+	windowStart := start.UnixNano()
+	windowEnd := end.UnixNano()
+	_, err := sac.AddRenewalInfoOverride(ctx, &sapb.RenewalInfoOverride{
+		Serial:      &serial,
+		WindowStart: &windowStart,
+		WindowEnd:   &windowEnd,
+	})
+	return err
+}
+
+// rateLimitOverrider is the subset of the RA's methods used to manage rate
+// limit overrides.
+type rateLimitOverrider interface {
+	AddRateLimitOverride(ctx context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error)
+	GetRateLimitOverrides(ctx context.Context, req *rapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error)
+	ExpireRateLimitOverride(ctx context.Context, req *rapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error)
+}
+
+// addRateLimitOverride overrides the threshold of the named limit for either
+// key or regID until expires, and returns the stored override.
+func addRateLimitOverride(ctx context.Context, rac rateLimitOverrider, limit, key string, regID, threshold int64, owner, justification string, expires time.Time) (*corepb.RateLimitOverride, error) {
+	if (key == "") == (regID == 0) {
+		return nil, fmt.Errorf("exactly one of --key and --registration-id must be provided")
+	}
+	expiresNS := expires.UnixNano()
+	return rac.AddRateLimitOverride(ctx, &corepb.RateLimitOverride{
+		Limit:          &limit,
+		Key:            &key,
+		RegistrationID: &regID,
+		Threshold:      &threshold,
+		Owner:          &owner,
+		Justification:  &justification,
+		Expires:        &expiresNS,
+	})
+}
+
+// listRateLimitOverrides writes a table of the rate limit overrides that
+// haven't expired, or of all of them if includeExpired is set, to w.
+func listRateLimitOverrides(ctx context.Context, rac rateLimitOverrider, w io.Writer, includeExpired bool) error {
+	resp, err := rac.GetRateLimitOverrides(ctx, &rapb.GetRateLimitOverridesRequest{IncludeExpired: &includeExpired})
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tLIMIT\tAPPLIES TO\tTHRESHOLD\tOWNER\tCREATED\tEXPIRES\tJUSTIFICATION")
+	for _, o := range resp.Overrides {
+		appliesTo := o.GetKey()
+		if appliesTo == "" {
+			appliesTo = fmt.Sprintf("registration %d", o.GetRegistrationID())
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+			o.GetId(),
+			o.GetLimit(),
+			appliesTo,
+			o.GetThreshold(),
+			o.GetOwner(),
+			time.Unix(0, o.GetCreated()).UTC().Format(time.RFC3339),
+			time.Unix(0, o.GetExpires()).UTC().Format(time.RFC3339),
+			o.GetJustification())
+	}
+	return tw.Flush()
+}
+
+// expireRateLimitOverride expires the rate limit override with the given ID
+// immediately.
+func expireRateLimitOverride(ctx context.Context, rac rateLimitOverrider, id int64, actor, justification string) error {
+	_, err := rac.ExpireRateLimitOverride(ctx, &rapb.ExpireRateLimitOverrideRequest{
+		Id:            &id,
+		Actor:         &actor,
+		Justification: &justification,
+	})
+	return err
+}
+
+// heldOrdersListLimit is the maximum number of held orders listed by
+// held-orders-list.
+const heldOrdersListLimit = 1000
+
+// listHeldOrders writes a table of the orders held for review to w, oldest
+// first.
+func listHeldOrders(ctx context.Context, sac core.StorageAuthority, w io.Writer) error {
+	limit := int64(heldOrdersListLimit)
+	resp, err := sac.GetHeldOrders(ctx, &sapb.GetHeldOrdersRequest{Limit: &limit})
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ORDER ID\tREGISTRATION ID\tHELD AT\tNAMES FOR REVIEW")
+	for _, o := range resp.Orders {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\n",
+			o.GetOrderID(),
+			o.GetRegistrationID(),
+			time.Unix(0, o.GetHeldAt()).UTC().Format(time.RFC3339),
+			strings.Join(o.ReviewNames, ", "))
+	}
+	return tw.Flush()
+}
+
+// heldOrderReviewer is the subset of the RA's methods used to review held
+// orders.
+type heldOrderReviewer interface {
+	ReviewHeldOrder(ctx context.Context, req *rapb.ReviewHeldOrderRequest) (*corepb.Order, error)
+}
+
+// reviewHeldOrder approves or rejects the held order with the given ID and
+// returns the order once it has been issued or failed.
+func reviewHeldOrder(ctx context.Context, rac heldOrderReviewer, orderID int64, approve bool, actor, justification string) (*corepb.Order, error) {
+	return rac.ReviewHeldOrder(ctx, &rapb.ReviewHeldOrderRequest{
+		OrderID:       &orderID,
+		Approve:       &approve,
+		Actor:         &actor,
+		Justification: &justification,
+	})
+}
+
+// addAccountScope restricts the account with the given ID to issuing for the
+// given domains and their subdomains.
+func addAccountScope(ctx context.Context, sac core.StorageAuthority, regID int64, domains []string, actor, justification string) error {
+	for i, domain := range domains {
+		domains[i] = strings.ToLower(domain)
+		if err := policy.ValidDomain(domains[i]); err != nil {
+			return fmt.Errorf("invalid domain %q: %s", domain, err)
+		}
+	}
+	_, err := sac.AddAccountScope(ctx, &sapb.AccountScopeChange{
+		RegistrationID: &regID,
+		Suffixes:       domains,
+		Actor:          &actor,
+		Justification:  &justification,
+	})
+	return err
+}
+
+// removeAccountScope stops the account with the given ID from issuing for the
+// given domains.
+func removeAccountScope(ctx context.Context, sac core.StorageAuthority, regID int64, domains []string, actor, justification string) error {
+	for i, domain := range domains {
+		domains[i] = strings.ToLower(domain)
+	}
+	_, err := sac.RemoveAccountScope(ctx, &sapb.AccountScopeChange{
+		RegistrationID: &regID,
+		Suffixes:       domains,
+		Actor:          &actor,
+		Justification:  &justification,
+	})
+	return err
+}
+
+// clearAccountScope lets the account with the given ID issue for any domain
+// again.
+func clearAccountScope(ctx context.Context, sac core.StorageAuthority, regID int64, actor, justification string) error {
+	_, err := sac.ClearAccountScope(ctx, &sapb.ClearAccountScopeRequest{
+		RegistrationID: &regID,
+		Actor:          &actor,
+		Justification:  &justification,
+	})
+	return err
+}
+
+// printAccountScope writes the domains the account with the given ID is
+// restricted to, one per line, to w.
+func printAccountScope(ctx context.Context, sac core.StorageAuthority, w io.Writer, regID int64) error {
+	scope, err := sac.GetAccountScope(ctx, &sapb.RegistrationID{Id: &regID})
+	if err != nil {
+		return err
+	}
+	if len(scope.Suffixes) == 0 {
+		fmt.Fprintf(w, "Registration %d may issue for any domain\n", regID)
+		return nil
+	}
+	for _, suffix := range scope.Suffixes {
+		fmt.Fprintln(w, suffix)
+	}
+	return nil
+}
+
+// printValidationAttempts writes a report of the stored validation attempts of
+// the authorization with the given ID to w, oldest first.
+func printValidationAttempts(ctx context.Context, sac core.StorageAuthority, w io.Writer, authzID int64) error {
+	resp, err := sac.GetValidationAttempts(ctx, &sapb.GetValidationAttemptsRequest{AuthzID: &authzID})
+	if err != nil {
+		return err
+	}
+	if len(resp.Attempts) == 0 {
+		return fmt.Errorf("no validation attempts of authorization %d found", authzID)
+	}
+	for _, a := range resp.Attempts {
+		fmt.Fprintf(w, "Attempt %d at %s: %s %s\n",
+			a.GetId(),
+			time.Unix(0, a.GetAttemptedAt()).UTC().Format(time.RFC3339),
+			a.GetChallengeType(),
+			a.GetStatus())
+		fmt.Fprintf(w, "  Identifier: %s (registration %d)\n", a.GetIdentifier(), a.GetRegistrationID())
+		if a.ValidationError != nil {
+			fmt.Fprintf(w, "  Error: %s: %s\n", a.ValidationError.GetProblemType(), a.ValidationError.GetDetail())
+		}
+		err := printValidationRecords(w, "  ", a.ValidationRecords)
+		if err != nil {
+			return err
+		}
+		for _, r := range a.RemoteResults {
+			result := "valid"
+			if r.Problem != nil {
+				result = fmt.Sprintf("%s: %s", r.Problem.GetProblemType(), r.Problem.GetDetail())
+			}
+			fmt.Fprintf(w, "  Remote VA %s: %s\n", r.GetVaHostname(), result)
+			err := printValidationRecords(w, "    ", r.ValidationRecords)
+			if err != nil {
+				return err
+			}
+		}
+		for _, caa := range a.CaaRecords {
+			fmt.Fprintf(w, "  CAA: %s\n", caa)
+		}
+	}
+	return nil
+}
+
+// printValidationRecords writes one line per validation record to w, in
+// order, so that the records of an HTTP-01 validation show its redirects.
+func printValidationRecords(w io.Writer, indent string, recordsPB []*corepb.ValidationRecord) error {
+	for _, recordPB := range recordsPB {
+		record, err := bgrpc.PBToValidationRecord(recordPB)
+		if err != nil {
+			return err
+		}
+		line := record.URL
+		if line == "" {
+			line = record.Hostname
+			if record.Port != "" {
+				line = net.JoinHostPort(record.Hostname, record.Port)
+			}
+		}
+		if len(record.AddressesResolved) > 0 {
+			line += fmt.Sprintf(" resolved %v", record.AddressesResolved)
+		}
+		if record.AddressUsed != nil {
+			line += fmt.Sprintf(" used %s", record.AddressUsed)
+		}
+		fmt.Fprintf(w, "%sRecord: %s\n", indent, line)
+	}
+	return nil
+}
+
+func main() {
+	usage := func() {
+		fmt.Fprint(os.Stderr, usageString)
+		os.Exit(1)
+	}
+	if len(os.Args) <= 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flagSet := flag.NewFlagSet(command, flag.ContinueOnError)
+	configFile := flagSet.String("config", "", "File path to the configuration file for this service")
+	windowStart := flagSet.String("start", "", "Start of the suggested renewal window, in RFC 3339 format")
+	windowEnd := flagSet.String("end", "", "End of the suggested renewal window, in RFC 3339 format")
+	limit := flagSet.String("limit", "", "Name of the rate limit, as in the rate limit policy file")
+	key := flagSet.String("key", "", "Key the override applies to, e.g. a domain name")
+	regID := flagSet.Int64("registration-id", 0, "ID of the account the override applies to")
+	threshold := flagSet.Int64("threshold", -1, "Threshold of the limit for the key or account")
+	owner := flagSet.String("owner", "", "Who is responsible for the change")
+	justification := flagSet.String("justification", "", "Why the change is being made")
+	expires := flagSet.String("expires", "", "When the override expires, in RFC 3339 format")
+	all := flagSet.Bool("all", false, "Include expired overrides")
+	err := flagSet.Parse(os.Args[2:])
+	cmd.FailOnError(err, "Error parsing flagset")
+
+	if *configFile == "" {
+		usage()
+	}
+
+	var c config
+	err = cmd.ReadConfigFile(*configFile, &c)
+	cmd.FailOnError(err, "Reading JSON config file into config structure")
+	err = features.Set(c.Admin.Features)
+	cmd.FailOnError(err, "Failed to set feature flags")
+
+	ctx := context.Background()
+	args := flagSet.Args()
+	switch {
+	case command == "eab-mint" && len(args) <= 1:
+		// 1 (optional): key ID
+		var keyID string
+		if len(args) == 1 {
+			keyID = args[0]
+		}
+
+		sac, logger, clk := setupContext(c)
+		keyID, hmacKey, err := mintEABKey(ctx, sac, clk, rand.Reader, keyID)
+		cmd.FailOnError(err, "Couldn't create external account binding key")
+		logger.Infof("Created external account binding key %q", keyID)
+		fmt.Printf("Key ID:   %s\nHMAC key: %s\n", keyID, base64.RawURLEncoding.EncodeToString(hmacKey))
+
+	case command == "eab-revoke" && len(args) == 1:
+		// 1: key ID
+		keyID := args[0]
+
+		sac, logger, _ := setupContext(c)
+		err := revokeEABKey(ctx, sac, keyID)
+		cmd.FailOnError(err, "Couldn't revoke external account binding key")
+		logger.Infof("Revoked external account binding key %q", keyID)
+
+	case command == "ari-override" && len(args) >= 1:
+		// 1+: serials
+		start, err := time.Parse(time.RFC3339, *windowStart)
+		cmd.FailOnError(err, "Couldn't parse --start")
+		end, err := time.Parse(time.RFC3339, *windowEnd)
+		cmd.FailOnError(err, "Couldn't parse --end")
+
+		sac, logger, _ := setupContext(c)
+		for _, serial := range args {
+			err := setRenewalInfoOverride(ctx, sac, serial, start, end)
+			cmd.FailOnError(err, fmt.Sprintf("Couldn't set renewal info override for %q", serial))
+			logger.Infof("Set renewal info override for %q: %s to %s", serial, start, end)
+		}
+
+	case command == "ratelimit-override-add" && len(args) == 0:
+		if *limit == "" || *threshold < 0 || *owner == "" || *justification == "" {
+			usage()
+		}
+		expiry, err := time.Parse(time.RFC3339, *expires)
+		cmd.FailOnError(err, "Couldn't parse --expires")
+
+		_, logger, clk := setupContext(c)
+		rac := setupRA(c, clk)
+		override, err := addRateLimitOverride(ctx, rac, *limit, *key, *regID, *threshold, *owner, *justification, expiry)
+		cmd.FailOnError(err, "Couldn't add rate limit override")
+		logger.Infof("Added rate limit override %d of %s", override.GetId(), *limit)
+		fmt.Printf("Override ID: %d\n", override.GetId())
+
+	case command == "ratelimit-override-list" && len(args) == 0:
+		_, _, clk := setupContext(c)
+		rac := setupRA(c, clk)
+		err := listRateLimitOverrides(ctx, rac, os.Stdout, *all)
+		cmd.FailOnError(err, "Couldn't list rate limit overrides")
+
+	case command == "ratelimit-override-expire" && len(args) == 1:
+		// 1: override ID
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Couldn't parse override ID")
+		if *owner == "" || *justification == "" {
+			usage()
+		}
+
+		_, logger, clk := setupContext(c)
+		rac := setupRA(c, clk)
+		err = expireRateLimitOverride(ctx, rac, id, *owner, *justification)
+		cmd.FailOnError(err, "Couldn't expire rate limit override")
+		logger.Infof("Expired rate limit override %d", id)
+
+	case command == "validation-attempts" && len(args) == 1:
+		// 1: authorization ID
+		authzID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Couldn't parse authorization ID")
+
+		sac, _, _ := setupContext(c)
+		err = printValidationAttempts(ctx, sac, os.Stdout, authzID)
+		cmd.FailOnError(err, "Couldn't get validation attempts")
+
+	case command == "held-orders-list" && len(args) == 0:
+		sac, _, _ := setupContext(c)
+		err := listHeldOrders(ctx, sac, os.Stdout)
+		cmd.FailOnError(err, "Couldn't list held orders")
+
+	case (command == "held-order-approve" || command == "held-order-reject") && len(args) == 1:
+		// 1: order ID
+		orderID, err := strconv.ParseInt(args[0], 10, 64)
+		cmd.FailOnError(err, "Couldn't parse order ID")
+		if *owner == "" || *justification == "" {
+			usage()
+		}
+
+		_, logger, clk := setupContext(c)
+		rac := setupRA(c, clk)
+		order, err := reviewHeldOrder(ctx, rac, orderID, command == "held-order-approve", *owner, *justification)
+		cmd.FailOnError(err, "Couldn't review held order")
+		logger.Infof("Reviewed held order %d, which is now %s", orderID, order.GetStatus())
+
+	case command == "account-scope-add" && len(args) > 0:
+		// 1+: domains
+		if *regID == 0 || *owner == "" || *justification == "" {
+			usage()
+		}
+
+		sac, logger, _ := setupContext(c)
+		err := addAccountScope(ctx, sac, *regID, args, *owner, *justification)
+		cmd.FailOnError(err, "Couldn't restrict account")
+		logger.Infof("Restricted registration %d to %q", *regID, args)
+
+	case command == "account-scope-remove" && len(args) > 0:
+		// 1+: domains
+		if *regID == 0 || *owner == "" || *justification == "" {
+			usage()
+		}
+
+		sac, logger, _ := setupContext(c)
+		err := removeAccountScope(ctx, sac, *regID, args, *owner, *justification)
+		cmd.FailOnError(err, "Couldn't remove account restriction")
+		logger.Infof("Removed restriction of registration %d to %q", *regID, args)
+
+	case command == "account-scope-clear" && len(args) == 0:
+		if *regID == 0 || *owner == "" || *justification == "" {
+			usage()
+		}
+
+		sac, logger, _ := setupContext(c)
+		err := clearAccountScope(ctx, sac, *regID, *owner, *justification)
+		cmd.FailOnError(err, "Couldn't clear account restriction")
+		logger.Infof("Cleared restriction of registration %d", *regID)
+
+	case command == "account-scope-show" && len(args) == 0:
+		if *regID == 0 {
+			usage()
+		}
+
+		sac, _, _ := setupContext(c)
+		err := printAccountScope(ctx, sac, os.Stdout, *regID)
+		cmd.FailOnError(err, "Couldn't get account restriction")
+
+	default:
+		usage()
+	}
+}
@@ -0,0 +1,434 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
+	berrors "github.com/letsencrypt/boulder/errors"
+	"github.com/letsencrypt/boulder/mocks"
+	rapb "github.com/letsencrypt/boulder/ra/proto"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
+	"github.com/letsencrypt/boulder/test"
+)
+
+type mockEABSA struct {
+	mocks.StorageAuthority
+	added   []*sapb.ExternalAccountKey
+	revoked []string
+}
+
+func (sa *mockEABSA) AddExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKey) (*corepb.Empty, error) {
+	sa.added = append(sa.added, req)
+	return &corepb.Empty{}, nil
+}
+
+func (sa *mockEABSA) RevokeExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	if req.GetKeyID() == "kid-unknown" {
+		return nil, berrors.NotFoundError("no unrevoked external account key %q found", req.GetKeyID())
+	}
+	sa.revoked = append(sa.revoked, req.GetKeyID())
+	return &corepb.Empty{}, nil
+}
+
+type mockRenewalInfoSA struct {
+	mocks.StorageAuthority
+	overrides []*sapb.RenewalInfoOverride
+}
+
+func (sa *mockRenewalInfoSA) AddRenewalInfoOverride(_ context.Context, req *sapb.RenewalInfoOverride) (*corepb.Empty, error) {
+	if req.GetWindowEnd() <= req.GetWindowStart() {
+		return nil, berrors.MalformedError("renewal window must end after it starts")
+	}
+	sa.overrides = append(sa.overrides, req)
+	return &corepb.Empty{}, nil
+}
+
+func TestMintEABKey(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Date(2020, 10, 18, 12, 0, 0, 0, time.UTC))
+	sa := &mockEABSA{}
+	randBytes := bytes.Repeat([]byte{0x42}, eabHMACKeyLength)
+
+	keyID, hmacKey, err := mintEABKey(context.Background(), sa, fc, bytes.NewReader(randBytes), "kid-1")
+	test.AssertNotError(t, err, "mintEABKey failed")
+	test.AssertEquals(t, keyID, "kid-1")
+	test.AssertByteEquals(t, hmacKey, randBytes)
+	test.AssertEquals(t, len(sa.added), 1)
+	test.AssertEquals(t, sa.added[0].GetKeyID(), "kid-1")
+	test.AssertByteEquals(t, sa.added[0].GetHmacKey(), randBytes)
+	test.AssertEquals(t, sa.added[0].GetCreated(), fc.Now().UnixNano())
+
+	// Without a key ID a random one should be generated
+	keyID, _, err = mintEABKey(context.Background(), sa, fc, bytes.NewReader(randBytes), "")
+	test.AssertNotError(t, err, "mintEABKey failed")
+	test.Assert(t, keyID != "", "Expected a random key ID to be generated")
+	test.AssertEquals(t, sa.added[1].GetKeyID(), keyID)
+
+	// A short read of random bytes should fail without adding a key
+	_, _, err = mintEABKey(context.Background(), sa, fc, bytes.NewReader(randBytes[:4]), "kid-2")
+	test.AssertError(t, err, "mintEABKey with a short random read didn't fail")
+	test.AssertEquals(t, len(sa.added), 2)
+}
+
+func TestRevokeEABKey(t *testing.T) {
+	sa := &mockEABSA{}
+
+	err := revokeEABKey(context.Background(), sa, "kid-1")
+	test.AssertNotError(t, err, "revokeEABKey failed")
+	test.AssertDeepEquals(t, sa.revoked, []string{"kid-1"})
+
+	err = revokeEABKey(context.Background(), sa, "kid-unknown")
+	test.AssertError(t, err, "revokeEABKey of an unknown key didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+}
+
+func TestSetRenewalInfoOverride(t *testing.T) {
+	sa := &mockRenewalInfoSA{}
+	serial := "0000000000000000000000000000000000ee"
+	start := time.Date(2020, 10, 18, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	err := setRenewalInfoOverride(context.Background(), sa, serial, start, end)
+	test.AssertNotError(t, err, "setRenewalInfoOverride failed")
+	test.AssertEquals(t, len(sa.overrides), 1)
+	test.AssertEquals(t, sa.overrides[0].GetSerial(), serial)
+	test.AssertEquals(t, sa.overrides[0].GetWindowStart(), start.UnixNano())
+	test.AssertEquals(t, sa.overrides[0].GetWindowEnd(), end.UnixNano())
+
+	// An invalid serial should fail without reaching the SA
+	err = setRenewalInfoOverride(context.Background(), sa, "ee", start, end)
+	test.AssertError(t, err, "setRenewalInfoOverride with an invalid serial didn't fail")
+	test.AssertEquals(t, len(sa.overrides), 1)
+
+	// A window that ends before it starts should be rejected by the SA
+	err = setRenewalInfoOverride(context.Background(), sa, serial, end, start)
+	test.AssertError(t, err, "setRenewalInfoOverride with an inverted window didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.Malformed), "Expected a Malformed error")
+}
+
+type mockOverrideRA struct {
+	added   []*corepb.RateLimitOverride
+	expired []*rapb.ExpireRateLimitOverrideRequest
+	listed  *corepb.RateLimitOverrides
+}
+
+func (ra *mockOverrideRA) AddRateLimitOverride(_ context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	ra.added = append(ra.added, req)
+	id := int64(len(ra.added))
+	return &corepb.RateLimitOverride{Id: &id, Limit: req.Limit}, nil
+}
+
+func (ra *mockOverrideRA) GetRateLimitOverrides(_ context.Context, req *rapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	if req.GetIncludeExpired() {
+		return ra.listed, nil
+	}
+	return &corepb.RateLimitOverrides{Overrides: ra.listed.Overrides[:1]}, nil
+}
+
+func (ra *mockOverrideRA) ExpireRateLimitOverride(_ context.Context, req *rapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	if req.GetId() == 404 {
+		return nil, berrors.NotFoundError("no unexpired rate limit override found with ID %d", req.GetId())
+	}
+	ra.expired = append(ra.expired, req)
+	return &corepb.Empty{}, nil
+}
+
+func TestAddRateLimitOverride(t *testing.T) {
+	ra := &mockOverrideRA{}
+	expires := time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	override, err := addRateLimitOverride(context.Background(), ra, "certificatesPerName", "example.com", 0, 500, "alice", "Big hosting provider", expires)
+	test.AssertNotError(t, err, "addRateLimitOverride failed")
+	test.AssertEquals(t, override.GetId(), int64(1))
+	test.AssertEquals(t, len(ra.added), 1)
+	test.AssertEquals(t, ra.added[0].GetLimit(), "certificatesPerName")
+	test.AssertEquals(t, ra.added[0].GetKey(), "example.com")
+	test.AssertEquals(t, ra.added[0].GetRegistrationID(), int64(0))
+	test.AssertEquals(t, ra.added[0].GetThreshold(), int64(500))
+	test.AssertEquals(t, ra.added[0].GetOwner(), "alice")
+	test.AssertEquals(t, ra.added[0].GetJustification(), "Big hosting provider")
+	test.AssertEquals(t, ra.added[0].GetExpires(), expires.UnixNano())
+
+	// Exactly one of a key and a registration ID must be given
+	_, err = addRateLimitOverride(context.Background(), ra, "newOrdersPerAccount", "", 0, 500, "alice", "Integration", expires)
+	test.AssertError(t, err, "addRateLimitOverride without a key or registration ID didn't fail")
+	_, err = addRateLimitOverride(context.Background(), ra, "certificatesPerName", "example.com", 101, 500, "alice", "Integration", expires)
+	test.AssertError(t, err, "addRateLimitOverride with a key and a registration ID didn't fail")
+	test.AssertEquals(t, len(ra.added), 1)
+}
+
+func TestListRateLimitOverrides(t *testing.T) {
+	override := func(id int64, limit, key string, regID, threshold int64, expires time.Time) *corepb.RateLimitOverride {
+		owner := "alice"
+		justification := "Big hosting provider"
+		created := time.Date(2020, 10, 18, 0, 0, 0, 0, time.UTC).UnixNano()
+		expiresNS := expires.UnixNano()
+		return &corepb.RateLimitOverride{
+			Id:             &id,
+			Limit:          &limit,
+			Key:            &key,
+			RegistrationID: &regID,
+			Threshold:      &threshold,
+			Owner:          &owner,
+			Justification:  &justification,
+			Created:        &created,
+			Expires:        &expiresNS,
+		}
+	}
+	ra := &mockOverrideRA{listed: &corepb.RateLimitOverrides{Overrides: []*corepb.RateLimitOverride{
+		override(1, "certificatesPerName", "example.com", 0, 500, time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)),
+		override(2, "newOrdersPerAccount", "", 101, 1000, time.Date(2020, 10, 19, 0, 0, 0, 0, time.UTC)),
+	}}}
+
+	var out bytes.Buffer
+	err := listRateLimitOverrides(context.Background(), ra, &out, false)
+	test.AssertNotError(t, err, "listRateLimitOverrides failed")
+	test.AssertEquals(t, out.String(),
+		"ID  LIMIT                APPLIES TO   THRESHOLD  OWNER  CREATED               EXPIRES               JUSTIFICATION\n"+
+			"1   certificatesPerName  example.com  500        alice  2020-10-18T00:00:00Z  2020-12-01T00:00:00Z  Big hosting provider\n")
+
+	out.Reset()
+	err = listRateLimitOverrides(context.Background(), ra, &out, true)
+	test.AssertNotError(t, err, "listRateLimitOverrides failed")
+	test.AssertContains(t, out.String(), "newOrdersPerAccount  registration 101")
+}
+
+func TestExpireRateLimitOverride(t *testing.T) {
+	ra := &mockOverrideRA{}
+
+	err := expireRateLimitOverride(context.Background(), ra, 7, "bob", "No longer needed")
+	test.AssertNotError(t, err, "expireRateLimitOverride failed")
+	test.AssertEquals(t, len(ra.expired), 1)
+	test.AssertEquals(t, ra.expired[0].GetId(), int64(7))
+	test.AssertEquals(t, ra.expired[0].GetActor(), "bob")
+	test.AssertEquals(t, ra.expired[0].GetJustification(), "No longer needed")
+
+	err = expireRateLimitOverride(context.Background(), ra, 404, "bob", "No longer needed")
+	test.AssertError(t, err, "expireRateLimitOverride of an unknown override didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+}
+
+type mockValidationAttemptsSA struct {
+	mocks.StorageAuthority
+	attempts []*corepb.ValidationAttempt
+}
+
+func (sa *mockValidationAttemptsSA) GetValidationAttempts(_ context.Context, req *sapb.GetValidationAttemptsRequest) (*corepb.ValidationAttempts, error) {
+	var resp corepb.ValidationAttempts
+	for _, a := range sa.attempts {
+		if a.GetAuthzID() == req.GetAuthzID() {
+			resp.Attempts = append(resp.Attempts, a)
+		}
+	}
+	return &resp, nil
+}
+
+func TestPrintValidationAttempts(t *testing.T) {
+	id, authzID, regID := int64(3), int64(7), int64(1)
+	ident, challType, status := "example.com", "http-01", "invalid"
+	attemptedAt := time.Date(2020, 10, 18, 1, 2, 3, 0, time.UTC).UnixNano()
+	hostname, port := "example.com", "80"
+	url, redirect := "http://example.com/.well-known/acme-challenge/abc", "http://www.example.com/abc"
+	ip := []byte(net.ParseIP("1.2.3.4"))
+	ipText := []byte("1.2.3.4")
+	remoteA, remoteB := "va-remote-a", "va-remote-b"
+	probType, detail := "connection", "Fetching http://www.example.com/abc: Timeout"
+	sa := &mockValidationAttemptsSA{attempts: []*corepb.ValidationAttempt{{
+		Id:             &id,
+		AuthzID:        &authzID,
+		RegistrationID: &regID,
+		Identifier:     &ident,
+		ChallengeType:  &challType,
+		AttemptedAt:    &attemptedAt,
+		Status:         &status,
+		ValidationRecords: []*corepb.ValidationRecord{
+			{Hostname: &hostname, Port: &port, Url: &url, AddressesResolved: [][]byte{ip}, AddressUsed: ipText},
+			{Hostname: &hostname, Port: &port, Url: &redirect, AddressesResolved: [][]byte{ip}, AddressUsed: ipText},
+		},
+		ValidationError: &corepb.ProblemDetails{ProblemType: &probType, Detail: &detail},
+		RemoteResults: []*corepb.RemoteValidationResult{
+			{VaHostname: &remoteA},
+			{VaHostname: &remoteB, Problem: &corepb.ProblemDetails{ProblemType: &probType, Detail: &detail}},
+		},
+		CaaRecords: []string{`example.com.	3600	IN	CAA	0 issue "letsencrypt.org"`},
+	}}}
+
+	var out bytes.Buffer
+	err := printValidationAttempts(context.Background(), sa, &out, authzID)
+	test.AssertNotError(t, err, "printValidationAttempts failed")
+	test.AssertEquals(t, out.String(),
+		"Attempt 3 at 2020-10-18T01:02:03Z: http-01 invalid\n"+
+			"  Identifier: example.com (registration 1)\n"+
+			"  Error: connection: Fetching http://www.example.com/abc: Timeout\n"+
+			"  Record: http://example.com/.well-known/acme-challenge/abc resolved [1.2.3.4] used 1.2.3.4\n"+
+			"  Record: http://www.example.com/abc resolved [1.2.3.4] used 1.2.3.4\n"+
+			"  Remote VA va-remote-a: valid\n"+
+			"  Remote VA va-remote-b: connection: Fetching http://www.example.com/abc: Timeout\n"+
+			"  CAA: example.com.	3600	IN	CAA	0 issue \"letsencrypt.org\"\n")
+
+	err = printValidationAttempts(context.Background(), sa, &out, 8)
+	test.AssertError(t, err, "printValidationAttempts didn't fail for an authz without attempts")
+}
+
+type mockHeldOrdersSA struct {
+	mocks.StorageAuthority
+	held []*sapb.HeldOrder
+}
+
+func (sa *mockHeldOrdersSA) GetHeldOrders(_ context.Context, req *sapb.GetHeldOrdersRequest) (*sapb.HeldOrders, error) {
+	return &sapb.HeldOrders{Orders: sa.held}, nil
+}
+
+func TestListHeldOrders(t *testing.T) {
+	held := func(orderID, regID int64, names ...string) *sapb.HeldOrder {
+		heldAt := time.Date(2020, 10, 18, 0, 0, 0, 0, time.UTC).UnixNano()
+		return &sapb.HeldOrder{
+			OrderID:        &orderID,
+			RegistrationID: &regID,
+			ReviewNames:    names,
+			HeldAt:         &heldAt,
+		}
+	}
+	sa := &mockHeldOrdersSA{held: []*sapb.HeldOrder{
+		held(12, 1, "bigbank.com"),
+		held(13, 2, "login.bigbank.com", "agency.gov.example"),
+	}}
+
+	var out bytes.Buffer
+	err := listHeldOrders(context.Background(), sa, &out)
+	test.AssertNotError(t, err, "listHeldOrders failed")
+	test.AssertEquals(t, out.String(),
+		"ORDER ID  REGISTRATION ID  HELD AT               NAMES FOR REVIEW\n"+
+			"12        1                2020-10-18T00:00:00Z  bigbank.com\n"+
+			"13        2                2020-10-18T00:00:00Z  login.bigbank.com, agency.gov.example\n")
+}
+
+type mockHeldOrderRA struct {
+	reviewed []*rapb.ReviewHeldOrderRequest
+}
+
+func (ra *mockHeldOrderRA) ReviewHeldOrder(_ context.Context, req *rapb.ReviewHeldOrderRequest) (*corepb.Order, error) {
+	if req.GetOrderID() == 404 {
+		return nil, berrors.NotFoundError("no held order found for order ID %d", req.GetOrderID())
+	}
+	ra.reviewed = append(ra.reviewed, req)
+	status := string(core.StatusInvalid)
+	if req.GetApprove() {
+		status = string(core.StatusValid)
+	}
+	return &corepb.Order{Id: req.OrderID, Status: &status}, nil
+}
+
+func TestReviewHeldOrder(t *testing.T) {
+	ra := &mockHeldOrderRA{}
+
+	order, err := reviewHeldOrder(context.Background(), ra, 12, true, "bob", "Verified with the bank")
+	test.AssertNotError(t, err, "reviewHeldOrder failed")
+	test.AssertEquals(t, order.GetStatus(), string(core.StatusValid))
+	order, err = reviewHeldOrder(context.Background(), ra, 13, false, "bob", "Phishing")
+	test.AssertNotError(t, err, "reviewHeldOrder failed")
+	test.AssertEquals(t, order.GetStatus(), string(core.StatusInvalid))
+	test.AssertEquals(t, len(ra.reviewed), 2)
+	test.AssertEquals(t, ra.reviewed[0].GetOrderID(), int64(12))
+	test.AssertEquals(t, ra.reviewed[0].GetApprove(), true)
+	test.AssertEquals(t, ra.reviewed[0].GetActor(), "bob")
+	test.AssertEquals(t, ra.reviewed[0].GetJustification(), "Verified with the bank")
+	test.AssertEquals(t, ra.reviewed[1].GetApprove(), false)
+
+	_, err = reviewHeldOrder(context.Background(), ra, 404, true, "bob", "Verified with the bank")
+	test.AssertError(t, err, "reviewHeldOrder of an order that isn't held didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+}
+
+type mockAccountScopeSA struct {
+	mocks.StorageAuthority
+	scopes map[int64][]string
+	actors []string
+}
+
+func (sa *mockAccountScopeSA) GetAccountScope(_ context.Context, req *sapb.RegistrationID) (*sapb.AccountScope, error) {
+	return &sapb.AccountScope{RegistrationID: req.Id, Suffixes: sa.scopes[req.GetId()]}, nil
+}
+
+func (sa *mockAccountScopeSA) AddAccountScope(_ context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	sa.scopes[req.GetRegistrationID()] = append(sa.scopes[req.GetRegistrationID()], req.Suffixes...)
+	sa.actors = append(sa.actors, req.GetActor())
+	return &corepb.Empty{}, nil
+}
+
+func (sa *mockAccountScopeSA) RemoveAccountScope(_ context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	var kept []string
+	for _, suffix := range sa.scopes[req.GetRegistrationID()] {
+		removed := false
+		for _, r := range req.Suffixes {
+			removed = removed || r == suffix
+		}
+		if !removed {
+			kept = append(kept, suffix)
+		}
+	}
+	if len(kept) == len(sa.scopes[req.GetRegistrationID()]) {
+		return nil, berrors.NotFoundError("no allowed domains to remove found for registration ID %d", req.GetRegistrationID())
+	}
+	if len(kept) == 0 {
+		return nil, berrors.MalformedError("removing every allowed domain would let registration ID %d issue for any domain; clear its scope instead", req.GetRegistrationID())
+	}
+	sa.scopes[req.GetRegistrationID()] = kept
+	sa.actors = append(sa.actors, req.GetActor())
+	return &corepb.Empty{}, nil
+}
+
+func (sa *mockAccountScopeSA) ClearAccountScope(_ context.Context, req *sapb.ClearAccountScopeRequest) (*corepb.Empty, error) {
+	if len(sa.scopes[req.GetRegistrationID()]) == 0 {
+		return nil, berrors.NotFoundError("no allowed domains to clear found for registration ID %d", req.GetRegistrationID())
+	}
+	delete(sa.scopes, req.GetRegistrationID())
+	sa.actors = append(sa.actors, req.GetActor())
+	return &corepb.Empty{}, nil
+}
+
+func TestAccountScope(t *testing.T) {
+	sa := &mockAccountScopeSA{scopes: make(map[int64][]string)}
+
+	var out bytes.Buffer
+	err := printAccountScope(context.Background(), sa, &out, 1)
+	test.AssertNotError(t, err, "printAccountScope failed")
+	test.AssertEquals(t, out.String(), "Registration 1 may issue for any domain\n")
+
+	// Domains are lowercased
+	err = addAccountScope(context.Background(), sa, 1, []string{"Example.com", "example.net"}, "alice", "testing")
+	test.AssertNotError(t, err, "addAccountScope failed")
+	out.Reset()
+	err = printAccountScope(context.Background(), sa, &out, 1)
+	test.AssertNotError(t, err, "printAccountScope failed")
+	test.AssertEquals(t, out.String(), "example.com\nexample.net\n")
+
+	// Invalid domains are rejected before reaching the SA
+	err = addAccountScope(context.Background(), sa, 1, []string{"*.example.org"}, "alice", "testing")
+	test.AssertError(t, err, "addAccountScope with a wildcard didn't fail")
+	err = addAccountScope(context.Background(), sa, 1, []string{"com"}, "alice", "testing")
+	test.AssertError(t, err, "addAccountScope with a TLD didn't fail")
+	test.AssertEquals(t, len(sa.scopes[1]), 2)
+
+	err = removeAccountScope(context.Background(), sa, 1, []string{"EXAMPLE.com"}, "bob", "testing")
+	test.AssertNotError(t, err, "removeAccountScope failed")
+	test.AssertDeepEquals(t, sa.scopes[1], []string{"example.net"})
+	err = removeAccountScope(context.Background(), sa, 1, []string{"example.com"}, "bob", "testing")
+	test.AssertError(t, err, "removeAccountScope of a domain that wasn't allowed didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	// Removing the last domain doesn't lift the restriction; clearing does
+	err = removeAccountScope(context.Background(), sa, 1, []string{"example.net"}, "bob", "testing")
+	test.AssertError(t, err, "removeAccountScope of the last domain didn't fail")
+	test.AssertDeepEquals(t, sa.scopes[1], []string{"example.net"})
+	err = clearAccountScope(context.Background(), sa, 1, "carol", "testing")
+	test.AssertNotError(t, err, "clearAccountScope failed")
+	test.AssertEquals(t, len(sa.scopes[1]), 0)
+	test.AssertDeepEquals(t, sa.actors, []string{"alice", "bob", "carol"})
+}
@@ -48,7 +48,7 @@ func loadCFSSLIssuers(c config) ([]ca.Issuer, error) {
 	return issuers, nil
 }
 
-func loadBoulderIssuers(configs []ca_config.IssuerConfig, profile bsigner.ProfileConfig, ignoredLints []string) ([]bsigner.Config, error) {
+func loadBoulderIssuers(configs []ca_config.IssuerConfig, profile bsigner.ProfileConfig, profiles map[string]bsigner.ProfileConfig, ignoredLints []string) ([]bsigner.Config, error) {
 	boulderIssuerConfigs := make([]bsigner.Config, 0, len(configs))
 	for _, issuerConfig := range configs {
 		signer, issuer, err := loadIssuer(issuerConfig)
@@ -61,6 +61,7 @@ func loadBoulderIssuers(configs []ca_config.IssuerConfig, profile bsigner.Profil
 			IgnoredLints: ignoredLints,
 			Clk:          cmd.Clock(),
 			Profile:      profile,
+			Profiles:     profiles,
 		})
 	}
 	return boulderIssuerConfigs, nil
@@ -174,7 +175,7 @@ func main() {
 	var cfsslIssuers []ca.Issuer
 	var boulderIssuerConfigs []bsigner.Config
 	if features.Enabled(features.NonCFSSLSigner) {
-		boulderIssuerConfigs, err = loadBoulderIssuers(c.CA.Issuers, c.CA.SignerProfile, c.CA.IgnoredLints)
+		boulderIssuerConfigs, err = loadBoulderIssuers(c.CA.Issuers, c.CA.SignerProfile, c.CA.SignerProfiles, c.CA.IgnoredLints)
 		cmd.FailOnError(err, "Couldn't load issuers")
 	} else {
 		cfsslIssuers, err = loadCFSSLIssuers(c)
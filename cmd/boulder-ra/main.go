@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/x509"
 	"flag"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	pubpb "github.com/letsencrypt/boulder/publisher/proto"
 	"github.com/letsencrypt/boulder/ra"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
+	"github.com/letsencrypt/boulder/ratelimit"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	vapb "github.com/letsencrypt/boulder/va/proto"
 )
@@ -31,6 +33,27 @@ type config struct {
 
 		RateLimitPoliciesFilename string
 
+		// RateLimitOverridesRefreshInterval is how often the rate limit
+		// overrides stored in the database are reloaded. Defaults to 1 minute.
+		RateLimitOverridesRefreshInterval cmd.ConfigDuration
+
+		// TokenBucketRateLimits configures where rate limit token buckets are
+		// kept when the TokenBucketRateLimits feature is enabled.
+		TokenBucketRateLimits struct {
+			// Backend is "memory", which keeps buckets in this RA only and is
+			// meant for testing, or "redis".
+			Backend string
+			// Redis configures the server holding the buckets when Backend is
+			// "redis". It may be any server speaking the Redis protocol.
+			Redis struct {
+				Addr string
+				cmd.PasswordConfig
+				// Timeout bounds each command sent to the server. Defaults to
+				// 1 second.
+				Timeout cmd.ConfigDuration
+			}
+		}
+
 		MaxContactsPerRegistration int
 
 		SAService           *cmd.GRPCClientConfig
@@ -48,6 +71,35 @@ type config struct {
 		// creating another.
 		ReuseValidAuthz bool
 
+		// AuthzReuse limits which valid authorizations are reused when
+		// ReuseValidAuthz is true.
+		AuthzReuse struct {
+			// MaxAge is, for each challenge type, how long after validation
+			// an authorization validated with that type may be reused, and
+			// may be used to issue a certificate. Challenge types that
+			// aren't listed may be used for the authorization's lifetime.
+			MaxAge map[string]cmd.ConfigDuration
+			// NoWildcardReuse prevents authorizations for wildcard names
+			// from being reused.
+			NoWildcardReuse bool
+			// NoReuseSuffixes are domains for which, along with their
+			// subdomains, authorizations are never reused.
+			NoReuseSuffixes []string
+		}
+
+		// ValidationRetries allows failed validations to be retried by the
+		// client without invalidating their authorization.
+		ValidationRetries struct {
+			// MaxAttempts is how many times each authorization may be
+			// validated. Zero or one allows no retries.
+			MaxAttempts int
+			// Backoff is how long after its first failed attempt an
+			// authorization may be retried. It doubles after each further
+			// failed attempt, up to MaxBackoff if that is set.
+			Backoff    cmd.ConfigDuration
+			MaxBackoff cmd.ConfigDuration
+		}
+
 		// AuthorizationLifetimeDays defines how long authorizations will be
 		// considered valid for. Given a value of 300 days when used with a 90-day
 		// cert lifetime, this allows creation of certs that will cover a whole
@@ -70,6 +122,33 @@ type config struct {
 
 		OrderLifetime cmd.ConfigDuration
 
+		// FinalizeTimeout is how long background issuance for an order may take
+		// when the AsyncFinalize feature is enabled. Orders that have been
+		// processing for longer than twice this are failed, since the RA that
+		// was issuing for them must have stopped. Defaults to 5 minutes.
+		FinalizeTimeout cmd.ConfigDuration
+
+		// AutoRenewal configures Short-Term, Automatically Renewed (STAR)
+		// orders, described in RFC 8739. STAR orders are rejected unless
+		// PolicyFile is set.
+		AutoRenewal struct {
+			// Profile is the name of the CA certificate profile STAR
+			// certificates are issued with.
+			Profile string
+			// PolicyFile is the path to a JSON file containing a
+			// cmd.AutoRenewalPolicy, shared with the WFE.
+			PolicyFile string
+			// CheckInterval is how often STAR orders due a new certificate
+			// are looked for. Defaults to 1 minute.
+			CheckInterval cmd.ConfigDuration
+		}
+
+		// CertificateProfiles describes the CA certificate profiles that leave
+		// the CommonName or the TLS client authentication usage out of the
+		// certificates they issue, keyed by profile name. Certificates issued
+		// with any other profile must include both.
+		CertificateProfiles map[string]ra.CertProfile
+
 		// CTLogGroups contains groupings of CT logs which we want SCTs from.
 		// When we retrieve SCTs we will submit the certificate to each log
 		// in a group and the first SCT returned will be used. This allows
@@ -210,6 +289,11 @@ func main() {
 		cmd.Fail("Error in RA config: MaxNames must not be 0")
 	}
 
+	finalizeTimeout := 5 * time.Minute
+	if c.RA.FinalizeTimeout.Duration != 0 {
+		finalizeTimeout = c.RA.FinalizeTimeout.Duration
+	}
+
 	rai := ra.NewRegistrationAuthorityImpl(
 		clk,
 		logger,
@@ -223,6 +307,7 @@ func main() {
 		pubc,
 		caaClient,
 		c.RA.OrderLifetime.Duration,
+		finalizeTimeout,
 		ctp,
 		apc,
 		issuerCert,
@@ -230,20 +315,131 @@ func main() {
 
 	policyErr := rai.SetRateLimitPoliciesFile(c.RA.RateLimitPoliciesFilename)
 	cmd.FailOnError(policyErr, "Couldn't load rate limit policies file")
+
+	if features.Enabled(features.TokenBucketRateLimits) {
+		switch c.RA.TokenBucketRateLimits.Backend {
+		case "memory":
+			rai.SetRateLimitSource(ratelimit.NewInmemSource(clk))
+		case "redis":
+			redisConf := c.RA.TokenBucketRateLimits.Redis
+			if redisConf.Addr == "" {
+				cmd.Fail("TokenBucketRateLimits.Redis.Addr must be provided")
+			}
+			password, err := redisConf.Pass()
+			cmd.FailOnError(err, "Failed to load Redis password")
+			timeout := time.Second
+			if redisConf.Timeout.Duration != 0 {
+				timeout = redisConf.Timeout.Duration
+			}
+			rai.SetRateLimitSource(ratelimit.NewRedisSource(redisConf.Addr, password, timeout))
+		default:
+			cmd.Fail(fmt.Sprintf("Unknown TokenBucketRateLimits.Backend %q", c.RA.TokenBucketRateLimits.Backend))
+		}
+	}
 	rai.PA = pa
 
 	rai.VA = vac
 	rai.CA = cac
 	rai.SA = sac
 
+	rai.CertProfiles = c.RA.CertificateProfiles
+	if c.RA.AutoRenewal.PolicyFile != "" {
+		var policy cmd.AutoRenewalPolicy
+		err = cmd.ReadConfigFile(c.RA.AutoRenewal.PolicyFile, &policy)
+		cmd.FailOnError(err, "Unable to read auto-renewal policy")
+		rai.AutoRenewal = ra.AutoRenewalConfig{
+			Profile:             c.RA.AutoRenewal.Profile,
+			MinLifetime:         policy.MinLifetime.Duration,
+			MaxLifetime:         policy.MaxLifetime.Duration,
+			MaxDuration:         policy.MaxDuration.Duration,
+			AllowCertificateGet: policy.AllowCertificateGet,
+		}
+	}
+
+	rai.AuthzReuse = ra.AuthzReusePolicy{
+		MaxAge:          make(map[core.AcmeChallenge]time.Duration),
+		NoWildcardReuse: c.RA.AuthzReuse.NoWildcardReuse,
+		NoReuseSuffixes: c.RA.AuthzReuse.NoReuseSuffixes,
+	}
+	for challType, maxAge := range c.RA.AuthzReuse.MaxAge {
+		if !core.AcmeChallenge(challType).IsValid() {
+			cmd.Fail(fmt.Sprintf("Unknown challenge type %q in AuthzReuse.MaxAge", challType))
+		}
+		rai.AuthzReuse.MaxAge[core.AcmeChallenge(challType)] = maxAge.Duration
+	}
+	rai.ValidationRetries = ra.ValidationRetryPolicy{
+		MaxAttempts: c.RA.ValidationRetries.MaxAttempts,
+		Backoff:     c.RA.ValidationRetries.Backoff.Duration,
+		MaxBackoff:  c.RA.ValidationRetries.MaxBackoff.Duration,
+	}
+
 	serverMetrics := bgrpc.NewServerMetrics(scope)
 	grpcSrv, listener, err := bgrpc.NewServer(c.RA.GRPC, tlsConfig, serverMetrics, clk)
 	cmd.FailOnError(err, "Unable to setup RA gRPC server")
 	gw := bgrpc.NewRegistrationAuthorityServer(rai)
 	rapb.RegisterRegistrationAuthorityServer(grpcSrv, gw)
 
-	go cmd.CatchSignals(logger, grpcSrv.GracefulStop)
+	// Periodically fail orders whose issuance was abandoned, e.g. by an RA that
+	// was restarted in the middle of background issuance. Without AsyncFinalize
+	// orders are finalized within the request, which isn't bounded by the
+	// finalize timeout, so they mustn't be failed as stale.
+	if features.Enabled(features.AsyncFinalize) {
+		go func() {
+			for {
+				failed, err := rai.FailStaleProcessingOrders(context.Background())
+				if err != nil {
+					logger.Errf("Failed to fail stale processing orders: %s", err)
+				} else if failed > 0 {
+					logger.Infof("Failed %d stale processing orders", failed)
+				}
+				clk.Sleep(finalizeTimeout)
+			}
+		}()
+	}
+
+	// Periodically reload the rate limit overrides stored in the database, so
+	// that overrides added or expired through another RA are picked up.
+	overridesInterval := time.Minute
+	if c.RA.RateLimitOverridesRefreshInterval.Duration != 0 {
+		overridesInterval = c.RA.RateLimitOverridesRefreshInterval.Duration
+	}
+	go func() {
+		for {
+			err := rai.RefreshRateLimitOverrides(context.Background())
+			if err != nil {
+				logger.Errf("Failed to refresh rate limit overrides: %s", err)
+			}
+			clk.Sleep(overridesInterval)
+		}
+	}()
+
+	if rai.AutoRenewal.MaxDuration != 0 {
+		autoRenewalInterval := time.Minute
+		if c.RA.AutoRenewal.CheckInterval.Duration != 0 {
+			autoRenewalInterval = c.RA.AutoRenewal.CheckInterval.Duration
+		}
+		go func() {
+			for {
+				renewed, err := rai.ProcessAutoRenewals(context.Background())
+				if err != nil {
+					logger.Errf("Failed to process STAR order auto-renewals: %s", err)
+				} else if renewed > 0 {
+					logger.Infof("Issued certificates for %d STAR orders", renewed)
+				}
+				clk.Sleep(autoRenewalInterval)
+			}
+		}()
+	}
+
+	go cmd.CatchSignals(logger, func() {
+		grpcSrv.GracefulStop()
+		// Wait for background issuance to finish before exiting.
+		rai.Drain()
+	})
 
 	err = cmd.FilterShutdownErrors(grpcSrv.Serve(listener))
 	cmd.FailOnError(err, "RA gRPC service failed")
+	// Serve returns as soon as GracefulStop is called, so wait for background
+	// issuance here too rather than exiting underneath it.
+	rai.Drain()
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"time"
@@ -37,6 +38,32 @@ type config struct {
 		DNSTries     int
 		DNSResolvers []string
 
+		// DNSSECTrustAnchors, if set, causes the VA to validate DNSSEC itself
+		// rather than trusting its resolvers, starting from these DS records in
+		// presentation format (e.g. the root zone's KSK). CAA and DNS-01 lookups
+		// that fail validation are treated as errors.
+		DNSSECTrustAnchors []string
+
+		// DNSTransports configures DNS over TLS or DNS over HTTPS for resolvers
+		// in DNSResolvers, keyed by their entry in that list. Resolvers without
+		// an entry here are queried over plain UDP.
+		DNSTransports map[string]bdns.TransportConfig
+
+		// DNSResolverDiscovery lists DNS names ("host:port") or SRV records
+		// whose resolvers are used alongside DNSResolvers. They're looked up
+		// again every DNSResolverRefresh (default one minute) so that the pool
+		// of resolvers can change without restarting the VA. Discovered
+		// resolvers are always queried over plain UDP, since DNSTransports
+		// only applies to DNSResolvers.
+		DNSResolverDiscovery []string
+		DNSResolverRefresh   cmd.ConfigDuration
+
+		// DNSRootHints, if set, causes CAA and DNS-01 TXT lookups to be resolved
+		// iteratively, starting from these root server addresses and querying
+		// each zone's authoritative servers directly, rather than through
+		// DNSResolvers.
+		DNSRootHints []string
+
 		RemoteVAs                   []cmd.GRPCClientConfig
 		MaxRemoteValidationFailures int
 
@@ -54,6 +81,27 @@ type config struct {
 	}
 }
 
+// configureResolver enables the DNSSEC validation, encrypted transports,
+// resolver discovery and iterative resolution that c configures for r.
+func configureResolver(r *bdns.DNSClientImpl, c config, dnsRefresh time.Duration) {
+	if len(c.VA.DNSSECTrustAnchors) > 0 {
+		err := r.EnableDNSSEC(c.VA.DNSSECTrustAnchors)
+		cmd.FailOnError(err, "Couldn't enable DNSSEC validation")
+	}
+	if len(c.VA.DNSTransports) > 0 {
+		err := r.ConfigureTransports(c.VA.DNSTransports)
+		cmd.FailOnError(err, "Couldn't configure DNS transports")
+	}
+	if len(c.VA.DNSResolverDiscovery) > 0 {
+		err := r.DiscoverServers(context.Background(), c.VA.DNSResolverDiscovery, dnsRefresh)
+		cmd.FailOnError(err, "Couldn't discover DNS resolvers")
+	}
+	if len(c.VA.DNSRootHints) > 0 {
+		err := r.EnableIterativeResolution(c.VA.DNSRootHints)
+		cmd.FailOnError(err, "Couldn't enable iterative resolution")
+	}
+}
+
 func main() {
 	grpcAddr := flag.String("addr", "", "gRPC listen address override")
 	debugAddr := flag.String("debug-addr", "", "Debug server address override")
@@ -99,34 +147,37 @@ func main() {
 
 	dnsTimeout, err := time.ParseDuration(c.Common.DNSTimeout)
 	cmd.FailOnError(err, "Couldn't parse DNS timeout")
+	dnsRefresh := c.VA.DNSResolverRefresh.Duration
+	if dnsRefresh == 0 {
+		dnsRefresh = time.Minute
+	}
 	dnsTries := c.VA.DNSTries
 	if dnsTries < 1 {
 		dnsTries = 1
 	}
 	clk := cmd.Clock()
-	var resolver bdns.DNSClient
 	if len(c.Common.DNSResolver) != 0 {
 		c.VA.DNSResolvers = append(c.VA.DNSResolvers, c.Common.DNSResolver)
 	}
+	var resolver *bdns.DNSClientImpl
 	if !c.Common.DNSAllowLoopbackAddresses {
-		r := bdns.NewDNSClientImpl(
+		resolver = bdns.NewDNSClientImpl(
 			dnsTimeout,
 			c.VA.DNSResolvers,
 			scope,
 			clk,
 			dnsTries,
 			logger)
-		resolver = r
 	} else {
-		r := bdns.NewTestDNSClientImpl(
+		resolver = bdns.NewTestDNSClientImpl(
 			dnsTimeout,
 			c.VA.DNSResolvers,
 			scope,
 			clk,
 			dnsTries,
 			logger)
-		resolver = r
 	}
+	configureResolver(resolver, c, dnsRefresh)
 
 	tlsConfig, err := c.VA.TLS.Load()
 	cmd.FailOnError(err, "tlsConfig config")
@@ -141,8 +192,14 @@ func main() {
 			remotes = append(
 				remotes,
 				va.RemoteVA{
-					VAClient: bgrpc.NewValidationAuthorityGRPCClient(vaConn),
-					Address:  rva.ServerAddress,
+					RemoteClients: struct {
+						vapb.VAClient
+						vapb.CAAClient
+					}{
+						bgrpc.NewValidationAuthorityGRPCClient(vaConn),
+						vapb.NewCAAClient(vaConn),
+					},
+					Address: rva.ServerAddress,
 				},
 			)
 		}
@@ -75,6 +75,27 @@ type config struct {
 		// "website" field.
 		DirectoryWebsite string
 
+		// ExternalAccountRequired controls whether new accounts must include
+		// an externalAccountBinding. When true, the /directory response's "meta"
+		// element also advertises "externalAccountRequired". External account
+		// keys are managed with the admin tool.
+		ExternalAccountRequired bool
+
+		// CertificateProfiles maps the names of the certificate profiles that
+		// new orders may select to a human readable description of each. They
+		// are advertised in the /directory response's "meta" element's
+		// "profiles" field, and must match the CA's configured signer profiles.
+		CertificateProfiles map[string]string
+
+		// AutoRenewalPolicyFile is the path to a JSON file containing a
+		// cmd.AutoRenewalPolicy describing the Short-Term, Automatically
+		// Renewed (STAR) orders supported by the RA, which are described in
+		// RFC 8739. It should be the RA's AutoRenewal.PolicyFile. The policy
+		// is advertised in the /directory response's "meta" element's
+		// "auto-renewal" field if its MaxDuration is set, otherwise new
+		// orders requesting auto-renewal are rejected.
+		AutoRenewalPolicyFile string
+
 		// ACMEv2 requests (outside some registration/revocation messages) use a JWS with
 		// a KeyID header containing the full account URL. For new accounts this
 		// will be a KeyID based on the HTTP request's Host header and the ACMEv2
@@ -320,6 +341,8 @@ func main() {
 	// don't load any weak keys, but do load blocked keys
 	kp, err := goodkey.NewKeyPolicy("", c.WFE.BlockedKeyFile, sac.KeyBlocked)
 	cmd.FailOnError(err, "Unable to create key policy")
+	// The WFE only checks account keys, which may be Ed25519 keys.
+	kp.AllowEd25519 = true
 
 	if c.WFE.StaleTimeout.Duration == 0 {
 		c.WFE.StaleTimeout.Duration = time.Minute * 10
@@ -344,6 +367,20 @@ func main() {
 	wfe.AllowOrigins = c.WFE.AllowOrigins
 	wfe.DirectoryCAAIdentity = c.WFE.DirectoryCAAIdentity
 	wfe.DirectoryWebsite = c.WFE.DirectoryWebsite
+	wfe.ExternalAccountRequired = c.WFE.ExternalAccountRequired
+	wfe.CertificateProfiles = c.WFE.CertificateProfiles
+	if c.WFE.AutoRenewalPolicyFile != "" {
+		var policy cmd.AutoRenewalPolicy
+		err = cmd.ReadConfigFile(c.WFE.AutoRenewalPolicyFile, &policy)
+		cmd.FailOnError(err, "Unable to read auto-renewal policy")
+		if policy.MaxDuration.Duration != 0 {
+			wfe.AutoRenewal = &wfe2.AutoRenewalMeta{
+				MinLifetime:         int64(policy.MinLifetime.Duration.Seconds()),
+				MaxDuration:         int64(policy.MaxDuration.Duration.Seconds()),
+				AllowCertificateGet: policy.AllowCertificateGet,
+			}
+		}
+	}
 	wfe.LegacyKeyIDPrefix = c.WFE.LegacyKeyIDPrefix
 
 	wfe.IssuerCert, err = cmd.LoadCert(c.Common.IssuerCert)
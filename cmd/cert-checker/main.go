@@ -94,6 +94,23 @@ type certChecker struct {
 	rMu          *sync.Mutex
 	issuedReport report
 	checkPeriod  time.Duration
+	// profiles contains the certificate profiles, other than the default one,
+	// that certificates may have been issued with, keyed by name.
+	profiles map[string]certProfile
+}
+
+// certProfile describes how certificates issued with a certificate profile
+// differ from those issued with the default profile. The zero value describes
+// the default profile.
+type certProfile struct {
+	// OmitCommonName is true if certificates have no subject CommonName.
+	OmitCommonName bool
+	// OmitClientAuth is true if certificates have only the TLS server
+	// authentication extended key usage.
+	OmitClientAuth bool
+	// ValidityPeriod is the validity period of certificates. If zero it is
+	// expectedValidityPeriod.
+	ValidityPeriod cmd.ConfigDuration
 }
 
 func newChecker(saDbMap certDB, clk clock.Clock, pa core.PolicyAuthority, period time.Duration) certChecker {
@@ -104,6 +121,7 @@ func newChecker(saDbMap certDB, clk clock.Clock, pa core.PolicyAuthority, period
 		rMu:         new(sync.Mutex),
 		clock:       clk,
 		checkPeriod: period,
+		profiles:    make(map[string]certProfile),
 	}
 	c.issuedReport.Entries = make(map[string]reportEntry)
 
@@ -251,13 +269,9 @@ func (c *certChecker) checkCert(cert core.Certificate, ignoredLints map[string]b
 		if parsedCert.IsCA {
 			problems = append(problems, "Certificate can sign other certificates")
 		}
-		// Check the cert has the correct validity period
-		validityPeriod := parsedCert.NotAfter.Sub(parsedCert.NotBefore)
-		if validityPeriod > expectedValidityPeriod {
-			problems = append(problems, fmt.Sprintf("Certificate has a validity period longer than %s", expectedValidityPeriod))
-		} else if validityPeriod < expectedValidityPeriod {
-			problems = append(problems, fmt.Sprintf("Certificate has a validity period shorter than %s", expectedValidityPeriod))
-		}
+		// Check the cert's validity period, CommonName and key usage extensions
+		// all match a single certificate profile
+		problems = append(problems, c.checkProfile(parsedCert)...)
 		// Check the stored issuance time isn't too far back/forward dated
 		if parsedCert.NotBefore.Before(cert.Issued.Add(-6*time.Hour)) || parsedCert.NotBefore.After(cert.Issued.Add(6*time.Hour)) {
 			problems = append(problems, "Stored issuance date is outside of 6 hour window of certificate NotBefore")
@@ -269,9 +283,18 @@ func (c *certChecker) checkCert(cert core.Certificate, ignoredLints map[string]b
 				fmt.Sprintf("Certificate has common name >64 characters long (%d)", len(parsedCert.Subject.CommonName)),
 			)
 		}
-		// Check that the PA is still willing to issue for each name in DNSNames + CommonName
-		for _, name := range append(parsedCert.DNSNames, parsedCert.Subject.CommonName) {
-			id := identifier.ACMEIdentifier{Type: identifier.DNS, Value: name}
+		// Check that the PA is still willing to issue for each name in DNSNames +
+		// CommonName + IPAddresses. checkProfile has already checked whether the
+		// CommonName may be omitted.
+		names := parsedCert.DNSNames
+		if parsedCert.Subject.CommonName != "" {
+			names = append(names, parsedCert.Subject.CommonName)
+		}
+		for _, ip := range parsedCert.IPAddresses {
+			names = append(names, ip.String())
+		}
+		for _, name := range names {
+			id := identifier.ForName(name)
 			// TODO(https://github.com/letsencrypt/boulder/issues/3371): Distinguish
 			// between certificates issued by v1 and v2 API.
 			if err = c.pa.WillingToIssueWildcards([]identifier.ACMEIdentifier{id}); err != nil {
@@ -288,11 +311,6 @@ func (c *certChecker) checkCert(cert core.Certificate, ignoredLints map[string]b
 				}
 			}
 		}
-		// Check the cert has the correct key usage extensions
-		if !reflect.DeepEqual(parsedCert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}) {
-			problems = append(problems, "Certificate has incorrect key usage extensions")
-		}
-
 		for _, ext := range parsedCert.Extensions {
 			if _, ok := allowedExtensions[ext.Id.String()]; !ok {
 				problems = append(problems, fmt.Sprintf("Certificate contains an unexpected extension: %s", ext.Id))
@@ -307,6 +325,51 @@ func (c *certChecker) checkCert(cert core.Certificate, ignoredLints map[string]b
 	return problems
 }
 
+// checkProfile returns no problems if the certificate's validity period,
+// CommonName and key usage extensions match either the default certificate
+// profile or one of the configured profiles. Otherwise it returns the problems
+// found when checking against the default profile.
+func (c *certChecker) checkProfile(parsedCert *x509.Certificate) []string {
+	problems := profileProblems(parsedCert, certProfile{})
+	if len(problems) == 0 {
+		return nil
+	}
+	for _, profile := range c.profiles {
+		if len(profileProblems(parsedCert, profile)) == 0 {
+			return nil
+		}
+	}
+	return problems
+}
+
+// profileProblems checks the certificate's validity period, CommonName and key
+// usage extensions against those of certificates issued with the profile.
+func profileProblems(parsedCert *x509.Certificate, profile certProfile) (problems []string) {
+	expectedValidity := expectedValidityPeriod
+	if profile.ValidityPeriod.Duration != 0 {
+		expectedValidity = profile.ValidityPeriod.Duration
+	}
+	validityPeriod := parsedCert.NotAfter.Sub(parsedCert.NotBefore)
+	if validityPeriod > expectedValidity {
+		problems = append(problems, fmt.Sprintf("Certificate has a validity period longer than %s", expectedValidity))
+	} else if validityPeriod < expectedValidity {
+		problems = append(problems, fmt.Sprintf("Certificate has a validity period shorter than %s", expectedValidity))
+	}
+	if profile.OmitCommonName && parsedCert.Subject.CommonName != "" {
+		problems = append(problems, "Certificate has a common name")
+	} else if !profile.OmitCommonName && parsedCert.Subject.CommonName == "" {
+		problems = append(problems, "Certificate doesn't have a common name")
+	}
+	expectedEKU := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	if profile.OmitClientAuth {
+		expectedEKU = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	}
+	if !reflect.DeepEqual(parsedCert.ExtKeyUsage, expectedEKU) {
+		problems = append(problems, "Certificate has incorrect key usage extensions")
+	}
+	return problems
+}
+
 type config struct {
 	CertChecker struct {
 		cmd.DBConfig
@@ -322,6 +385,12 @@ type config struct {
 		// the IgnoredLists list are ignored regardless of LintStatus level.
 		IgnoredLints []string
 
+		// CertificateProfiles describes the certificate profiles, other than
+		// the default one, that certificates may have been issued with, keyed
+		// by profile name. A certificate's validity period, CommonName and key
+		// usage extensions must all match the same profile.
+		CertificateProfiles map[string]certProfile
+
 		Features map[string]bool
 	}
 
@@ -395,6 +464,9 @@ func main() {
 		pa,
 		config.CertChecker.CheckPeriod.Duration,
 	)
+	for name, profile := range config.CertChecker.CertificateProfiles {
+		checker.profiles[name] = profile
+	}
 	fmt.Fprintf(os.Stderr, "# Getting certificates issued in the last %s\n", config.CertChecker.CheckPeriod)
 
 	ignoredLintsMap := make(map[string]bool)
@@ -19,6 +19,7 @@ import (
 
 	"github.com/jmhodges/clock"
 
+	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/metrics"
@@ -251,6 +252,110 @@ func TestCheckCert(t *testing.T) {
 	test.AssertEquals(t, len(problems), 0)
 }
 
+func TestCheckCertProfiles(t *testing.T) {
+	testKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	fc := clock.NewFake()
+	fc.Add(time.Hour * 24 * 90)
+
+	checker := newChecker(nil, fc, pa, expectedValidityPeriod)
+	checker.profiles["short-lived"] = certProfile{
+		ValidityPeriod: cmd.ConfigDuration{Duration: 160 * time.Hour},
+	}
+	checker.profiles["tlsserver-no-cn"] = certProfile{
+		OmitCommonName: true,
+		OmitClientAuth: true,
+	}
+
+	serverAuth := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	serverAndClientAuth := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	testCases := []struct {
+		name             string
+		commonName       string
+		eku              []x509.ExtKeyUsage
+		validity         time.Duration
+		expectedProblems []string
+	}{
+		{
+			name:       "default profile",
+			commonName: "example-a.com",
+			eku:        serverAndClientAuth,
+			validity:   expectedValidityPeriod,
+		},
+		{
+			name:       "short-lived profile",
+			commonName: "example-a.com",
+			eku:        serverAndClientAuth,
+			validity:   160 * time.Hour,
+		},
+		{
+			name:     "profile without CommonName or client auth",
+			eku:      serverAuth,
+			validity: expectedValidityPeriod,
+		},
+		{
+			name:             "no CommonName with client auth",
+			eku:              serverAndClientAuth,
+			validity:         expectedValidityPeriod,
+			expectedProblems: []string{"Certificate doesn't have a common name"},
+		},
+		{
+			name:             "CommonName without client auth",
+			commonName:       "example-a.com",
+			eku:              serverAuth,
+			validity:         expectedValidityPeriod,
+			expectedProblems: []string{"Certificate has incorrect key usage extensions"},
+		},
+		{
+			name:     "properties of different profiles",
+			eku:      serverAuth,
+			validity: 160 * time.Hour,
+			expectedProblems: []string{
+				"Certificate has a validity period shorter than 2160h0m0s",
+				"Certificate doesn't have a common name",
+				"Certificate has incorrect key usage extensions",
+			},
+		},
+		{
+			name:             "unknown validity period",
+			commonName:       "example-a.com",
+			eku:              serverAndClientAuth,
+			validity:         100 * time.Hour,
+			expectedProblems: []string{"Certificate has a validity period shorter than 2160h0m0s"},
+		},
+	}
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			issued := checker.clock.Now().Add(-time.Hour * 24)
+			serial := big.NewInt(int64(1337 + i))
+			rawCert := x509.Certificate{
+				Subject:               pkix.Name{CommonName: tc.commonName},
+				NotBefore:             issued,
+				NotAfter:              issued.Add(tc.validity),
+				DNSNames:              []string{"example-a.com"},
+				SerialNumber:          serial,
+				BasicConstraintsValid: true,
+				ExtKeyUsage:           tc.eku,
+				KeyUsage:              x509.KeyUsageDigitalSignature,
+				OCSPServer:            []string{"http://example.com/ocsp"},
+				IssuingCertificateURL: []string{"http://example.com/cert"},
+			}
+			certDer, err := x509.CreateCertificate(rand.Reader, &rawCert, &rawCert, &testKey.PublicKey, testKey)
+			test.AssertNotError(t, err, "Couldn't create certificate")
+			parsed, err := x509.ParseCertificate(certDer)
+			test.AssertNotError(t, err, "Couldn't parse created certificate")
+			cert := core.Certificate{
+				Serial:  core.SerialToString(serial),
+				Digest:  core.Fingerprint256(certDer),
+				DER:     certDer,
+				Expires: parsed.NotAfter,
+				Issued:  parsed.NotBefore,
+			}
+			problems := checker.checkCert(cert, nil)
+			test.AssertDeepEquals(t, problems, tc.expectedProblems)
+		})
+	}
+}
+
 func TestGetAndProcessCerts(t *testing.T) {
 	saDbMap, err := sa.NewDbMap(vars.DBConnSA, 0)
 	test.AssertNotError(t, err, "Couldn't connect to database")
@@ -148,6 +148,25 @@ type RPCServerConfig struct {
 	RPCTimeout ConfigDuration
 }
 
+// AutoRenewalPolicy describes the Short-Term, Automatically Renewed (STAR)
+// orders, described in RFC 8739, that are supported. The RA enforces it and the
+// WFE advertises it in the /directory response, so both load it from the same
+// file.
+type AutoRenewalPolicy struct {
+	// MinLifetime and MaxLifetime bound the validity period a STAR order may
+	// request for its certificates. MaxLifetime must not be longer than the
+	// validity period of the RA's STAR certificate profile.
+	MinLifetime ConfigDuration
+	MaxLifetime ConfigDuration
+	// MaxDuration is the longest time between the start and end dates of a
+	// STAR order. It is further limited by the authorization lifetime. STAR
+	// orders are rejected unless it is set.
+	MaxDuration ConfigDuration
+	// AllowCertificateGet allows STAR orders to request that their
+	// certificates may be fetched with unauthenticated GET requests.
+	AllowCertificateGet bool
+}
+
 // SyslogConfig defines the config for syslogging.
 type SyslogConfig struct {
 	StdoutLevel int
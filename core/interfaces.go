@@ -88,8 +88,29 @@ type RegistrationAuthority interface {
 	// [WebFrontEnd]
 	FinalizeOrder(ctx context.Context, req *rapb.FinalizeOrderRequest) (*corepb.Order, error)
 
+	// [WebFrontEnd]
+	NewPreAuthorization(ctx context.Context, req *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error)
+
+	// [WebFrontEnd]
+	CancelAutoRenewal(ctx context.Context, req *rapb.CancelAutoRenewalRequest) (*corepb.Empty, error)
+
+	// [WebFrontEnd]
+	RateLimitStatus(ctx context.Context, req *rapb.RateLimitStatusRequest) (*rapb.RateLimitStatusResponse, error)
+
 	// [AdminRevoker]
 	AdministrativelyRevokeCertificate(ctx context.Context, cert x509.Certificate, code revocation.Reason, adminName string) error
+
+	// [Admin]
+	AddRateLimitOverride(ctx context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error)
+
+	// [Admin]
+	GetRateLimitOverrides(ctx context.Context, req *rapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error)
+
+	// [Admin]
+	ExpireRateLimitOverride(ctx context.Context, req *rapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error)
+
+	// [Admin]
+	ReviewHeldOrder(ctx context.Context, req *rapb.ReviewHeldOrderRequest) (*corepb.Order, error)
 }
 
 // ValidationAuthority defines the public interface for the Boulder VA
@@ -113,6 +134,8 @@ type PolicyAuthority interface {
 	WillingToIssueWildcards(identifiers []identifier.ACMEIdentifier) error
 	ChallengesFor(domain identifier.ACMEIdentifier) ([]Challenge, error)
 	ChallengeTypeEnabled(t AcmeChallenge) bool
+	ChallengeTypeAllowed(t AcmeChallenge, ident identifier.ACMEIdentifier) bool
+	ReviewRequired(ident identifier.ACMEIdentifier) bool
 }
 
 // StorageGetter are the Boulder SA's read-only methods
@@ -123,10 +146,10 @@ type StorageGetter interface {
 	GetPrecertificate(ctx context.Context, req *sapb.Serial) (*corepb.Certificate, error)
 	GetCertificateStatus(ctx context.Context, serial string) (CertificateStatus, error)
 	CountCertificatesByNames(ctx context.Context, domains []string, earliest, latest time.Time) (countByDomain []*sapb.CountByNames_MapElement, err error)
-	CountRegistrationsByIP(ctx context.Context, ip net.IP, earliest, latest time.Time) (int, error)
-	CountRegistrationsByIPRange(ctx context.Context, ip net.IP, earliest, latest time.Time) (int, error)
-	CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, error)
-	CountFQDNSets(ctx context.Context, window time.Duration, domains []string) (count int64, err error)
+	CountRegistrationsByIP(ctx context.Context, ip net.IP, earliest, latest time.Time) (count int, oldest time.Time, err error)
+	CountRegistrationsByIPRange(ctx context.Context, ip net.IP, earliest, latest time.Time) (count int, oldest time.Time, err error)
+	CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (count int, oldest time.Time, err error)
+	CountFQDNSets(ctx context.Context, window time.Duration, domains []string) (count int64, oldest time.Time, err error)
 	FQDNSetExists(ctx context.Context, domains []string) (exists bool, err error)
 	PreviousCertificateExists(ctx context.Context, req *sapb.PreviousCertificateExistsRequest) (exists *sapb.Exists, err error)
 	GetOrder(ctx context.Context, req *sapb.OrderRequest) (*corepb.Order, error)
@@ -140,6 +163,16 @@ type StorageGetter interface {
 	CountInvalidAuthorizations2(ctx context.Context, req *sapb.CountInvalidAuthorizationsRequest) (*sapb.Count, error)
 	GetValidAuthorizations2(ctx context.Context, req *sapb.GetValidAuthorizationsRequest) (*sapb.Authorizations, error)
 	KeyBlocked(ctx context.Context, req *sapb.KeyBlockedRequest) (*sapb.Exists, error)
+	GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error)
+	ReplacementOrderExists(ctx context.Context, req *sapb.Serial) (*sapb.Exists, error)
+	GetRenewalInfoOverride(ctx context.Context, req *sapb.Serial) (*sapb.RenewalInfoOverride, error)
+	GetOrdersForAccount(ctx context.Context, req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error)
+	GetStaleProcessingOrders(ctx context.Context, req *sapb.GetStaleProcessingOrdersRequest) (*sapb.OrderIDs, error)
+	GetDueAutoRenewals(ctx context.Context, req *sapb.GetDueAutoRenewalsRequest) (*sapb.DueAutoRenewals, error)
+	GetRateLimitOverrides(ctx context.Context, req *sapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error)
+	GetValidationAttempts(ctx context.Context, req *sapb.GetValidationAttemptsRequest) (*corepb.ValidationAttempts, error)
+	GetHeldOrders(ctx context.Context, req *sapb.GetHeldOrdersRequest) (*sapb.HeldOrders, error)
+	GetAccountScope(ctx context.Context, req *sapb.RegistrationID) (*sapb.AccountScope, error)
 }
 
 // StorageAdder are the Boulder SA's write/update methods
@@ -158,8 +191,24 @@ type StorageAdder interface {
 	// New authz2 methods
 	NewAuthorizations2(ctx context.Context, req *sapb.AddPendingAuthorizationsRequest) (*sapb.Authorization2IDs, error)
 	FinalizeAuthorization2(ctx context.Context, req *sapb.FinalizeAuthorizationRequest) error
+	RecordFailedValidation2(ctx context.Context, req *sapb.RecordFailedValidationRequest) error
 	DeactivateAuthorization2(ctx context.Context, req *sapb.AuthorizationID2) (*corepb.Empty, error)
 	AddBlockedKey(ctx context.Context, req *sapb.AddBlockedKeyRequest) (*corepb.Empty, error)
+	AddExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKey) (*corepb.Empty, error)
+	RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error)
+	AddRenewalInfoOverride(ctx context.Context, req *sapb.RenewalInfoOverride) (*corepb.Empty, error)
+	SetAutoRenewalCertificate(ctx context.Context, req *sapb.SetAutoRenewalCertificateRequest) (*corepb.Empty, error)
+	ClaimAutoRenewal(ctx context.Context, req *sapb.ClaimAutoRenewalRequest) (*sapb.Exists, error)
+	CancelAutoRenewal(ctx context.Context, req *sapb.CancelAutoRenewalRequest) (*corepb.Empty, error)
+	AddRateLimitOverride(ctx context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error)
+	ExpireRateLimitOverride(ctx context.Context, req *sapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error)
+	AddValidationAttempt(ctx context.Context, req *corepb.ValidationAttempt) (*corepb.Empty, error)
+	DeleteProcessingOrder(ctx context.Context, req *sapb.OrderRequest) (*corepb.Empty, error)
+	HoldOrder(ctx context.Context, req *sapb.HeldOrder) (*corepb.Empty, error)
+	ReleaseHeldOrder(ctx context.Context, req *sapb.ReleaseHeldOrderRequest) (*sapb.HeldOrder, error)
+	AddAccountScope(ctx context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error)
+	RemoveAccountScope(ctx context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error)
+	ClearAccountScope(ctx context.Context, req *sapb.ClearAccountScopeRequest) (*corepb.Empty, error)
 }
 
 // StorageAuthority interface represents a simple key/value
@@ -31,6 +31,7 @@ const (
 	StatusInvalid     = AcmeStatus("invalid")     // Validation failed
 	StatusRevoked     = AcmeStatus("revoked")     // Object no longer valid
 	StatusDeactivated = AcmeStatus("deactivated") // Object has been deactivated
+	StatusCanceled    = AcmeStatus("canceled")    // STAR order auto-renewal has been canceled
 )
 
 // AcmeResource values identify different types of ACME resources
@@ -54,15 +55,16 @@ type AcmeChallenge string
 // These types are the available challenges
 // TODO(#5009): Make this a custom type as well.
 const (
-	ChallengeTypeHTTP01    = AcmeChallenge("http-01")
-	ChallengeTypeDNS01     = AcmeChallenge("dns-01")
-	ChallengeTypeTLSALPN01 = AcmeChallenge("tls-alpn-01")
+	ChallengeTypeHTTP01       = AcmeChallenge("http-01")
+	ChallengeTypeDNS01        = AcmeChallenge("dns-01")
+	ChallengeTypeTLSALPN01    = AcmeChallenge("tls-alpn-01")
+	ChallengeTypeDNSAccount01 = AcmeChallenge("dns-account-01")
 )
 
 // IsValid tests whether the challenge is a known challenge
 func (c AcmeChallenge) IsValid() bool {
 	switch c {
-	case ChallengeTypeHTTP01, ChallengeTypeDNS01, ChallengeTypeTLSALPN01:
+	case ChallengeTypeHTTP01, ChallengeTypeDNS01, ChallengeTypeTLSALPN01, ChallengeTypeDNSAccount01:
 		return true
 	default:
 		return false
@@ -140,6 +142,16 @@ type Registration struct {
 	CreatedAt time.Time `json:"createdAt"`
 
 	Status AcmeStatus `json:"status"`
+
+	// ExternalAccountID is the key ID of the external account binding (RFC 8555
+	// Section 7.3.4) that was presented when the registration was created, if
+	// any.
+	ExternalAccountID string `json:"externalAccountID,omitempty"`
+
+	// Orders is the URL of the account's orders list (RFC 8555 Section
+	// 7.1.2.1). It is not stored, and is only populated by the WFE when
+	// displaying an account.
+	Orders string `json:"orders,omitempty"`
 }
 
 // ValidationRecord represents a validation attempt against a specific URL/hostname
@@ -168,6 +180,9 @@ type ValidationRecord struct {
 	//   ...
 	// }
 	AddressesTried []net.IP `json:"addressesTried,omitempty"`
+	// DNSSEC is the DNSSEC status ("secure", "insecure" or "bogus") of the
+	// DNS answer the record is based on, if the VA validates DNSSEC itself.
+	DNSSEC string `json:"dnssec,omitempty"`
 }
 
 func looksLikeKeyAuthorization(str string) error {
@@ -219,6 +234,17 @@ type Challenge struct {
 	// Contains information about URLs used or redirected to and IPs resolved and
 	// used
 	ValidationRecord []ValidationRecord `json:"validationRecord,omitempty"`
+
+	// The number of failed validation attempts of the authorization that were
+	// retried, and the time after which it may be retried again. The Error and
+	// ValidationRecord of a pending challenge are those of its latest failed
+	// attempt, if any.
+	Attempts   int        `json:"attempts,omitempty"`
+	RetryAfter *time.Time `json:"retryAfter,omitempty"`
+
+	// The time at which the server validated this challenge, if it is valid
+	// and that time was recorded.
+	Validated *time.Time `json:"validated,omitempty"`
 }
 
 // ExpectedKeyAuthorization computes the expected KeyAuthorization value for
@@ -262,7 +288,7 @@ func (ch Challenge) RecordsSane() bool {
 			ch.ValidationRecord[0].AddressUsed == nil || len(ch.ValidationRecord[0].AddressesResolved) == 0 {
 			return false
 		}
-	case ChallengeTypeDNS01:
+	case ChallengeTypeDNS01, ChallengeTypeDNSAccount01:
 		if len(ch.ValidationRecord) > 1 {
 			return false
 		}
@@ -585,3 +611,47 @@ type SCTDERs [][]byte
 // CertDER is a convenience type that helps differentiate what the
 // underlying byte slice contains
 type CertDER []byte
+
+// SuggestedWindow is a JSON object expressing a period of time during which a
+// certificate should be renewed. It is part of the RenewalInfo object.
+type SuggestedWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// RenewalInfo is a type which is exposed to clients which query the
+// renewalInfo endpoint specified in draft-ietf-acme-ari.
+type RenewalInfo struct {
+	SuggestedWindow SuggestedWindow `json:"suggestedWindow"`
+}
+
+// RenewalInfoSimple constructs a `RenewalInfo` object and suggested window
+// using a very simple renewal calculation: calculate a point 2/3rds of the way
+// through the validity period, then give a 2-day window around that. Both the
+// `issued` and `expires` timestamps are expected to be UTC.
+func RenewalInfoSimple(issued time.Time, expires time.Time) RenewalInfo {
+	validity := expires.Add(time.Second).Sub(issued)
+	renewalOffset := validity / time.Duration(3)
+	idealRenewal := expires.Add(-renewalOffset)
+	return RenewalInfo{
+		SuggestedWindow: SuggestedWindow{
+			Start: idealRenewal.Add(-24 * time.Hour),
+			End:   idealRenewal.Add(24 * time.Hour),
+		},
+	}
+}
+
+// RenewalInfoImmediate constructs a `RenewalInfo` object with a suggested
+// window in the past. Per the draft-ietf-acme-ari-01 spec, clients should
+// attempt to renew immediately if the suggested window is in the past. The
+// passed `now` is assumed to be a timestamp representing the current moment in
+// time.
+func RenewalInfoImmediate(now time.Time) RenewalInfo {
+	oneHourAgo := now.Add(-1 * time.Hour)
+	return RenewalInfo{
+		SuggestedWindow: SuggestedWindow{
+			Start: oneHourAgo,
+			End:   oneHourAgo.Add(time.Minute * 30),
+		},
+	}
+}
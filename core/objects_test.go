@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net"
 	"testing"
+	"time"
 
 	"gopkg.in/square/go-jose.v2"
 
@@ -57,7 +58,7 @@ func TestChallengeSanityCheck(t *testing.T) {
   }`), &accountKey)
 	test.AssertNotError(t, err, "Error unmarshaling JWK")
 
-	types := []AcmeChallenge{ChallengeTypeHTTP01, ChallengeTypeDNS01, ChallengeTypeTLSALPN01}
+	types := []AcmeChallenge{ChallengeTypeHTTP01, ChallengeTypeDNS01, ChallengeTypeTLSALPN01, ChallengeTypeDNSAccount01}
 	for _, challengeType := range types {
 		chall := Challenge{
 			Type:   challengeType,
@@ -173,3 +174,23 @@ func TestFindChallengeByType(t *testing.T) {
 	test.AssertEquals(t, 1, authz.FindChallengeByStringID(authz.Challenges[1].StringID()))
 	test.AssertEquals(t, -1, authz.FindChallengeByStringID("hello"))
 }
+
+func TestRenewalInfoSimple(t *testing.T) {
+	issued := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	expires := issued.Add(90*24*time.Hour - time.Second)
+
+	ri := RenewalInfoSimple(issued, expires)
+	// Two thirds of the way through a 90 day validity period is 30 days before
+	// expiry, the window is a day either side of that.
+	idealRenewal := expires.Add(-30 * 24 * time.Hour)
+	test.AssertEquals(t, ri.SuggestedWindow.Start, idealRenewal.Add(-24*time.Hour))
+	test.AssertEquals(t, ri.SuggestedWindow.End, idealRenewal.Add(24*time.Hour))
+}
+
+func TestRenewalInfoImmediate(t *testing.T) {
+	now := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	ri := RenewalInfoImmediate(now)
+	test.Assert(t, ri.SuggestedWindow.End.Before(now), "Suggested window should end in the past")
+	test.Assert(t, ri.SuggestedWindow.Start.Before(ri.SuggestedWindow.End), "Suggested window should start before it ends")
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"bytes"
 	"crypto"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/sha256"
 	"crypto/x509"
@@ -16,12 +17,14 @@ import (
 	"io/ioutil"
 	"math/big"
 	mrand "math/rand"
+	"net"
 	"regexp"
 	"sort"
 	"strings"
 	"time"
 	"unicode"
 
+	xed25519 "golang.org/x/crypto/ed25519"
 	jose "gopkg.in/square/go-jose.v2"
 
 	blog "github.com/letsencrypt/boulder/log"
@@ -101,7 +104,7 @@ func KeyDigest(key crypto.PublicKey) (Sha256Digest, error) {
 	case jose.JSONWebKey:
 		return KeyDigest(t.Key)
 	default:
-		keyDER, err := x509.MarshalPKIXPublicKey(key)
+		keyDER, err := x509.MarshalPKIXPublicKey(stdlibPublicKey(key))
 		if err != nil {
 			logger := blog.Get()
 			logger.Debugf("Problem marshaling public key: %s", err)
@@ -111,6 +114,16 @@ func KeyDigest(key crypto.PublicKey) (Sha256Digest, error) {
 	}
 }
 
+// stdlibPublicKey converts Ed25519 public keys from golang.org/x/crypto/ed25519,
+// which is what go-jose produces when parsing a JWK, to the crypto/ed25519
+// type understood by x509.MarshalPKIXPublicKey. Other keys are returned as-is.
+func stdlibPublicKey(key interface{}) interface{} {
+	if k, ok := key.(xed25519.PublicKey); ok {
+		return ed25519.PublicKey(k)
+	}
+	return key
+}
+
 // KeyDigestB64 produces a padded, standard Base64-encoded SHA256 digest of a
 // provided public key.
 func KeyDigestB64(key crypto.PublicKey) (string, error) {
@@ -139,11 +152,11 @@ func PublicKeysEqual(a, b interface{}) (bool, error) {
 	if a == nil || b == nil {
 		return false, errors.New("One or more nil arguments to PublicKeysEqual")
 	}
-	aBytes, err := x509.MarshalPKIXPublicKey(a)
+	aBytes, err := x509.MarshalPKIXPublicKey(stdlibPublicKey(a))
 	if err != nil {
 		return false, err
 	}
-	bBytes, err := x509.MarshalPKIXPublicKey(b)
+	bBytes, err := x509.MarshalPKIXPublicKey(stdlibPublicKey(b))
 	if err != nil {
 		return false, err
 	}
@@ -299,6 +312,19 @@ func UniqueLowerNames(names []string) (unique []string) {
 	return
 }
 
+// UniqueLowerNamesAndIPs returns the unique lowercased DNS names and the unique
+// IP addresses, in their canonical textual form, as one sorted list. This is
+// the form in which a certificate's or CSR's names are compared with an order's
+// names and counted for rate limiting.
+func UniqueLowerNamesAndIPs(dnsNames []string, ips []net.IP) []string {
+	names := make([]string, 0, len(dnsNames)+len(ips))
+	names = append(names, dnsNames...)
+	for _, ip := range ips {
+		names = append(names, ip.String())
+	}
+	return UniqueLowerNames(names)
+}
+
 // LoadCertBundle loads a PEM bundle of certificates from disk
 func LoadCertBundle(filename string) ([]*x509.Certificate, error) {
 	bundleBytes, err := ioutil.ReadFile(filename)
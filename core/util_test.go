@@ -1,10 +1,15 @@
 package core
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"net"
 	"sort"
 	"strings"
 	"testing"
@@ -90,6 +95,32 @@ func TestKeyDigest(t *testing.T) {
 	test.Assert(t, err != nil, "Should have rejected unknown key type")
 }
 
+func TestKeyDigestEd25519(t *testing.T) {
+	// Example Ed25519 key from RFC 8037 Appendix A.2
+	var jwk jose.JSONWebKey
+	err := json.Unmarshal([]byte(`{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`), &jwk)
+	test.AssertNotError(t, err, "Failed to unmarshal Ed25519 JWK")
+
+	raw, err := base64.RawURLEncoding.DecodeString("11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo")
+	test.AssertNotError(t, err, "Failed to decode key")
+	spki, err := x509.MarshalPKIXPublicKey(ed25519.PublicKey(raw))
+	test.AssertNotError(t, err, "Failed to marshal key")
+	expected := sha256.Sum256(spki)
+
+	// The digest of the go-jose key must match the digest of the
+	// SubjectPublicKeyInfo, which is what blockedKeys and keyHashToSerial hold.
+	digest, err := KeyDigest(jwk)
+	test.AssertNotError(t, err, "Failed to digest Ed25519 JWK")
+	test.AssertEquals(t, digest, Sha256Digest(expected))
+	digest, err = KeyDigest(ed25519.PublicKey(raw))
+	test.AssertNotError(t, err, "Failed to digest Ed25519 key")
+	test.AssertEquals(t, digest, Sha256Digest(expected))
+
+	equal, err := PublicKeysEqual(jwk.Key, ed25519.PublicKey(raw))
+	test.AssertNotError(t, err, "Failed to compare Ed25519 keys")
+	test.Assert(t, equal, "Ed25519 keys should be equal")
+}
+
 func TestKeyDigestEquals(t *testing.T) {
 	var jwk1, jwk2 jose.JSONWebKey
 	err := json.Unmarshal([]byte(JWK1JSON), &jwk1)
@@ -146,6 +177,13 @@ func TestUniqueLowerNames(t *testing.T) {
 	test.AssertDeepEquals(t, []string{"a.com", "bar.com", "baz.com", "foobar.com"}, u)
 }
 
+func TestUniqueLowerNamesAndIPs(t *testing.T) {
+	u := UniqueLowerNamesAndIPs(
+		[]string{"fooBAR.com", "foobar.com", "a.com"},
+		[]net.IP{net.ParseIP("1.2.3.4"), net.IPv4(1, 2, 3, 4), net.ParseIP("2600::1")})
+	test.AssertDeepEquals(t, []string{"1.2.3.4", "2600::1", "a.com", "foobar.com"}, u)
+}
+
 func TestValidSerial(t *testing.T) {
 	notLength32Or36 := "A"
 	length32 := strings.Repeat("A", 32)
@@ -5,6 +5,7 @@ import (
 	"crypto"
 	"crypto/x509"
 	"errors"
+	"net"
 	"strings"
 
 	"github.com/letsencrypt/boulder/core"
@@ -38,14 +39,13 @@ var (
 	unsupportedSigAlg    = berrors.BadCSRError("signature algorithm not supported")
 	invalidSig           = berrors.BadCSRError("invalid signature on CSR")
 	invalidEmailPresent  = berrors.BadCSRError("CSR contains one or more email address fields")
-	invalidIPPresent     = berrors.BadCSRError("CSR contains one or more IP address fields")
-	invalidNoDNS         = berrors.BadCSRError("at least one DNS name is required")
+	invalidNoDNS         = berrors.BadCSRError("at least one DNS name or IP address is required")
 	invalidAllSANTooLong = berrors.BadCSRError("CSR doesn't contain a SAN short enough to fit in CN")
 )
 
 // VerifyCSR checks the validity of a x509.CertificateRequest. Before doing checks it normalizes
-// the CSR which lowers the case of DNS names and subject CN, and hoist a DNS name into the CN
-// if it is empty.
+// the CSR which lowers the case of DNS names and subject CN, and hoist a DNS name (or failing
+// that an IP address) into the CN if it is empty.
 func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int, keyPolicy *goodkey.KeyPolicy, pa core.PolicyAuthority, regID int64) error {
 	normalizeCSR(csr)
 	key, ok := csr.PublicKey.(crypto.PublicKey)
@@ -67,10 +67,7 @@ func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int,
 	if len(csr.EmailAddresses) > 0 {
 		return invalidEmailPresent
 	}
-	if len(csr.IPAddresses) > 0 {
-		return invalidIPPresent
-	}
-	if len(csr.DNSNames) == 0 && csr.Subject.CommonName == "" {
+	if len(csr.DNSNames) == 0 && len(csr.IPAddresses) == 0 && csr.Subject.CommonName == "" {
 		return invalidNoDNS
 	}
 	if csr.Subject.CommonName == "" {
@@ -79,12 +76,15 @@ func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int,
 	if len(csr.Subject.CommonName) > maxCNLength {
 		return berrors.BadCSRError("CN was longer than %d bytes", maxCNLength)
 	}
-	if len(csr.DNSNames) > maxNames {
-		return berrors.BadCSRError("CSR contains more than %d DNS names", maxNames)
+	if len(csr.DNSNames)+len(csr.IPAddresses) > maxNames {
+		return berrors.BadCSRError("CSR contains more than %d DNS names and IP addresses", maxNames)
+	}
+	idents := make([]identifier.ACMEIdentifier, 0, len(csr.DNSNames)+len(csr.IPAddresses))
+	for _, dnsName := range csr.DNSNames {
+		idents = append(idents, identifier.DNSIdentifier(dnsName))
 	}
-	idents := make([]identifier.ACMEIdentifier, len(csr.DNSNames))
-	for i, dnsName := range csr.DNSNames {
-		idents[i] = identifier.DNSIdentifier(dnsName)
+	for _, ip := range csr.IPAddresses {
+		idents = append(idents, identifier.IPIdentifier(ip))
 	}
 	if err := pa.WillingToIssueWildcards(idents); err != nil {
 		return err
@@ -92,8 +92,10 @@ func VerifyCSR(ctx context.Context, csr *x509.CertificateRequest, maxNames int,
 	return nil
 }
 
-// normalizeCSR deduplicates and lowers the case of dNSNames and the subject CN.
-// It will also hoist a dNSName into the CN if it is empty.
+// normalizeCSR deduplicates and lowers the case of dNSNames and the subject CN,
+// and deduplicates iPAddresses. A CN that is an IP address is added to the
+// iPAddresses rather than the dNSNames. It will also hoist a dNSName, or an
+// iPAddress if there are no dNSNames, into the CN if it is empty.
 func normalizeCSR(csr *x509.CertificateRequest) {
 	if csr.Subject.CommonName == "" {
 		var forcedCN string
@@ -104,10 +106,38 @@ func normalizeCSR(csr *x509.CertificateRequest) {
 				break
 			}
 		}
+		// An IP address always fits in the CN
+		if forcedCN == "" && len(csr.IPAddresses) > 0 {
+			forcedCN = csr.IPAddresses[0].String()
+		}
 		csr.Subject.CommonName = forcedCN
-	} else if csr.Subject.CommonName != "" {
+	} else if ip := net.ParseIP(csr.Subject.CommonName); ip != nil {
+		csr.Subject.CommonName = ip.String()
+		csr.IPAddresses = append(csr.IPAddresses, ip)
+	} else {
 		csr.DNSNames = append(csr.DNSNames, csr.Subject.CommonName)
 	}
 	csr.Subject.CommonName = strings.ToLower(csr.Subject.CommonName)
 	csr.DNSNames = core.UniqueLowerNames(csr.DNSNames)
+	csr.IPAddresses = uniqueIPs(csr.IPAddresses)
+}
+
+// uniqueIPs deduplicates a list of IP addresses, comparing them in their
+// canonical textual form, and returns them sorted by that form.
+func uniqueIPs(ips []net.IP) []net.IP {
+	if len(ips) == 0 {
+		return ips
+	}
+	names := core.UniqueLowerNamesAndIPs(nil, ips)
+	unique := make([]net.IP, len(names))
+	for i, name := range names {
+		unique[i] = net.ParseIP(name)
+	}
+	return unique
+}
+
+// Names returns the DNS names and IP addresses from a CSR as one deduplicated,
+// lowercased and sorted list of names, in the same form as an order's names.
+func Names(csr *x509.CertificateRequest) []string {
+	return core.UniqueLowerNamesAndIPs(csr.DNSNames, csr.IPAddresses)
 }
@@ -2,11 +2,13 @@ package csr
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
+	"fmt"
 	"net"
 	"strings"
 	"testing"
@@ -47,6 +49,14 @@ func (pa *mockPA) ChallengeTypeEnabled(t core.AcmeChallenge) bool {
 	return true
 }
 
+func (pa *mockPA) ChallengeTypeAllowed(t core.AcmeChallenge, ident identifier.ACMEIdentifier) bool {
+	return true
+}
+
+func (pa *mockPA) ReviewRequired(ident identifier.ACMEIdentifier) bool {
+	return false
+}
+
 func TestVerifyCSR(t *testing.T) {
 	private, err := rsa.GenerateKey(rand.Reader, 2048)
 	test.AssertNotError(t, err, "error generating test key")
@@ -75,6 +85,15 @@ func TestVerifyCSR(t *testing.T) {
 	signedReqWithAllLongSANs := new(x509.CertificateRequest)
 	*signedReqWithAllLongSANs = *signedReq
 	signedReqWithAllLongSANs.DNSNames = []string{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.com"}
+	// Ed25519 keys are acceptable for accounts but we can't issue for them.
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "error generating Ed25519 test key")
+	edReqBytes, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{PublicKey: edPub, DNSNames: []string{"a.com"}}, edPriv)
+	test.AssertNotError(t, err, "error generating Ed25519 test CSR")
+	edReq, err := x509.ParseCertificateRequest(edReqBytes)
+	test.AssertNotError(t, err, "error parsing Ed25519 test CSR")
+	edPolicy := *testingPolicy
+	edPolicy.AllowEd25519 = true
 
 	cases := []struct {
 		csr           *x509.CertificateRequest
@@ -130,7 +149,7 @@ func TestVerifyCSR(t *testing.T) {
 			testingPolicy,
 			&mockPA{},
 			0,
-			berrors.BadCSRError("CSR contains more than 1 DNS names"),
+			berrors.BadCSRError("CSR contains more than 1 DNS names and IP addresses"),
 		},
 		{
 			signedReqWithBadNames,
@@ -154,7 +173,7 @@ func TestVerifyCSR(t *testing.T) {
 			testingPolicy,
 			&mockPA{},
 			0,
-			invalidIPPresent,
+			nil,
 		},
 		{
 			signedReqWithAllLongSANs,
@@ -164,6 +183,14 @@ func TestVerifyCSR(t *testing.T) {
 			0,
 			invalidAllSANTooLong,
 		},
+		{
+			edReq,
+			100,
+			&edPolicy,
+			&mockPA{},
+			0,
+			unsupportedSigAlg,
+		},
 	}
 
 	for _, c := range cases {
@@ -226,3 +253,64 @@ func TestNormalizeCSR(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeCSRIPAddresses(t *testing.T) {
+	cases := []struct {
+		name          string
+		csr           *x509.CertificateRequest
+		expectedCN    string
+		expectedNames []string
+		expectedIPs   []net.IP
+	}{
+		{
+			"no explicit CN, only IP addresses",
+			&x509.CertificateRequest{IPAddresses: []net.IP{net.ParseIP("2600::1"), net.IPv4(1, 2, 3, 4)}},
+			"2600::1",
+			[]string{},
+			[]net.IP{net.IPv4(1, 2, 3, 4), net.ParseIP("2600::1")},
+		},
+		{
+			"no explicit CN, DNS name preferred over IP address",
+			&x509.CertificateRequest{DNSNames: []string{"a.com"}, IPAddresses: []net.IP{net.IPv4(1, 2, 3, 4)}},
+			"a.com",
+			[]string{"a.com"},
+			[]net.IP{net.IPv4(1, 2, 3, 4)},
+		},
+		{
+			"explicit IP address CN",
+			&x509.CertificateRequest{Subject: pkix.Name{CommonName: "2600:0::A"}, DNSNames: []string{"a.com"}},
+			"2600::a",
+			[]string{"a.com"},
+			[]net.IP{net.ParseIP("2600::a")},
+		},
+		{
+			"duplicate IP addresses",
+			&x509.CertificateRequest{
+				Subject:     pkix.Name{CommonName: "1.2.3.4"},
+				IPAddresses: []net.IP{net.IPv4(1, 2, 3, 4), net.ParseIP("1.2.3.4").To4()},
+			},
+			"1.2.3.4",
+			[]string{},
+			[]net.IP{net.IPv4(1, 2, 3, 4)},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			normalizeCSR(c.csr)
+			test.AssertEquals(t, c.expectedCN, c.csr.Subject.CommonName)
+			test.AssertDeepEquals(t, c.expectedNames, c.csr.DNSNames)
+			test.AssertEquals(t, len(c.csr.IPAddresses), len(c.expectedIPs))
+			for i, ip := range c.expectedIPs {
+				test.Assert(t, ip.Equal(c.csr.IPAddresses[i]), fmt.Sprintf("expected IP %s, got %s", ip, c.csr.IPAddresses[i]))
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	names := Names(&x509.CertificateRequest{
+		DNSNames:    []string{"b.com", "A.com"},
+		IPAddresses: []net.IP{net.IPv4(1, 2, 3, 4), net.ParseIP("2600::1")},
+	})
+	test.AssertDeepEquals(t, names, []string{"1.2.3.4", "2600::1", "a.com", "b.com"})
+}
@@ -2,6 +2,7 @@ package errors
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/letsencrypt/boulder/identifier"
 )
@@ -27,6 +28,8 @@ const (
 	DNS
 	BadPublicKey
 	BadCSR
+	AutoRenewalCanceled
+	AutoRenewalCancellationInvalid
 )
 
 // BoulderError represents internal Boulder errors
@@ -34,6 +37,9 @@ type BoulderError struct {
 	Type      ErrorType
 	Detail    string
 	SubErrors []SubBoulderError
+	// RetryAfter is how long the client should wait before retrying the
+	// request, if known. It is only set for RateLimit errors.
+	RetryAfter time.Duration
 }
 
 // SubBoulderError represents sub-errors specific to an identifier that are
@@ -51,9 +57,21 @@ func (be *BoulderError) Error() string {
 // provided subErrs to the existing BoulderError.
 func (be *BoulderError) WithSubErrors(subErrs []SubBoulderError) *BoulderError {
 	return &BoulderError{
-		Type:      be.Type,
-		Detail:    be.Detail,
-		SubErrors: append(be.SubErrors, subErrs...),
+		Type:       be.Type,
+		Detail:     be.Detail,
+		SubErrors:  append(be.SubErrors, subErrs...),
+		RetryAfter: be.RetryAfter,
+	}
+}
+
+// WithRetryAfter returns a new BoulderError instance created by setting the
+// RetryAfter of the existing BoulderError.
+func (be *BoulderError) WithRetryAfter(retryAfter time.Duration) *BoulderError {
+	return &BoulderError{
+		Type:       be.Type,
+		Detail:     be.Detail,
+		SubErrors:  be.SubErrors,
+		RetryAfter: retryAfter,
 	}
 }
 
@@ -140,3 +158,11 @@ func BadPublicKeyError(msg string, args ...interface{}) error {
 func BadCSRError(msg string, args ...interface{}) error {
 	return New(BadCSR, msg, args...)
 }
+
+func AutoRenewalCanceledError(msg string, args ...interface{}) error {
+	return New(AutoRenewalCanceled, msg, args...)
+}
+
+func AutoRenewalCancellationInvalidError(msg string, args ...interface{}) error {
+	return New(AutoRenewalCancellationInvalid, msg, args...)
+}
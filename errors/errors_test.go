@@ -2,6 +2,7 @@ package errors
 
 import (
 	"testing"
+	"time"
 
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/test"
@@ -48,3 +49,22 @@ func TestWithSubErrors(t *testing.T) {
 	outResult = outResult.WithSubErrors([]SubBoulderError{anotherSubErr})
 	test.AssertDeepEquals(t, outResult.SubErrors, append(subErrs, anotherSubErr))
 }
+
+// TestWithRetryAfter tests that a boulder error can be created by setting the
+// RetryAfter of an existing boulder error
+func TestWithRetryAfter(t *testing.T) {
+	topErr := RateLimitError("slow down").(*BoulderError).WithSubErrors([]SubBoulderError{
+		{
+			Identifier:   identifier.DNSIdentifier("example.com"),
+			BoulderError: RateLimitError("everyone uses this example domain").(*BoulderError),
+		},
+	})
+
+	outResult := topErr.WithRetryAfter(time.Hour)
+	test.AssertNotEquals(t, topErr, outResult)
+	test.AssertEquals(t, topErr.RetryAfter, time.Duration(0))
+	test.AssertEquals(t, outResult.RetryAfter, time.Hour)
+	test.AssertDeepEquals(t, outResult.SubErrors, topErr.SubErrors)
+	// Adding suberrors keeps the RetryAfter
+	test.AssertEquals(t, outResult.WithSubErrors(nil).RetryAfter, time.Hour)
+}
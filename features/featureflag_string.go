@@ -31,11 +31,20 @@ func _() {
 	_ = x[RestrictRSAKeySizes-20]
 	_ = x[FasterNewOrdersRateLimit-21]
 	_ = x[NonCFSSLSigner-22]
+	_ = x[AsyncFinalize-23]
+	_ = x[TokenBucketRateLimits-24]
+	_ = x[StoreValidationAttempts-25]
+	_ = x[EnforceAccountScopes-26]
+	_ = x[EnforceMultiCAA-27]
+	_ = x[StoreAuthzRetries-28]
+	_ = x[StoreExternalAccountID-29]
+	_ = x[StoreCertificateProfileName-30]
+	_ = x[StoreAuthzAttemptedAt-31]
 }
 
-const _FeatureFlag_name = "unusedWriteIssuedNamesPrecertHeadNonceStatusOKRemoveWFE2AccountIDCheckRenewalFirstParallelCheckFailedValidationDeleteUnusedChallengesBlockedKeyTableStoreKeyHashesCAAValidationMethodsCAAAccountURIEnforceMultiVAMultiVAFullResultsMandatoryPOSTAsGETAllowV1RegistrationV1DisableNewValidationsPrecertificateRevocationStripDefaultSchemePortStoreIssuerInfoStoreRevokerInfoRestrictRSAKeySizesFasterNewOrdersRateLimitNonCFSSLSigner"
+const _FeatureFlag_name = "unusedWriteIssuedNamesPrecertHeadNonceStatusOKRemoveWFE2AccountIDCheckRenewalFirstParallelCheckFailedValidationDeleteUnusedChallengesBlockedKeyTableStoreKeyHashesCAAValidationMethodsCAAAccountURIEnforceMultiVAMultiVAFullResultsMandatoryPOSTAsGETAllowV1RegistrationV1DisableNewValidationsPrecertificateRevocationStripDefaultSchemePortStoreIssuerInfoStoreRevokerInfoRestrictRSAKeySizesFasterNewOrdersRateLimitNonCFSSLSignerAsyncFinalizeTokenBucketRateLimitsStoreValidationAttemptsEnforceAccountScopesEnforceMultiCAAStoreAuthzRetriesStoreExternalAccountIDStoreCertificateProfileNameStoreAuthzAttemptedAt"
 
-var _FeatureFlag_index = [...]uint16{0, 6, 29, 46, 65, 82, 111, 133, 148, 162, 182, 195, 209, 227, 245, 264, 287, 311, 333, 348, 364, 383, 407, 421}
+var _FeatureFlag_index = [...]uint16{0, 6, 29, 46, 65, 82, 111, 133, 148, 162, 182, 195, 209, 227, 245, 264, 287, 311, 333, 348, 364, 383, 407, 421, 434, 455, 478, 498, 513, 530, 552, 579, 600}
 
 func (i FeatureFlag) String() string {
 	if i < 0 || i >= FeatureFlag(len(_FeatureFlag_index)-1) {
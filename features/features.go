@@ -61,6 +61,40 @@ const (
 	// NonCFSSLSigner enables usage of our own certificate signer instead of the
 	// CFSSL signer.
 	NonCFSSLSigner
+	// AsyncFinalize causes the RA to return from FinalizeOrder as soon as the
+	// order is processing, and to issue the certificate in the background.
+	AsyncFinalize
+	// TokenBucketRateLimits causes the RA to enforce the certificates per name,
+	// certificates per FQDN set and new orders per account rate limits with
+	// token buckets kept in a key-value store, instead of by counting rows in
+	// the database.
+	TokenBucketRateLimits
+	// StoreValidationAttempts causes the RA to store what the VA saw during
+	// each validation attempt in the validationAttempts table.
+	StoreValidationAttempts
+	// EnforceAccountScopes causes the RA to reject new orders and validations
+	// for identifiers outside of the allowed suffixes of accounts that have
+	// them.
+	EnforceAccountScopes
+	// EnforceMultiCAA causes the VA to perform CAA checks from each of its
+	// remote VAs as well, and to block on their results in order to make a
+	// valid/invalid decision.
+	EnforceMultiCAA
+	// StoreAuthzRetries enables storage of the failed attempts of pending
+	// authorizations that may be retried, and when they may next be retried,
+	// in the authz2 table.
+	StoreAuthzRetries
+	// StoreExternalAccountID enables storage of the key ID of the external
+	// account binding used to create a registration in the registrations
+	// table.
+	StoreExternalAccountID
+	// StoreCertificateProfileName enables storage of the name of the
+	// certificate profile selected for an order in the orders table.
+	StoreCertificateProfileName
+	// StoreAuthzAttemptedAt enables storage of when an authorization was
+	// finalized in the authz2 table, so the age of valid authorizations can be
+	// checked before they are reused.
+	StoreAuthzAttemptedAt
 )
 
 // List of features and their default value, protected by fMu
@@ -88,6 +122,15 @@ var features = map[FeatureFlag]bool{
 	FasterNewOrdersRateLimit:      false,
 	BlockedKeyTable:               false,
 	NonCFSSLSigner:                false,
+	AsyncFinalize:                 false,
+	TokenBucketRateLimits:         false,
+	StoreValidationAttempts:       false,
+	EnforceAccountScopes:          false,
+	EnforceMultiCAA:               false,
+	StoreAuthzRetries:             false,
+	StoreExternalAccountID:        false,
+	StoreCertificateProfileName:   false,
+	StoreAuthzAttemptedAt:         false,
 }
 
 var fMu = new(sync.RWMutex)
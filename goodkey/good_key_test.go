@@ -3,9 +3,12 @@ package goodkey
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"math/big"
@@ -14,12 +17,15 @@ import (
 	"github.com/letsencrypt/boulder/features"
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
+
+	xed25519 "golang.org/x/crypto/ed25519"
 )
 
 var testingPolicy = &KeyPolicy{
 	AllowRSA:           true,
 	AllowECDSANISTP256: true,
 	AllowECDSANISTP384: true,
+	AllowEd25519:       true,
 }
 
 func TestUnknownKeyType(t *testing.T) {
@@ -279,6 +285,52 @@ func TestDBBlacklist(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "public key is forbidden")
 }
 
+func TestEd25519GoodKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "Error generating key")
+	test.AssertNotError(t, testingPolicy.GoodKey(context.Background(), pub), "Should have accepted good key")
+	// Keys parsed from a JWK by go-jose use the golang.org/x/crypto type.
+	test.AssertNotError(t, testingPolicy.GoodKey(context.Background(), xed25519.PublicKey(pub)), "Should have accepted good x/crypto key")
+
+	err = testingPolicy.GoodKey(context.Background(), ed25519.PublicKey(pub[:31]))
+	test.AssertError(t, err, "Should have rejected truncated key")
+	test.AssertEquals(t, err.Error(), "Ed25519 key must be 32 bytes, not 31")
+
+	noEd25519 := *testingPolicy
+	noEd25519.AllowEd25519 = false
+	err = noEd25519.GoodKey(context.Background(), pub)
+	test.AssertError(t, err, "Should have rejected Ed25519 key")
+	test.AssertEquals(t, err.Error(), "Ed25519 keys are not allowed")
+}
+
+func TestEd25519DBBlocklist(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	test.AssertNotError(t, err, "Error generating key")
+	spki, err := x509.MarshalPKIXPublicKey(pub)
+	test.AssertNotError(t, err, "Error marshaling key")
+	expected := sha256.Sum256(spki)
+
+	exists := false
+	var gotHash []byte
+	testCheck := func(_ context.Context, req *sapb.KeyBlockedRequest) (*sapb.Exists, error) {
+		gotHash = req.KeyHash
+		return &sapb.Exists{Exists: &exists}, nil
+	}
+	policy, err := NewKeyPolicy("", "", testCheck)
+	test.AssertNotError(t, err, "NewKeyPolicy failed")
+
+	err = policy.GoodKey(context.Background(), xed25519.PublicKey(pub))
+	test.AssertError(t, err, "GoodKey didn't fail with an Ed25519 key")
+	test.AssertEquals(t, err.Error(), "Ed25519 keys are not allowed")
+
+	exists = true
+	policy.AllowEd25519 = true
+	err = policy.GoodKey(context.Background(), xed25519.PublicKey(pub))
+	test.AssertError(t, err, "GoodKey didn't fail with a blocked key")
+	test.AssertEquals(t, err.Error(), "public key is forbidden")
+	test.AssertDeepEquals(t, gotHash, expected[:])
+}
+
 func TestRSAStrangeSize(t *testing.T) {
 	err := features.Set(map[string]bool{"RestrictRSAKeySizes": true})
 	test.AssertNotError(t, err, "failed to set features")
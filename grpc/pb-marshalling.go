@@ -72,14 +72,27 @@ func ChallengeToPB(challenge core.Challenge) (*corepb.Challenge, error) {
 			return nil, err
 		}
 	}
-	return &corepb.Challenge{
+	pb := &corepb.Challenge{
 		Type:              &ctype,
 		Status:            &st,
 		Token:             &challenge.Token,
 		KeyAuthorization:  &challenge.ProvidedKeyAuthorization,
 		Error:             prob,
 		Validationrecords: recordAry,
-	}, nil
+	}
+	if challenge.Attempts != 0 {
+		attempts := int64(challenge.Attempts)
+		pb.Attempts = &attempts
+	}
+	if challenge.RetryAfter != nil {
+		retryAfter := challenge.RetryAfter.UTC().UnixNano()
+		pb.RetryAfter = &retryAfter
+	}
+	if challenge.Validated != nil {
+		validated := challenge.Validated.UTC().UnixNano()
+		pb.Validated = &validated
+	}
+	return pb, nil
 }
 
 func PBToChallenge(in *corepb.Challenge) (challenge core.Challenge, err error) {
@@ -113,6 +126,17 @@ func PBToChallenge(in *corepb.Challenge) (challenge core.Challenge, err error) {
 	if in.KeyAuthorization != nil {
 		ch.ProvidedKeyAuthorization = *in.KeyAuthorization
 	}
+	if in.Attempts != nil {
+		ch.Attempts = int(*in.Attempts)
+	}
+	if in.RetryAfter != nil {
+		retryAfter := time.Unix(0, *in.RetryAfter).UTC()
+		ch.RetryAfter = &retryAfter
+	}
+	if in.Validated != nil {
+		validated := time.Unix(0, *in.Validated).UTC()
+		ch.Validated = &validated
+	}
 	return ch, nil
 }
 
@@ -130,14 +154,18 @@ func ValidationRecordToPB(record core.ValidationRecord) (*corepb.ValidationRecor
 	if err != nil {
 		return nil, err
 	}
-	return &corepb.ValidationRecord{
+	pb := &corepb.ValidationRecord{
 		Hostname:          &record.Hostname,
 		Port:              &record.Port,
 		AddressesResolved: addrs,
 		AddressUsed:       addrUsed,
 		Url:               &record.URL,
 		AddressesTried:    addrsTried,
-	}, nil
+	}
+	if record.DNSSEC != "" {
+		pb.Dnssec = &record.DNSSEC
+	}
+	return pb, nil
 }
 
 func PBToValidationRecord(in *corepb.ValidationRecord) (record core.ValidationRecord, err error) {
@@ -160,6 +188,10 @@ func PBToValidationRecord(in *corepb.ValidationRecord) (record core.ValidationRe
 	if err != nil {
 		return
 	}
+	var dnssec string
+	if in.Dnssec != nil {
+		dnssec = *in.Dnssec
+	}
 	return core.ValidationRecord{
 		Hostname:          *in.Hostname,
 		Port:              *in.Port,
@@ -167,6 +199,7 @@ func PBToValidationRecord(in *corepb.ValidationRecord) (record core.ValidationRe
 		AddressUsed:       addrUsed,
 		URL:               *in.Url,
 		AddressesTried:    addrsTried,
+		DNSSEC:            dnssec,
 	}, nil
 }
 
@@ -228,14 +261,15 @@ func registrationToPB(reg core.Registration) (*corepb.Registration, error) {
 		contacts = *reg.Contact
 	}
 	return &corepb.Registration{
-		Id:              &reg.ID,
-		Key:             keyBytes,
-		Contact:         contacts,
-		ContactsPresent: &contactsPresent,
-		Agreement:       &reg.Agreement,
-		InitialIP:       ipBytes,
-		CreatedAt:       &createdAt,
-		Status:          &status,
+		Id:                &reg.ID,
+		Key:               keyBytes,
+		Contact:           contacts,
+		ContactsPresent:   &contactsPresent,
+		Agreement:         &reg.Agreement,
+		InitialIP:         ipBytes,
+		CreatedAt:         &createdAt,
+		Status:            &status,
+		ExternalAccountID: &reg.ExternalAccountID,
 	}, nil
 }
 
@@ -265,13 +299,14 @@ func pbToRegistration(pb *corepb.Registration) (core.Registration, error) {
 		}
 	}
 	return core.Registration{
-		ID:        *pb.Id,
-		Key:       &key,
-		Contact:   contacts,
-		Agreement: *pb.Agreement,
-		InitialIP: initialIP,
-		CreatedAt: time.Unix(0, *pb.CreatedAt),
-		Status:    core.AcmeStatus(*pb.Status),
+		ID:                *pb.Id,
+		Key:               &key,
+		Contact:           contacts,
+		Agreement:         *pb.Agreement,
+		InitialIP:         initialIP,
+		CreatedAt:         time.Unix(0, *pb.CreatedAt),
+		Status:            core.AcmeStatus(*pb.Status),
+		ExternalAccountID: pb.GetExternalAccountID(),
 	}, nil
 }
 
@@ -289,9 +324,11 @@ func AuthzToPB(authz core.Authorization) (*corepb.Authorization, error) {
 	if authz.Expires != nil {
 		expires = authz.Expires.UTC().UnixNano()
 	}
+	identType := string(authz.Identifier.Type)
 	return &corepb.Authorization{
 		Id:             &authz.ID,
 		Identifier:     &authz.Identifier.Value,
+		IdentifierType: &identType,
 		RegistrationID: &authz.RegistrationID,
 		Status:         &status,
 		Expires:        &expires,
@@ -309,8 +346,14 @@ func PBToAuthz(pb *corepb.Authorization) (core.Authorization, error) {
 		challs[i] = chall
 	}
 	expires := time.Unix(0, *pb.Expires).UTC()
+	// Authorizations from before IP identifiers were supported don't carry an
+	// identifier type and are always DNS identifiers.
+	identType := identifier.DNS
+	if pb.GetIdentifierType() != "" {
+		identType = identifier.IdentifierType(pb.GetIdentifierType())
+	}
 	authz := core.Authorization{
-		Identifier:     identifier.ACMEIdentifier{Type: identifier.DNS, Value: *pb.Identifier},
+		Identifier:     identifier.ACMEIdentifier{Type: identType, Value: *pb.Identifier},
 		RegistrationID: *pb.RegistrationID,
 		Status:         core.AcmeStatus(*pb.Status),
 		Expires:        &expires,
@@ -419,3 +462,25 @@ func PBToAuthzMap(pb *sapb.Authorizations) (map[string]*core.Authorization, erro
 	}
 	return m, nil
 }
+
+// countToPB converts a count of events towards a rate limit, the oldest of
+// which happened at earliest, to a protobuf Count. The zero time, for when
+// nothing was counted, is sent as 0.
+func countToPB(count int64, earliest time.Time) *sapb.Count {
+	var earliestNanos int64
+	if !earliest.IsZero() {
+		earliestNanos = earliest.UnixNano()
+	}
+	return &sapb.Count{Count: &count, Earliest: &earliestNanos}
+}
+
+// PBToEarliest converts the Unix nanoseconds of the oldest event counted
+// towards a rate limit, as sent in a Count or CountByNames_MapElement, to a
+// time.Time. 0, for when nothing was counted or the SA didn't say, gives the
+// zero time.
+func PBToEarliest(earliest int64) time.Time {
+	if earliest == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, earliest)
+}
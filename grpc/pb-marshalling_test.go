@@ -88,6 +88,15 @@ func TestChallenge(t *testing.T) {
 	test.AssertNotError(t, err, "PBToChallenge failed")
 	test.AssertDeepEquals(t, recon, chall)
 
+	retryAfter := time.Date(2020, 10, 18, 1, 2, 3, 0, time.UTC)
+	chall.Attempts = 2
+	chall.RetryAfter = &retryAfter
+	pb, err = ChallengeToPB(chall)
+	test.AssertNotError(t, err, "ChallengeToPB failed")
+	recon, err = PBToChallenge(pb)
+	test.AssertNotError(t, err, "PBToChallenge failed")
+	test.AssertDeepEquals(t, recon, chall)
+
 	_, err = PBToChallenge(nil)
 	test.AssertError(t, err, "PBToChallenge did not fail")
 	test.AssertEquals(t, err, ErrMissingParameters)
@@ -105,6 +114,7 @@ func TestValidationRecord(t *testing.T) {
 		AddressUsed:       ip,
 		URL:               "url",
 		AddressesTried:    []net.IP{ip},
+		DNSSEC:            "secure",
 	}
 
 	pb, err := ValidationRecordToPB(vr)
@@ -219,6 +229,14 @@ func TestAuthz(t *testing.T) {
 	outAuthz, err := PBToAuthz(pbAuthz)
 	test.AssertNotError(t, err, "pbToAuthz failed")
 	test.AssertDeepEquals(t, inAuthz, outAuthz)
+
+	inAuthz.Identifier.Type = "ip"
+	inAuthz.Identifier.Value = "64.112.117.1"
+	pbAuthz, err = AuthzToPB(inAuthz)
+	test.AssertNotError(t, err, "AuthzToPB failed")
+	outAuthz, err = PBToAuthz(pbAuthz)
+	test.AssertNotError(t, err, "pbToAuthz failed")
+	test.AssertDeepEquals(t, inAuthz, outAuthz)
 }
 
 func TestCert(t *testing.T) {
@@ -186,6 +186,93 @@ func (ras *RegistrationAuthorityClientWrapper) FinalizeOrder(ctx context.Context
 	return resp, nil
 }
 
+func (ras *RegistrationAuthorityClientWrapper) NewPreAuthorization(ctx context.Context, request *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	resp, err := ras.inner.NewPreAuthorization(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || !authorizationValid(resp) {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (ras *RegistrationAuthorityClientWrapper) CancelAutoRenewal(ctx context.Context, request *rapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	resp, err := ras.inner.CancelAutoRenewal(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (ras *RegistrationAuthorityClientWrapper) RateLimitStatus(ctx context.Context, request *rapb.RateLimitStatusRequest) (*rapb.RateLimitStatusResponse, error) {
+	resp, err := ras.inner.RateLimitStatus(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	for _, limit := range resp.Limits {
+		if limit == nil || limit.Limit == nil || limit.Usage == nil || limit.Threshold == nil || limit.ResetAt == nil {
+			return nil, errIncompleteResponse
+		}
+	}
+	return resp, nil
+}
+
+func (ras *RegistrationAuthorityClientWrapper) AddRateLimitOverride(ctx context.Context, request *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	resp, err := ras.inner.AddRateLimitOverride(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if !rateLimitOverrideValid(resp) {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (ras *RegistrationAuthorityClientWrapper) GetRateLimitOverrides(ctx context.Context, request *rapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	resp, err := ras.inner.GetRateLimitOverrides(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	for _, o := range resp.Overrides {
+		if !rateLimitOverrideValid(o) {
+			return nil, errIncompleteResponse
+		}
+	}
+	return resp, nil
+}
+
+func (ras *RegistrationAuthorityClientWrapper) ExpireRateLimitOverride(ctx context.Context, request *rapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	resp, err := ras.inner.ExpireRateLimitOverride(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (ras *RegistrationAuthorityClientWrapper) ReviewHeldOrder(ctx context.Context, request *rapb.ReviewHeldOrderRequest) (*corepb.Order, error) {
+	resp, err := ras.inner.ReviewHeldOrder(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || !orderValid(resp) {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
 // RegistrationAuthorityServerWrapper is the gRPC version of a core.RegistrationAuthority server
 type RegistrationAuthorityServerWrapper struct {
 	inner core.RegistrationAuthority
@@ -342,3 +429,52 @@ func (ras *RegistrationAuthorityServerWrapper) FinalizeOrder(ctx context.Context
 
 	return ras.inner.FinalizeOrder(ctx, request)
 }
+
+func (ras *RegistrationAuthorityServerWrapper) NewPreAuthorization(ctx context.Context, request *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	if request == nil || request.RegistrationID == nil || request.IdentifierType == nil || request.IdentifierValue == nil {
+		return nil, errIncompleteRequest
+	}
+	return ras.inner.NewPreAuthorization(ctx, request)
+}
+
+func (ras *RegistrationAuthorityServerWrapper) CancelAutoRenewal(ctx context.Context, request *rapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	if request == nil || request.OrderID == nil || request.RegistrationID == nil {
+		return nil, errIncompleteRequest
+	}
+	return ras.inner.CancelAutoRenewal(ctx, request)
+}
+
+func (ras *RegistrationAuthorityServerWrapper) RateLimitStatus(ctx context.Context, request *rapb.RateLimitStatusRequest) (*rapb.RateLimitStatusResponse, error) {
+	if request == nil || request.RegistrationID == nil {
+		return nil, errIncompleteRequest
+	}
+	return ras.inner.RateLimitStatus(ctx, request)
+}
+
+func (ras *RegistrationAuthorityServerWrapper) AddRateLimitOverride(ctx context.Context, request *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	if request == nil || request.Limit == nil || request.Threshold == nil || request.Owner == nil || request.Justification == nil || request.Expires == nil {
+		return nil, errIncompleteRequest
+	}
+	return ras.inner.AddRateLimitOverride(ctx, request)
+}
+
+func (ras *RegistrationAuthorityServerWrapper) GetRateLimitOverrides(ctx context.Context, request *rapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	if request == nil {
+		return nil, errIncompleteRequest
+	}
+	return ras.inner.GetRateLimitOverrides(ctx, request)
+}
+
+func (ras *RegistrationAuthorityServerWrapper) ExpireRateLimitOverride(ctx context.Context, request *rapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	if request == nil || request.Id == nil || request.Actor == nil || request.Justification == nil {
+		return nil, errIncompleteRequest
+	}
+	return ras.inner.ExpireRateLimitOverride(ctx, request)
+}
+
+func (ras *RegistrationAuthorityServerWrapper) ReviewHeldOrder(ctx context.Context, request *rapb.ReviewHeldOrderRequest) (*corepb.Order, error) {
+	if request == nil || request.OrderID == nil || request.Approve == nil || request.Actor == nil || request.Justification == nil {
+		return nil, errIncompleteRequest
+	}
+	return ras.inner.ReviewHeldOrder(ctx, request)
+}
@@ -113,7 +113,7 @@ func (sac StorageAuthorityClientWrapper) CountCertificatesByNames(ctx context.Co
 	return response.CountByNames, nil
 }
 
-func (sac StorageAuthorityClientWrapper) CountRegistrationsByIP(ctx context.Context, ip net.IP, earliest, latest time.Time) (int, error) {
+func (sac StorageAuthorityClientWrapper) CountRegistrationsByIP(ctx context.Context, ip net.IP, earliest, latest time.Time) (int, time.Time, error) {
 	earliestNano := earliest.UnixNano()
 	latestNano := latest.UnixNano()
 
@@ -125,17 +125,17 @@ func (sac StorageAuthorityClientWrapper) CountRegistrationsByIP(ctx context.Cont
 		Ip: ip,
 	})
 	if err != nil {
-		return 0, err
+		return 0, time.Time{}, err
 	}
 
 	if response == nil || response.Count == nil {
-		return 0, errIncompleteResponse
+		return 0, time.Time{}, errIncompleteResponse
 	}
 
-	return int(*response.Count), nil
+	return int(*response.Count), PBToEarliest(response.GetEarliest()), nil
 }
 
-func (sac StorageAuthorityClientWrapper) CountRegistrationsByIPRange(ctx context.Context, ip net.IP, earliest, latest time.Time) (int, error) {
+func (sac StorageAuthorityClientWrapper) CountRegistrationsByIPRange(ctx context.Context, ip net.IP, earliest, latest time.Time) (int, time.Time, error) {
 	earliestNano := earliest.UnixNano()
 	latestNano := latest.UnixNano()
 
@@ -147,17 +147,17 @@ func (sac StorageAuthorityClientWrapper) CountRegistrationsByIPRange(ctx context
 		Ip: ip,
 	})
 	if err != nil {
-		return 0, err
+		return 0, time.Time{}, err
 	}
 
 	if response == nil || response.Count == nil {
-		return 0, errIncompleteResponse
+		return 0, time.Time{}, errIncompleteResponse
 	}
 
-	return int(*response.Count), nil
+	return int(*response.Count), PBToEarliest(response.GetEarliest()), nil
 }
 
-func (sac StorageAuthorityClientWrapper) CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, error) {
+func (sac StorageAuthorityClientWrapper) CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, time.Time, error) {
 	earliestNano := earliest.UnixNano()
 	latestNano := latest.UnixNano()
 
@@ -169,17 +169,17 @@ func (sac StorageAuthorityClientWrapper) CountOrders(ctx context.Context, acctID
 		},
 	})
 	if err != nil {
-		return 0, err
+		return 0, time.Time{}, err
 	}
 
 	if response == nil || response.Count == nil {
-		return 0, errIncompleteResponse
+		return 0, time.Time{}, errIncompleteResponse
 	}
 
-	return int(*response.Count), nil
+	return int(*response.Count), PBToEarliest(response.GetEarliest()), nil
 }
 
-func (sac StorageAuthorityClientWrapper) CountFQDNSets(ctx context.Context, window time.Duration, domains []string) (int64, error) {
+func (sac StorageAuthorityClientWrapper) CountFQDNSets(ctx context.Context, window time.Duration, domains []string) (int64, time.Time, error) {
 	windowNanos := window.Nanoseconds()
 
 	response, err := sac.inner.CountFQDNSets(ctx, &sapb.CountFQDNSetsRequest{
@@ -187,14 +187,14 @@ func (sac StorageAuthorityClientWrapper) CountFQDNSets(ctx context.Context, wind
 		Domains: domains,
 	})
 	if err != nil {
-		return 0, err
+		return 0, time.Time{}, err
 	}
 
 	if response == nil || response.Count == nil {
-		return 0, errIncompleteResponse
+		return 0, time.Time{}, errIncompleteResponse
 	}
 
-	return *response.Count, nil
+	return *response.Count, PBToEarliest(response.GetEarliest()), nil
 }
 
 func (sac StorageAuthorityClientWrapper) PreviousCertificateExists(
@@ -418,6 +418,11 @@ func (sas StorageAuthorityClientWrapper) FinalizeAuthorization2(ctx context.Cont
 	return err
 }
 
+func (sas StorageAuthorityClientWrapper) RecordFailedValidation2(ctx context.Context, req *sapb.RecordFailedValidationRequest) error {
+	_, err := sas.inner.RecordFailedValidation2(ctx, req)
+	return err
+}
+
 func (sas StorageAuthorityClientWrapper) GetPendingAuthorization2(ctx context.Context, req *sapb.GetPendingAuthorizationRequest) (*corepb.Authorization, error) {
 	authz, err := sas.inner.GetPendingAuthorization2(ctx, req)
 	if err != nil {
@@ -488,6 +493,247 @@ func (sac StorageAuthorityClientWrapper) KeyBlocked(ctx context.Context, req *sa
 	return sac.inner.KeyBlocked(ctx, req)
 }
 
+func (sac StorageAuthorityClientWrapper) GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	key, err := sac.inner.GetExternalAccountKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil || key.KeyID == nil || key.HmacKey == nil || key.Created == nil || key.Revoked == nil {
+		return nil, errIncompleteResponse
+	}
+	return key, nil
+}
+
+func (sac StorageAuthorityClientWrapper) AddExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKey) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.AddExternalAccountKey(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.RevokeExternalAccountKey(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) ReplacementOrderExists(ctx context.Context, req *sapb.Serial) (*sapb.Exists, error) {
+	exists, err := sac.inner.ReplacementOrderExists(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if exists == nil || exists.Exists == nil {
+		return nil, errIncompleteResponse
+	}
+	return exists, nil
+}
+
+func (sac StorageAuthorityClientWrapper) GetOrdersForAccount(ctx context.Context, req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	resp, err := sac.inner.GetOrdersForAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// An account with no orders results in an empty (nil) slice of IDs, so only
+	// the response itself is checked.
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) GetStaleProcessingOrders(ctx context.Context, req *sapb.GetStaleProcessingOrdersRequest) (*sapb.OrderIDs, error) {
+	resp, err := sac.inner.GetStaleProcessingOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// There are usually no stale processing orders, which results in an empty
+	// (nil) slice of IDs, so only the response itself is checked.
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) GetDueAutoRenewals(ctx context.Context, req *sapb.GetDueAutoRenewalsRequest) (*sapb.DueAutoRenewals, error) {
+	resp, err := sac.inner.GetDueAutoRenewals(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	for _, r := range resp.Renewals {
+		if r == nil || r.OrderID == nil || r.Csr == nil || r.NextIssuance == nil {
+			return nil, errIncompleteResponse
+		}
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) SetAutoRenewalCertificate(ctx context.Context, req *sapb.SetAutoRenewalCertificateRequest) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.SetAutoRenewalCertificate(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) ClaimAutoRenewal(ctx context.Context, req *sapb.ClaimAutoRenewalRequest) (*sapb.Exists, error) {
+	resp, err := sac.inner.ClaimAutoRenewal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Exists == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) CancelAutoRenewal(ctx context.Context, req *sapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.CancelAutoRenewal(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) GetRateLimitOverrides(ctx context.Context, req *sapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	resp, err := sac.inner.GetRateLimitOverrides(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	for _, o := range resp.Overrides {
+		if !rateLimitOverrideValid(o) {
+			return nil, errIncompleteResponse
+		}
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) AddRateLimitOverride(ctx context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	resp, err := sac.inner.AddRateLimitOverride(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !rateLimitOverrideValid(resp) {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) ExpireRateLimitOverride(ctx context.Context, req *sapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.ExpireRateLimitOverride(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) GetValidationAttempts(ctx context.Context, req *sapb.GetValidationAttemptsRequest) (*corepb.ValidationAttempts, error) {
+	resp, err := sac.inner.GetValidationAttempts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	for _, a := range resp.Attempts {
+		if a.Id == nil || a.AuthzID == nil || a.RegistrationID == nil || a.Identifier == nil ||
+			a.ChallengeType == nil || a.AttemptedAt == nil || a.Status == nil {
+			return nil, errIncompleteResponse
+		}
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) AddValidationAttempt(ctx context.Context, req *corepb.ValidationAttempt) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.AddValidationAttempt(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) GetHeldOrders(ctx context.Context, req *sapb.GetHeldOrdersRequest) (*sapb.HeldOrders, error) {
+	resp, err := sac.inner.GetHeldOrders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errIncompleteResponse
+	}
+	for _, o := range resp.Orders {
+		if !heldOrderValid(o) {
+			return nil, errIncompleteResponse
+		}
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) DeleteProcessingOrder(ctx context.Context, req *sapb.OrderRequest) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.DeleteProcessingOrder(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) HoldOrder(ctx context.Context, req *sapb.HeldOrder) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.HoldOrder(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) ReleaseHeldOrder(ctx context.Context, req *sapb.ReleaseHeldOrderRequest) (*sapb.HeldOrder, error) {
+	resp, err := sac.inner.ReleaseHeldOrder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !heldOrderValid(resp) || len(resp.Csr) == 0 {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) GetAccountScope(ctx context.Context, req *sapb.RegistrationID) (*sapb.AccountScope, error) {
+	resp, err := sac.inner.GetAccountScope(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.RegistrationID == nil {
+		return nil, errIncompleteResponse
+	}
+	return resp, nil
+}
+
+func (sac StorageAuthorityClientWrapper) AddAccountScope(ctx context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.AddAccountScope(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) RemoveAccountScope(ctx context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.RemoveAccountScope(ctx, req)
+}
+
+func (sac StorageAuthorityClientWrapper) ClearAccountScope(ctx context.Context, req *sapb.ClearAccountScopeRequest) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.ClearAccountScope(ctx, req)
+}
+
+// heldOrderValid returns whether o has every field a held order has, other
+// than the CSR which GetHeldOrders doesn't return.
+func heldOrderValid(o *sapb.HeldOrder) bool {
+	return o != nil && o.OrderID != nil && o.RegistrationID != nil && o.HeldAt != nil
+}
+
+// rateLimitOverrideValid returns whether o has every field a stored rate
+// limit override has.
+func rateLimitOverrideValid(o *corepb.RateLimitOverride) bool {
+	return o != nil && o.Id != nil && o.Limit != nil && o.Key != nil && o.RegistrationID != nil &&
+		o.Threshold != nil && o.Owner != nil && o.Justification != nil && o.Created != nil && o.Expires != nil
+}
+
+func (sac StorageAuthorityClientWrapper) GetRenewalInfoOverride(ctx context.Context, req *sapb.Serial) (*sapb.RenewalInfoOverride, error) {
+	override, err := sac.inner.GetRenewalInfoOverride(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if override == nil || override.Serial == nil || override.WindowStart == nil || override.WindowEnd == nil {
+		return nil, errIncompleteResponse
+	}
+	return override, nil
+}
+
+func (sac StorageAuthorityClientWrapper) AddRenewalInfoOverride(ctx context.Context, req *sapb.RenewalInfoOverride) (*corepb.Empty, error) {
+	// All return checking is done at the call site
+	return sac.inner.AddRenewalInfoOverride(ctx, req)
+}
+
 // StorageAuthorityServerWrapper is the gRPC version of a core.ServerAuthority server
 type StorageAuthorityServerWrapper struct {
 	// TODO(#3119): Don't use core.StorageAuthority
@@ -582,7 +828,7 @@ func (sas StorageAuthorityServerWrapper) CountRegistrationsByIP(ctx context.Cont
 		return nil, errIncompleteRequest
 	}
 
-	count, err := sas.inner.CountRegistrationsByIP(
+	count, earliest, err := sas.inner.CountRegistrationsByIP(
 		ctx,
 		net.IP(request.Ip),
 		time.Unix(0, *request.Range.Earliest),
@@ -591,8 +837,7 @@ func (sas StorageAuthorityServerWrapper) CountRegistrationsByIP(ctx context.Cont
 		return nil, err
 	}
 
-	castedCount := int64(count)
-	return &sapb.Count{Count: &castedCount}, nil
+	return countToPB(int64(count), earliest), nil
 }
 
 func (sas StorageAuthorityServerWrapper) CountRegistrationsByIPRange(ctx context.Context, request *sapb.CountRegistrationsByIPRequest) (*sapb.Count, error) {
@@ -600,7 +845,7 @@ func (sas StorageAuthorityServerWrapper) CountRegistrationsByIPRange(ctx context
 		return nil, errIncompleteRequest
 	}
 
-	count, err := sas.inner.CountRegistrationsByIPRange(
+	count, earliest, err := sas.inner.CountRegistrationsByIPRange(
 		ctx,
 		net.IP(request.Ip),
 		time.Unix(0, *request.Range.Earliest),
@@ -609,8 +854,7 @@ func (sas StorageAuthorityServerWrapper) CountRegistrationsByIPRange(ctx context
 		return nil, err
 	}
 
-	castedCount := int64(count)
-	return &sapb.Count{Count: &castedCount}, nil
+	return countToPB(int64(count), earliest), nil
 }
 
 func (sas StorageAuthorityServerWrapper) CountOrders(ctx context.Context, request *sapb.CountOrdersRequest) (*sapb.Count, error) {
@@ -618,7 +862,7 @@ func (sas StorageAuthorityServerWrapper) CountOrders(ctx context.Context, reques
 		return nil, errIncompleteRequest
 	}
 
-	count, err := sas.inner.CountOrders(ctx,
+	count, earliest, err := sas.inner.CountOrders(ctx,
 		*request.AccountID,
 		time.Unix(0, *request.Range.Earliest),
 		time.Unix(0, *request.Range.Latest),
@@ -627,8 +871,7 @@ func (sas StorageAuthorityServerWrapper) CountOrders(ctx context.Context, reques
 		return nil, err
 	}
 
-	castedCount := int64(count)
-	return &sapb.Count{Count: &castedCount}, nil
+	return countToPB(int64(count), earliest), nil
 }
 
 func (sas StorageAuthorityServerWrapper) CountFQDNSets(ctx context.Context, request *sapb.CountFQDNSetsRequest) (*sapb.Count, error) {
@@ -638,13 +881,12 @@ func (sas StorageAuthorityServerWrapper) CountFQDNSets(ctx context.Context, requ
 
 	window := time.Duration(*request.Window)
 
-	count, err := sas.inner.CountFQDNSets(ctx, window, request.Domains)
+	count, earliest, err := sas.inner.CountFQDNSets(ctx, window, request.Domains)
 	if err != nil {
 		return nil, err
 	}
 
-	castedCount := int64(count)
-	return &sapb.Count{Count: &castedCount}, nil
+	return countToPB(int64(count), earliest), nil
 }
 
 func (sas StorageAuthorityServerWrapper) FQDNSetExists(ctx context.Context, request *sapb.FQDNSetExistsRequest) (*sapb.Exists, error) {
@@ -833,6 +1075,14 @@ func (sas StorageAuthorityServerWrapper) FinalizeAuthorization2(ctx context.Cont
 	return &corepb.Empty{}, sas.inner.FinalizeAuthorization2(ctx, req)
 }
 
+func (sas StorageAuthorityServerWrapper) RecordFailedValidation2(ctx context.Context, req *sapb.RecordFailedValidationRequest) (*corepb.Empty, error) {
+	if req == nil || req.Id == nil || req.Attempted == nil || req.AttemptedAt == nil || req.ValidationError == nil || req.RetryAfter == nil {
+		return nil, errIncompleteRequest
+	}
+
+	return &corepb.Empty{}, sas.inner.RecordFailedValidation2(ctx, req)
+}
+
 func (sas StorageAuthorityServerWrapper) GetPendingAuthorization2(ctx context.Context, req *sapb.GetPendingAuthorizationRequest) (*corepb.Authorization, error) {
 	if req == nil || req.RegistrationID == nil || req.IdentifierValue == nil || req.ValidUntil == nil {
 		return nil, errIncompleteRequest
@@ -890,3 +1140,128 @@ func (sas StorageAuthorityServerWrapper) KeyBlocked(ctx context.Context, req *sa
 	// All request checking is done in the method
 	return sas.inner.KeyBlocked(ctx, req)
 }
+
+func (sas StorageAuthorityServerWrapper) GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	// All request checking is done in the method
+	return sas.inner.GetExternalAccountKey(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) AddExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKey) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.AddExternalAccountKey(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.RevokeExternalAccountKey(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) ReplacementOrderExists(ctx context.Context, req *sapb.Serial) (*sapb.Exists, error) {
+	// All request checking is done in the method
+	return sas.inner.ReplacementOrderExists(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetOrdersForAccount(ctx context.Context, req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	// All request checking is done in the method
+	return sas.inner.GetOrdersForAccount(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetStaleProcessingOrders(ctx context.Context, req *sapb.GetStaleProcessingOrdersRequest) (*sapb.OrderIDs, error) {
+	// All request checking is done in the method
+	return sas.inner.GetStaleProcessingOrders(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetDueAutoRenewals(ctx context.Context, req *sapb.GetDueAutoRenewalsRequest) (*sapb.DueAutoRenewals, error) {
+	// All request checking is done in the method
+	return sas.inner.GetDueAutoRenewals(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) SetAutoRenewalCertificate(ctx context.Context, req *sapb.SetAutoRenewalCertificateRequest) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.SetAutoRenewalCertificate(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) ClaimAutoRenewal(ctx context.Context, req *sapb.ClaimAutoRenewalRequest) (*sapb.Exists, error) {
+	// All request checking is done in the method
+	return sas.inner.ClaimAutoRenewal(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) CancelAutoRenewal(ctx context.Context, req *sapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.CancelAutoRenewal(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetRateLimitOverrides(ctx context.Context, req *sapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	// All request checking is done in the method
+	return sas.inner.GetRateLimitOverrides(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) AddRateLimitOverride(ctx context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	// All request checking is done in the method
+	return sas.inner.AddRateLimitOverride(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) ExpireRateLimitOverride(ctx context.Context, req *sapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.ExpireRateLimitOverride(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetValidationAttempts(ctx context.Context, req *sapb.GetValidationAttemptsRequest) (*corepb.ValidationAttempts, error) {
+	// All request checking is done in the method
+	return sas.inner.GetValidationAttempts(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) AddValidationAttempt(ctx context.Context, req *corepb.ValidationAttempt) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.AddValidationAttempt(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetHeldOrders(ctx context.Context, req *sapb.GetHeldOrdersRequest) (*sapb.HeldOrders, error) {
+	// All request checking is done in the method
+	return sas.inner.GetHeldOrders(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) DeleteProcessingOrder(ctx context.Context, req *sapb.OrderRequest) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.DeleteProcessingOrder(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) HoldOrder(ctx context.Context, req *sapb.HeldOrder) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.HoldOrder(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) ReleaseHeldOrder(ctx context.Context, req *sapb.ReleaseHeldOrderRequest) (*sapb.HeldOrder, error) {
+	// All request checking is done in the method
+	return sas.inner.ReleaseHeldOrder(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetAccountScope(ctx context.Context, req *sapb.RegistrationID) (*sapb.AccountScope, error) {
+	// All request checking is done in the method
+	return sas.inner.GetAccountScope(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) AddAccountScope(ctx context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.AddAccountScope(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) RemoveAccountScope(ctx context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.RemoveAccountScope(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) ClearAccountScope(ctx context.Context, req *sapb.ClearAccountScopeRequest) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.ClearAccountScope(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) GetRenewalInfoOverride(ctx context.Context, req *sapb.Serial) (*sapb.RenewalInfoOverride, error) {
+	// All request checking is done in the method
+	return sas.inner.GetRenewalInfoOverride(ctx, req)
+}
+
+func (sas StorageAuthorityServerWrapper) AddRenewalInfoOverride(ctx context.Context, req *sapb.RenewalInfoOverride) (*corepb.Empty, error) {
+	// All request checking is done in the method
+	return sas.inner.AddRenewalInfoOverride(ctx, req)
+}
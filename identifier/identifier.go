@@ -1,6 +1,8 @@
 // The identifier package defines types for RFC 8555 ACME identifiers.
 package identifier
 
+import "net"
+
 // IdentifierType is a named string type for registered ACME identifier types.
 // See https://tools.ietf.org/html/rfc8555#section-9.7.7
 type IdentifierType string
@@ -8,17 +10,20 @@ type IdentifierType string
 const (
 	// DNS is specified in RFC 8555 for DNS type identifiers.
 	DNS = IdentifierType("dns")
+	// IP is specified in RFC 8738 for IP address type identifiers.
+	IP = IdentifierType("ip")
 )
 
 // ACMEIdentifier is a struct encoding an identifier that can be validated. The
 // protocol allows for different types of identifier to be supported (DNS
-// names, IP addresses, etc.), but currently we only support RFC 8555 DNS type
-// identifiers for domain names.
+// names, IP addresses, etc.). We support RFC 8555 DNS type identifiers for
+// domain names and RFC 8738 IP type identifiers for IP addresses.
 type ACMEIdentifier struct {
 	// Type is the registered IdentifierType of the identifier.
 	Type IdentifierType `json:"type"`
 	// Value is the value of the identifier. For a DNS type identifier it is
-	// a domain name.
+	// a domain name. For an IP type identifier it is the textual form of the IP
+	// address (RFC 8738 Section 3).
 	Value string `json:"value"`
 }
 
@@ -30,3 +35,24 @@ func DNSIdentifier(domain string) ACMEIdentifier {
 		Value: domain,
 	}
 }
+
+// IPIdentifier is a convenience function for creating an ACMEIdentifier with
+// Type IP for a given IP address.
+func IPIdentifier(ip net.IP) ACMEIdentifier {
+	return ACMEIdentifier{
+		Type:  IP,
+		Value: ip.String(),
+	}
+}
+
+// ForName returns the ACMEIdentifier for a name as it appears in an order or
+// a certificate's list of names. Names that parse as IP addresses are IP type
+// identifiers, everything else is a DNS type identifier. This is unambiguous
+// because the policy authority never allows a DNS identifier whose value looks
+// like an IP address.
+func ForName(name string) ACMEIdentifier {
+	if net.ParseIP(name) != nil {
+		return ACMEIdentifier{Type: IP, Value: name}
+	}
+	return DNSIdentifier(name)
+}
@@ -70,6 +70,12 @@ const (
     "n":"qih-cx32M0wq8MhhN-kBi2xPE-wnw4_iIg1hWO5wtBfpt2PtWikgPuBT6jvK9oyQwAWbSfwqlVZatMPY_-3IyytMNb9R9OatNr6o5HROBoyZnDVSiC4iMRd7bRl_PWSIqj_MjhPNa9cYwBdW5iC3jM5TaOgmp0-YFm4tkLGirDcIBDkQYlnv9NKILvuwqkapZ7XBixeqdCcikUcTRXW5unqygO6bnapzw-YtPsPPlj4Ih3SvK4doyziPV96U8u5lbNYYEzYiW1mbu9n0KLvmKDikGcdOpf6-yRa_10kMZyYQatY1eclIKI0xb54kbluEl0GQDaL5FxLmiKeVnsapzw",
     "e":"AQAB"
   }`
+	// Ed25519 example key from RFC 8037 Appendix A.2
+	testEd1KeyPublicJSON = `{
+     "kty":"OKP",
+     "crv":"Ed25519",
+     "x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"
+   }`
 
 	agreementURL = "http://example.invalid/terms"
 )
@@ -152,6 +158,7 @@ func (sa *StorageAuthority) GetRegistrationByKey(_ context.Context, jwk *jose.JS
 	var test4KeyPublic jose.JSONWebKey
 	var testE1KeyPublic jose.JSONWebKey
 	var testE2KeyPublic jose.JSONWebKey
+	var testEd1KeyPublic jose.JSONWebKey
 	var err error
 	err = test1KeyPublic.UnmarshalJSON([]byte(test1KeyPublicJSON))
 	if err != nil {
@@ -187,6 +194,10 @@ func (sa *StorageAuthority) GetRegistrationByKey(_ context.Context, jwk *jose.JS
 	if err != nil {
 		panic(err)
 	}
+	err = testEd1KeyPublic.UnmarshalJSON([]byte(testEd1KeyPublicJSON))
+	if err != nil {
+		panic(err)
+	}
 
 	contacts := []string{"mailto:person@mail.com"}
 
@@ -223,6 +234,10 @@ func (sa *StorageAuthority) GetRegistrationByKey(_ context.Context, jwk *jose.JS
 		return core.Registration{ID: 4}, berrors.NotFoundError("reg not found")
 	}
 
+	if core.KeyDigestEquals(jwk, testEd1KeyPublic) {
+		return core.Registration{ID: 6}, berrors.NotFoundError("reg not found")
+	}
+
 	if core.KeyDigestEquals(jwk, test3KeyPublic) {
 		// deactivated registration
 		return core.Registration{
@@ -373,8 +388,8 @@ func (sa *StorageAuthority) UpdateRegistration(_ context.Context, reg core.Regis
 }
 
 // CountFQDNSets is a mock
-func (sa *StorageAuthority) CountFQDNSets(_ context.Context, since time.Duration, names []string) (int64, error) {
-	return 0, nil
+func (sa *StorageAuthority) CountFQDNSets(_ context.Context, since time.Duration, names []string) (int64, time.Time, error) {
+	return 0, time.Time{}, nil
 }
 
 // FQDNSetExists is a mock
@@ -435,13 +450,13 @@ func (sa *StorageAuthority) CountCertificatesByNames(_ context.Context, _ []stri
 }
 
 // CountRegistrationsByIP is a mock
-func (sa *StorageAuthority) CountRegistrationsByIP(_ context.Context, _ net.IP, _, _ time.Time) (int, error) {
-	return 0, nil
+func (sa *StorageAuthority) CountRegistrationsByIP(_ context.Context, _ net.IP, _, _ time.Time) (int, time.Time, error) {
+	return 0, time.Time{}, nil
 }
 
 // CountRegistrationsByIPRange is a mock
-func (sa *StorageAuthority) CountRegistrationsByIPRange(_ context.Context, _ net.IP, _, _ time.Time) (int, error) {
-	return 0, nil
+func (sa *StorageAuthority) CountRegistrationsByIPRange(_ context.Context, _ net.IP, _, _ time.Time) (int, time.Time, error) {
+	return 0, time.Time{}, nil
 }
 
 // CountPendingAuthorizations is a mock
@@ -450,8 +465,8 @@ func (sa *StorageAuthority) CountPendingAuthorizations(_ context.Context, _ int6
 }
 
 // CountOrders is a mock
-func (sa *StorageAuthority) CountOrders(_ context.Context, _ int64, _, _ time.Time) (int, error) {
-	return 0, nil
+func (sa *StorageAuthority) CountOrders(_ context.Context, _ int64, _, _ time.Time) (int, time.Time, error) {
+	return 0, time.Time{}, nil
 }
 
 // DeactivateAuthorization is a mock
@@ -545,6 +560,47 @@ func (sa *StorageAuthority) GetOrder(_ context.Context, req *sapb.OrderRequest)
 		validOrder.Created = &now
 	}
 
+	// Order 10 is processing
+	if *req.Id == 10 {
+		processing := string(core.StatusProcessing)
+		validOrder.Status = &processing
+		validOrder.CertificateSerial = nil
+	}
+
+	// Order 11 is a STAR order whose current certificate may be fetched with
+	// an unauthenticated GET request
+	if *req.Id == 11 {
+		start := sa.clk.Now().Add(-24 * time.Hour).UnixNano()
+		end := sa.clk.Now().AddDate(0, 0, 30).UnixNano()
+		lifetime := int64(7 * 24 * time.Hour)
+		allowGet := true
+		current := "0000000000000000000000000000000000b2"
+		validOrder.AutoRenewal = &corepb.AutoRenewal{
+			StartDate:           &start,
+			EndDate:             &end,
+			Lifetime:            &lifetime,
+			AllowCertificateGet: &allowGet,
+			CurrentSerial:       &current,
+		}
+	}
+
+	// Order 12 is a STAR order that is still pending
+	if *req.Id == 12 {
+		pending := string(core.StatusPending)
+		validOrder.Status = &pending
+		validOrder.CertificateSerial = nil
+		start := sa.clk.Now().UnixNano()
+		end := sa.clk.Now().AddDate(0, 0, 30).UnixNano()
+		lifetime := int64(7 * 24 * time.Hour)
+		allowGet := false
+		validOrder.AutoRenewal = &corepb.AutoRenewal{
+			StartDate:           &start,
+			EndDate:             &end,
+			Lifetime:            &lifetime,
+			AllowCertificateGet: &allowGet,
+		}
+	}
+
 	return validOrder, nil
 }
 
@@ -580,6 +636,11 @@ func (sa *StorageAuthority) FinalizeAuthorization2(ctx context.Context, req *sap
 	return nil
 }
 
+// RecordFailedValidation2 is a mock
+func (sa *StorageAuthority) RecordFailedValidation2(ctx context.Context, req *sapb.RecordFailedValidationRequest) error {
+	return nil
+}
+
 func (sa *StorageAuthority) DeactivateAuthorization2(ctx context.Context, req *sapb.AuthorizationID2) (*corepb.Empty, error) {
 	return nil, nil
 }
@@ -709,6 +770,163 @@ func (sa *StorageAuthority) KeyBlocked(ctx context.Context, req *sapb.KeyBlocked
 	return &sapb.Exists{Exists: &exists}, nil
 }
 
+// ExternalAccountKeyID and ExternalAccountHMACKey are the key ID and MAC key
+// of the unrevoked external account binding key known to the mock
+// StorageAuthority. RevokedExternalAccountKeyID identifies a revoked key with
+// the same MAC key.
+const (
+	ExternalAccountKeyID        = "kid-1"
+	RevokedExternalAccountKeyID = "kid-revoked"
+)
+
+// ExternalAccountHMACKey is the MAC key for the mock external account keys.
+var ExternalAccountHMACKey = []byte("zWNDZM6eQGHWpSRTPal5eIUYFTu7EajVIoguysqZ9wG44nMEtx3MUAsUDkMTQ12W")
+
+// GetExternalAccountKey is a mock
+func (sa *StorageAuthority) GetExternalAccountKey(_ context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	created := sa.clk.Now().Add(-24 * time.Hour).UnixNano()
+	var revoked int64
+	switch req.GetKeyID() {
+	case ExternalAccountKeyID:
+	case RevokedExternalAccountKeyID:
+		revoked = sa.clk.Now().Add(-time.Hour).UnixNano()
+	default:
+		return nil, berrors.NotFoundError("external account key %q not found", req.GetKeyID())
+	}
+	return &sapb.ExternalAccountKey{
+		KeyID:   req.KeyID,
+		HmacKey: ExternalAccountHMACKey,
+		Created: &created,
+		Revoked: &revoked,
+	}, nil
+}
+
+// AddExternalAccountKey is a mock
+func (sa *StorageAuthority) AddExternalAccountKey(_ context.Context, _ *sapb.ExternalAccountKey) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// RevokeExternalAccountKey is a mock
+func (sa *StorageAuthority) RevokeExternalAccountKey(_ context.Context, _ *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// ReplacementOrderExists is a mock
+func (sa *StorageAuthority) ReplacementOrderExists(_ context.Context, _ *sapb.Serial) (*sapb.Exists, error) {
+	exists := false
+	return &sapb.Exists{Exists: &exists}, nil
+}
+
+// GetRenewalInfoOverride is a mock
+func (sa *StorageAuthority) GetRenewalInfoOverride(_ context.Context, req *sapb.Serial) (*sapb.RenewalInfoOverride, error) {
+	return nil, berrors.NotFoundError("no renewal info override for serial %q", req.GetSerial())
+}
+
+// GetOrdersForAccount is a mock
+func (sa *StorageAuthority) GetOrdersForAccount(_ context.Context, req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	// Account 1 has a single order with ID 1
+	if req.GetAcctID() == 1 && req.GetAfterID() < 1 {
+		return &sapb.OrderIDs{Ids: []int64{1}}, nil
+	}
+	return &sapb.OrderIDs{}, nil
+}
+
+// GetStaleProcessingOrders is a mock
+func (sa *StorageAuthority) GetStaleProcessingOrders(_ context.Context, _ *sapb.GetStaleProcessingOrdersRequest) (*sapb.OrderIDs, error) {
+	return &sapb.OrderIDs{}, nil
+}
+
+// AddRenewalInfoOverride is a mock
+func (sa *StorageAuthority) AddRenewalInfoOverride(_ context.Context, _ *sapb.RenewalInfoOverride) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// GetDueAutoRenewals is a mock
+func (sa *StorageAuthority) GetDueAutoRenewals(_ context.Context, _ *sapb.GetDueAutoRenewalsRequest) (*sapb.DueAutoRenewals, error) {
+	return &sapb.DueAutoRenewals{}, nil
+}
+
+// SetAutoRenewalCertificate is a mock
+func (sa *StorageAuthority) SetAutoRenewalCertificate(_ context.Context, _ *sapb.SetAutoRenewalCertificateRequest) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// ClaimAutoRenewal is a mock
+func (sa *StorageAuthority) ClaimAutoRenewal(_ context.Context, _ *sapb.ClaimAutoRenewalRequest) (*sapb.Exists, error) {
+	claimed := true
+	return &sapb.Exists{Exists: &claimed}, nil
+}
+
+// CancelAutoRenewal is a mock
+func (sa *StorageAuthority) CancelAutoRenewal(_ context.Context, _ *sapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// GetRateLimitOverrides is a mock
+func (sa *StorageAuthority) GetRateLimitOverrides(_ context.Context, _ *sapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	return &corepb.RateLimitOverrides{}, nil
+}
+
+// AddRateLimitOverride is a mock
+func (sa *StorageAuthority) AddRateLimitOverride(_ context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	return req, nil
+}
+
+// ExpireRateLimitOverride is a mock
+func (sa *StorageAuthority) ExpireRateLimitOverride(_ context.Context, _ *sapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// GetValidationAttempts is a mock
+func (sa *StorageAuthority) GetValidationAttempts(_ context.Context, _ *sapb.GetValidationAttemptsRequest) (*corepb.ValidationAttempts, error) {
+	return &corepb.ValidationAttempts{}, nil
+}
+
+// AddValidationAttempt is a mock
+func (sa *StorageAuthority) AddValidationAttempt(_ context.Context, _ *corepb.ValidationAttempt) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// GetHeldOrders is a mock
+func (sa *StorageAuthority) GetHeldOrders(_ context.Context, _ *sapb.GetHeldOrdersRequest) (*sapb.HeldOrders, error) {
+	return &sapb.HeldOrders{}, nil
+}
+
+// DeleteProcessingOrder is a mock
+func (sa *StorageAuthority) DeleteProcessingOrder(_ context.Context, _ *sapb.OrderRequest) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// HoldOrder is a mock
+func (sa *StorageAuthority) HoldOrder(_ context.Context, _ *sapb.HeldOrder) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// ReleaseHeldOrder is a mock
+func (sa *StorageAuthority) ReleaseHeldOrder(_ context.Context, req *sapb.ReleaseHeldOrderRequest) (*sapb.HeldOrder, error) {
+	return nil, berrors.NotFoundError("no held order found for order ID %d", req.GetOrderID())
+}
+
+// GetAccountScope is a mock
+func (sa *StorageAuthority) GetAccountScope(_ context.Context, req *sapb.RegistrationID) (*sapb.AccountScope, error) {
+	return &sapb.AccountScope{RegistrationID: req.Id}, nil
+}
+
+// AddAccountScope is a mock
+func (sa *StorageAuthority) AddAccountScope(_ context.Context, _ *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// RemoveAccountScope is a mock
+func (sa *StorageAuthority) RemoveAccountScope(_ context.Context, _ *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
+// ClearAccountScope is a mock
+func (sa *StorageAuthority) ClearAccountScope(_ context.Context, _ *sapb.ClearAccountScopeRequest) (*corepb.Empty, error) {
+	return &corepb.Empty{}, nil
+}
+
 // Publisher is a mock
 type Publisher struct {
 	// empty
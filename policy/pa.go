@@ -14,6 +14,7 @@ import (
 	"golang.org/x/net/idna"
 	"golang.org/x/text/unicode/norm"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/iana"
@@ -30,6 +31,8 @@ type AuthorityImpl struct {
 	blocklist              map[string]bool
 	exactBlocklist         map[string]bool
 	wildcardExactBlocklist map[string]bool
+	challengeRules         map[string]challengeRule
+	reviewList             map[string]bool
 	blocklistMu            sync.RWMutex
 
 	enabledChallenges map[core.AcmeChallenge]bool
@@ -70,6 +73,34 @@ type blockedNamesPolicy struct {
 	// time above and beyond the high-risk domains. Managing these entries separately
 	// from HighRiskBlockedNames makes it easier to vet changes accurately.
 	AdminBlockedNames []string `yaml:"AdminBlockedNames"`
+
+	// ChallengeRules change the challenge types offered for the names within
+	// a domain subtree, e.g. to only allow DNS-01 for high-value zones. Only
+	// the rule with the longest suffix matching a name applies to it.
+	ChallengeRules []challengeRule `yaml:"ChallengeRules"`
+
+	// ReviewNames is a list of domain names that issuance isn't forbidden for
+	// but must be approved by an operator, e.g. brand names or government
+	// suffixes. Like HighRiskBlockedNames it applies to subdomains as well.
+	// Orders containing a matching name are held when they're finalized until
+	// they're approved or rejected.
+	ReviewNames []string `yaml:"ReviewNames"`
+}
+
+// challengeRule changes the challenge types offered for a domain and all of
+// its subdomains.
+type challengeRule struct {
+	// Suffix is the domain the rule applies to, e.g. `example.com` applies to
+	// `example.com`, `www.example.com` and `*.example.com`.
+	Suffix string `yaml:"Suffix"`
+	// Allowed, if not empty, limits the challenge types offered to those
+	// listed. Types that are neither enabled nor Additional are still not
+	// offered.
+	Allowed []core.AcmeChallenge `yaml:"Allowed"`
+	// Additional are challenge types offered in addition to the enabled
+	// challenge types, even if they aren't enabled. As with enabled types,
+	// only DNS based types are offered for wildcard names.
+	Additional []core.AcmeChallenge `yaml:"Additional"`
 }
 
 // SetHostnamePolicyFile will load the given policy file, returning error if it
@@ -138,10 +169,34 @@ func (pa *AuthorityImpl) processHostnamePolicy(policy blockedNamesPolicy) error
 		// wildcardNameMap to block issuance for `*.`+parts[1]
 		wildcardNameMap[parts[1]] = true
 	}
+	ruleMap := make(map[string]challengeRule)
+	for _, rule := range policy.ChallengeRules {
+		if rule.Suffix == "" || rule.Suffix != strings.ToLower(rule.Suffix) || strings.HasPrefix(rule.Suffix, "*") {
+			return fmt.Errorf("Malformed ChallengeRules entry, invalid suffix: %q", rule.Suffix)
+		}
+		if _, present := ruleMap[rule.Suffix]; present {
+			return fmt.Errorf("Malformed ChallengeRules entry, duplicate suffix: %q", rule.Suffix)
+		}
+		if len(rule.Allowed) == 0 && len(rule.Additional) == 0 {
+			return fmt.Errorf("Malformed ChallengeRules entry, no Allowed or Additional challenge types: %q", rule.Suffix)
+		}
+		for _, t := range append(rule.Allowed, rule.Additional...) {
+			if !t.IsValid() {
+				return fmt.Errorf("Malformed ChallengeRules entry, unknown challenge type %q: %q", t, rule.Suffix)
+			}
+		}
+		ruleMap[rule.Suffix] = rule
+	}
+	reviewMap := make(map[string]bool)
+	for _, v := range policy.ReviewNames {
+		reviewMap[v] = true
+	}
 	pa.blocklistMu.Lock()
 	pa.blocklist = nameMap
 	pa.exactBlocklist = exactNameMap
 	pa.wildcardExactBlocklist = wildcardNameMap
+	pa.challengeRules = ruleMap
+	pa.reviewList = reviewMap
 	pa.blocklistMu.Unlock()
 	return nil
 }
@@ -198,6 +253,8 @@ var (
 	errMalformedWildcard    = berrors.MalformedError("Domain name contains an invalid wildcard. A wildcard is only permitted before the first dot in a domain name")
 	errICANNTLDWildcard     = berrors.MalformedError("Domain name is a wildcard for an ICANN TLD")
 	errWildcardNotSupported = berrors.MalformedError("Wildcard domain names are not supported")
+	errInvalidIP            = berrors.MalformedError("IP address is not in canonical IPv4 or IPv6 textual form")
+	errReservedIP           = berrors.RejectedIdentifierError("The ACME server refuses to issue a certificate for this IP address, because it is in a private or reserved range")
 )
 
 // ValidDomain checks that a domain isn't:
@@ -293,6 +350,21 @@ func ValidDomain(domain string) error {
 	return nil
 }
 
+// ValidIP checks that an IP identifier value is the canonical textual form of
+// an IPv4 or IPv6 address (e.g. no leading zeros, no IPv4-mapped IPv6 form and
+// the shortest IPv6 form per RFC 5952) and that it isn't in a private or
+// reserved range.
+func ValidIP(value string) error {
+	ip := net.ParseIP(value)
+	if ip == nil || ip.String() != value {
+		return errInvalidIP
+	}
+	if bdns.IsReservedIP(ip) {
+		return errReservedIP
+	}
+	return nil
+}
+
 // forbiddenMailDomains is a map of domain names we do not allow after the
 // @ symbol in contact mailto addresses. These are frequently used when
 // copy-pasting example configurations and would not result in expiration
@@ -335,9 +407,8 @@ func ValidEmail(address string) error {
 // identifier. It expects domains in id to be lowercase to prevent mismatched
 // cases breaking queries.
 //
-// We place several criteria on identifiers we are willing to issue for:
+// We place several criteria on DNS identifiers we are willing to issue for:
 //
-//  * MUST self-identify as DNS identifiers
 //  * MUST contain only bytes in the DNS hostname character set
 //  * MUST NOT have more than maxLabels labels
 //  * MUST follow the DNS hostname syntax rules in RFC 1035 and RFC 2181
@@ -349,10 +420,20 @@ func ValidEmail(address string) error {
 //  * MUST NOT be a label-wise suffix match for a name on the block list,
 //    where comparison is case-independent (normalized to lower case)
 //
+// IP identifiers MUST be in the canonical textual form of an IPv4 or IPv6
+// address and MUST NOT be in a private or reserved range. The hostname block
+// lists do not apply to IP identifiers.
+//
+// Identifiers of any other type are rejected.
+//
 // If WillingToIssue returns an error, it will be of type MalformedRequestError
 // or RejectedIdentifierError
 func (pa *AuthorityImpl) WillingToIssue(id identifier.ACMEIdentifier) error {
-	if id.Type != identifier.DNS {
+	switch id.Type {
+	case identifier.DNS:
+	case identifier.IP:
+		return ValidIP(id.Value)
+	default:
 		return errInvalidIdentifier
 	}
 	domain := id.Value
@@ -377,7 +458,8 @@ func (pa *AuthorityImpl) WillingToIssue(id identifier.ACMEIdentifier) error {
 // returned. In addition to the regular WillingToIssue checks this function
 // also checks each wildcard identifier to enforce that:
 //
-// * The identifier is a DNS type identifier
+// * The identifier is a DNS or IP type identifier
+// * A wildcard identifier is a DNS type identifier
 // * There is at most one `*` wildcard character
 // * That the wildcard character is the leftmost label
 // * That the wildcard label is not immediately adjacent to a top level ICANN
@@ -434,7 +516,11 @@ func (pa *AuthorityImpl) WillingToIssueWildcards(idents []identifier.ACMEIdentif
 // willingToIssueWildcard vets a single identifier. It is used by
 // the plural WillingToIssueWildcards when evaluating a list of identifiers.
 func (pa *AuthorityImpl) willingToIssueWildcard(ident identifier.ACMEIdentifier) error {
-	// We're only willing to process DNS identifiers
+	// IP identifiers can't be wildcards, and we're only willing to process DNS
+	// identifiers otherwise
+	if ident.Type == identifier.IP {
+		return pa.WillingToIssue(ident)
+	}
 	if ident.Type != identifier.DNS {
 		return errInvalidIdentifier
 	}
@@ -530,35 +616,24 @@ func (pa *AuthorityImpl) checkHostLists(domain string) error {
 
 // ChallengesFor makes a decision of what challenges are acceptable for
 // the given identifier.
-func (pa *AuthorityImpl) ChallengesFor(identifier identifier.ACMEIdentifier) ([]core.Challenge, error) {
-	challenges := []core.Challenge{}
+func (pa *AuthorityImpl) ChallengesFor(ident identifier.ACMEIdentifier) ([]core.Challenge, error) {
+	types, err := pa.challengeTypesFor(ident)
+	if err != nil {
+		return nil, err
+	}
 
 	token := core.NewToken()
-
-	// If the identifier is for a DNS wildcard name we only
-	// provide a DNS-01 challenge as a matter of CA policy.
-	if strings.HasPrefix(identifier.Value, "*.") {
-		// We must have the DNS-01 challenge type enabled to create challenges for
-		// a wildcard identifier per LE policy.
-		if !pa.ChallengeTypeEnabled(core.ChallengeTypeDNS01) {
-			return nil, fmt.Errorf(
-				"Challenges requested for wildcard identifier but DNS-01 " +
-					"challenge type is not enabled")
-		}
-		// Only provide a DNS-01-Wildcard challenge
-		challenges = []core.Challenge{core.DNSChallenge01(token)}
-	} else {
-		// Otherwise we collect up challenges based on what is enabled.
-		if pa.ChallengeTypeEnabled(core.ChallengeTypeHTTP01) {
+	challenges := make([]core.Challenge, 0, len(types))
+	for _, t := range types {
+		switch t {
+		case core.ChallengeTypeHTTP01:
 			challenges = append(challenges, core.HTTPChallenge01(token))
-		}
-
-		if pa.ChallengeTypeEnabled(core.ChallengeTypeTLSALPN01) {
+		case core.ChallengeTypeTLSALPN01:
 			challenges = append(challenges, core.TLSALPNChallenge01(token))
-		}
-
-		if pa.ChallengeTypeEnabled(core.ChallengeTypeDNS01) {
+		case core.ChallengeTypeDNS01:
 			challenges = append(challenges, core.DNSChallenge01(token))
+		case core.ChallengeTypeDNSAccount01:
+			challenges = append(challenges, core.DNSAccountChallenge01(token))
 		}
 	}
 
@@ -575,6 +650,118 @@ func (pa *AuthorityImpl) ChallengesFor(identifier identifier.ACMEIdentifier) ([]
 	return shuffled, nil
 }
 
+// challengeTypesFor returns the challenge types acceptable for the given
+// identifier, taking into account the enabled challenge types, the type of
+// the identifier and any challenge rule for its domain.
+func (pa *AuthorityImpl) challengeTypesFor(ident identifier.ACMEIdentifier) ([]core.AcmeChallenge, error) {
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+
+	// If the identifier is for an IP address we only provide HTTP-01 and
+	// TLS-ALPN-01 challenges. RFC 8738 Section 7 forbids DNS-01 for IP
+	// identifiers, and DNS-ACCOUNT-01 likewise needs a DNS name. Challenge
+	// rules only apply to domain names.
+	if ident.Type == identifier.IP {
+		var types []core.AcmeChallenge
+		for _, t := range []core.AcmeChallenge{core.ChallengeTypeHTTP01, core.ChallengeTypeTLSALPN01} {
+			if pa.enabledChallenges[t] {
+				types = append(types, t)
+			}
+		}
+		return types, nil
+	}
+
+	domain := ident.Value
+	candidates := []core.AcmeChallenge{
+		core.ChallengeTypeHTTP01,
+		core.ChallengeTypeTLSALPN01,
+		core.ChallengeTypeDNS01,
+		core.ChallengeTypeDNSAccount01,
+	}
+	if strings.HasPrefix(domain, "*.") {
+		// If the identifier is for a DNS wildcard name we only provide DNS
+		// based challenges as a matter of CA policy. We must have the DNS-01
+		// challenge type enabled to create challenges for a wildcard
+		// identifier per LE policy.
+		if !pa.enabledChallenges[core.ChallengeTypeDNS01] {
+			return nil, fmt.Errorf(
+				"Challenges requested for wildcard identifier but DNS-01 " +
+					"challenge type is not enabled")
+		}
+		domain = strings.TrimPrefix(domain, "*.")
+		candidates = []core.AcmeChallenge{core.ChallengeTypeDNS01, core.ChallengeTypeDNSAccount01}
+	}
+
+	rule, hasRule := pa.challengeRuleFor(domain)
+	var types []core.AcmeChallenge
+	for _, t := range candidates {
+		if !pa.enabledChallenges[t] && !(hasRule && challengeTypeIn(t, rule.Additional)) {
+			continue
+		}
+		if hasRule && len(rule.Allowed) > 0 && !challengeTypeIn(t, rule.Allowed) {
+			continue
+		}
+		types = append(types, t)
+	}
+	if hasRule && len(types) == 0 {
+		return nil, fmt.Errorf("No challenge types are allowed for %q by policy", ident.Value)
+	}
+	return types, nil
+}
+
+// challengeRuleFor returns the challenge rule with the longest suffix that
+// matches domain, if any. It must be called with blocklistMu held.
+func (pa *AuthorityImpl) challengeRuleFor(domain string) (challengeRule, bool) {
+	labels := strings.Split(domain, ".")
+	for i := range labels {
+		rule, ok := pa.challengeRules[strings.Join(labels[i:], ".")]
+		if ok {
+			return rule, true
+		}
+	}
+	return challengeRule{}, false
+}
+
+func challengeTypeIn(t core.AcmeChallenge, types []core.AcmeChallenge) bool {
+	for _, other := range types {
+		if t == other {
+			return true
+		}
+	}
+	return false
+}
+
+// ChallengeTypeAllowed returns whether the specified challenge type may be
+// used to validate the given identifier. Unlike ChallengeTypeEnabled it takes
+// the challenge rules of the hostname policy into account.
+func (pa *AuthorityImpl) ChallengeTypeAllowed(t core.AcmeChallenge, ident identifier.ACMEIdentifier) bool {
+	types, err := pa.challengeTypesFor(ident)
+	if err != nil {
+		return false
+	}
+	return challengeTypeIn(t, types)
+}
+
+// ReviewRequired returns whether issuance for the given identifier must be
+// approved by an operator, i.e. whether it or one of its parent domains is on
+// the review list of the hostname policy. The wildcard form of a name requires
+// review if the base name does.
+func (pa *AuthorityImpl) ReviewRequired(ident identifier.ACMEIdentifier) bool {
+	if ident.Type != identifier.DNS {
+		return false
+	}
+	pa.blocklistMu.RLock()
+	defer pa.blocklistMu.RUnlock()
+
+	labels := strings.Split(strings.TrimPrefix(ident.Value, "*."), ".")
+	for i := range labels {
+		if pa.reviewList[strings.Join(labels[i:], ".")] {
+			return true
+		}
+	}
+	return false
+}
+
 // ChallengeTypeEnabled returns whether the specified challenge type is enabled
 func (pa *AuthorityImpl) ChallengeTypeEnabled(t core.AcmeChallenge) bool {
 	pa.blocklistMu.RLock()
@@ -2,6 +2,7 @@ package policy
 
 import (
 	"io/ioutil"
+	"net"
 	"os"
 	"testing"
 
@@ -154,7 +155,7 @@ func TestWillingToIssue(t *testing.T) {
 	test.AssertNotError(t, err, "Couldn't load rules")
 
 	// Test for invalid identifier type
-	ident := identifier.ACMEIdentifier{Type: "ip", Value: "example.com"}
+	ident := identifier.ACMEIdentifier{Type: "email", Value: "example.com"}
 	err = pa.WillingToIssue(ident)
 	if err != errInvalidIdentifier {
 		t.Error("Identifier was not correctly forbidden: ", ident)
@@ -207,6 +208,46 @@ func TestWillingToIssue(t *testing.T) {
 	}
 }
 
+func TestWillingToIssueIP(t *testing.T) {
+	testCases := []struct {
+		ip  string
+		err error
+	}{
+		{`1.2.3.4`, nil},
+		{`2600:1f1c:5e0:e702::1`, nil},
+		{`example.com`, errInvalidIP},
+		{`1.2.3`, errInvalidIP},
+		{`01.2.3.4`, errInvalidIP},                // leading zeros
+		{`::ffff:1.2.3.4`, errInvalidIP},          // IPv4-mapped IPv6 form
+		{`2600:1f1c:05e0:e702::1`, errInvalidIP},  // non-canonical IPv6
+		{`[2600:1f1c:5e0:e702::1]`, errInvalidIP}, // bracketed IPv6
+		{`10.0.0.1`, errReservedIP},               // RFC 1918
+		{`127.0.0.1`, errReservedIP},              // loopback
+		{`192.0.2.1`, errReservedIP},              // documentation range
+		{`::1`, errReservedIP},                    // loopback
+		{`fe80::1`, errReservedIP},                // link local
+		{`2001:db8::1`, errReservedIP},            // documentation range
+	}
+
+	pa := paImpl(t)
+	for _, tc := range testCases {
+		err := pa.WillingToIssue(identifier.ACMEIdentifier{Type: identifier.IP, Value: tc.ip})
+		if err != tc.err {
+			t.Errorf("WillingToIssue(%q) = %q, expected %q", tc.ip, err, tc.err)
+		}
+	}
+
+	// IP identifiers can be mixed with DNS identifiers but never be wildcards
+	err := pa.WillingToIssueWildcards([]identifier.ACMEIdentifier{
+		{Type: identifier.IP, Value: "1.2.3.4"},
+		{Type: identifier.IP, Value: "*.1.2.3.4"},
+	})
+	test.AssertError(t, err, "WillingToIssueWildcards didn't fail for a wildcard IP")
+	berr, ok := err.(*berrors.BoulderError)
+	test.Assert(t, ok, "Error wasn't a BoulderError")
+	test.AssertEquals(t, berr.Detail, `Cannot issue for "*.1.2.3.4": `+errInvalidIP.Error())
+}
+
 func TestWillingToIssueWildcard(t *testing.T) {
 	bannedDomains := []string{
 		"zombo.gov.us",
@@ -416,6 +457,52 @@ func TestChallengesForWildcard(t *testing.T) {
 		"unexpectedly")
 	test.AssertEquals(t, len(challenges), 1)
 	test.AssertEquals(t, challenges[0].Type, core.ChallengeTypeDNS01)
+
+	// With DNS-ACCOUNT-01 also enabled both DNS challenge types should be
+	// returned, but still no HTTP-01 challenge
+	enabledChallenges[core.ChallengeTypeDNSAccount01] = true
+	pa = mustConstructPA(t, enabledChallenges)
+	challenges, err = pa.ChallengesFor(wildcardIdent)
+	test.AssertNotError(t, err, "ChallengesFor errored for a wildcard ident "+
+		"unexpectedly")
+	test.AssertEquals(t, len(challenges), 2)
+	for _, chall := range challenges {
+		test.Assert(t, chall.Type == core.ChallengeTypeDNS01 || chall.Type == core.ChallengeTypeDNSAccount01,
+			"ChallengesFor returned a non-DNS challenge for a wildcard ident")
+	}
+}
+
+func TestChallengesForDNSAccount01(t *testing.T) {
+	pa, err := New(map[core.AcmeChallenge]bool{
+		core.ChallengeTypeHTTP01:       true,
+		core.ChallengeTypeDNSAccount01: true,
+	})
+	test.AssertNotError(t, err, "Couldn't create policy implementation")
+
+	challenges, err := pa.ChallengesFor(identifier.DNSIdentifier("zombo.com"))
+	test.AssertNotError(t, err, "ChallengesFor failed")
+	test.AssertEquals(t, len(challenges), 2)
+	seenChalls := make(map[core.AcmeChallenge]bool)
+	for _, chall := range challenges {
+		seenChalls[chall.Type] = true
+	}
+	test.Assert(t, seenChalls[core.ChallengeTypeDNSAccount01], "No DNS-ACCOUNT-01 challenge returned")
+
+	// DNS-ACCOUNT-01 must not be offered for an IP identifier
+	challenges, err = pa.ChallengesFor(identifier.IPIdentifier(net.ParseIP("1.2.3.4")))
+	test.AssertNotError(t, err, "ChallengesFor failed")
+	test.AssertEquals(t, len(challenges), 1)
+	test.AssertEquals(t, challenges[0].Type, core.ChallengeTypeHTTP01)
+}
+
+func TestChallengesForIP(t *testing.T) {
+	pa := paImpl(t)
+
+	challenges, err := pa.ChallengesFor(identifier.IPIdentifier(net.ParseIP("1.2.3.4")))
+	test.AssertNotError(t, err, "ChallengesFor failed")
+	// DNS-01 is enabled but must not be offered for an IP identifier
+	test.AssertEquals(t, len(challenges), 1)
+	test.AssertEquals(t, challenges[0].Type, core.ChallengeTypeHTTP01)
 }
 
 // TestMalformedExactBlocklist tests that loading a YAML policy file with an
@@ -465,3 +552,145 @@ func TestValidEmailError(t *testing.T) {
 	err = ValidEmail("example@-foobar.com")
 	test.AssertEquals(t, err.Error(), "contact email \"example@-foobar.com\" has invalid domain : Domain name contains an invalid character")
 }
+
+func TestChallengeRules(t *testing.T) {
+	pa, err := New(map[core.AcmeChallenge]bool{
+		core.ChallengeTypeHTTP01: true,
+		core.ChallengeTypeDNS01:  true,
+	})
+	test.AssertNotError(t, err, "Couldn't create policy implementation")
+	err = pa.processHostnamePolicy(blockedNamesPolicy{
+		ChallengeRules: []challengeRule{
+			{Suffix: "highvalue.com", Allowed: []core.AcmeChallenge{core.ChallengeTypeDNS01}},
+			{Suffix: "open.highvalue.com", Additional: []core.AcmeChallenge{core.ChallengeTypeTLSALPN01}},
+			{Suffix: "account.com", Additional: []core.AcmeChallenge{core.ChallengeTypeDNSAccount01}},
+			{Suffix: "nothing.com", Allowed: []core.AcmeChallenge{core.ChallengeTypeTLSALPN01}},
+		},
+	})
+	test.AssertNotError(t, err, "Couldn't load challenge rules")
+
+	typesFor := func(name string) map[core.AcmeChallenge]bool {
+		challenges, err := pa.ChallengesFor(identifier.ForName(name))
+		test.AssertNotError(t, err, "ChallengesFor failed")
+		types := make(map[core.AcmeChallenge]bool)
+		for _, chall := range challenges {
+			types[chall.Type] = true
+		}
+		return types
+	}
+	dns01 := map[core.AcmeChallenge]bool{core.ChallengeTypeDNS01: true}
+	enabled := map[core.AcmeChallenge]bool{core.ChallengeTypeHTTP01: true, core.ChallengeTypeDNS01: true}
+
+	testCases := []struct {
+		name  string
+		types map[core.AcmeChallenge]bool
+	}{
+		// Names without a rule get the enabled types
+		{"example.com", enabled},
+		// Rules apply to the suffix and all its subdomains
+		{"highvalue.com", dns01},
+		{"www.highvalue.com", dns01},
+		{"*.highvalue.com", dns01},
+		{"nothighvalue.com", enabled},
+		// The rule with the longest suffix wins
+		{"open.highvalue.com", map[core.AcmeChallenge]bool{core.ChallengeTypeHTTP01: true, core.ChallengeTypeDNS01: true, core.ChallengeTypeTLSALPN01: true}},
+		// Additional types are offered even though they aren't enabled, but
+		// wildcards still only get DNS based types
+		{"www.account.com", map[core.AcmeChallenge]bool{core.ChallengeTypeHTTP01: true, core.ChallengeTypeDNS01: true, core.ChallengeTypeDNSAccount01: true}},
+		{"*.account.com", map[core.AcmeChallenge]bool{core.ChallengeTypeDNS01: true, core.ChallengeTypeDNSAccount01: true}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.AssertDeepEquals(t, typesFor(tc.name), tc.types)
+		})
+	}
+
+	// Rules don't apply to IP identifiers
+	challenges, err := pa.ChallengesFor(identifier.IPIdentifier(net.ParseIP("1.2.3.4")))
+	test.AssertNotError(t, err, "ChallengesFor failed")
+	test.AssertEquals(t, len(challenges), 1)
+
+	// A rule that leaves no challenge types is an error
+	_, err = pa.ChallengesFor(identifier.ForName("www.nothing.com"))
+	test.AssertError(t, err, "ChallengesFor didn't fail when a rule allows no challenge types")
+
+	test.Assert(t, pa.ChallengeTypeAllowed(core.ChallengeTypeDNS01, identifier.ForName("www.highvalue.com")), "DNS-01 not allowed for www.highvalue.com")
+	test.Assert(t, !pa.ChallengeTypeAllowed(core.ChallengeTypeHTTP01, identifier.ForName("www.highvalue.com")), "HTTP-01 allowed for www.highvalue.com")
+	test.Assert(t, pa.ChallengeTypeAllowed(core.ChallengeTypeHTTP01, identifier.ForName("www.example.com")), "HTTP-01 not allowed for www.example.com")
+	test.Assert(t, !pa.ChallengeTypeAllowed(core.ChallengeTypeHTTP01, identifier.ForName("*.example.com")), "HTTP-01 allowed for *.example.com")
+	test.Assert(t, pa.ChallengeTypeAllowed(core.ChallengeTypeDNSAccount01, identifier.ForName("account.com")), "DNS-ACCOUNT-01 not allowed for account.com")
+}
+
+func TestMalformedChallengeRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rules    []challengeRule
+		expected string
+	}{
+		{
+			name:     "empty suffix",
+			rules:    []challengeRule{{Allowed: []core.AcmeChallenge{core.ChallengeTypeDNS01}}},
+			expected: "Malformed ChallengeRules entry, invalid suffix: \"\"",
+		},
+		{
+			name:     "wildcard suffix",
+			rules:    []challengeRule{{Suffix: "*.example.com", Allowed: []core.AcmeChallenge{core.ChallengeTypeDNS01}}},
+			expected: "Malformed ChallengeRules entry, invalid suffix: \"*.example.com\"",
+		},
+		{
+			name: "duplicate suffix",
+			rules: []challengeRule{
+				{Suffix: "example.com", Allowed: []core.AcmeChallenge{core.ChallengeTypeDNS01}},
+				{Suffix: "example.com", Allowed: []core.AcmeChallenge{core.ChallengeTypeHTTP01}},
+			},
+			expected: "Malformed ChallengeRules entry, duplicate suffix: \"example.com\"",
+		},
+		{
+			name:     "no types",
+			rules:    []challengeRule{{Suffix: "example.com"}},
+			expected: "Malformed ChallengeRules entry, no Allowed or Additional challenge types: \"example.com\"",
+		},
+		{
+			name:     "unknown type",
+			rules:    []challengeRule{{Suffix: "example.com", Additional: []core.AcmeChallenge{"tls-sni-01"}}},
+			expected: "Malformed ChallengeRules entry, unknown challenge type \"tls-sni-01\": \"example.com\"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pa := paImpl(t)
+			err := pa.processHostnamePolicy(blockedNamesPolicy{ChallengeRules: tc.rules})
+			test.AssertError(t, err, "Loaded malformed challenge rules without error")
+			test.AssertEquals(t, err.Error(), tc.expected)
+		})
+	}
+}
+
+func TestReviewRequired(t *testing.T) {
+	pa := paImpl(t)
+	err := pa.processHostnamePolicy(blockedNamesPolicy{
+		ReviewNames: []string{"bigbank.com", "gov.example"},
+	})
+	test.AssertNotError(t, err, "Couldn't load review names")
+
+	testCases := []struct {
+		name   string
+		review bool
+	}{
+		{"bigbank.com", true},
+		{"login.bigbank.com", true},
+		{"*.bigbank.com", true},
+		{"notbigbank.com", false},
+		{"bigbank.com.evil.com", false},
+		{"agency.gov.example", true},
+		{"example.com", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.AssertEquals(t, pa.ReviewRequired(identifier.ForName(tc.name)), tc.review)
+		})
+	}
+
+	// The review list doesn't apply to IP identifiers
+	test.Assert(t, !pa.ReviewRequired(identifier.IPIdentifier(net.ParseIP("1.2.3.4"))), "IP identifier requires review")
+}
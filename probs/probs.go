@@ -3,30 +3,36 @@ package probs
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/letsencrypt/boulder/identifier"
 )
 
 // Error types that can be used in ACME payloads
 const (
-	ConnectionProblem            = ProblemType("connection")
-	MalformedProblem             = ProblemType("malformed")
-	ServerInternalProblem        = ProblemType("serverInternal")
-	TLSProblem                   = ProblemType("tls")
-	UnauthorizedProblem          = ProblemType("unauthorized")
-	RateLimitedProblem           = ProblemType("rateLimited")
-	BadNonceProblem              = ProblemType("badNonce")
-	InvalidEmailProblem          = ProblemType("invalidEmail")
-	RejectedIdentifierProblem    = ProblemType("rejectedIdentifier")
-	AccountDoesNotExistProblem   = ProblemType("accountDoesNotExist")
-	CAAProblem                   = ProblemType("caa")
-	DNSProblem                   = ProblemType("dns")
-	AlreadyRevokedProblem        = ProblemType("alreadyRevoked")
-	OrderNotReadyProblem         = ProblemType("orderNotReady")
-	BadSignatureAlgorithmProblem = ProblemType("badSignatureAlgorithm")
-	BadPublicKeyProblem          = ProblemType("badPublicKey")
-	BadRevocationReasonProblem   = ProblemType("badRevocationReason")
-	BadCSRProblem                = ProblemType("badCSR")
+	ConnectionProblem              = ProblemType("connection")
+	MalformedProblem               = ProblemType("malformed")
+	ServerInternalProblem          = ProblemType("serverInternal")
+	TLSProblem                     = ProblemType("tls")
+	UnauthorizedProblem            = ProblemType("unauthorized")
+	RateLimitedProblem             = ProblemType("rateLimited")
+	BadNonceProblem                = ProblemType("badNonce")
+	InvalidEmailProblem            = ProblemType("invalidEmail")
+	RejectedIdentifierProblem      = ProblemType("rejectedIdentifier")
+	AccountDoesNotExistProblem     = ProblemType("accountDoesNotExist")
+	CAAProblem                     = ProblemType("caa")
+	DNSProblem                     = ProblemType("dns")
+	AlreadyRevokedProblem          = ProblemType("alreadyRevoked")
+	OrderNotReadyProblem           = ProblemType("orderNotReady")
+	BadSignatureAlgorithmProblem   = ProblemType("badSignatureAlgorithm")
+	BadPublicKeyProblem            = ProblemType("badPublicKey")
+	BadRevocationReasonProblem     = ProblemType("badRevocationReason")
+	BadCSRProblem                  = ProblemType("badCSR")
+	ExternalAccountRequiredProblem = ProblemType("externalAccountRequired")
+	// AutoRenewalCanceledProblem and AutoRenewalCancellationInvalidProblem are
+	// defined by RFC 8739 Section 3.4
+	AutoRenewalCanceledProblem            = ProblemType("autoRenewalCanceled")
+	AutoRenewalCancellationInvalidProblem = ProblemType("autoRenewalCancellationInvalid")
 
 	V1ErrorNS = "urn:acme:error:"
 	V2ErrorNS = "urn:ietf:params:acme:error:"
@@ -46,6 +52,10 @@ type ProblemDetails struct {
 	// SubProblems are optional additional per-identifier problems. See
 	// RFC 8555 Section 6.7.1: https://tools.ietf.org/html/rfc8555#section-6.7.1
 	SubProblems []SubProblemDetails `json:"subproblems,omitempty"`
+	// RetryAfter is how long the client should wait before retrying the
+	// request, if known. It is sent as a Retry-After header rather than in the
+	// problem document.
+	RetryAfter time.Duration `json:"-"`
 }
 
 // SubProblemDetails represents sub-problems specific to an identifier that are
@@ -68,6 +78,7 @@ func (pd *ProblemDetails) WithSubProblems(subProbs []SubProblemDetails) *Problem
 		Detail:      pd.Detail,
 		HTTPStatus:  pd.HTTPStatus,
 		SubProblems: append(pd.SubProblems, subProbs...),
+		RetryAfter:  pd.RetryAfter,
 	}
 }
 
@@ -99,7 +110,8 @@ func ProblemDetailsToStatusCode(prob *ProblemDetails) int {
 		return http.StatusInternalServerError
 	case
 		UnauthorizedProblem,
-		CAAProblem:
+		CAAProblem,
+		ExternalAccountRequiredProblem:
 		return http.StatusForbidden
 	case RateLimitedProblem:
 		return statusTooManyRequests
@@ -334,3 +346,33 @@ func BadCSR(detail string, a ...interface{}) *ProblemDetails {
 		HTTPStatus: http.StatusBadRequest,
 	}
 }
+
+// ExternalAccountRequired returns a ProblemDetails representing an
+// ExternalAccountRequiredProblem.
+func ExternalAccountRequired(detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:       ExternalAccountRequiredProblem,
+		Detail:     detail,
+		HTTPStatus: http.StatusForbidden,
+	}
+}
+
+// AutoRenewalCanceled returns a ProblemDetails representing an
+// AutoRenewalCanceledProblem
+func AutoRenewalCanceled(detail string, a ...interface{}) *ProblemDetails {
+	return &ProblemDetails{
+		Type:       AutoRenewalCanceledProblem,
+		Detail:     fmt.Sprintf(detail, a...),
+		HTTPStatus: http.StatusForbidden,
+	}
+}
+
+// AutoRenewalCancellationInvalid returns a ProblemDetails representing an
+// AutoRenewalCancellationInvalidProblem
+func AutoRenewalCancellationInvalid(detail string, a ...interface{}) *ProblemDetails {
+	return &ProblemDetails{
+		Type:       AutoRenewalCancellationInvalidProblem,
+		Detail:     fmt.Sprintf(detail, a...),
+		HTTPStatus: http.StatusBadRequest,
+	}
+}
@@ -36,6 +36,7 @@ func TestProblemDetailsToStatusCode(t *testing.T) {
 		{&ProblemDetails{Type: ConnectionProblem, HTTPStatus: 200}, 200},
 		{&ProblemDetails{Type: AccountDoesNotExistProblem}, http.StatusBadRequest},
 		{&ProblemDetails{Type: BadRevocationReasonProblem}, http.StatusBadRequest},
+		{&ProblemDetails{Type: ExternalAccountRequiredProblem}, http.StatusForbidden},
 	}
 
 	for _, c := range testCases {
@@ -64,6 +65,9 @@ func TestProblemDetailsConvenience(t *testing.T) {
 		{RejectedIdentifier("rejected identifier detail"), RejectedIdentifierProblem, http.StatusBadRequest, "rejected identifier detail"},
 		{AccountDoesNotExist("no account detail"), AccountDoesNotExistProblem, http.StatusBadRequest, "no account detail"},
 		{BadRevocationReason("only reason xxx is supported"), BadRevocationReasonProblem, http.StatusBadRequest, "only reason xxx is supported"},
+		{ExternalAccountRequired("eab required detail"), ExternalAccountRequiredProblem, http.StatusForbidden, "eab required detail"},
+		{AutoRenewalCanceled("canceled detail"), AutoRenewalCanceledProblem, http.StatusForbidden, "canceled detail"},
+		{AutoRenewalCancellationInvalid("cancellation detail"), AutoRenewalCancellationInvalidProblem, http.StatusBadRequest, "cancellation detail"},
 	}
 
 	for _, c := range testCases {
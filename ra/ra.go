@@ -11,8 +11,10 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/jmhodges/clock"
 	"github.com/letsencrypt/boulder/akamai"
 	akamaipb "github.com/letsencrypt/boulder/akamai/proto"
@@ -62,6 +64,20 @@ type RegistrationAuthorityImpl struct {
 	PA        core.PolicyAuthority
 	publisher core.Publisher
 	caa       caaChecker
+	// AutoRenewal configures STAR orders. STAR orders are rejected if its
+	// MaxDuration is zero.
+	AutoRenewal AutoRenewalConfig
+	// AuthzReuse limits which valid authorizations are reused when
+	// reuseValidAuthz is set. Its MaxAge is also enforced at issuance.
+	AuthzReuse AuthzReusePolicy
+	// ValidationRetries allows failed validations to be retried without
+	// invalidating their authorization.
+	ValidationRetries ValidationRetryPolicy
+	// CertProfiles describes the certificate profiles orders may select that
+	// leave fields out of issued certificates, keyed by profile name. Other
+	// profiles, including the default one, must issue certificates with a
+	// CommonName and both TLS extended key usages.
+	CertProfiles map[string]CertProfile
 
 	clk       clock.Clock
 	log       blog.Logger
@@ -74,6 +90,16 @@ type RegistrationAuthorityImpl struct {
 	maxNames                     int
 	reuseValidAuthz              bool
 	orderLifetime                time.Duration
+	// How long background issuance for an order may take when the
+	// AsyncFinalize feature is enabled.
+	finalizeTimeout time.Duration
+	// drainWG tracks background issuance goroutines so that they can finish
+	// before the RA exits.
+	drainWG sync.WaitGroup
+	// limiter enforces rlPolicies as token buckets when the
+	// TokenBucketRateLimits feature is enabled. It is nil until
+	// SetRateLimitSource is called.
+	limiter *ratelimit.Limiter
 
 	issuer *x509.Certificate
 	purger akamaipb.AkamaiPurgerClient
@@ -82,6 +108,7 @@ type RegistrationAuthorityImpl struct {
 
 	ctpolicyResults         *prometheus.HistogramVec
 	rateLimitCounter        *prometheus.CounterVec
+	shadowDenialCounter     *prometheus.CounterVec
 	revocationReasonCounter *prometheus.CounterVec
 	namesPerCert            *prometheus.HistogramVec
 	newRegCounter           prometheus.Counter
@@ -90,6 +117,110 @@ type RegistrationAuthorityImpl struct {
 	newCertCounter          prometheus.Counter
 }
 
+// AutoRenewalConfig configures Short-Term, Automatically Renewed (STAR) orders,
+// described in RFC 8739.
+type AutoRenewalConfig struct {
+	// Profile is the name of the certificate profile STAR certificates are
+	// issued with, or empty for the default profile.
+	Profile string
+	// MinLifetime is the shortest certificate lifetime a STAR order may
+	// request.
+	MinLifetime time.Duration
+	// MaxLifetime is the longest certificate lifetime a STAR order may
+	// request. It must not be longer than the validity period of Profile.
+	MaxLifetime time.Duration
+	// MaxDuration is the longest time between the start and end dates of a
+	// STAR order.
+	MaxDuration time.Duration
+	// AllowCertificateGet allows STAR orders to request that their current
+	// certificate may be fetched with unauthenticated GET requests.
+	AllowCertificateGet bool
+}
+
+// CertProfile describes the fields a CA certificate profile leaves out of the
+// certificates it issues. It must agree with the CA's configuration for the
+// profile of the same name.
+type CertProfile struct {
+	// OmitCommonName is true if certificates have no subject CommonName.
+	OmitCommonName bool
+	// OmitClientAuth is true if certificates have only the TLS server
+	// authentication extended key usage.
+	OmitClientAuth bool
+}
+
+// AuthzReusePolicy limits the reuse of valid authorizations. The zero value
+// allows any valid authorization to be reused for its whole lifetime.
+type AuthzReusePolicy struct {
+	// MaxAge is, for each challenge type, how long after validation an
+	// authorization validated with that type may be used. An authorization
+	// older than this is not reused for new orders or authorizations, and
+	// can't be used to issue a certificate. Challenge types without a MaxAge
+	// may be used for the authorization's whole lifetime. Authorizations
+	// whose validation time wasn't recorded are treated as older than any
+	// MaxAge.
+	MaxAge map[core.AcmeChallenge]time.Duration
+	// NoWildcardReuse prevents valid authorizations for wildcard names from
+	// being reused for new orders.
+	NoWildcardReuse bool
+	// NoReuseSuffixes are domains for which, along with their subdomains,
+	// valid authorizations are never reused for new orders or authorizations.
+	NoReuseSuffixes []string
+}
+
+// tooOld returns whether an authorization validated with challType at
+// validated is past its MaxAge at now. An authorization with no recorded
+// validation time is treated as too old if challType has a MaxAge.
+func (p AuthzReusePolicy) tooOld(challType core.AcmeChallenge, validated *time.Time, now time.Time) bool {
+	maxAge, ok := p.MaxAge[challType]
+	return ok && (validated == nil || now.Sub(*validated) > maxAge)
+}
+
+// reusable returns whether an authorization for name validated with
+// challType at validated may be reused at now.
+func (p AuthzReusePolicy) reusable(name string, challType core.AcmeChallenge, validated *time.Time, now time.Time) bool {
+	if strings.HasPrefix(name, "*.") {
+		if p.NoWildcardReuse {
+			return false
+		}
+		name = strings.TrimPrefix(name, "*.")
+	}
+	for _, suffix := range p.NoReuseSuffixes {
+		if name == suffix || strings.HasSuffix(name, "."+suffix) {
+			return false
+		}
+	}
+	return !p.tooOld(challType, validated, now)
+}
+
+// ValidationRetryPolicy allows failed validations to be retried, as RFC 8555
+// Section 8.2 permits. The zero value allows no retries.
+type ValidationRetryPolicy struct {
+	// MaxAttempts is how many times each authorization may be validated. An
+	// authorization only becomes invalid when its last attempt fails.
+	MaxAttempts int
+	// Backoff is how long after its first failed attempt an authorization may
+	// be retried. It doubles after each further failed attempt, up to
+	// MaxBackoff if that is set.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// retryAfter returns when an authorization whose failed-th attempt failed at
+// now may next be retried, or false if it may not be retried.
+func (p ValidationRetryPolicy) retryAfter(failed int, now time.Time) (time.Time, bool) {
+	if failed >= p.MaxAttempts {
+		return time.Time{}, false
+	}
+	backoff := p.Backoff
+	for i := 1; i < failed && (p.MaxBackoff == 0 || backoff < p.MaxBackoff); i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff != 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return now.Add(backoff), true
+}
+
 // NewRegistrationAuthorityImpl constructs a new RA object.
 func NewRegistrationAuthorityImpl(
 	clk clock.Clock,
@@ -104,6 +235,7 @@ func NewRegistrationAuthorityImpl(
 	pubc core.Publisher,
 	caaClient caaChecker,
 	orderLifetime time.Duration,
+	finalizeTimeout time.Duration,
 	ctp *ctpolicy.CTPolicy,
 	purger akamaipb.AkamaiPurgerClient,
 	issuer *x509.Certificate,
@@ -137,6 +269,12 @@ func NewRegistrationAuthorityImpl(
 	}, []string{"limit", "result"})
 	stats.MustRegister(rateLimitCounter)
 
+	shadowDenialCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ra_ratelimit_shadow_denials",
+		Help: "A counter of requests that would have been denied by a rate limit in shadow mode, labelled by limit",
+	}, []string{"limit"})
+	stats.MustRegister(shadowDenialCounter)
+
 	newRegCounter := prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "new_registrations",
 		Help: "A counter of new registrations",
@@ -180,12 +318,14 @@ func NewRegistrationAuthorityImpl(
 		publisher:                    pubc,
 		caa:                          caaClient,
 		orderLifetime:                orderLifetime,
+		finalizeTimeout:              finalizeTimeout,
 		ctpolicy:                     ctp,
 		ctpolicyResults:              ctpolicyResults,
 		purger:                       purger,
 		issuer:                       issuer,
 		namesPerCert:                 namesPerCert,
 		rateLimitCounter:             rateLimitCounter,
+		shadowDenialCounter:          shadowDenialCounter,
 		newRegCounter:                newRegCounter,
 		reusedValidAuthzCounter:      reusedValidAuthzCounter,
 		recheckCAACounter:            recheckCAACounter,
@@ -204,6 +344,13 @@ func (ra *RegistrationAuthorityImpl) SetRateLimitPoliciesFile(filename string) e
 	return nil
 }
 
+// SetRateLimitSource sets the key-value store holding the token buckets used to
+// enforce the rate limit policies when the TokenBucketRateLimits feature is
+// enabled.
+func (ra *RegistrationAuthorityImpl) SetRateLimitSource(source ratelimit.Source) {
+	ra.limiter = ratelimit.NewLimiter(ra.clk, source, ra.rlPolicies)
+}
+
 func (ra *RegistrationAuthorityImpl) rateLimitPoliciesLoadError(err error) {
 	ra.log.Errf("error reloading rate limit policy: %s", err)
 }
@@ -245,6 +392,9 @@ type certificateRequestEvent struct {
 	ResponseTime time.Time `json:",omitempty"`
 	// Error contains any encountered errors
 	Error string `json:",omitempty"`
+	// CertProfile is the name of the certificate profile selected for the
+	// order, empty for the default profile
+	CertProfile string `json:",omitempty"`
 	// Authorizations is a map of identifier names to certificateRequestAuthz
 	// objects. It can be used to understand how the names in a certificate
 	// request were authorized.
@@ -257,13 +407,30 @@ const noRegistrationID = -1
 
 // registrationCounter is a type to abstract the use of
 // ra.SA.CountRegistrationsByIP or ra.SA.CountRegistrationsByIPRange
-type registrationCounter func(context.Context, net.IP, time.Time, time.Time) (int, error)
+type registrationCounter func(context.Context, net.IP, time.Time, time.Time) (int, time.Time, error)
+
+// retryAfter returns how long it will be, at now, until the oldest event
+// counted towards limit, which happened at oldest, leaves its window. If the SA
+// didn't say when that was it's a full window, which is as long as the client
+// can have to wait.
+func retryAfter(limit ratelimit.RateLimitPolicy, oldest, now time.Time) time.Duration {
+	if oldest.IsZero() {
+		return limit.Window.Duration
+	}
+	retryIn := oldest.Add(limit.Window.Duration).Sub(now)
+	if retryIn < 0 {
+		return 0
+	}
+	return retryIn
+}
 
 // checkRegistrationIPLimit checks a specific registraton limit by using the
 // provided registrationCounter function to determine if the limit has been
-// exceeded for a given IP or IP range
+// exceeded for a given IP or IP range. name is the name of the limit, for
+// reporting denials in shadow mode.
 func (ra *RegistrationAuthorityImpl) checkRegistrationIPLimit(
 	ctx context.Context,
+	name ratelimit.Name,
 	limit ratelimit.RateLimitPolicy,
 	ip net.IP,
 	counter registrationCounter) error {
@@ -274,13 +441,17 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationIPLimit(
 
 	now := ra.clk.Now()
 	windowBegin := limit.WindowBegin(now)
-	count, err := counter(ctx, ip, windowBegin, now)
+	count, oldest, err := counter(ctx, ip, windowBegin, now)
 	if err != nil {
 		return err
 	}
 
 	if count >= limit.GetThreshold(ip.String(), noRegistrationID) {
-		return berrors.RateLimitError("too many registrations for this IP")
+		if limit.Shadow {
+			ra.shadowDenial(name, ip.String(), 0)
+			return nil
+		}
+		return berrors.RateLimitError("too many registrations for this IP").(*berrors.BoulderError).WithRetryAfter(retryAfter(limit, oldest, now))
 	}
 
 	return nil
@@ -292,7 +463,7 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationLimits(ctx context.Context
 	// Check the registrations per IP limit using the CountRegistrationsByIP SA
 	// function that matches IP addresses exactly
 	exactRegLimit := ra.rlPolicies.RegistrationsPerIP()
-	err := ra.checkRegistrationIPLimit(ctx, exactRegLimit, ip, ra.SA.CountRegistrationsByIP)
+	err := ra.checkRegistrationIPLimit(ctx, ratelimit.RegistrationsPerIP, exactRegLimit, ip, ra.SA.CountRegistrationsByIP)
 	if err != nil {
 		ra.rateLimitCounter.WithLabelValues("registrations_by_ip", "exceeded").Inc()
 		ra.log.Infof("Rate limit exceeded, RegistrationsByIP, IP: %s", ip)
@@ -311,13 +482,17 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationLimits(ctx context.Context
 	// CountRegistrationsByIPRange SA function that fuzzy-matches IPv6 addresses
 	// within a larger address range
 	fuzzyRegLimit := ra.rlPolicies.RegistrationsPerIPRange()
-	err = ra.checkRegistrationIPLimit(ctx, fuzzyRegLimit, ip, ra.SA.CountRegistrationsByIPRange)
+	err = ra.checkRegistrationIPLimit(ctx, ratelimit.RegistrationsPerIPRange, fuzzyRegLimit, ip, ra.SA.CountRegistrationsByIPRange)
 	if err != nil {
 		ra.rateLimitCounter.WithLabelValues("registrations_by_ip_range", "exceeded").Inc()
 		ra.log.Infof("Rate limit exceeded, RegistrationsByIPRange, IP: %s", ip)
 		// For the fuzzyRegLimit we use a new error message that specifically
 		// mentions that the limit being exceeded is applied to a *range* of IPs
-		return berrors.RateLimitError("too many registrations for this IP range")
+		retryIn := fuzzyRegLimit.Window.Duration
+		if bErr, ok := err.(*berrors.BoulderError); ok && bErr.Type == berrors.RateLimit {
+			retryIn = bErr.RetryAfter
+		}
+		return berrors.RateLimitError("too many registrations for this IP range").(*berrors.BoulderError).WithRetryAfter(retryIn)
 	}
 	ra.rateLimitCounter.WithLabelValues("registrations_by_ip_range", "pass").Inc()
 
@@ -326,7 +501,10 @@ func (ra *RegistrationAuthorityImpl) checkRegistrationLimits(ctx context.Context
 
 // NewRegistration constructs a new Registration from a request.
 func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, init core.Registration) (core.Registration, error) {
-	if err := ra.keyPolicy.GoodKey(ctx, init.Key.Key); err != nil {
+	// Account keys may be Ed25519 keys even though certificate keys may not.
+	accountKeyPolicy := ra.keyPolicy
+	accountKeyPolicy.AllowEd25519 = true
+	if err := accountKeyPolicy.GoodKey(ctx, init.Key.Key); err != nil {
 		return core.Registration{}, berrors.MalformedError("invalid public key: %s", err.Error())
 	}
 	if err := ra.checkRegistrationLimits(ctx, init.InitialIP); err != nil {
@@ -339,9 +517,10 @@ func (ra *RegistrationAuthorityImpl) NewRegistration(ctx context.Context, init c
 	}
 	_ = mergeUpdate(&reg, init)
 
-	// This field isn't updatable by the end user, so it isn't copied by
-	// MergeUpdate. But we need to fill it in for new registrations.
+	// These fields aren't updatable by the end user, so they aren't copied by
+	// MergeUpdate. But we need to fill them in for new registrations.
 	reg.InitialIP = init.InitialIP
+	reg.ExternalAccountID = init.ExternalAccountID
 
 	if err := ra.validateContacts(ctx, reg.Contact); err != nil {
 		return core.Registration{}, err
@@ -435,6 +614,10 @@ func (ra *RegistrationAuthorityImpl) checkPendingAuthorizationLimit(ctx context.
 		// here.
 		noKey := ""
 		if int(*countPB.Count) >= limit.GetThreshold(noKey, regID) {
+			if limit.Shadow {
+				ra.shadowDenial(ratelimit.PendingAuthorizationsPerAccount, noKey, regID)
+				return nil
+			}
 			ra.rateLimitCounter.WithLabelValues("pending_authorizations_by_registration_id", "exceeded").Inc()
 			ra.log.Infof("Rate limit exceeded, PendingAuthorizationsByRegID, regID: %d", regID)
 			return berrors.RateLimitError("too many currently pending authorizations")
@@ -469,6 +652,28 @@ func (ra *RegistrationAuthorityImpl) checkInvalidAuthorizationLimit(ctx context.
 	if !limit.Enabled() {
 		return nil
 	}
+	count, oldest, err := ra.countInvalidAuthorizations(ctx, regID, hostname, limit)
+	if err != nil {
+		return err
+	}
+	// Most rate limits have a key for overrides, but there is no meaningful key
+	// here.
+	noKey := ""
+	if count >= int64(limit.GetThreshold(noKey, regID)) {
+		if limit.Shadow {
+			ra.shadowDenial(ratelimit.InvalidAuthorizationsPerAccount, hostname, regID)
+			return nil
+		}
+		ra.log.Infof("Rate limit exceeded, InvalidAuthorizationsByRegID, regID: %d", regID)
+		return berrors.RateLimitError("too many failed authorizations recently").(*berrors.BoulderError).WithRetryAfter(retryAfter(limit, oldest, ra.clk.Now()))
+	}
+	return nil
+}
+
+// countInvalidAuthorizations returns the number of invalid authorizations for
+// hostname that count towards the InvalidAuthorizationsPerAccount limit of
+// regID, and when the oldest of them started to count.
+func (ra *RegistrationAuthorityImpl) countInvalidAuthorizations(ctx context.Context, regID int64, hostname string, limit ratelimit.RateLimitPolicy) (int64, time.Time, error) {
 	latest := ra.clk.Now().Add(ra.pendingAuthorizationLifetime)
 	earliest := latest.Add(-limit.Window.Duration)
 	latestNanos := latest.UnixNano()
@@ -483,16 +688,16 @@ func (ra *RegistrationAuthorityImpl) checkInvalidAuthorizationLimit(ctx context.
 	}
 	count, err := ra.SA.CountInvalidAuthorizations2(ctx, req)
 	if err != nil {
-		return err
+		return 0, time.Time{}, err
 	}
-	// Most rate limits have a key for overrides, but there is no meaningful key
-	// here.
-	noKey := ""
-	if *count.Count >= int64(limit.GetThreshold(noKey, regID)) {
-		ra.log.Infof("Rate limit exceeded, InvalidAuthorizationsByRegID, regID: %d", regID)
-		return berrors.RateLimitError("too many failed authorizations recently")
+	// Authorizations are counted by when they expire, so the window is shifted
+	// forward by the pending authorization lifetime, and the earliest expiry
+	// counted is shifted back to compare with the window's start.
+	oldest := bgrpc.PBToEarliest(count.GetEarliest())
+	if !oldest.IsZero() {
+		oldest = oldest.Add(-ra.pendingAuthorizationLifetime)
 	}
-	return nil
+	return *count.Count, oldest, nil
 }
 
 // checkNewOrdersPerAccountLimit enforces the rlPolicies `NewOrdersPerAccount`
@@ -503,17 +708,38 @@ func (ra *RegistrationAuthorityImpl) checkNewOrdersPerAccountLimit(ctx context.C
 	if !limit.Enabled() {
 		return nil
 	}
+	if ra.useTokenBucketRateLimits() {
+		// There is no meaningful override key to use for this rate limit
+		decision, err := ra.limiter.Spend(ctx, ratelimit.NewOrdersPerAccount, "", acctID, 1)
+		if err != nil {
+			return err
+		}
+		if !decision.Allowed {
+			if limit.Shadow {
+				ra.shadowDenial(ratelimit.NewOrdersPerAccount, "", acctID)
+				return nil
+			}
+			ra.rateLimitCounter.WithLabelValues("new_order_by_registration_id", "exceeded").Inc()
+			return berrors.RateLimitError("too many new orders recently").(*berrors.BoulderError).WithRetryAfter(decision.RetryIn)
+		}
+		ra.rateLimitCounter.WithLabelValues("new_order_by_registration_id", "pass").Inc()
+		return nil
+	}
 	latest := ra.clk.Now()
 	earliest := latest.Add(-limit.Window.Duration)
-	count, err := ra.SA.CountOrders(ctx, acctID, earliest, latest)
+	count, oldest, err := ra.SA.CountOrders(ctx, acctID, earliest, latest)
 	if err != nil {
 		return err
 	}
 	// There is no meaningful override key to use for this rate limit
 	noKey := ""
 	if count >= limit.GetThreshold(noKey, acctID) {
+		if limit.Shadow {
+			ra.shadowDenial(ratelimit.NewOrdersPerAccount, noKey, acctID)
+			return nil
+		}
 		ra.rateLimitCounter.WithLabelValues("new_order_by_registration_id", "exceeded").Inc()
-		return berrors.RateLimitError("too many new orders recently")
+		return berrors.RateLimitError("too many new orders recently").(*berrors.BoulderError).WithRetryAfter(retryAfter(limit, oldest, latest))
 	}
 	ra.rateLimitCounter.WithLabelValues("new_order_by_registration_id", "pass").Inc()
 	return nil
@@ -522,7 +748,33 @@ func (ra *RegistrationAuthorityImpl) checkNewOrdersPerAccountLimit(ctx context.C
 // NewAuthorization constructs a new Authz from a request. Values (domains) in
 // request.Identifier will be lowercased before storage.
 func (ra *RegistrationAuthorityImpl) NewAuthorization(ctx context.Context, request core.Authorization, regID int64) (core.Authorization, error) {
-	identifier := request.Identifier
+	return ra.newAuthorization(ctx, request.Identifier, regID, true)
+}
+
+// NewPreAuthorization constructs a new Authz for a single identifier outside
+// of an order, as described in RFC 8555 Section 7.4.1. Wildcard identifiers
+// are rejected since the identifier of a pre-authorization is exactly the
+// identifier being authorized.
+func (ra *RegistrationAuthorityImpl) NewPreAuthorization(ctx context.Context, req *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	ident := identifier.ACMEIdentifier{
+		Type:  identifier.IdentifierType(*req.IdentifierType),
+		Value: *req.IdentifierValue,
+	}
+	if strings.Contains(ident.Value, "*") {
+		return nil, berrors.MalformedError("pre-authorization of wildcard identifiers is not supported")
+	}
+	authz, err := ra.newAuthorization(ctx, ident, *req.RegistrationID, false)
+	if err != nil {
+		return nil, err
+	}
+	return bgrpc.AuthzToPB(authz)
+}
+
+// newAuthorization returns a valid or pending authorization for the given
+// identifier, reusing an existing one where possible and otherwise creating a
+// new pending authorization. The acmeV1 argument indicates the request came
+// from the ACMEv1 API, which is subject to the V1DisableNewValidations feature.
+func (ra *RegistrationAuthorityImpl) newAuthorization(ctx context.Context, identifier identifier.ACMEIdentifier, regID int64, acmeV1 bool) (core.Authorization, error) {
 	identifier.Value = strings.ToLower(identifier.Value)
 
 	// Check that the identifier is present and appropriate
@@ -530,6 +782,10 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(ctx context.Context, reque
 		return core.Authorization{}, err
 	}
 
+	if err := ra.checkAccountScope(ctx, regID, identsForNames([]string{identifier.Value})); err != nil {
+		return core.Authorization{}, err
+	}
+
 	if err := ra.checkPendingAuthorizationLimit(ctx, regID); err != nil {
 		return core.Authorization{}, err
 	}
@@ -561,7 +817,7 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(ctx context.Context, reque
 		}
 
 		if existingAuthz, ok := auths[identifier.Value]; ok {
-			if ra.authzValidChallengeEnabled(existingAuthz) {
+			if ra.authzValidChallengeEnabled(existingAuthz) && ra.authzReusePermitted(existingAuthz) {
 				// The existing authorization must not expire within the next 24 hours for
 				// it to be OK for reuse
 				reuseCutOff := ra.clk.Now().Add(time.Hour * 24)
@@ -592,7 +848,7 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(ctx context.Context, reque
 		return bgrpc.PBToAuthz(pendingPB)
 	}
 
-	if features.Enabled(features.V1DisableNewValidations) {
+	if acmeV1 && features.Enabled(features.V1DisableNewValidations) {
 		exists, err := ra.SA.PreviousCertificateExists(ctx, &sapb.PreviousCertificateExistsRequest{
 			Domain: &identifier.Value,
 			RegID:  &regID,
@@ -628,19 +884,28 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(ctx context.Context, reque
 }
 
 // MatchesCSR tests the contents of a generated certificate to make sure
-// that the PublicKey, CommonName, and DNSNames match those provided in
-// the CSR that was used to generate the certificate. It also checks the
+// that the PublicKey, CommonName, DNSNames and IPAddresses match those provided
+// in the CSR that was used to generate the certificate. It also checks the
 // following fields for:
 //		* notBefore is not more than 24 hours ago
 //		* BasicConstraintsValid is true
 //		* IsCA is false
 //		* ExtKeyUsage only contains ExtKeyUsageServerAuth & ExtKeyUsageClientAuth
 //		* Subject only contains CommonName & Names
-func (ra *RegistrationAuthorityImpl) MatchesCSR(parsedCertificate *x509.Certificate, csr *x509.CertificateRequest) error {
+// The CommonName and ExtKeyUsageClientAuth must instead be absent if the named
+// certificate profile omits them.
+func (ra *RegistrationAuthorityImpl) MatchesCSR(parsedCertificate *x509.Certificate, csr *x509.CertificateRequest, profile string) error {
+	certProfile := ra.CertProfiles[profile]
 	// Check issued certificate matches what was expected from the CSR
 	hostNames := make([]string, len(csr.DNSNames))
 	copy(hostNames, csr.DNSNames)
-	if len(csr.Subject.CommonName) > 0 {
+	ips := make([]net.IP, len(csr.IPAddresses))
+	copy(ips, csr.IPAddresses)
+	// A CommonName that is an IP address is expected to be among the
+	// certificate's IPAddresses rather than its DNSNames.
+	if cnIP := net.ParseIP(csr.Subject.CommonName); cnIP != nil {
+		ips = append(ips, cnIP)
+	} else if len(csr.Subject.CommonName) > 0 {
 		hostNames = append(hostNames, csr.Subject.CommonName)
 	}
 	hostNames = core.UniqueLowerNames(hostNames)
@@ -648,7 +913,11 @@ func (ra *RegistrationAuthorityImpl) MatchesCSR(parsedCertificate *x509.Certific
 	if !core.KeyDigestEquals(parsedCertificate.PublicKey, csr.PublicKey) {
 		return berrors.InternalServerError("generated certificate public key doesn't match CSR public key")
 	}
-	if parsedCertificate.Subject.CommonName != strings.ToLower(csr.Subject.CommonName) {
+	if certProfile.OmitCommonName {
+		if parsedCertificate.Subject.CommonName != "" {
+			return berrors.InternalServerError("generated certificate has a CommonName but profile %q omits it", profile)
+		}
+	} else if parsedCertificate.Subject.CommonName != strings.ToLower(csr.Subject.CommonName) {
 		return berrors.InternalServerError("generated certificate CommonName doesn't match CSR CommonName")
 	}
 	// Sort both slices of names before comparison.
@@ -658,7 +927,9 @@ func (ra *RegistrationAuthorityImpl) MatchesCSR(parsedCertificate *x509.Certific
 	if !reflect.DeepEqual(parsedNames, hostNames) {
 		return berrors.InternalServerError("generated certificate DNSNames don't match CSR DNSNames")
 	}
-	if !reflect.DeepEqual(parsedCertificate.IPAddresses, csr.IPAddresses) {
+	// Compare IP addresses in their canonical textual form since the same IPv4
+	// address may be represented by a 4 or 16 byte net.IP
+	if !reflect.DeepEqual(core.UniqueLowerNamesAndIPs(nil, parsedCertificate.IPAddresses), core.UniqueLowerNamesAndIPs(nil, ips)) {
 		return berrors.InternalServerError("generated certificate IPAddresses don't match CSR IPAddresses")
 	}
 	if !reflect.DeepEqual(parsedCertificate.EmailAddresses, csr.EmailAddresses) {
@@ -680,7 +951,11 @@ func (ra *RegistrationAuthorityImpl) MatchesCSR(parsedCertificate *x509.Certific
 	if parsedCertificate.IsCA {
 		return berrors.InternalServerError("generated certificate can sign other certificates")
 	}
-	if !reflect.DeepEqual(parsedCertificate.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}) {
+	expectedEKU := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	if certProfile.OmitClientAuth {
+		expectedEKU = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	}
+	if !reflect.DeepEqual(parsedCertificate.ExtKeyUsage, expectedEKU) {
 		return berrors.InternalServerError("generated certificate doesn't have correct key usage extensions")
 	}
 
@@ -785,8 +1060,13 @@ func (ra *RegistrationAuthorityImpl) checkAuthorizationsCAA(
 			return berrors.InternalServerError("found an authorization with a nil Expires field: id %s", authz.ID)
 		} else if authz.Expires.Before(now) {
 			badNames = append(badNames, name)
-		} else if authz.Expires.Before(caaRecheckTime) {
-			// Ensure that CAA is rechecked for this name
+		} else if ra.authzTooOld(authz, now) {
+			// The authorization was validated with a challenge type whose
+			// maximum age under the authz reuse policy has passed.
+			badNames = append(badNames, name)
+		} else if authz.Expires.Before(caaRecheckTime) && authz.Identifier.Type != identifier.IP {
+			// Ensure that CAA is rechecked for this name. There are no CAA records
+			// for IP addresses so there is nothing to recheck for IP identifiers.
 			recheckAuthzs = append(recheckAuthzs, authz)
 		}
 	}
@@ -810,7 +1090,8 @@ func (ra *RegistrationAuthorityImpl) checkAuthorizationsCAA(
 // recheckCAA accepts a list of of names that need to have their CAA records
 // rechecked because their associated authorizations are sufficiently old and
 // performs the CAA checks required for each. If any of the rechecks fail an
-// error is returned.
+// error is returned. The VA performs each recheck from the perspective of its
+// remote VAs as well as its own.
 func (ra *RegistrationAuthorityImpl) recheckCAA(ctx context.Context, authzs []*core.Authorization) error {
 	ra.recheckCAACounter.Add(float64(len(authzs)))
 
@@ -928,8 +1209,10 @@ func (ra *RegistrationAuthorityImpl) failOrder(
 // unexpired authorizations for all of its associated names an error is
 // returned. Similarly we vet that all of the names in the order are acceptable
 // based on current policy and return an error if the order can't be fulfilled.
-// If successful the order will be returned in processing status for the client
-// to poll while awaiting finalization to occur.
+// If the AsyncFinalize feature is enabled the order is returned in processing
+// status as soon as it has been checked, for the client to poll while issuance
+// continues in the background. Otherwise the order is returned once issuance
+// has completed.
 func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rapb.FinalizeOrderRequest) (*corepb.Order, error) {
 	order := req.Order
 
@@ -958,9 +1241,9 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		return nil, err
 	}
 
-	// Dedupe, lowercase and sort both the names from the CSR and the names in the
-	// order.
-	csrNames := core.UniqueLowerNames(csrOb.DNSNames)
+	// Dedupe, lowercase and sort both the names (including IP addresses) from
+	// the CSR and the names in the order.
+	csrNames := csrlib.Names(csrOb)
 	orderNames := core.UniqueLowerNames(order.Names)
 
 	// Immediately reject the request if the number of names differ
@@ -975,9 +1258,13 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		}
 	}
 
-	// Update the order to be status processing - we issue synchronously at the
-	// present time so this is somewhat artificial/unnecessary but allows planning
-	// for the future.
+	// The account may have been restricted to other domains since the order
+	// was created.
+	if err := ra.checkAccountScope(ctx, *order.RegistrationID, identsForNames(orderNames)); err != nil {
+		return nil, err
+	}
+
+	// Update the order to be status processing.
 	//
 	// NOTE(@cpu): After this point any errors that are encountered must update
 	// the state of the order to invalid by setting the order's error field.
@@ -991,13 +1278,171 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		return nil, err
 	}
 
-	// Attempt issuance for the order. If the order isn't fully authorized this
-	// will return an error.
+	// Orders containing names that the hostname policy requires review for are
+	// held until an operator approves or rejects them with ReviewHeldOrder.
+	var reviewNames []string
+	for _, name := range orderNames {
+		if ra.PA.ReviewRequired(identifier.ForName(name)) {
+			reviewNames = append(reviewNames, name)
+		}
+	}
+	if len(reviewNames) > 0 {
+		return ra.holdOrder(ctx, order, req.Csr, reviewNames)
+	}
+
 	issueReq := core.CertificateRequest{
 		Bytes: req.Csr,
 		CSR:   csrOb,
 	}
-	cert, err := ra.issueCertificate(ctx, issueReq, accountID(*order.RegistrationID), orderID(*order.Id))
+
+	if features.Enabled(features.AsyncFinalize) {
+		// Issue in the background with a context that isn't cancelled when this
+		// RPC returns. Any error is recorded on the order by
+		// issueCertificateForOrder, and if the RA stops before then the order is
+		// failed by FailStaleProcessingOrders. The goroutine gets its own copy of
+		// the order since the one returned here is marshalled concurrently.
+		bgOrder := proto.Clone(order).(*corepb.Order)
+		ra.drainWG.Add(1)
+		go func() {
+			defer ra.drainWG.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), ra.finalizeTimeout)
+			defer cancel()
+			_, _ = ra.issueCertificateForOrder(ctx, bgOrder, issueReq)
+		}()
+
+		beganProcessing := true
+		processingStatus := string(core.StatusProcessing)
+		order.BeganProcessing = &beganProcessing
+		order.Status = &processingStatus
+		return order, nil
+	}
+
+	return ra.issueCertificateForOrder(ctx, order, issueReq)
+}
+
+// holdOrder parks an order that has been set to processing status until the
+// given names have been reviewed. The order stays in processing status for the
+// client to poll in the meantime.
+func (ra *RegistrationAuthorityImpl) holdOrder(ctx context.Context, order *corepb.Order, csr []byte, reviewNames []string) (*corepb.Order, error) {
+	_, err := ra.SA.HoldOrder(ctx, &sapb.HeldOrder{
+		OrderID:        order.Id,
+		RegistrationID: order.RegistrationID,
+		ReviewNames:    reviewNames,
+		Csr:            csr,
+	})
+	if err != nil {
+		ra.failOrder(ctx, order, probs.ServerInternal("Error holding order for review"))
+		return nil, err
+	}
+	ra.log.AuditInfof("Holding order %d for review of names %q", *order.Id, reviewNames)
+
+	beganProcessing := true
+	processingStatus := string(core.StatusProcessing)
+	order.BeganProcessing = &beganProcessing
+	order.Status = &processingStatus
+	return order, nil
+}
+
+// ReviewHeldOrder resumes an order that was held for review when it was
+// finalized. If the order is approved its certificate is issued as it would
+// have been when it was finalized, otherwise the order is failed. The SA
+// checks that the order can still be issued a certificate when releasing it;
+// an order that can't, e.g. because it expired while it was held, is removed
+// from review and an OrderNotReady error is returned.
+func (ra *RegistrationAuthorityImpl) ReviewHeldOrder(ctx context.Context, req *rapb.ReviewHeldOrderRequest) (*corepb.Order, error) {
+	if req.GetActor() == "" || req.GetJustification() == "" {
+		return nil, berrors.MalformedError("reviewing a held order requires an actor and a justification")
+	}
+	held, err := ra.SA.ReleaseHeldOrder(ctx, &sapb.ReleaseHeldOrderRequest{OrderID: req.OrderID})
+	if err != nil {
+		if berrors.Is(err, berrors.OrderNotReady) {
+			ra.log.AuditInfof("Order %d removed from review by %s without being reviewed: %s",
+				req.GetOrderID(), req.GetActor(), err)
+		}
+		return nil, err
+	}
+	useV2Authzs := true
+	order, err := ra.SA.GetOrder(ctx, &sapb.OrderRequest{Id: req.OrderID, UseV2Authorizations: &useV2Authzs})
+	if err != nil {
+		return nil, err
+	}
+
+	if !*req.Approve {
+		ra.log.AuditInfof("Order %d rejected by %s after review of names %q: %s",
+			*order.Id, *req.Actor, held.ReviewNames, *req.Justification)
+		ra.failOrder(ctx, order, probs.RejectedIdentifier(fmt.Sprintf(
+			"Issuance for %s was refused after review, please contact the CA for more information",
+			strings.Join(held.ReviewNames, ", "))))
+		invalidStatus := string(core.StatusInvalid)
+		order.Status = &invalidStatus
+		return order, nil
+	}
+
+	ra.log.AuditInfof("Order %d approved by %s after review of names %q: %s",
+		*order.Id, *req.Actor, held.ReviewNames, *req.Justification)
+	csr, err := x509.ParseCertificateRequest(held.Csr)
+	if err != nil {
+		ra.failOrder(ctx, order, probs.ServerInternal("Error parsing held order CSR"))
+		return nil, err
+	}
+	return ra.issueCertificateForOrder(ctx, order, core.CertificateRequest{
+		Bytes: held.Csr,
+		CSR:   csr,
+	})
+}
+
+// replacedNames returns the names on the certificate with the given serial,
+// which an order replacing it may be issued for without counting against the
+// CertificatesPerName limit.
+func (ra *RegistrationAuthorityImpl) replacedNames(ctx context.Context, serial string) ([]string, error) {
+	cert, err := ra.SA.GetCertificate(ctx, serial)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := x509.ParseCertificate(cert.DER)
+	if err != nil {
+		return nil, err
+	}
+	return core.UniqueLowerNamesAndIPs(parsed.DNSNames, parsed.IPAddresses), nil
+}
+
+// claimedReplacementNames checks that order still holds the claim, taken when
+// it was created, to replace the certificate named by its replaces field and
+// returns the names on that certificate. The SA releases the claim once the
+// order fails or expires, so an order may only be issued a replacement while
+// no other order has taken it over.
+func (ra *RegistrationAuthorityImpl) claimedReplacementNames(ctx context.Context, order *corepb.Order) ([]string, error) {
+	useV2Authzs := true
+	current, err := ra.SA.GetOrder(ctx, &sapb.OrderRequest{Id: order.Id, UseV2Authorizations: &useV2Authzs})
+	if err != nil {
+		return nil, err
+	}
+	if current.GetReplaces() != order.GetReplaces() {
+		return nil, berrors.DuplicateError("order no longer replaces certificate %s", order.GetReplaces())
+	}
+	return ra.replacedNames(ctx, order.GetReplaces())
+}
+
+// issueCertificateForOrder issues a certificate for an order that has been set
+// to processing status, and finalizes the order with the certificate serial.
+// Any error encountered is also recorded on the order so that it does not stay
+// in processing status.
+func (ra *RegistrationAuthorityImpl) issueCertificateForOrder(
+	ctx context.Context,
+	order *corepb.Order,
+	issueReq core.CertificateRequest) (*corepb.Order, error) {
+	// Attempt issuance for the order. If the order isn't fully authorized, or
+	// no longer holds the claim to replace the certificate it replaces, this
+	// will return an error.
+	var replacedNames []string
+	var err error
+	if order.GetReplaces() != "" {
+		replacedNames, err = ra.claimedReplacementNames(ctx, order)
+	}
+	var cert core.Certificate
+	if err == nil {
+		cert, err = ra.issueCertificate(ctx, issueReq, accountID(*order.RegistrationID), orderID(*order.Id), replacedNames, order.AutoRenewal, order.GetCertProfile())
+	}
 	if err != nil {
 		// Fail the order. The problem is computed using
 		// `web.ProblemDetailsForError`, the same function the WFE uses to convert
@@ -1028,6 +1473,23 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 		return nil, err
 	}
 
+	// Start renewing STAR orders now that their first certificate has been
+	// issued.
+	if order.AutoRenewal != nil {
+		nextIssuance := autoRenewalDue(parsedCertificate).UnixNano()
+		_, err := ra.SA.SetAutoRenewalCertificate(ctx, &sapb.SetAutoRenewalCertificateRequest{
+			OrderID:      order.Id,
+			Serial:       &serial,
+			Csr:          issueReq.Bytes,
+			NextIssuance: &nextIssuance,
+		})
+		if err != nil {
+			ra.failOrder(ctx, order, probs.ServerInternal("Error persisting order auto-renewal"))
+			return nil, err
+		}
+		order.AutoRenewal.CurrentSerial = &serial
+	}
+
 	// Note how many names were in this finalized certificate order.
 	ra.namesPerCert.With(
 		prometheus.Labels{"type": "issued"},
@@ -1040,6 +1502,195 @@ func (ra *RegistrationAuthorityImpl) FinalizeOrder(ctx context.Context, req *rap
 	return order, nil
 }
 
+// Drain blocks until all background issuance started by FinalizeOrder has
+// finished. It should be called after the RA has stopped serving requests.
+func (ra *RegistrationAuthorityImpl) Drain() {
+	ra.drainWG.Wait()
+}
+
+// staleProcessingOrdersBatchSize is the maximum number of stale processing
+// orders failed by a single call to FailStaleProcessingOrders.
+const staleProcessingOrdersBatchSize = 1000
+
+// FailStaleProcessingOrders sets an error on orders that began processing more
+// than twice the finalize timeout ago and still haven't been finalized. Such
+// orders were abandoned by an RA that stopped, e.g. because it was restarted,
+// before issuance completed; no RA is still working on them since background
+// issuance is cancelled once the finalize timeout passes. It returns the number
+// of orders that were failed.
+func (ra *RegistrationAuthorityImpl) FailStaleProcessingOrders(ctx context.Context) (int, error) {
+	beganBefore := ra.clk.Now().Add(-2 * ra.finalizeTimeout).UnixNano()
+	limit := int64(staleProcessingOrdersBatchSize)
+	orderIDs, err := ra.SA.GetStaleProcessingOrders(ctx, &sapb.GetStaleProcessingOrdersRequest{
+		BeganBefore: &beganBefore,
+		Limit:       &limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var failed int
+	useV2Authzs := true
+	for _, id := range orderIDs.Ids {
+		id := id
+		order, err := ra.SA.GetOrder(ctx, &sapb.OrderRequest{Id: &id, UseV2Authorizations: &useV2Authzs})
+		if err != nil && !berrors.Is(err, berrors.NotFound) {
+			ra.log.AuditErrf("Could not get stale processing order %d: %s", id, err)
+			continue
+		}
+		// An order that no longer exists, or that was finalized after all,
+		// doesn't need failing, but its row must still be deleted so that it
+		// stops being returned as stale.
+		if err != nil || order.GetStatus() == string(core.StatusValid) {
+			_, err = ra.SA.DeleteProcessingOrder(ctx, &sapb.OrderRequest{Id: &id})
+			if err != nil {
+				ra.log.AuditErrf("Could not delete stale processing order %d: %s", id, err)
+			}
+			continue
+		}
+		// Orders that expired while processing are reported as invalid rather
+		// than processing, but still need their error set so that they stop
+		// being returned as stale.
+		ra.log.AuditInfof("Failing order %d: processing was interrupted", id)
+		ra.failOrder(ctx, order, probs.ServerInternal("Order processing was interrupted, please finalize a new order"))
+		failed++
+	}
+	return failed, nil
+}
+
+// autoRenewalsBatchSize is the maximum number of STAR orders renewed by a
+// single call to ProcessAutoRenewals.
+const autoRenewalsBatchSize = 100
+
+// autoRenewalDue returns when the STAR order that cert was issued for is due
+// its next certificate: halfway through cert's validity period, so that the
+// validity periods of consecutive certificates overlap.
+func autoRenewalDue(cert *x509.Certificate) time.Time {
+	return cert.NotBefore.Add(cert.NotAfter.Sub(cert.NotBefore) / 2)
+}
+
+// ProcessAutoRenewals issues a new certificate for each STAR order (RFC 8739)
+// that is due one. Every RA runs ProcessAutoRenewals, so each order is first
+// claimed so that only one RA issues it a certificate. Issuance goes through
+// the same path as finalization, so each order's authorizations must still be
+// valid and CAA is rechecked as needed. If a certificate can't be issued
+// because the order is no longer authorized, the order's auto-renewal is
+// canceled; other failures are retried once the claim expires, twice the
+// finalize timeout later. It returns the number of certificates issued.
+func (ra *RegistrationAuthorityImpl) ProcessAutoRenewals(ctx context.Context) (int, error) {
+	now := ra.clk.Now()
+	nowNS := now.UnixNano()
+	limit := int64(autoRenewalsBatchSize)
+	due, err := ra.SA.GetDueAutoRenewals(ctx, &sapb.GetDueAutoRenewalsRequest{
+		Now:   &nowNS,
+		Limit: &limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	claimUntil := now.Add(2 * ra.finalizeTimeout).UnixNano()
+	var renewed int
+	for _, r := range due.Renewals {
+		claimed, err := ra.SA.ClaimAutoRenewal(ctx, &sapb.ClaimAutoRenewalRequest{
+			OrderID:      r.OrderID,
+			NextIssuance: r.NextIssuance,
+			ClaimUntil:   &claimUntil,
+		})
+		if err != nil {
+			ra.log.AuditErrf("Could not claim STAR order %d: %s", *r.OrderID, err)
+			continue
+		}
+		// Another RA has claimed the order since it was found to be due.
+		if !claimed.GetExists() {
+			continue
+		}
+		err = ra.renewAutoRenewal(ctx, *r.OrderID, r.Csr)
+		if err != nil {
+			ra.log.AuditErrf("Could not renew STAR order %d: %s", *r.OrderID, err)
+			continue
+		}
+		renewed++
+	}
+	return renewed, nil
+}
+
+// renewAutoRenewal issues the next certificate for a STAR order from the CSR
+// the order was finalized with, and records it as the order's current
+// certificate. Renewals aren't subject to rate limits, which the order was
+// already counted against when it was created and finalized.
+func (ra *RegistrationAuthorityImpl) renewAutoRenewal(ctx context.Context, id int64, csrDER []byte) error {
+	useV2Authzs := true
+	order, err := ra.SA.GetOrder(ctx, &sapb.OrderRequest{Id: &id, UseV2Authorizations: &useV2Authzs})
+	if err != nil {
+		return err
+	}
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil {
+		return err
+	}
+
+	issueReq := core.CertificateRequest{
+		Bytes: csrDER,
+		CSR:   csr,
+	}
+	cert, err := ra.issueCertificate(ctx, issueReq, accountID(*order.RegistrationID), orderID(id), nil, order.AutoRenewal, order.GetCertProfile())
+	if err != nil {
+		// An order that is no longer authorized, e.g. because one of its
+		// authorizations was deactivated or CAA now forbids issuance, won't
+		// become authorized again. Stop renewing it.
+		if berrors.Is(err, berrors.Unauthorized) || berrors.Is(err, berrors.CAA) {
+			ra.log.AuditInfof("Canceling auto-renewal of STAR order %d: %s", id, err)
+			_, cancelErr := ra.SA.CancelAutoRenewal(ctx, &sapb.CancelAutoRenewalRequest{OrderID: &id})
+			if cancelErr != nil {
+				ra.log.AuditErrf("Could not cancel auto-renewal of STAR order %d: %s", id, cancelErr)
+			}
+		}
+		return err
+	}
+
+	parsedCertificate, err := x509.ParseCertificate([]byte(cert.DER))
+	if err != nil {
+		return err
+	}
+	serial := core.SerialToString(parsedCertificate.SerialNumber)
+	nextIssuance := autoRenewalDue(parsedCertificate).UnixNano()
+	_, err = ra.SA.SetAutoRenewalCertificate(ctx, &sapb.SetAutoRenewalCertificateRequest{
+		OrderID:      &id,
+		Serial:       &serial,
+		NextIssuance: &nextIssuance,
+	})
+	return err
+}
+
+// CancelAutoRenewal stops a STAR order (RFC 8739) belonging to the requesting
+// account from being issued any more certificates.
+func (ra *RegistrationAuthorityImpl) CancelAutoRenewal(ctx context.Context, req *rapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	useV2Authzs := true
+	order, err := ra.SA.GetOrder(ctx, &sapb.OrderRequest{Id: req.OrderID, UseV2Authorizations: &useV2Authzs})
+	if err != nil {
+		return nil, err
+	}
+	if order.GetRegistrationID() != req.GetRegistrationID() {
+		return nil, berrors.NotFoundError("no order found for ID %d", req.GetOrderID())
+	}
+	if order.AutoRenewal == nil {
+		return nil, berrors.AutoRenewalCancellationInvalidError("order %d is not an auto-renewal order", req.GetOrderID())
+	}
+	if order.GetStatus() == string(core.StatusCanceled) {
+		return nil, berrors.AutoRenewalCanceledError("auto-renewal of order %d has already been canceled", req.GetOrderID())
+	}
+	if order.GetStatus() != string(core.StatusValid) {
+		return nil, berrors.AutoRenewalCancellationInvalidError("only valid auto-renewal orders can be canceled, order %d is %q", req.GetOrderID(), order.GetStatus())
+	}
+	_, err = ra.SA.CancelAutoRenewal(ctx, &sapb.CancelAutoRenewalRequest{OrderID: req.OrderID})
+	if err != nil {
+		return nil, err
+	}
+	ra.log.AuditInfof("Canceled auto-renewal of STAR order %d for account %d", req.GetOrderID(), req.GetRegistrationID())
+	return &corepb.Empty{}, nil
+}
+
 // NewCertificate requests the issuance of a certificate.
 func (ra *RegistrationAuthorityImpl) NewCertificate(ctx context.Context, req core.CertificateRequest, regID int64) (core.Certificate, error) {
 	// Verify the CSR
@@ -1049,7 +1700,7 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(ctx context.Context, req cor
 	// NewCertificate provides an order ID of 0, indicating this is a classic ACME
 	// v1 issuance request from the new certificate endpoint that is not
 	// associated with an ACME v2 order.
-	return ra.issueCertificate(ctx, req, accountID(regID), orderID(0))
+	return ra.issueCertificate(ctx, req, accountID(regID), orderID(0), nil, nil, "")
 }
 
 // To help minimize the chance that an accountID would be used as an order ID
@@ -1059,21 +1710,30 @@ type accountID int64
 type orderID int64
 
 // issueCertificate sets up a log event structure and captures any errors
-// encountered during issuance, then calls issueCertificateInner.
+// encountered during issuance, then calls issueCertificateInner. The
+// replacedNames are the names on the certificate being replaced, if any, which
+// aren't subject to the CertificatesPerName limit. The autoRenewal argument is
+// the auto-renewal object of a STAR order, whose lifetime and end date bound
+// the validity of the certificate, or nil. The profile argument names the
+// certificate profile to issue with, or is empty for the default profile.
 func (ra *RegistrationAuthorityImpl) issueCertificate(
 	ctx context.Context,
 	req core.CertificateRequest,
 	acctID accountID,
-	oID orderID) (core.Certificate, error) {
+	oID orderID,
+	replacedNames []string,
+	autoRenewal *corepb.AutoRenewal,
+	profile string) (core.Certificate, error) {
 	// Construct the log event
 	logEvent := certificateRequestEvent{
 		ID:          core.NewToken(),
 		OrderID:     int64(oID),
 		Requester:   int64(acctID),
 		RequestTime: ra.clk.Now(),
+		CertProfile: profile,
 	}
 	var result string
-	cert, err := ra.issueCertificateInner(ctx, req, acctID, oID, &logEvent)
+	cert, err := ra.issueCertificateInner(ctx, req, acctID, oID, replacedNames, autoRenewal, profile, &logEvent)
 	if err != nil {
 		logEvent.Error = err.Error()
 		result = "error"
@@ -1104,6 +1764,9 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	req core.CertificateRequest,
 	acctID accountID,
 	oID orderID,
+	replacedNames []string,
+	autoRenewal *corepb.AutoRenewal,
+	profile string,
 	logEvent *certificateRequestEvent) (core.Certificate, error) {
 	emptyCert := core.Certificate{}
 	if acctID <= 0 {
@@ -1123,11 +1786,11 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 
 	csr := req.CSR
 	logEvent.CommonName = csr.Subject.CommonName
-	logEvent.Names = csr.DNSNames
 
-	// Validate that authorization key is authorized for all domains in the CSR
-	names := make([]string, len(csr.DNSNames))
-	copy(names, csr.DNSNames)
+	// Validate that authorization key is authorized for all domains and IP
+	// addresses in the CSR
+	names := csrlib.Names(csr)
+	logEvent.Names = names
 
 	if core.KeyDigestEquals(csr.PublicKey, account.Key) {
 		return emptyCert, berrors.MalformedError("certificate public key must be different than account key")
@@ -1135,10 +1798,16 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 
 	// Check rate limits before checking authorizations. If someone is unable to
 	// issue a cert due to rate limiting, we don't want to tell them to go get the
-	// necessary authorizations, only to later fail the rate limit check.
-	err = ra.checkLimits(ctx, names, account.ID)
-	if err != nil {
-		return emptyCert, err
+	// necessary authorizations, only to later fail the rate limit check. What's
+	// reserved from the limits is refunded if the certificate isn't issued. A STAR
+	// order counts against the limits when it's created and finalized, so the
+	// renewals scheduled after its first certificate don't.
+	var limitSpends []limitSpend
+	if autoRenewal.GetCurrentSerial() == "" {
+		limitSpends, err = ra.reserveLimits(ctx, names, account.ID, replacedNames)
+		if err != nil {
+			return emptyCert, err
+		}
 	}
 
 	var authzs map[string]*core.Authorization
@@ -1153,6 +1822,7 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 		authzs, err = ra.checkOrderAuthorizations(ctx, names, acctID, oID)
 	}
 	if err != nil {
+		ra.refundLimits(ctx, limitSpends, account.ID)
 		// Pass through the error without wrapping it because the called functions
 		// return BoulderError and we don't want to lose the type.
 		return emptyCert, err
@@ -1184,6 +1854,13 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 		Csr:            csr.Raw,
 		RegistrationID: int64(acctID),
 		OrderID:        int64(oID),
+		CertProfile:    profile,
+	}
+	if autoRenewal != nil {
+		// The CA issues for the requested lifetime, but never past the end of the
+		// STAR order.
+		issueReq.Lifetime = autoRenewal.GetLifetime()
+		issueReq.NotAfter = autoRenewal.GetEndDate()
 	}
 
 	// wrapError adds a prefix to an error. If the error is a boulder error then
@@ -1199,6 +1876,7 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 
 	precert, err := ra.CA.IssuePrecertificate(ctx, issueReq)
 	if err != nil {
+		ra.refundLimits(ctx, limitSpends, account.ID)
 		return emptyCert, wrapError(err, "issuing precertificate")
 	}
 	parsedPrecert, err := x509.ParseCertificate(precert.DER)
@@ -1214,6 +1892,7 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 		SCTs:           scts,
 		RegistrationID: int64(acctID),
 		OrderID:        int64(oID),
+		CertProfile:    profile,
 	})
 	if err != nil {
 		return emptyCert, wrapError(err, "issuing certificate for precertificate")
@@ -1229,7 +1908,7 @@ func (ra *RegistrationAuthorityImpl) issueCertificateInner(
 	// Asynchronously submit the final certificate to any configured logs
 	go ra.ctpolicy.SubmitFinalCert(cert.Der, parsedCertificate.NotAfter)
 
-	err = ra.MatchesCSR(parsedCertificate, csr)
+	err = ra.MatchesCSR(parsedCertificate, csr, profile)
 	if err != nil {
 		return emptyCert, err
 	}
@@ -1272,10 +1951,15 @@ func (ra *RegistrationAuthorityImpl) getSCTs(ctx context.Context, cert []byte, e
 
 // domainsForRateLimiting transforms a list of FQDNs into a list of eTLD+1's
 // for the purpose of rate limiting. It also de-duplicates the output
-// domains. Exact public suffix matches are included.
+// domains. Exact public suffix matches are included. IP addresses are
+// included as-is, each IP address is rate limited on its own.
 func domainsForRateLimiting(names []string) ([]string, error) {
 	var domains []string
 	for _, name := range names {
+		if net.ParseIP(name) != nil {
+			domains = append(domains, name)
+			continue
+		}
 		domain, err := publicsuffix.Domain(name)
 		if err != nil {
 			// The only possible errors are:
@@ -1293,34 +1977,40 @@ func domainsForRateLimiting(names []string) ([]string, error) {
 // enforceNameCounts uses the provided count RPC to find a count of certificates
 // for each of the names. If the count for any of the names exceeds the limit
 // for the given registration then the names out of policy are returned to be
-// used for a rate limit error.
+// used for a rate limit error, along with how long until the oldest
+// certificate counted for each of them has left the window.
 func (ra *RegistrationAuthorityImpl) enforceNameCounts(
 	ctx context.Context,
 	names []string,
 	limit ratelimit.RateLimitPolicy,
-	regID int64) ([]string, error) {
+	regID int64) ([]string, time.Duration, error) {
 
 	now := ra.clk.Now()
 	windowBegin := limit.WindowBegin(now)
 	counts, err := ra.SA.CountCertificatesByNames(ctx, names, windowBegin, now)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var badNames []string
+	var retryIn time.Duration
 	for _, entry := range counts {
 		// Should not happen, but be defensive.
 		if entry.Count == nil || entry.Name == nil {
-			return nil, fmt.Errorf("CountByNames_MapElement had nil Count or Name")
+			return nil, 0, fmt.Errorf("CountByNames_MapElement had nil Count or Name")
 		}
 		if int(*entry.Count) >= limit.GetThreshold(*entry.Name, regID) {
 			badNames = append(badNames, *entry.Name)
+			nameRetryIn := retryAfter(limit, bgrpc.PBToEarliest(entry.GetEarliest()), now)
+			if nameRetryIn > retryIn {
+				retryIn = nameRetryIn
+			}
 		}
 	}
-	return badNames, nil
+	return badNames, retryIn, nil
 }
 
-func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.Context, names []string, limit ratelimit.RateLimitPolicy, regID int64) error {
+func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.Context, names []string, replacedNames []string, limit ratelimit.RateLimitPolicy, regID int64) error {
 	// check if there is already an existing certificate for
 	// the exact name set we are issuing for. If so bypass the
 	// the certificatesPerName limit.
@@ -1333,12 +2023,17 @@ func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.C
 		return nil
 	}
 
-	tldNames, err := domainsForRateLimiting(names)
+	counted := namesNotReplaced(names, replacedNames)
+	if len(counted) == 0 {
+		ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "replacement bypass").Inc()
+		return nil
+	}
+	tldNames, err := domainsForRateLimiting(counted)
 	if err != nil {
 		return err
 	}
 
-	namesOutOfLimit, err := ra.enforceNameCounts(ctx, tldNames, limit, regID)
+	namesOutOfLimit, retryIn, err := ra.enforceNameCounts(ctx, tldNames, limit, regID)
 	if err != nil {
 		return fmt.Errorf("checking certificates per name limit for %q: %s",
 			names, err)
@@ -1357,44 +2052,89 @@ func (ra *RegistrationAuthorityImpl) checkCertificatesPerNameLimit(ctx context.C
 			return nil
 		}
 
-		ra.log.Infof("Rate limit exceeded, CertificatesForDomain, regID: %d, domains: %s", regID, strings.Join(namesOutOfLimit, ", "))
-		ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "exceeded").Inc()
-		if len(namesOutOfLimit) > 1 {
-			var subErrors []berrors.SubBoulderError
+		if limit.Shadow {
 			for _, name := range namesOutOfLimit {
-				subErrors = append(subErrors, berrors.SubBoulderError{
-					Identifier:   identifier.DNSIdentifier(name),
-					BoulderError: berrors.RateLimitError("too many certificates already issued").(*berrors.BoulderError),
-				})
+				ra.shadowDenial(ratelimit.CertificatesPerName, name, regID)
 			}
-			return berrors.RateLimitError("too many certificates already issued for multiple names (%s and %d others)", namesOutOfLimit[0], len(namesOutOfLimit)).(*berrors.BoulderError).WithSubErrors(subErrors)
+			return nil
 		}
-		return berrors.RateLimitError("too many certificates already issued for: %s", namesOutOfLimit[0])
+		ra.log.Infof("Rate limit exceeded, CertificatesForDomain, regID: %d, domains: %s", regID, strings.Join(namesOutOfLimit, ", "))
+		ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "exceeded").Inc()
+		return certificatesPerNameError(namesOutOfLimit, retryIn)
 	}
 	ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "pass").Inc()
 
 	return nil
 }
 
+// namesNotReplaced returns the names that aren't in replacedNames. Only those
+// count against the CertificatesPerName limit: a subscriber renewing early at
+// our request (see draft-ietf-acme-ari) should not be penalized for doing so,
+// but may not use a replacement to issue for other names without limit.
+func namesNotReplaced(names []string, replacedNames []string) []string {
+	replaced := make(map[string]bool, len(replacedNames))
+	for _, name := range replacedNames {
+		replaced[strings.ToLower(name)] = true
+	}
+	var counted []string
+	for _, name := range names {
+		if !replaced[strings.ToLower(name)] {
+			counted = append(counted, name)
+		}
+	}
+	return counted
+}
+
+// certificatesPerNameError returns the error for exceeding the
+// CertificatesPerName rate limit for each of namesOutOfLimit, which the client
+// may retry after retryAfter.
+func certificatesPerNameError(namesOutOfLimit []string, retryAfter time.Duration) error {
+	if len(namesOutOfLimit) > 1 {
+		var subErrors []berrors.SubBoulderError
+		for _, name := range namesOutOfLimit {
+			subErrors = append(subErrors, berrors.SubBoulderError{
+				Identifier:   identifier.ForName(name),
+				BoulderError: berrors.RateLimitError("too many certificates already issued").(*berrors.BoulderError),
+			})
+		}
+		return berrors.RateLimitError("too many certificates already issued for multiple names (%s and %d others)", namesOutOfLimit[0], len(namesOutOfLimit)).(*berrors.BoulderError).WithSubErrors(subErrors).WithRetryAfter(retryAfter)
+	}
+	return berrors.RateLimitError("too many certificates already issued for: %s", namesOutOfLimit[0]).(*berrors.BoulderError).WithRetryAfter(retryAfter)
+}
+
 func (ra *RegistrationAuthorityImpl) checkCertificatesPerFQDNSetLimit(ctx context.Context, names []string, limit ratelimit.RateLimitPolicy, regID int64) error {
-	count, err := ra.SA.CountFQDNSets(ctx, limit.Window.Duration, names)
+	count, oldest, err := ra.SA.CountFQDNSets(ctx, limit.Window.Duration, names)
 	if err != nil {
 		return fmt.Errorf("checking duplicate certificate limit for %q: %s", names, err)
 	}
 	names = core.UniqueLowerNames(names)
 	if int(count) >= limit.GetThreshold(strings.Join(names, ","), regID) {
+		if limit.Shadow {
+			ra.shadowDenial(ratelimit.CertificatesPerFQDNSet, strings.Join(names, ","), regID)
+			return nil
+		}
 		return berrors.RateLimitError(
 			"too many certificates already issued for exact set of domains: %s",
 			strings.Join(names, ","),
-		)
+		).(*berrors.BoulderError).WithRetryAfter(retryAfter(limit, oldest, ra.clk.Now()))
 	}
 	return nil
 }
 
-func (ra *RegistrationAuthorityImpl) checkLimits(ctx context.Context, names []string, regID int64) error {
+// checkLimits checks the certificate issuance rate limits for the given names
+// and account. replacedNames are the names on the certificate being replaced
+// by the order the names are issued for, if any, which aren't subject to the
+// CertificatesPerName limit (see namesNotReplaced). Limits enforced with
+// token buckets are only checked, not spent from; see reserveLimits.
+func (ra *RegistrationAuthorityImpl) checkLimits(ctx context.Context, names []string, regID int64, replacedNames []string) error {
+	if ra.useTokenBucketRateLimits() {
+		_, err := ra.checkLimitsTokenBucket(ctx, names, regID, replacedNames, false)
+		return err
+	}
+
 	certNameLimits := ra.rlPolicies.CertificatesPerName()
 	if certNameLimits.Enabled() {
-		err := ra.checkCertificatesPerNameLimit(ctx, names, certNameLimits, regID)
+		err := ra.checkCertificatesPerNameLimit(ctx, names, replacedNames, certNameLimits, regID)
 		if err != nil {
 			return err
 		}
@@ -1410,14 +2150,417 @@ func (ra *RegistrationAuthorityImpl) checkLimits(ctx context.Context, names []st
 	return nil
 }
 
-// UpdateRegistration updates an existing Registration with new values. Caller
-// is responsible for making sure that update.Key is only different from base.Key
-// if it is being called from the WFE key change endpoint.
-func (ra *RegistrationAuthorityImpl) UpdateRegistration(ctx context.Context, base core.Registration, update core.Registration) (core.Registration, error) {
-	if changed := mergeUpdate(&base, update); !changed {
-		// If merging the update didn't actually change the base then our work is
-		// done, we can return before calling ra.SA.UpdateRegistration since there's
-		// nothing for the SA to do
+// reserveLimits is like checkLimits, but is called right before issuing a
+// certificate for the names. Limits enforced with token buckets are spent from
+// as they're checked, so that concurrent requests can't both be allowed the
+// last token, and the buckets spent from are returned so that they can be
+// refunded with refundLimits if the certificate isn't issued after all.
+func (ra *RegistrationAuthorityImpl) reserveLimits(ctx context.Context, names []string, regID int64, replacedNames []string) ([]limitSpend, error) {
+	if ra.useTokenBucketRateLimits() {
+		return ra.checkLimitsTokenBucket(ctx, names, regID, replacedNames, true)
+	}
+	return nil, ra.checkLimits(ctx, names, regID, replacedNames)
+}
+
+// shadowDenial records that a request would have been denied by the limit
+// named name for key and regID, had the limit not been in shadow mode.
+func (ra *RegistrationAuthorityImpl) shadowDenial(name ratelimit.Name, key string, regID int64) {
+	ra.shadowDenialCounter.WithLabelValues(string(name)).Inc()
+	details, err := json.Marshal(struct {
+		Limit          ratelimit.Name
+		Key            string `json:",omitempty"`
+		RegistrationID int64  `json:",omitempty"`
+	}{name, key, regID})
+	if err != nil {
+		ra.log.Errf("marshaling shadow rate limit denial: %s", err)
+		return
+	}
+	ra.log.Infof("Rate limit shadow denial JSON=%s", details)
+}
+
+// useTokenBucketRateLimits returns true if rate limits should be enforced with
+// ra.limiter rather than by counting rows in the database.
+func (ra *RegistrationAuthorityImpl) useTokenBucketRateLimits() bool {
+	return features.Enabled(features.TokenBucketRateLimits) && ra.limiter != nil
+}
+
+// limitSpend is a token bucket that a request for a certificate was spent from.
+type limitSpend struct {
+	name ratelimit.Name
+	key  string
+}
+
+// checkLimitsTokenBucket is like checkLimits but enforces the limits with
+// ra.limiter. If reserve is true the buckets are spent from and returned,
+// otherwise they're only checked. A request denied by one limit doesn't use up
+// the others: whatever was spent from them is refunded.
+func (ra *RegistrationAuthorityImpl) checkLimitsTokenBucket(ctx context.Context, names []string, regID int64, replacedNames []string, reserve bool) (spends []limitSpend, err error) {
+	var reserved []limitSpend
+	defer func() {
+		if err != nil {
+			ra.refundLimits(ctx, reserved, regID)
+		}
+	}()
+	decide := func(name ratelimit.Name, key string) (*ratelimit.Decision, error) {
+		if !reserve {
+			return ra.limiter.Check(ctx, name, key, regID, 1)
+		}
+		decision, err := ra.limiter.Spend(ctx, name, key, regID, 1)
+		if err == nil && decision.Allowed {
+			reserved = append(reserved, limitSpend{name, key})
+		}
+		return decision, err
+	}
+
+	certNameLimits := ra.rlPolicies.CertificatesPerName()
+	if certNameLimits.Enabled() {
+		exists, err := ra.SA.FQDNSetExists(ctx, names)
+		if err != nil {
+			return nil, fmt.Errorf("checking renewal exemption for %q: %s", names, err)
+		}
+		counted := namesNotReplaced(names, replacedNames)
+		if exists {
+			ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "FQDN set bypass").Inc()
+		} else if len(counted) == 0 {
+			ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "replacement bypass").Inc()
+		} else {
+			tldNames, err := domainsForRateLimiting(counted)
+			if err != nil {
+				return nil, err
+			}
+			var namesOutOfLimit []string
+			var retryAfter time.Duration
+			for _, name := range tldNames {
+				decision, err := decide(ratelimit.CertificatesPerName, name)
+				if err != nil {
+					return nil, fmt.Errorf("checking certificates per name limit for %q: %s", names, err)
+				}
+				if !decision.Allowed {
+					namesOutOfLimit = append(namesOutOfLimit, name)
+					if decision.RetryIn > retryAfter {
+						retryAfter = decision.RetryIn
+					}
+				}
+			}
+			if len(namesOutOfLimit) > 0 && certNameLimits.Shadow {
+				for _, name := range namesOutOfLimit {
+					ra.shadowDenial(ratelimit.CertificatesPerName, name, regID)
+				}
+			} else if len(namesOutOfLimit) > 0 {
+				ra.log.Infof("Rate limit exceeded, CertificatesForDomain, regID: %d, domains: %s", regID, strings.Join(namesOutOfLimit, ", "))
+				ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "exceeded").Inc()
+				return nil, certificatesPerNameError(namesOutOfLimit, retryAfter)
+			} else {
+				ra.rateLimitCounter.WithLabelValues("certificates_for_domain", "pass").Inc()
+			}
+		}
+	}
+
+	fqdnLimits := ra.rlPolicies.CertificatesPerFQDNSet()
+	if fqdnLimits.Enabled() {
+		fqdnSet := strings.Join(core.UniqueLowerNames(names), ",")
+		decision, err := decide(ratelimit.CertificatesPerFQDNSet, fqdnSet)
+		if err != nil {
+			return nil, fmt.Errorf("checking duplicate certificate limit for %q: %s", names, err)
+		}
+		if !decision.Allowed && fqdnLimits.Shadow {
+			ra.shadowDenial(ratelimit.CertificatesPerFQDNSet, fqdnSet, regID)
+		} else if !decision.Allowed {
+			return nil, berrors.RateLimitError(
+				"too many certificates already issued for exact set of domains: %s",
+				fqdnSet,
+			).(*berrors.BoulderError).WithRetryAfter(decision.RetryIn)
+		}
+	}
+	return reserved, nil
+}
+
+// refundLimits gives back what reserveLimits spent from the token buckets, when
+// the certificate it was reserved for isn't issued. Failures are logged and
+// counted, since the reservation would only deny requests it shouldn't have
+// until the buckets refill.
+func (ra *RegistrationAuthorityImpl) refundLimits(ctx context.Context, spends []limitSpend, regID int64) {
+	for _, sp := range spends {
+		err := ra.limiter.Refund(ctx, sp.name, sp.key, regID, 1)
+		if err != nil {
+			ra.log.Errf("refunding to %s rate limit for %q: %s", sp.name, sp.key, err)
+			ra.rateLimitCounter.WithLabelValues(string(sp.name), "refund failure").Inc()
+		}
+	}
+}
+
+// RateLimitStatus reports the usage of the rate limits that apply to an
+// account, so that subscribers can see how close they are to them. If req.Name
+// is set the limits applied to that domain name are reported too. Limits that
+// aren't enabled are omitted.
+func (ra *RegistrationAuthorityImpl) RateLimitStatus(ctx context.Context, req *rapb.RateLimitStatusRequest) (*rapb.RateLimitStatusResponse, error) {
+	regID := req.GetRegistrationID()
+	type query struct {
+		name ratelimit.Name
+		key  string
+	}
+	queries := []query{
+		{ratelimit.NewOrdersPerAccount, ""},
+		{ratelimit.PendingAuthorizationsPerAccount, ""},
+	}
+	if req.GetName() != "" {
+		name := strings.ToLower(req.GetName())
+		err := ra.checkOrderNames([]string{name})
+		if err != nil {
+			return nil, err
+		}
+		tldNames, err := domainsForRateLimiting([]string{name})
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries,
+			query{ratelimit.CertificatesPerName, tldNames[0]},
+			query{ratelimit.CertificatesPerFQDNSet, name},
+			query{ratelimit.InvalidAuthorizationsPerAccount, name},
+		)
+	}
+
+	now := ra.clk.Now()
+	resp := &rapb.RateLimitStatusResponse{}
+	for _, q := range queries {
+		limit, err := ratelimit.Policy(ra.rlPolicies, q.name)
+		if err != nil {
+			return nil, err
+		}
+		if !limit.Enabled() {
+			continue
+		}
+		// The invalid authorizations limit is keyed by name, for the status,
+		// but its overrides are only by registration ID.
+		thresholdKey := q.key
+		if q.name == ratelimit.InvalidAuthorizationsPerAccount {
+			thresholdKey = ""
+		}
+		threshold := int64(limit.GetThreshold(thresholdKey, regID))
+		usage, resetIn, err := ra.rateLimitUsage(ctx, q.name, q.key, regID, limit, threshold)
+		if err != nil {
+			return nil, err
+		}
+		limitName := string(q.name)
+		key := q.key
+		resetAt := now.Add(resetIn).UnixNano()
+		resp.Limits = append(resp.Limits, &rapb.RateLimitStatus{
+			Limit:     &limitName,
+			Key:       &key,
+			Usage:     &usage,
+			Threshold: &threshold,
+			ResetAt:   &resetAt,
+		})
+	}
+	return resp, nil
+}
+
+// rateLimitUsage returns the usage of the limit named name for key and regID,
+// and how long it will take to fall if no more requests are made. For limits
+// enforced by counting rows, that's when the oldest row counted leaves the
+// window.
+func (ra *RegistrationAuthorityImpl) rateLimitUsage(ctx context.Context, name ratelimit.Name, key string, regID int64, limit ratelimit.RateLimitPolicy, threshold int64) (int64, time.Duration, error) {
+	now := ra.clk.Now()
+	var count int64
+	var oldest time.Time
+	switch name {
+	case ratelimit.NewOrdersPerAccount, ratelimit.CertificatesPerName, ratelimit.CertificatesPerFQDNSet:
+		if ra.useTokenBucketRateLimits() {
+			// Checking a cost of zero returns what's left in the bucket without
+			// spending anything.
+			decision, err := ra.limiter.Check(ctx, name, key, regID, 0)
+			if err != nil {
+				return 0, 0, err
+			}
+			usage := threshold - decision.Remaining
+			if usage < 0 {
+				usage = 0
+			}
+			return usage, decision.ResetIn, nil
+		}
+		switch name {
+		case ratelimit.NewOrdersPerAccount:
+			orders, ordersOldest, err := ra.SA.CountOrders(ctx, regID, now.Add(-limit.Window.Duration), now)
+			if err != nil {
+				return 0, 0, err
+			}
+			count = int64(orders)
+			oldest = ordersOldest
+		case ratelimit.CertificatesPerName:
+			counts, err := ra.SA.CountCertificatesByNames(ctx, []string{key}, now.Add(-limit.Window.Duration), now)
+			if err != nil {
+				return 0, 0, err
+			}
+			for _, entry := range counts {
+				if entry.GetName() == key {
+					count = entry.GetCount()
+					oldest = bgrpc.PBToEarliest(entry.GetEarliest())
+				}
+			}
+		case ratelimit.CertificatesPerFQDNSet:
+			var err error
+			count, oldest, err = ra.SA.CountFQDNSets(ctx, limit.Window.Duration, []string{key})
+			if err != nil {
+				return 0, 0, err
+			}
+		}
+	case ratelimit.PendingAuthorizationsPerAccount:
+		countPB, err := ra.SA.CountPendingAuthorizations2(ctx, &sapb.RegistrationID{Id: &regID})
+		if err != nil {
+			return 0, 0, err
+		}
+		count = countPB.GetCount()
+		if count == 0 {
+			return 0, 0, nil
+		}
+		// Pending authorizations count until they expire, unless they are
+		// validated or deactivated first.
+		return count, ra.pendingAuthorizationLifetime, nil
+	case ratelimit.InvalidAuthorizationsPerAccount:
+		var err error
+		count, oldest, err = ra.countInvalidAuthorizations(ctx, regID, key, limit)
+		if err != nil {
+			return 0, 0, err
+		}
+	default:
+		return 0, 0, fmt.Errorf("no usage for rate limit %q", name)
+	}
+	if count == 0 {
+		return 0, 0, nil
+	}
+	return count, retryAfter(limit, oldest, now), nil
+}
+
+// AddRateLimitOverride validates and stores a new rate limit override, which
+// replaces any unexpired override of the same limit for the same key or
+// registration. The overrides of this RA are refreshed once it is stored;
+// other RAs pick it up on their next refresh.
+func (ra *RegistrationAuthorityImpl) AddRateLimitOverride(ctx context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	name := ratelimit.Name(req.GetLimit())
+	_, err := ratelimit.Policy(ra.rlPolicies, name)
+	if err != nil {
+		return nil, berrors.MalformedError("%s", err)
+	}
+	key, regID := req.GetKey(), req.GetRegistrationID()
+	if (key == "") == (regID == 0) {
+		return nil, berrors.MalformedError("rate limit override must have exactly one of a key or a registration ID")
+	}
+	switch name {
+	case ratelimit.PendingAuthorizationsPerAccount, ratelimit.InvalidAuthorizationsPerAccount,
+		ratelimit.PendingOrdersPerAccount, ratelimit.NewOrdersPerAccount:
+		if key != "" {
+			return nil, berrors.MalformedError("%s can only be overridden by registration ID", name)
+		}
+	case ratelimit.RegistrationsPerIP, ratelimit.RegistrationsPerIPRange:
+		if regID != 0 {
+			return nil, berrors.MalformedError("%s can only be overridden by key", name)
+		}
+	}
+	if req.GetThreshold() < 0 {
+		return nil, berrors.MalformedError("rate limit override threshold must not be negative")
+	}
+	if req.GetOwner() == "" || req.GetJustification() == "" {
+		return nil, berrors.MalformedError("rate limit override must have an owner and a justification")
+	}
+	if !time.Unix(0, req.GetExpires()).After(ra.clk.Now()) {
+		return nil, berrors.MalformedError("rate limit override must expire in the future")
+	}
+
+	override, err := ra.SA.AddRateLimitOverride(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	expires := time.Unix(0, override.GetExpires()).UTC()
+	ra.log.AuditObject("Added rate limit override", rateLimitOverrideEvent{
+		ID:             override.GetId(),
+		Limit:          override.GetLimit(),
+		Key:            override.GetKey(),
+		RegistrationID: override.GetRegistrationID(),
+		Threshold:      override.GetThreshold(),
+		Actor:          override.GetOwner(),
+		Justification:  override.GetJustification(),
+		Expires:        &expires,
+	})
+	err = ra.RefreshRateLimitOverrides(ctx)
+	if err != nil {
+		ra.log.Errf("refreshing rate limit overrides: %s", err)
+	}
+	return override, nil
+}
+
+// GetRateLimitOverrides returns the stored rate limit overrides that haven't
+// expired, or all of them if req.IncludeExpired is set.
+func (ra *RegistrationAuthorityImpl) GetRateLimitOverrides(ctx context.Context, req *rapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	return ra.SA.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{IncludeExpired: req.IncludeExpired})
+}
+
+// ExpireRateLimitOverride expires a stored rate limit override immediately.
+// The overrides of this RA are refreshed once it has expired; other RAs drop
+// it on their next refresh.
+func (ra *RegistrationAuthorityImpl) ExpireRateLimitOverride(ctx context.Context, req *rapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	if req.GetActor() == "" || req.GetJustification() == "" {
+		return nil, berrors.MalformedError("expiring a rate limit override requires an actor and a justification")
+	}
+	_, err := ra.SA.ExpireRateLimitOverride(ctx, &sapb.ExpireRateLimitOverrideRequest{
+		Id:            req.Id,
+		Actor:         req.Actor,
+		Justification: req.Justification,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ra.log.AuditObject("Expired rate limit override", rateLimitOverrideEvent{
+		ID:            req.GetId(),
+		Actor:         req.GetActor(),
+		Justification: req.GetJustification(),
+	})
+	err = ra.RefreshRateLimitOverrides(ctx)
+	if err != nil {
+		ra.log.Errf("refreshing rate limit overrides: %s", err)
+	}
+	return &corepb.Empty{}, nil
+}
+
+// rateLimitOverrideEvent is logged when a rate limit override is added or
+// expired.
+type rateLimitOverrideEvent struct {
+	ID             int64
+	Actor          string
+	Justification  string
+	Limit          string     `json:",omitempty"`
+	Key            string     `json:",omitempty"`
+	RegistrationID int64      `json:",omitempty"`
+	Threshold      int64      `json:",omitempty"`
+	Expires        *time.Time `json:",omitempty"`
+}
+
+// RefreshRateLimitOverrides loads the unexpired rate limit overrides from the
+// SA and applies them on top of the rate limit policy file.
+func (ra *RegistrationAuthorityImpl) RefreshRateLimitOverrides(ctx context.Context) error {
+	resp, err := ra.SA.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	if err != nil {
+		return err
+	}
+	var overrides []ratelimit.Override
+	for _, o := range resp.Overrides {
+		overrides = append(overrides, ratelimit.Override{
+			Limit:          ratelimit.Name(o.GetLimit()),
+			Key:            o.GetKey(),
+			RegistrationID: o.GetRegistrationID(),
+			Threshold:      int(o.GetThreshold()),
+		})
+	}
+	return ra.rlPolicies.SetOverrides(overrides)
+}
+
+// UpdateRegistration updates an existing Registration with new values. Caller
+// is responsible for making sure that update.Key is only different from base.Key
+// if it is being called from the WFE key change endpoint.
+func (ra *RegistrationAuthorityImpl) UpdateRegistration(ctx context.Context, base core.Registration, update core.Registration) (core.Registration, error) {
+	if changed := mergeUpdate(&base, update); !changed {
+		// If merging the update didn't actually change the base then our work is
+		// done, we can return before calling ra.SA.UpdateRegistration since there's
+		// nothing for the SA to do
 		return base, nil
 	}
 
@@ -1509,11 +2652,13 @@ func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authI
 	}
 	status := string(challenge.Status)
 	ctype := string(challenge.Type)
+	now := ra.clk.Now()
+	attemptedAt := now.UnixNano()
 	var expires int64
 	if challenge.Status == core.StatusInvalid {
 		expires = authExpires.UnixNano()
 	} else {
-		expires = ra.clk.Now().Add(ra.authorizationLifetime).UnixNano()
+		expires = now.Add(ra.authorizationLifetime).UnixNano()
 	}
 	vr, err := bgrpc.ValidationResultToPB(challenge.ValidationRecord, challenge.Error)
 	if err != nil {
@@ -1526,6 +2671,7 @@ func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authI
 		Attempted:         &ctype,
 		ValidationRecords: vr.Records,
 		ValidationError:   vr.Problems,
+		AttemptedAt:       &attemptedAt,
 	})
 	if err != nil {
 		return err
@@ -1533,6 +2679,72 @@ func (ra *RegistrationAuthorityImpl) recordValidation(ctx context.Context, authI
 	return nil
 }
 
+// recordFailedValidation records a failed validation attempt of a v2 style
+// authorization which may be retried after retryAfter, leaving it pending.
+func (ra *RegistrationAuthorityImpl) recordFailedValidation(ctx context.Context, authID string, challenge *core.Challenge, retryAfter time.Time) error {
+	authzID, err := strconv.ParseInt(authID, 10, 64)
+	if err != nil {
+		return err
+	}
+	ctype := string(challenge.Type)
+	attemptedAt := ra.clk.Now().UnixNano()
+	retryAfterNS := retryAfter.UnixNano()
+	vr, err := bgrpc.ValidationResultToPB(challenge.ValidationRecord, challenge.Error)
+	if err != nil {
+		return err
+	}
+	return ra.SA.RecordFailedValidation2(ctx, &sapb.RecordFailedValidationRequest{
+		Id:                &authzID,
+		Attempted:         &ctype,
+		AttemptedAt:       &attemptedAt,
+		ValidationRecords: vr.Records,
+		ValidationError:   vr.Problems,
+		RetryAfter:        &retryAfterNS,
+	})
+}
+
+// recordValidationAttempt stores what the VA saw during a validation attempt
+// of a v2 style authorization, including the results of the remote VAs and the
+// CAA records checked, if the VA returned them. Since this is only used to
+// investigate validations after the fact, failing to store it doesn't fail the
+// validation.
+func (ra *RegistrationAuthorityImpl) recordValidationAttempt(ctx context.Context, authz core.Authorization, challenge *core.Challenge, prob *probs.ProblemDetails, res *vapb.ValidationResult) {
+	authzID, err := strconv.ParseInt(authz.ID, 10, 64)
+	if err != nil {
+		ra.log.Errf("Could not record validation attempt of authzID=[%s]: %s", authz.ID, err)
+		return
+	}
+	status := string(core.StatusValid)
+	if prob != nil {
+		status = string(core.StatusInvalid)
+	}
+	ctype := string(challenge.Type)
+	attemptedAt := ra.clk.Now().UnixNano()
+	vr, err := bgrpc.ValidationResultToPB(challenge.ValidationRecord, prob)
+	if err != nil {
+		ra.log.Errf("Could not record validation attempt of authzID=[%s]: %s", authz.ID, err)
+		return
+	}
+	attempt := &corepb.ValidationAttempt{
+		AuthzID:           &authzID,
+		RegistrationID:    &authz.RegistrationID,
+		Identifier:        &authz.Identifier.Value,
+		ChallengeType:     &ctype,
+		AttemptedAt:       &attemptedAt,
+		Status:            &status,
+		ValidationRecords: vr.Records,
+		ValidationError:   vr.Problems,
+	}
+	if res != nil {
+		attempt.RemoteResults = res.RemoteResults
+		attempt.CaaRecords = res.CaaRecords
+	}
+	_, err = ra.SA.AddValidationAttempt(ctx, attempt)
+	if err != nil {
+		ra.log.Errf("Could not record validation attempt of authzID=[%s]: %s", authz.ID, err)
+	}
+}
+
 // PerformValidation initiates validation for a specific challenge associated
 // with the given base authorization. The authorization and challenge are
 // updated based on the results.
@@ -1558,8 +2770,9 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 
 	ch := &authz.Challenges[challIndex]
 
-	// This challenge type may have been disabled since the challenge was created.
-	if !ra.PA.ChallengeTypeEnabled(ch.Type) {
+	// This challenge type may have been disabled, or disallowed for this
+	// identifier, since the challenge was created.
+	if !ra.PA.ChallengeTypeAllowed(ch.Type, authz.Identifier) {
 		return nil, berrors.MalformedError("challenge type %q no longer allowed", ch.Type)
 	}
 
@@ -1576,6 +2789,21 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 		return nil, berrors.WrongAuthorizationStateError("authorization must be pending")
 	}
 
+	// The account may have been restricted to other domains since the
+	// authorization was created.
+	err = ra.checkAccountScope(ctx, authz.RegistrationID, []identifier.ACMEIdentifier{authz.Identifier})
+	if err != nil {
+		return nil, err
+	}
+
+	// A failed validation that may be retried leaves the authorization pending,
+	// but it may not be retried again until its backoff has passed.
+	if ch.RetryAfter != nil && ra.clk.Now().Before(*ch.RetryAfter) {
+		retryIn := ch.RetryAfter.Sub(ra.clk.Now())
+		return nil, berrors.New(berrors.RateLimit,
+			"challenge may not be retried for another %s", retryIn.Round(time.Second)).(*berrors.BoulderError).WithRetryAfter(retryIn)
+	}
+
 	// Look up the account key for this authorization
 	reg, err := ra.SA.GetRegistration(ctx, authz.RegistrationID)
 	if err != nil {
@@ -1652,9 +2880,24 @@ func (ra *RegistrationAuthorityImpl) PerformValidation(
 			prob = probs.ServerInternal("Records for validation failed sanity check")
 		}
 
+		if features.Enabled(features.StoreValidationAttempts) {
+			ra.recordValidationAttempt(vaCtx, authz, challenge, prob, res)
+		}
+
 		if prob != nil {
-			challenge.Status = core.StatusInvalid
 			challenge.Error = prob
+			retryAfter, retry := ra.ValidationRetries.retryAfter(challenge.Attempts+1, ra.clk.Now())
+			if retry {
+				err := ra.recordFailedValidation(vaCtx, authz.ID, challenge, retryAfter)
+				if err == nil {
+					return
+				}
+				// Finalize the authz as invalid instead, rather than leave it
+				// pending without any record of the failure.
+				ra.log.AuditErrf("Could not record failed validation: err=[%s] regID=[%d] authzID=[%s]",
+					err, authz.RegistrationID, authz.ID)
+			}
+			challenge.Status = core.StatusInvalid
 		} else {
 			challenge.Status = core.StatusValid
 		}
@@ -1834,7 +3077,7 @@ func (ra *RegistrationAuthorityImpl) DeactivateAuthorization(ctx context.Context
 func (ra *RegistrationAuthorityImpl) checkOrderNames(names []string) error {
 	idents := make([]identifier.ACMEIdentifier, len(names))
 	for i, name := range names {
-		idents[i] = identifier.DNSIdentifier(name)
+		idents[i] = identifier.ForName(name)
 	}
 	if err := ra.PA.WillingToIssueWildcards(idents); err != nil {
 		return err
@@ -1842,16 +3085,84 @@ func (ra *RegistrationAuthorityImpl) checkOrderNames(names []string) error {
 	return nil
 }
 
+// checkAccountScope returns a RejectedIdentifier error, with a sub-error for
+// each offending identifier, if the account isn't allowed to issue for all of
+// the given identifiers. Accounts may be restricted to a set of domains and
+// their subdomains so that a leaked account key can't be used to issue for
+// other names; accounts that aren't restricted may issue for any identifier.
+func (ra *RegistrationAuthorityImpl) checkAccountScope(ctx context.Context, regID int64, idents []identifier.ACMEIdentifier) error {
+	if !features.Enabled(features.EnforceAccountScopes) {
+		return nil
+	}
+	scope, err := ra.SA.GetAccountScope(ctx, &sapb.RegistrationID{Id: &regID})
+	if err != nil {
+		return err
+	}
+	if len(scope.Suffixes) == 0 {
+		return nil
+	}
+
+	var subErrors []berrors.SubBoulderError
+	for _, ident := range idents {
+		if !inAccountScope(ident, scope.Suffixes) {
+			subErrors = append(subErrors, berrors.SubBoulderError{
+				Identifier: ident,
+				BoulderError: &berrors.BoulderError{
+					Type:   berrors.RejectedIdentifier,
+					Detail: "account is not allowed to issue for this identifier",
+				}})
+		}
+	}
+	if len(subErrors) == 0 {
+		return nil
+	}
+	detail := fmt.Sprintf("Cannot issue for %q: %s", subErrors[0].Identifier.Value, subErrors[0].Detail)
+	if len(subErrors) > 1 {
+		detail += fmt.Sprintf(" (and %d more problems. Refer to sub-problems for more information.)", len(subErrors)-1)
+	}
+	return (&berrors.BoulderError{
+		Type:   berrors.RejectedIdentifier,
+		Detail: detail,
+	}).WithSubErrors(subErrors)
+}
+
+// identsForNames returns the identifier of each name, which may be a DNS name
+// or an IP address.
+func identsForNames(names []string) []identifier.ACMEIdentifier {
+	idents := make([]identifier.ACMEIdentifier, len(names))
+	for i, name := range names {
+		idents[i] = identifier.ForName(name)
+	}
+	return idents
+}
+
+// inAccountScope returns whether ident is one of suffixes, or for DNS
+// identifiers a subdomain or wildcard of one of them.
+func inAccountScope(ident identifier.ACMEIdentifier, suffixes []string) bool {
+	name := ident.Value
+	if ident.Type == identifier.DNS {
+		name = strings.TrimPrefix(name, "*.")
+	}
+	for _, suffix := range suffixes {
+		if name == suffix || (ident.Type == identifier.DNS && strings.HasSuffix(name, "."+suffix)) {
+			return true
+		}
+	}
+	return false
+}
+
 // NewOrder creates a new order object
 func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.NewOrderRequest) (*corepb.Order, error) {
 	order := &corepb.Order{
 		RegistrationID: req.RegistrationID,
 		Names:          core.UniqueLowerNames(req.Names),
+		Replaces:       req.Replaces,
+		CertProfile:    req.CertProfile,
 	}
 
 	if len(order.Names) > ra.maxNames {
 		return nil, berrors.MalformedError(
-			"Order cannot contain more than %d DNS names", ra.maxNames)
+			"Order cannot contain more than %d DNS names and IP addresses", ra.maxNames)
 	}
 
 	// Validate that our policy allows issuing for each of the names in the order
@@ -1863,22 +3174,48 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 		return nil, err
 	}
 
-	// See if there is an existing unexpired pending (or ready) order that can be reused
-	// for this account
-	useV2Authzs := true
-	existingOrder, err := ra.SA.GetOrderForNames(ctx, &sapb.GetOrderForNamesRequest{
-		AcctID:              order.RegistrationID,
-		Names:               order.Names,
-		UseV2Authorizations: &useV2Authzs,
-	})
-	// If there was an error and it wasn't an acceptable "NotFound" error, return
-	// immediately
-	if err != nil && !berrors.Is(err, berrors.NotFound) {
+	if err := ra.checkAccountScope(ctx, *order.RegistrationID, identsForNames(order.Names)); err != nil {
 		return nil, err
 	}
-	// If there was an order, return it
-	if existingOrder != nil {
-		return existingOrder, nil
+
+	isReplacement := req.GetReplaces() != ""
+	isAutoRenewal := req.AutoRenewal != nil
+	var replacedNames []string
+	if isAutoRenewal {
+		if isReplacement {
+			return nil, berrors.MalformedError("auto-renewal orders cannot replace a certificate")
+		}
+		autoRenewal, err := ra.checkAutoRenewal(req.AutoRenewal)
+		if err != nil {
+			return nil, err
+		}
+		order.AutoRenewal = autoRenewal
+		order.CertProfile = &ra.AutoRenewal.Profile
+	}
+
+	// See if there is an existing unexpired pending (or ready) order that can be reused
+	// for this account. Orders replacing an existing certificate are never
+	// reused since the existing order would not carry the replacement link, and
+	// neither are STAR orders since their auto-renewal parameters are unlikely
+	// to match.
+	if !isReplacement && !isAutoRenewal {
+		useV2Authzs := true
+		existingOrder, err := ra.SA.GetOrderForNames(ctx, &sapb.GetOrderForNamesRequest{
+			AcctID:              order.RegistrationID,
+			Names:               order.Names,
+			UseV2Authorizations: &useV2Authzs,
+		})
+		// If there was an error and it wasn't an acceptable "NotFound" error, return
+		// immediately
+		if err != nil && !berrors.Is(err, berrors.NotFound) {
+			return nil, err
+		}
+		// If there was an order, return it. Orders selecting a different
+		// certificate profile aren't reused since the certificate issued for
+		// them would not match the request.
+		if existingOrder != nil && existingOrder.GetCertProfile() == req.GetCertProfile() && existingOrder.AutoRenewal == nil {
+			return existingOrder, nil
+		}
 	}
 
 	// Check if there is rate limit space for a new order within the current window
@@ -1888,7 +3225,14 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	// Check if there is rate limit space for issuing a certificate for the new
 	// order's names. If there isn't then it doesn't make sense to allow creating
 	// an order - it will just fail when finalization checks the same limits.
-	if err := ra.checkLimits(ctx, order.Names, *order.RegistrationID); err != nil {
+	if isReplacement {
+		var err error
+		replacedNames, err = ra.replacedNames(ctx, req.GetReplaces())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := ra.checkLimits(ctx, order.Names, *order.RegistrationID, replacedNames); err != nil {
 		return nil, err
 	}
 
@@ -1899,7 +3243,12 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	// expiring very soon.
 	// To prevent this we only return authorizations that are at least 1 day away
 	// from expiring.
+	// STAR orders are issued certificates until their end date, so they may
+	// only reuse authorizations that are valid until then.
 	authzExpiryCutoff := ra.clk.Now().AddDate(0, 0, 1).UnixNano()
+	if isAutoRenewal && *order.AutoRenewal.EndDate > authzExpiryCutoff {
+		authzExpiryCutoff = *order.AutoRenewal.EndDate
+	}
 
 	// We do not want any legacy V1 API authorizations not associated with an
 	// order to be returned from the SA so we set requireV2Authzs to true
@@ -1938,13 +3287,22 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 			continue
 		}
 		authz := nameToExistingAuthz[name]
-		// If the identifier is a wildcard and the existing authz only has one
-		// DNS-01 type challenge we can reuse it. In theory we will
-		// never get back an authorization for a domain with a wildcard prefix
-		// that doesn't meet this criteria from SA.GetAuthorizations but we verify
-		// again to be safe.
-		if strings.HasPrefix(name, "*.") &&
-			len(authz.Challenges) == 1 && core.AcmeChallenge(*authz.Challenges[0].Type) == core.ChallengeTypeDNS01 {
+		// Don't reuse a valid authorization whose validation method is no
+		// longer allowed for the name, e.g. because of a new challenge rule
+		// in the hostname policy, or that the authz reuse policy forbids
+		// reusing.
+		if authz.GetStatus() == string(core.StatusValid) &&
+			(!ra.authzPBValidChallengeAllowed(authz, name) || !ra.authzPBReusePermitted(authz, name)) {
+			delete(nameToExistingAuthz, name)
+			missingAuthzNames = append(missingAuthzNames, name)
+			continue
+		}
+		// If the identifier is a wildcard and the existing authz only has
+		// DNS-01 or DNS-ACCOUNT-01 type challenges we can reuse it. In theory we
+		// will never get back an authorization for a domain with a wildcard
+		// prefix that doesn't meet this criteria from SA.GetAuthorizations but we
+		// verify again to be safe.
+		if strings.HasPrefix(name, "*.") && onlyDNSChallenges(authz.Challenges) {
 			authzID, err := strconv.ParseInt(*authz.Id, 10, 64)
 			if err != nil {
 				return nil, err
@@ -1983,10 +3341,7 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	// authorization for each.
 	var newAuthzs []*corepb.Authorization
 	for _, name := range missingAuthzNames {
-		pb, err := ra.createPendingAuthz(ctx, *order.RegistrationID, identifier.ACMEIdentifier{
-			Type:  identifier.DNS,
-			Value: name,
-		})
+		pb, err := ra.createPendingAuthz(ctx, *order.RegistrationID, identifier.ForName(name))
 		if err != nil {
 			return nil, err
 		}
@@ -2047,14 +3402,88 @@ func (ra *RegistrationAuthorityImpl) NewOrder(ctx context.Context, req *rapb.New
 	return storedOrder, nil
 }
 
+// onlyDNSChallenges returns true if challenges is non-empty and every challenge
+// in it is validated through DNS, as required for wildcard authorizations.
+func onlyDNSChallenges(challenges []*corepb.Challenge) bool {
+	if len(challenges) == 0 {
+		return false
+	}
+	for _, chall := range challenges {
+		switch core.AcmeChallenge(chall.GetType()) {
+		case core.ChallengeTypeDNS01, core.ChallengeTypeDNSAccount01:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// checkAutoRenewal checks that the auto-renewal parameters requested for a STAR
+// order are acceptable. It returns the parameters to store for the order, with
+// a missing start date filled in.
+func (ra *RegistrationAuthorityImpl) checkAutoRenewal(req *corepb.AutoRenewal) (*corepb.AutoRenewal, error) {
+	if ra.AutoRenewal.MaxDuration == 0 {
+		return nil, berrors.MalformedError("auto-renewal orders are not supported")
+	}
+	now := ra.clk.Now()
+	start := now
+	if req.GetStartDate() != 0 {
+		start = time.Unix(0, req.GetStartDate())
+	}
+	end := time.Unix(0, req.GetEndDate())
+	lifetime := time.Duration(req.GetLifetime())
+
+	// The first certificate is issued as soon as the order is finalized.
+	if start.After(now) {
+		return nil, berrors.MalformedError("auto-renewal start-date must not be in the future")
+	}
+	if !end.After(now) {
+		return nil, berrors.MalformedError("auto-renewal end-date must be in the future")
+	}
+	// Authorizations must remain valid until the end date for certificates to
+	// be issued until then.
+	maxDuration := ra.AutoRenewal.MaxDuration
+	if ra.authorizationLifetime < maxDuration {
+		maxDuration = ra.authorizationLifetime
+	}
+	if end.Sub(now) > maxDuration {
+		return nil, berrors.MalformedError(
+			"auto-renewal end-date must be at most %d seconds from now", int64(maxDuration.Seconds()))
+	}
+	if lifetime < ra.AutoRenewal.MinLifetime {
+		return nil, berrors.MalformedError(
+			"auto-renewal lifetime must be at least %d seconds", int64(ra.AutoRenewal.MinLifetime.Seconds()))
+	}
+	if lifetime > ra.AutoRenewal.MaxLifetime {
+		return nil, berrors.MalformedError(
+			"auto-renewal lifetime must be at most %d seconds", int64(ra.AutoRenewal.MaxLifetime.Seconds()))
+	}
+	if req.GetAllowCertificateGet() && !ra.AutoRenewal.AllowCertificateGet {
+		return nil, berrors.MalformedError("auto-renewal allow-certificate-get is not supported")
+	}
+
+	startDate := start.UnixNano()
+	endDate := end.UnixNano()
+	lifetimeNS := int64(lifetime)
+	allowCertificateGet := req.GetAllowCertificateGet()
+	return &corepb.AutoRenewal{
+		StartDate:           &startDate,
+		EndDate:             &endDate,
+		Lifetime:            &lifetimeNS,
+		AllowCertificateGet: &allowCertificateGet,
+	}, nil
+}
+
 // createPendingAuthz checks that a name is allowed for issuance and creates the
 // necessary challenges for it and puts this and all of the relevant information
 // into a corepb.Authorization for transmission to the SA to be stored
 func (ra *RegistrationAuthorityImpl) createPendingAuthz(ctx context.Context, reg int64, identifier identifier.ACMEIdentifier) (*corepb.Authorization, error) {
 	expires := ra.clk.Now().Add(ra.pendingAuthorizationLifetime).Truncate(time.Second).UnixNano()
 	status := string(core.StatusPending)
+	identType := string(identifier.Type)
 	authz := &corepb.Authorization{
 		Identifier:     &identifier.Value,
+		IdentifierType: &identType,
 		RegistrationID: &reg,
 		Status:         &status,
 		Expires:        &expires,
@@ -2086,11 +3515,68 @@ func (ra *RegistrationAuthorityImpl) createPendingAuthz(ctx context.Context, reg
 }
 
 // authzValidChallengeEnabled checks whether the valid challenge in an authorization uses a type
-// which is still enabled for given regID
+// which is still enabled, and still allowed by policy for the authorization's identifier
 func (ra *RegistrationAuthorityImpl) authzValidChallengeEnabled(authz *core.Authorization) bool {
+	ident := authz.Identifier
+	if authz.Wildcard {
+		ident.Value = "*." + ident.Value
+	}
+	for _, chall := range authz.Challenges {
+		if chall.Status == core.StatusValid {
+			return ra.PA.ChallengeTypeAllowed(chall.Type, ident)
+		}
+	}
+	return false
+}
+
+// authzTooOld checks whether a valid authorization is past the maximum age the
+// authz reuse policy sets for the type of its valid challenge.
+func (ra *RegistrationAuthorityImpl) authzTooOld(authz *core.Authorization, now time.Time) bool {
+	for _, chall := range authz.Challenges {
+		if chall.Status == core.StatusValid {
+			return ra.AuthzReuse.tooOld(chall.Type, chall.Validated, now)
+		}
+	}
+	return false
+}
+
+// authzReusePermitted checks whether the authz reuse policy allows a valid
+// authorization to be reused now.
+func (ra *RegistrationAuthorityImpl) authzReusePermitted(authz *core.Authorization) bool {
+	name := authz.Identifier.Value
+	if authz.Wildcard {
+		name = "*." + name
+	}
 	for _, chall := range authz.Challenges {
 		if chall.Status == core.StatusValid {
-			return ra.PA.ChallengeTypeEnabled(chall.Type)
+			return ra.AuthzReuse.reusable(name, chall.Type, chall.Validated, ra.clk.Now())
+		}
+	}
+	return false
+}
+
+// authzPBReusePermitted is like authzReusePermitted, for an authorization
+// protobuf covering the given name.
+func (ra *RegistrationAuthorityImpl) authzPBReusePermitted(authz *corepb.Authorization, name string) bool {
+	for _, chall := range authz.Challenges {
+		if chall.GetStatus() == string(core.StatusValid) {
+			var validated *time.Time
+			if chall.Validated != nil {
+				t := time.Unix(0, chall.GetValidated())
+				validated = &t
+			}
+			return ra.AuthzReuse.reusable(name, core.AcmeChallenge(chall.GetType()), validated, ra.clk.Now())
+		}
+	}
+	return false
+}
+
+// authzPBValidChallengeAllowed is like authzValidChallengeEnabled, for an
+// authorization protobuf covering the given name.
+func (ra *RegistrationAuthorityImpl) authzPBValidChallengeAllowed(authz *corepb.Authorization, name string) bool {
+	for _, chall := range authz.Challenges {
+		if chall.GetStatus() == string(core.StatusValid) {
+			return ra.PA.ChallengeTypeAllowed(core.AcmeChallenge(chall.GetType()), identifier.ForName(name))
 		}
 	}
 	return false
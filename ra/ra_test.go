@@ -267,6 +267,10 @@ func (r *dummyRateLimitConfig) LoadPolicies(contents []byte) error {
 	return nil // NOP - unrequired behaviour for this mock
 }
 
+func (r *dummyRateLimitConfig) SetOverrides(overrides []ratelimit.Override) error {
+	return nil // NOP - unrequired behaviour for this mock
+}
+
 func initAuthorities(t *testing.T) (*DummyValidationAuthority, *sa.SQLStorageAuthority, *RegistrationAuthorityImpl, clock.FakeClock, func()) {
 	err := json.Unmarshal(AccountKeyJSONA, &AccountKeyA)
 	test.AssertNotError(t, err, "Failed to unmarshal public JWK")
@@ -329,7 +333,7 @@ func initAuthorities(t *testing.T) (*DummyValidationAuthority, *sa.SQLStorageAut
 	ra := NewRegistrationAuthorityImpl(fc,
 		log,
 		stats,
-		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, ctp, nil, nil)
+		1, testKeyPolicy, 100, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, time.Minute, ctp, nil, nil)
 	ra.SA = ssa
 	ra.VA = va
 	ra.CA = ca
@@ -1245,6 +1249,43 @@ func TestEarlyOrderRateLimiting(t *testing.T) {
 	test.Assert(t,
 		strings.HasPrefix(err.Error(), expectedErrPrefix),
 		fmt.Sprintf("expected error to have prefix %q got %q", expectedErrPrefix, err))
+
+	// An order replacing an existing certificate should not be subject to the
+	// CertificatesPerNamePolicy for the names on that certificate.
+	testKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(10),
+		DNSNames:     []string{domain},
+	}
+	replacedDER, err := x509.CreateCertificate(rand.Reader, template, template, testKey.Public(), testKey)
+	test.AssertNotError(t, err, "Failed to create replaced cert")
+	ra.SA = &mockSAWithCertificate{StorageAuthority: ra.SA, der: replacedDER}
+
+	replaces := "ff000000000000000000000000000000000a"
+	newOrder.Replaces = &replaces
+	order, err := ra.NewOrder(ctx, newOrder)
+	test.AssertNotError(t, err, "NewOrder applied CertificatesPerNamePolicy to replacement order")
+	test.AssertEquals(t, order.GetReplaces(), replaces)
+
+	// but is for names that weren't.
+	newOrder.Names = []string{domain, "www." + domain}
+	_, err = ra.NewOrder(ctx, newOrder)
+	test.AssertError(t, err, "NewOrder didn't apply CertificatesPerNamePolicy to a name added by a replacement order")
+	test.Assert(t,
+		strings.HasPrefix(err.Error(), expectedErrPrefix),
+		fmt.Sprintf("expected error to have prefix %q got %q", expectedErrPrefix, err))
+}
+
+// mockSAWithCertificate is a StorageAuthority that returns a certificate with
+// the given DER for any serial.
+type mockSAWithCertificate struct {
+	core.StorageAuthority
+	der []byte
+}
+
+func (m *mockSAWithCertificate) GetCertificate(_ context.Context, serial string) (core.Certificate, error) {
+	return core.Certificate{Serial: serial, DER: m.der}, nil
 }
 
 func TestAuthzFailedRateLimiting(t *testing.T) {
@@ -1290,6 +1331,14 @@ func TestAuthzFailedRateLimitingNewOrder(t *testing.T) {
 	testcase()
 }
 
+func TestNamesNotReplaced(t *testing.T) {
+	test.AssertEquals(t, len(namesNotReplaced([]string{"a.example.com"}, nil)), 1)
+	test.AssertEquals(t, len(namesNotReplaced([]string{"a.example.com"}, []string{"A.example.com"})), 0)
+	test.AssertDeepEquals(t,
+		namesNotReplaced([]string{"a.example.com", "b.example.net", "1.2.3.4"}, []string{"a.example.com", "1.2.3.4"}),
+		[]string{"b.example.net"})
+}
+
 func TestDomainsForRateLimiting(t *testing.T) {
 	domains, err := domainsForRateLimiting([]string{})
 	test.AssertNotError(t, err, "failed on empty")
@@ -1314,6 +1363,10 @@ func TestDomainsForRateLimiting(t *testing.T) {
 	domains, err = domainsForRateLimiting([]string{"github.io", "foo.github.io", "bar.github.io"})
 	test.AssertNotError(t, err, "failed on public suffix private domain")
 	test.AssertDeepEquals(t, domains, []string{"bar.github.io", "foo.github.io", "github.io"})
+
+	domains, err = domainsForRateLimiting([]string{"www.example.com", "1.2.3.4", "1.2.3.5", "2600::1"})
+	test.AssertNotError(t, err, "failed on IP addresses")
+	test.AssertDeepEquals(t, domains, []string{"1.2.3.4", "1.2.3.5", "2600::1", "example.com"})
 }
 
 func TestRateLimitLiveReload(t *testing.T) {
@@ -1422,13 +1475,13 @@ func TestCheckCertificatesPerNameLimit(t *testing.T) {
 	ra.SA = mockSA
 
 	// One base domain, below threshold
-	err := ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "example.com"}, rlp, 99)
+	err := ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "example.com"}, nil, rlp, 99)
 	test.AssertNotError(t, err, "rate limited example.com incorrectly")
 
 	// Two base domains, one above threshold, one below
 	mockSA.nameCounts["example.com"] = nameCount("example.com", 10)
 	mockSA.nameCounts["good-example.com"] = nameCount("good-example.com", 1)
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "example.com", "good-example.com"}, rlp, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "example.com", "good-example.com"}, nil, rlp, 99)
 	test.AssertError(t, err, "incorrectly failed to rate limit example.com")
 	if !berrors.Is(err, berrors.RateLimit) {
 		t.Errorf("Incorrect error type %#v", err)
@@ -1441,7 +1494,7 @@ func TestCheckCertificatesPerNameLimit(t *testing.T) {
 	mockSA.nameCounts["example.com"] = nameCount("example.com", 10)
 	mockSA.nameCounts["other-example.com"] = nameCount("other-example.com", 10)
 	mockSA.nameCounts["good-example.com"] = nameCount("good-example.com", 1)
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"example.com", "other-example.com", "good-example.com"}, rlp, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"example.com", "other-example.com", "good-example.com"}, nil, rlp, 99)
 	test.AssertError(t, err, "incorrectly failed to rate limit example.com, other-example.com")
 	if !berrors.Is(err, berrors.RateLimit) {
 		t.Errorf("Incorrect error type %#v", err)
@@ -1451,19 +1504,19 @@ func TestCheckCertificatesPerNameLimit(t *testing.T) {
 	test.AssertEquals(t, len(err.(*berrors.BoulderError).SubErrors), 2)
 
 	// SA misbehaved and didn't send back a count for every input name
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"zombo.com", "www.example.com", "example.com"}, rlp, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"zombo.com", "www.example.com", "example.com"}, nil, rlp, 99)
 	test.AssertError(t, err, "incorrectly failed to error on misbehaving SA")
 
 	// Two base domains, one above threshold but with an override.
 	mockSA.nameCounts["example.com"] = nameCount("example.com", 0)
 	mockSA.nameCounts["bigissuer.com"] = nameCount("bigissuer.com", 50)
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "subdomain.bigissuer.com"}, rlp, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "subdomain.bigissuer.com"}, nil, rlp, 99)
 	test.AssertNotError(t, err, "incorrectly rate limited bigissuer")
 
 	// Two base domains, one above its override
 	mockSA.nameCounts["example.com"] = nameCount("example.com", 0)
 	mockSA.nameCounts["bigissuer.com"] = nameCount("bigissuer.com", 100)
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "subdomain.bigissuer.com"}, rlp, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "subdomain.bigissuer.com"}, nil, rlp, 99)
 	test.AssertError(t, err, "incorrectly failed to rate limit bigissuer")
 	if !berrors.Is(err, berrors.RateLimit) {
 		t.Errorf("Incorrect error type")
@@ -1471,7 +1524,7 @@ func TestCheckCertificatesPerNameLimit(t *testing.T) {
 
 	// One base domain, above its override (which is below threshold)
 	mockSA.nameCounts["smallissuer.co.uk"] = nameCount("smallissuer.co.uk", 1)
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.smallissuer.co.uk"}, rlp, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.smallissuer.co.uk"}, nil, rlp, 99)
 	test.AssertError(t, err, "incorrectly failed to rate limit smallissuer")
 	if !berrors.Is(err, berrors.RateLimit) {
 		t.Errorf("Incorrect error type %#v", err)
@@ -1646,6 +1699,93 @@ func TestRegistrationKeyUpdate(t *testing.T) {
 	}
 }
 
+func TestCheckLimitsTokenBucket(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"TokenBucketRateLimits": true})
+	test.AssertNotError(t, err, "Failed to enable feature")
+	defer features.Reset()
+
+	ra.rlPolicies = &dummyRateLimitConfig{
+		CertificatesPerNamePolicy: ratelimit.RateLimitPolicy{
+			Threshold: 2,
+			Window:    cmd.ConfigDuration{Duration: 2 * time.Hour},
+		},
+		CertificatesPerFQDNSetPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 1,
+			Window:    cmd.ConfigDuration{Duration: time.Hour},
+		},
+		NewOrdersPerAccountPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 1,
+			Window:    cmd.ConfigDuration{Duration: time.Hour},
+		},
+	}
+	ra.SA = &mockSAWithFQDNSet{
+		fqdnSet: map[string]bool{},
+		t:       t,
+	}
+	ra.SetRateLimitSource(ratelimit.NewInmemSource(fc))
+
+	// Checking the limits doesn't spend from them, since the certificate may
+	// not be issued
+	for i := 0; i < 3; i++ {
+		err = ra.checkLimits(ctx, []string{"a.example.com"}, 1, nil)
+		test.AssertNotError(t, err, "checking limits for example.com was rate limited")
+	}
+
+	_, err = ra.reserveLimits(ctx, []string{"a.example.com"}, 1, nil)
+	test.AssertNotError(t, err, "first certificate for example.com was rate limited")
+
+	// The same set of names again exceeds the certificates per FQDN set limit,
+	// without spending from the certificates per name limit
+	_, err = ra.reserveLimits(ctx, []string{"a.example.com"}, 1, nil)
+	test.AssertError(t, err, "duplicate certificate wasn't rate limited")
+	test.Assert(t, berrors.Is(err, berrors.RateLimit), "wrong error type")
+	test.AssertEquals(t, err.Error(), "too many certificates already issued for exact set of domains: a.example.com: see https://letsencrypt.org/docs/rate-limits/")
+
+	_, err = ra.reserveLimits(ctx, []string{"b.example.com"}, 1, nil)
+	test.AssertNotError(t, err, "second certificate for example.com was rate limited")
+
+	_, err = ra.reserveLimits(ctx, []string{"c.example.com"}, 1, nil)
+	test.AssertError(t, err, "third certificate for example.com wasn't rate limited")
+	test.Assert(t, berrors.Is(err, berrors.RateLimit), "wrong error type")
+	test.AssertEquals(t, err.Error(), "too many certificates already issued for: example.com: see https://letsencrypt.org/docs/rate-limits/")
+
+	// Names on the certificate being replaced aren't subject to the
+	// certificates per name limit
+	_, err = ra.reserveLimits(ctx, []string{"c.example.com"}, 1, []string{"c.example.com"})
+	test.AssertNotError(t, err, "replacement certificate was rate limited")
+
+	// but names added by the replacement are
+	_, err = ra.reserveLimits(ctx, []string{"c.example.com", "e.example.com"}, 1, []string{"c.example.com"})
+	test.AssertError(t, err, "replacement certificate adding a name wasn't rate limited")
+	test.AssertEquals(t, err.Error(), "too many certificates already issued for: example.com: see https://letsencrypt.org/docs/rate-limits/")
+
+	// After an hour one certificate for example.com has been refilled
+	fc.Add(time.Hour)
+	_, err = ra.reserveLimits(ctx, []string{"d.example.com"}, 1, nil)
+	test.AssertNotError(t, err, "certificate for example.com after refill was rate limited")
+
+	// What's reserved for a certificate that isn't issued is refunded
+	spends, err := ra.reserveLimits(ctx, []string{"a.example.net"}, 1, nil)
+	test.AssertNotError(t, err, "first certificate for example.net was rate limited")
+	test.AssertEquals(t, len(spends), 2)
+	ra.refundLimits(ctx, spends, 1)
+	_, err = ra.reserveLimits(ctx, []string{"a.example.net"}, 1, nil)
+	test.AssertNotError(t, err, "refunded certificate for example.net was rate limited")
+	_, err = ra.reserveLimits(ctx, []string{"b.example.net"}, 1, nil)
+	test.AssertNotError(t, err, "second certificate for example.net was rate limited")
+
+	err = ra.checkNewOrdersPerAccountLimit(ctx, 1)
+	test.AssertNotError(t, err, "first new order was rate limited")
+	err = ra.checkNewOrdersPerAccountLimit(ctx, 1)
+	test.AssertError(t, err, "second new order wasn't rate limited")
+	test.AssertEquals(t, err.Error(), "too many new orders recently: see https://letsencrypt.org/docs/rate-limits/")
+	err = ra.checkNewOrdersPerAccountLimit(ctx, 2)
+	test.AssertNotError(t, err, "new order for another account was rate limited")
+}
+
 // A mockSAWithFQDNSet is a mock StorageAuthority that supports
 // CountCertificatesByName as well as FQDNSetExists. This allows testing
 // checkCertificatesPerNameRateLimit's FQDN exemption logic.
@@ -1690,14 +1830,308 @@ func (m mockSAWithFQDNSet) CountCertificatesByNames(ctx context.Context, names [
 	return results, nil
 }
 
-func (m mockSAWithFQDNSet) CountFQDNSets(_ context.Context, _ time.Duration, names []string) (int64, error) {
+func (m mockSAWithFQDNSet) CountFQDNSets(_ context.Context, _ time.Duration, names []string) (int64, time.Time, error) {
 	var count int64
 	for _, name := range names {
 		if entry, ok := m.nameCounts[name]; ok {
 			count += *entry.Count
 		}
 	}
-	return count, nil
+	return count, time.Time{}, nil
+}
+
+// mockSAWithCounts is a mock SA that returns the same count for every rate
+// limit query.
+type mockSAWithCounts struct {
+	mocks.StorageAuthority
+	count int64
+	// oldest is when the oldest event counted happened, or the zero time if
+	// the SA doesn't say.
+	oldest time.Time
+	// authzLifetime is how long after it happened the oldest invalid
+	// authorization counted expires, since those are counted by expiry.
+	authzLifetime time.Duration
+}
+
+// earliestNanos returns the earliest field of a count of events, the oldest
+// of which happened at oldest.
+func earliestNanos(oldest time.Time) *int64 {
+	var nanos int64
+	if !oldest.IsZero() {
+		nanos = oldest.UnixNano()
+	}
+	return &nanos
+}
+
+func (m *mockSAWithCounts) CountRegistrationsByIP(_ context.Context, _ net.IP, _, _ time.Time) (int, time.Time, error) {
+	return int(m.count), m.oldest, nil
+}
+
+func (m *mockSAWithCounts) CountRegistrationsByIPRange(_ context.Context, _ net.IP, _, _ time.Time) (int, time.Time, error) {
+	return int(m.count), m.oldest, nil
+}
+
+func (m *mockSAWithCounts) CountPendingAuthorizations2(_ context.Context, _ *sapb.RegistrationID) (*sapb.Count, error) {
+	return &sapb.Count{Count: &m.count}, nil
+}
+
+func (m *mockSAWithCounts) CountInvalidAuthorizations2(_ context.Context, _ *sapb.CountInvalidAuthorizationsRequest) (*sapb.Count, error) {
+	earliest := m.oldest
+	if !earliest.IsZero() {
+		earliest = earliest.Add(m.authzLifetime)
+	}
+	return &sapb.Count{Count: &m.count, Earliest: earliestNanos(earliest)}, nil
+}
+
+func (m *mockSAWithCounts) CountOrders(_ context.Context, _ int64, _, _ time.Time) (int, time.Time, error) {
+	return int(m.count), m.oldest, nil
+}
+
+func (m *mockSAWithCounts) CountCertificatesByNames(_ context.Context, names []string, _, _ time.Time) ([]*sapb.CountByNames_MapElement, error) {
+	var results []*sapb.CountByNames_MapElement
+	for _, name := range names {
+		entry := nameCount(name, int(m.count))
+		entry.Earliest = earliestNanos(m.oldest)
+		results = append(results, entry)
+	}
+	return results, nil
+}
+
+func (m *mockSAWithCounts) CountFQDNSets(_ context.Context, _ time.Duration, _ []string) (int64, time.Time, error) {
+	return m.count, m.oldest, nil
+}
+
+func TestRateLimitShadowMode(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	policy := ratelimit.RateLimitPolicy{
+		Threshold: 1,
+		Window:    cmd.ConfigDuration{Duration: time.Hour},
+		Shadow:    true,
+	}
+	limits := &dummyRateLimitConfig{
+		CertificatesPerNamePolicy:             policy,
+		RegistrationsPerIPPolicy:              policy,
+		RegistrationsPerIPRangePolicy:         policy,
+		PendingAuthorizationsPerAccountPolicy: policy,
+		InvalidAuthorizationsPerAccountPolicy: policy,
+		CertificatesPerFQDNSetPolicy:          policy,
+		NewOrdersPerAccountPolicy:             policy,
+	}
+	ra.rlPolicies = limits
+	// Every limit has already been reached
+	ra.SA = &mockSAWithCounts{count: 1}
+	mockLog := ra.log.(*blog.Mock)
+	mockLog.Clear()
+
+	checks := func() {
+		err := ra.checkRegistrationLimits(ctx, net.ParseIP("2001:db8::1"))
+		test.AssertNotError(t, err, "registration limits denied in shadow mode")
+		err = ra.checkPendingAuthorizationLimit(ctx, 1)
+		test.AssertNotError(t, err, "pending authorization limit denied in shadow mode")
+		err = ra.checkInvalidAuthorizationLimit(ctx, 1, "a.example.com")
+		test.AssertNotError(t, err, "invalid authorization limit denied in shadow mode")
+		err = ra.checkNewOrdersPerAccountLimit(ctx, 1)
+		test.AssertNotError(t, err, "new orders limit denied in shadow mode")
+		_, err = ra.reserveLimits(ctx, []string{"a.example.com", "b.example.net"}, 1, nil)
+		test.AssertNotError(t, err, "certificate limits denied in shadow mode")
+	}
+	checks()
+
+	for name, count := range map[ratelimit.Name]int{
+		ratelimit.RegistrationsPerIP:              1,
+		ratelimit.RegistrationsPerIPRange:         1,
+		ratelimit.PendingAuthorizationsPerAccount: 1,
+		ratelimit.InvalidAuthorizationsPerAccount: 1,
+		ratelimit.NewOrdersPerAccount:             1,
+		ratelimit.CertificatesPerName:             2,
+		ratelimit.CertificatesPerFQDNSet:          1,
+	} {
+		test.AssertEquals(t, test.CountCounterVec("limit", string(name), ra.shadowDenialCounter), count)
+	}
+	test.AssertEquals(t, len(mockLog.GetAllMatching("Rate limit shadow denial JSON=")), 8)
+	test.AssertEquals(t, len(mockLog.GetAllMatching(`Rate limit shadow denial JSON={"Limit":"registrationsPerIP","Key":"2001:db8::1"}`)), 1)
+	test.AssertEquals(t, len(mockLog.GetAllMatching(`Rate limit shadow denial JSON={"Limit":"pendingAuthorizationsPerAccount","RegistrationID":1}`)), 1)
+	test.AssertEquals(t, len(mockLog.GetAllMatching(`Rate limit shadow denial JSON={"Limit":"certificatesPerName","Key":"example.net","RegistrationID":1}`)), 1)
+	test.AssertEquals(t, len(mockLog.GetAllMatching(`Rate limit shadow denial JSON={"Limit":"certificatesPerFQDNSet","Key":"a.example.com,b.example.net","RegistrationID":1}`)), 1)
+
+	// The same limits are enforced with token buckets
+	err := features.Set(map[string]bool{"TokenBucketRateLimits": true})
+	test.AssertNotError(t, err, "Failed to enable feature")
+	defer features.Reset()
+	ra.SetRateLimitSource(ratelimit.NewInmemSource(fc))
+	for i := 0; i < 2; i++ {
+		err = ra.checkNewOrdersPerAccountLimit(ctx, 1)
+		test.AssertNotError(t, err, "new orders limit denied in shadow mode")
+		_, err = ra.reserveLimits(ctx, []string{"a.example.com"}, 1, nil)
+		test.AssertNotError(t, err, "certificate limits denied in shadow mode")
+	}
+	test.AssertEquals(t, test.CountCounterVec("limit", string(ratelimit.NewOrdersPerAccount), ra.shadowDenialCounter), 2)
+	test.AssertEquals(t, test.CountCounterVec("limit", string(ratelimit.CertificatesPerName), ra.shadowDenialCounter), 3)
+	test.AssertEquals(t, test.CountCounterVec("limit", string(ratelimit.CertificatesPerFQDNSet), ra.shadowDenialCounter), 2)
+
+	// Without shadow mode the limits are enforced
+	policy.Shadow = false
+	limits.NewOrdersPerAccountPolicy = policy
+	err = ra.checkNewOrdersPerAccountLimit(ctx, 1)
+	test.AssertError(t, err, "new orders limit wasn't enforced without shadow mode")
+	test.Assert(t, berrors.Is(err, berrors.RateLimit), "wrong error type")
+}
+
+func TestRateLimitRetryAfter(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	policy := ratelimit.RateLimitPolicy{
+		Threshold: 1,
+		Window:    cmd.ConfigDuration{Duration: time.Hour},
+	}
+	ra.rlPolicies = &dummyRateLimitConfig{
+		CertificatesPerNamePolicy:             policy,
+		InvalidAuthorizationsPerAccountPolicy: policy,
+		NewOrdersPerAccountPolicy:             policy,
+		RegistrationsPerIPPolicy:              policy,
+	}
+	// Every limit has already been reached
+	ra.SA = &mockSAWithCounts{count: 1}
+
+	retryAfter := func(err error) time.Duration {
+		t.Helper()
+		test.AssertError(t, err, "limit wasn't enforced")
+		test.Assert(t, berrors.Is(err, berrors.RateLimit), "wrong error type")
+		return err.(*berrors.BoulderError).RetryAfter
+	}
+
+	// Limits enforced by counting rows are retried after a window if the SA
+	// doesn't say when the oldest row counted was added
+	test.AssertEquals(t, retryAfter(ra.checkNewOrdersPerAccountLimit(ctx, 1)), time.Hour)
+	test.AssertEquals(t, retryAfter(ra.checkInvalidAuthorizationLimit(ctx, 1, "a.example.com")), time.Hour)
+	err := ra.checkLimits(ctx, []string{"a.example.com", "b.example.net"}, 1, nil)
+	test.AssertEquals(t, retryAfter(err), time.Hour)
+	test.AssertEquals(t, retryAfter(ra.checkRegistrationLimits(ctx, net.ParseIP("192.0.2.1"))), time.Hour)
+
+	// Otherwise they are retried once the oldest row counted leaves the window
+	ra.SA = &mockSAWithCounts{
+		count:         1,
+		oldest:        fc.Now().Add(-40 * time.Minute),
+		authzLifetime: ra.pendingAuthorizationLifetime,
+	}
+	test.AssertEquals(t, retryAfter(ra.checkNewOrdersPerAccountLimit(ctx, 1)), 20*time.Minute)
+	test.AssertEquals(t, retryAfter(ra.checkInvalidAuthorizationLimit(ctx, 1, "a.example.com")), 20*time.Minute)
+	err = ra.checkLimits(ctx, []string{"a.example.com", "b.example.net"}, 1, nil)
+	test.AssertEquals(t, retryAfter(err), 20*time.Minute)
+	err = ra.checkCertificatesPerFQDNSetLimit(ctx, []string{"a.example.com"}, policy, 1)
+	test.AssertEquals(t, retryAfter(err), 20*time.Minute)
+	test.AssertEquals(t, retryAfter(ra.checkRegistrationLimits(ctx, net.ParseIP("192.0.2.1"))), 20*time.Minute)
+	ra.rlPolicies = &dummyRateLimitConfig{RegistrationsPerIPRangePolicy: policy}
+	test.AssertEquals(t, retryAfter(ra.checkRegistrationLimits(ctx, net.ParseIP("2001:db8::1"))), 20*time.Minute)
+	ra.rlPolicies = &dummyRateLimitConfig{
+		CertificatesPerNamePolicy:             policy,
+		InvalidAuthorizationsPerAccountPolicy: policy,
+		NewOrdersPerAccountPolicy:             policy,
+		RegistrationsPerIPPolicy:              policy,
+	}
+
+	// Limits enforced with token buckets are retried after the next refill
+	err = features.Set(map[string]bool{"TokenBucketRateLimits": true})
+	test.AssertNotError(t, err, "Failed to enable feature")
+	defer features.Reset()
+	ra.SetRateLimitSource(ratelimit.NewInmemSource(fc))
+	err = ra.checkNewOrdersPerAccountLimit(ctx, 1)
+	test.AssertNotError(t, err, "first new order was rate limited")
+	fc.Add(20 * time.Minute)
+	test.AssertEquals(t, retryAfter(ra.checkNewOrdersPerAccountLimit(ctx, 1)), 40*time.Minute)
+}
+
+func TestRateLimitStatus(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ra.rlPolicies = &dummyRateLimitConfig{
+		CertificatesPerNamePolicy: ratelimit.RateLimitPolicy{
+			Threshold:             50,
+			Window:                cmd.ConfigDuration{Duration: 7 * 24 * time.Hour},
+			RegistrationOverrides: map[int64]int{1: 100},
+		},
+		PendingAuthorizationsPerAccountPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 300,
+			Window:    cmd.ConfigDuration{Duration: 7 * 24 * time.Hour},
+		},
+		CertificatesPerFQDNSetPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 5,
+			Window:    cmd.ConfigDuration{Duration: 7 * 24 * time.Hour},
+		},
+		NewOrdersPerAccountPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 300,
+			Window:    cmd.ConfigDuration{Duration: 3 * time.Hour},
+		},
+	}
+	ra.SA = &mockSAWithCounts{count: 2}
+	now := fc.Now()
+	regID := int64(1)
+
+	type status struct {
+		limit     string
+		key       string
+		usage     int64
+		threshold int64
+		resetAt   time.Time
+	}
+	getStatus := func(name string) []status {
+		t.Helper()
+		resp, err := ra.RateLimitStatus(ctx, &rapb.RateLimitStatusRequest{
+			RegistrationID: &regID,
+			Name:           &name,
+		})
+		test.AssertNotError(t, err, "RateLimitStatus failed")
+		var statuses []status
+		for _, l := range resp.Limits {
+			statuses = append(statuses, status{
+				l.GetLimit(), l.GetKey(), l.GetUsage(), l.GetThreshold(), time.Unix(0, l.GetResetAt()).UTC(),
+			})
+		}
+		return statuses
+	}
+
+	// Only the account-scoped limits without a name, and only the enabled ones
+	test.AssertDeepEquals(t, getStatus(""), []status{
+		{"newOrdersPerAccount", "", 2, 300, now.Add(3 * time.Hour)},
+		{"pendingAuthorizationsPerAccount", "", 2, 300, now.Add(7 * 24 * time.Hour)},
+	})
+
+	// The certificates per name limit is reported for the registered domain,
+	// with the account's override
+	test.AssertDeepEquals(t, getStatus("WWW.Example.com")[2:], []status{
+		{"certificatesPerName", "example.com", 2, 100, now.Add(7 * 24 * time.Hour)},
+		{"certificatesPerFQDNSet", "www.example.com", 2, 5, now.Add(7 * 24 * time.Hour)},
+	})
+
+	_, err := ra.RateLimitStatus(ctx, &rapb.RateLimitStatusRequest{
+		RegistrationID: &regID,
+		Name:           proto.String("127.0.0.1"),
+	})
+	test.AssertError(t, err, "RateLimitStatus for an IP address didn't fail")
+
+	// Limits enforced by counting rows reset when the oldest row counted
+	// leaves the window, if the SA says when it was added
+	ra.SA = &mockSAWithCounts{count: 2, oldest: now.Add(-time.Hour)}
+	test.AssertDeepEquals(t, getStatus("")[0], status{
+		"newOrdersPerAccount", "", 2, 300, now.Add(2 * time.Hour),
+	})
+
+	// With token buckets the usage is what has been spent from the bucket
+	err = features.Set(map[string]bool{"TokenBucketRateLimits": true})
+	test.AssertNotError(t, err, "Failed to enable feature")
+	defer features.Reset()
+	ra.SetRateLimitSource(ratelimit.NewInmemSource(fc))
+	for i := 0; i < 3; i++ {
+		err = ra.checkNewOrdersPerAccountLimit(ctx, regID)
+		test.AssertNotError(t, err, "new order was rate limited")
+	}
+	test.AssertDeepEquals(t, getStatus("")[0], status{
+		"newOrdersPerAccount", "", 3, 300, now.Add(3 * 36 * time.Second),
+	})
 }
 
 // Tests for boulder issue 1925[0] - that the `checkCertificatesPerNameLimit`
@@ -1731,7 +2165,7 @@ func TestCheckFQDNSetRateLimitOverride(t *testing.T) {
 	// First check that without a pre-existing FQDN set that the provided set of
 	// names is rate limited due to being over the certificates per name limit for
 	// "example.com" and "zombo.com"
-	err := ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "example.com", "www.zombo.com"}, certsPerNamePolicy, 99)
+	err := ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "example.com", "www.zombo.com"}, nil, certsPerNamePolicy, 99)
 	test.AssertError(t, err, "certificate per name rate limit not applied correctly")
 
 	// Now add a FQDN set entry for these domains
@@ -1740,7 +2174,7 @@ func TestCheckFQDNSetRateLimitOverride(t *testing.T) {
 	// A subsequent check against the certificates per name limit should now be OK
 	// - there exists a FQDN set and so the exemption to this particular limit
 	// comes into effect.
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "example.com", "www.zombo.com"}, certsPerNamePolicy, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"www.example.com", "example.com", "www.zombo.com"}, nil, certsPerNamePolicy, 99)
 	test.AssertNotError(t, err, "FQDN set certificate per name exemption not applied correctly")
 }
 
@@ -1786,13 +2220,13 @@ func TestExactPublicSuffixCertLimit(t *testing.T) {
 	// Trying to issue for "test3.dedyn.io" and "dedyn.io" should succeed because
 	// test3.dedyn.io has no certificates and "dedyn.io" is an exact public suffix
 	// match with no certificates issued for it.
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"test3.dedyn.io", "dedyn.io"}, certsPerNamePolicy, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"test3.dedyn.io", "dedyn.io"}, nil, certsPerNamePolicy, 99)
 	test.AssertNotError(t, err, "certificate per name rate limit not applied correctly")
 
 	// Trying to issue for "test3.dedyn.io" and "dynv6.net" should fail because
 	// "dynv6.net" is an exact public suffic match with 2 certificates issued for
 	// it.
-	err = ra.checkCertificatesPerNameLimit(ctx, []string{"test3.dedyn.io", "dynv6.net"}, certsPerNamePolicy, 99)
+	err = ra.checkCertificatesPerNameLimit(ctx, []string{"test3.dedyn.io", "dynv6.net"}, nil, certsPerNamePolicy, 99)
 	test.AssertError(t, err, "certificate per name rate limit not applied correctly")
 }
 
@@ -2198,6 +2632,11 @@ func TestNewOrderLegacyAuthzReuse(t *testing.T) {
 // an identical order results in only one order being created & subsequently
 // reused.
 func TestNewOrderReuse(t *testing.T) {
+	// Orders selecting a profile are stored by the real SA
+	err := features.Set(map[string]bool{"StoreCertificateProfileName": true})
+	test.AssertNotError(t, err, "Failed to enable feature")
+	defer features.Reset()
+
 	_, _, ra, fc, cleanUp := initAuthorities(t)
 	defer cleanUp()
 
@@ -2209,6 +2648,7 @@ func TestNewOrderReuse(t *testing.T) {
 	ra.orderLifetime = time.Hour
 	// Create a var with two times the order lifetime to reference later
 	doubleLifetime := ra.orderLifetime * 2
+	shortLivedProfile := "short-lived"
 
 	// Create an initial request with regA and names
 	orderReq := &rapb.NewOrderRequest{
@@ -2221,7 +2661,7 @@ func TestNewOrderReuse(t *testing.T) {
 		Key:       &AccountKeyB,
 		InitialIP: net.ParseIP("42.42.42.42"),
 	}
-	secondReg, err := ra.NewRegistration(ctx, secondReg)
+	secondReg, err = ra.NewRegistration(ctx, secondReg)
 	test.AssertNotError(t, err, "Error creating a second test registration")
 
 	// First, add an order with `names` for regA
@@ -2268,6 +2708,17 @@ func TestNewOrderReuse(t *testing.T) {
 			// We do not expect reuse because firstOrder has expired
 			ExpectReuse: true,
 		},
+		{
+			Name: "Duplicate order, same regID, different certificate profile",
+			OrderReq: &rapb.NewOrderRequest{
+				RegistrationID: &regA,
+				Names:          names,
+				CertProfile:    &shortLivedProfile,
+			},
+			// We do not expect reuse because the certificate profile doesn't
+			// match firstOrder
+			ExpectReuse: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3151,118 +3602,786 @@ func TestFinalizeOrderWithMixedSANAndCN(t *testing.T) {
 	test.AssertEquals(t, *updatedOrder.Status, "valid")
 }
 
-func TestFinalizeOrderWildcard(t *testing.T) {
+func TestFinalizeOrderAsync(t *testing.T) {
 	_, sa, ra, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
+	ra.orderLifetime = time.Hour
+
+	_ = features.Set(map[string]bool{"AsyncFinalize": true})
+	defer features.Reset()
 
-	// Pick an expiry in the future
 	exp := ra.clk.Now().Add(365 * 24 * time.Hour)
+	authzID := createFinalizedAuthorization(t, sa, "not-example.com", exp, "valid")
+
+	expUnix := exp.UnixNano()
+	pendingStatus := "pending"
+	order, err := sa.NewOrder(context.Background(), &corepb.Order{
+		RegistrationID:   &Registration.ID,
+		Expires:          &expUnix,
+		Names:            []string{"not-example.com"},
+		V2Authorizations: []int64{authzID},
+		Status:           &pendingStatus,
+	})
+	test.AssertNotError(t, err, "Could not add test order with finalized authz IDs")
 
 	testKey, err := rsa.GenerateKey(rand.Reader, 2048)
-	test.AssertNotError(t, err, "Error creating test RSA key")
-	wildcardCSR, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+	test.AssertNotError(t, err, "error generating test key")
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
 		PublicKey:          testKey.PublicKey,
 		SignatureAlgorithm: x509.SHA256WithRSA,
-		DNSNames:           []string{"*.zombo.com"},
+		DNSNames:           []string{"not-example.com"},
 	}, testKey)
-	test.AssertNotError(t, err, "Error creating CSR with wildcard DNS name")
+	test.AssertNotError(t, err, "Could not create CSR")
 
 	template := &x509.Certificate{
-		SerialNumber:          big.NewInt(1337),
+		SerialNumber:          big.NewInt(12),
+		DNSNames:              []string{"not-example.com"},
 		NotBefore:             time.Now(),
-		NotAfter:              time.Now().AddDate(0, 0, 1),
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
 		BasicConstraintsValid: true,
-		Subject:               pkix.Name{CommonName: "*.zombo.com"},
-		DNSNames:              []string{"*.zombo.com"},
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, testKey.Public(), testKey)
+	test.AssertNotError(t, err, "Failed to create cert")
+	ra.CA = &mocks.MockCA{
+		PEM: pem.EncodeToMemory(&pem.Block{
+			Bytes: cert,
+		}),
 	}
 
-	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, testKey.Public(), testKey)
-	test.AssertNotError(t, err, "Error creating test certificate")
+	// The order should be returned in processing status without a serial
+	result, err := ra.FinalizeOrder(context.Background(), &rapb.FinalizeOrderRequest{Order: order, Csr: csr})
+	test.AssertNotError(t, err, "FinalizeOrder failed")
+	test.AssertEquals(t, result.GetStatus(), string(core.StatusProcessing))
+	test.AssertEquals(t, result.GetCertificateSerial(), "")
 
-	certPEM := pem.EncodeToMemory(&pem.Block{
-		Type:  "CERTIFICATE",
-		Bytes: certBytes,
-	})
+	// Once background issuance has finished the order should be valid
+	ra.Drain()
+	updatedOrder, err := sa.GetOrder(
+		context.Background(),
+		&sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "Error getting order to check serial")
+	test.AssertNotEquals(t, updatedOrder.GetCertificateSerial(), "")
+	test.AssertEquals(t, updatedOrder.GetStatus(), string(core.StatusValid))
+}
 
-	// Set up a mock CA capable of giving back a cert for the wildcardCSR above
-	ca := &mocks.MockCA{
-		PEM: certPEM,
-	}
-	ra.CA = ca
+// mockSAHeldOrders is a mock SA that holds a single order for review.
+type mockSAHeldOrders struct {
+	*mocks.StorageAuthority
 
-	// Create a new order for a wildcard domain
-	orderNames := []string{"*.zombo.com"}
-	wildcardOrderRequest := &rapb.NewOrderRequest{
-		RegistrationID: &Registration.ID,
-		Names:          orderNames,
-	}
-	order, err := ra.NewOrder(context.Background(), wildcardOrderRequest)
-	test.AssertNotError(t, err, "NewOrder failed for wildcard domain order")
+	clk    clock.Clock
+	order  *corepb.Order
+	held   *sapb.HeldOrder
+	failed *corepb.Order
+}
 
-	// Create one standard finalized authorization for Registration.ID for zombo.com
-	_ = createFinalizedAuthorization(t, sa, "zombo.com", exp, "valid")
+func (msa *mockSAHeldOrders) HoldOrder(_ context.Context, req *sapb.HeldOrder) (*corepb.Empty, error) {
+	msa.held = req
+	return &corepb.Empty{}, nil
+}
 
-	// Finalizing the order should *not* work since the existing validated authz
-	// is not a special DNS-01-Wildcard challenge authz, so the order will be
-	// "pending" not "ready".
-	finalizeReq := &rapb.FinalizeOrderRequest{
-		Order: order,
-		Csr:   wildcardCSR,
+func (msa *mockSAHeldOrders) ReleaseHeldOrder(_ context.Context, req *sapb.ReleaseHeldOrderRequest) (*sapb.HeldOrder, error) {
+	if msa.held == nil || req.GetOrderID() != msa.held.GetOrderID() {
+		return nil, berrors.NotFoundError("no held order found for order ID %d", req.GetOrderID())
 	}
-	_, err = ra.FinalizeOrder(context.Background(), finalizeReq)
-	test.AssertError(t, err, "FinalizeOrder did not fail for unauthorized "+
-		"wildcard order")
-	test.AssertEquals(t, err.Error(),
-		`Order's status ("pending") is not acceptable for finalization`)
+	held := msa.held
+	msa.held = nil
+	return held, nil
+}
 
-	// Creating another order for the wildcard name
-	validOrder, err := ra.NewOrder(context.Background(), wildcardOrderRequest)
-	test.AssertNotError(t, err, "NewOrder failed for wildcard domain order")
-	test.AssertEquals(t, numAuthorizations(validOrder), 1)
-	// We expect to be able to get the authorization by ID
-	_, err = sa.GetAuthorization2(ctx, &sapb.AuthorizationID2{Id: &validOrder.V2Authorizations[0]})
-	test.AssertNotError(t, err, "sa.GetAuthorization2 failed")
+func (msa *mockSAHeldOrders) GetOrder(_ context.Context, req *sapb.OrderRequest) (*corepb.Order, error) {
+	return proto.Clone(msa.order).(*corepb.Order), nil
+}
 
-	// Finalize the authorization with the challenge validated
-	status := string(core.StatusValid)
-	attempted := string(core.ChallengeTypeDNS01)
-	expInt := ra.clk.Now().Add(time.Hour * 24 * 7).UnixNano()
-	err = sa.FinalizeAuthorization2(ctx, &sapb.FinalizeAuthorizationRequest{
-		Id:        &validOrder.V2Authorizations[0],
-		Status:    &status,
-		Expires:   &expInt,
-		Attempted: &attempted,
-	})
-	test.AssertNotError(t, err, "sa.FinalizeAuthorization2 failed")
+func (msa *mockSAHeldOrders) SetOrderError(_ context.Context, order *corepb.Order) error {
+	msa.failed = order
+	return nil
+}
 
-	// Refresh the order so the SA sets its status
-	validOrder, err = sa.GetOrder(ctx, &sapb.OrderRequest{
-		Id: validOrder.Id,
+func (msa *mockSAHeldOrders) GetValidOrderAuthorizations2(_ context.Context, _ *sapb.GetValidOrderAuthorizationsRequest) (*sapb.Authorizations, error) {
+	exp := msa.clk.Now().Add(time.Hour)
+	authzPB, err := bgrpc.AuthzToPB(core.Authorization{
+		ID:             "1",
+		Identifier:     identifier.DNSIdentifier("www.review.le.wtf"),
+		RegistrationID: 1,
+		Status:         core.StatusValid,
+		Expires:        &exp,
+		Challenges: []core.Challenge{
+			{Type: core.ChallengeTypeDNS01, Status: core.StatusValid, Token: core.NewToken()},
+		},
 	})
-	test.AssertNotError(t, err, "Could not refresh valid order from SA")
-
-	// Now it should be possible to finalize the order
-	finalizeReq = &rapb.FinalizeOrderRequest{
-		Order: validOrder,
-		Csr:   wildcardCSR,
+	if err != nil {
+		return nil, err
 	}
-	_, err = ra.FinalizeOrder(context.Background(), finalizeReq)
-	test.AssertNotError(t, err, "FinalizeOrder failed for authorized "+
-		"wildcard order")
+	name := "www.review.le.wtf"
+	return &sapb.Authorizations{Authz: []*sapb.Authorizations_MapElement{{Domain: &name, Authz: authzPB}}}, nil
 }
 
-func TestIssueCertificateAuditLog(t *testing.T) {
-	_, sa, ra, _, cleanUp := initAuthorities(t)
+func TestFinalizeOrderHeldForReview(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
 
-	// Set up order and authz expiries
-	ra.orderLifetime = 24 * time.Hour
-	exp := ra.clk.Now().Add(24 * time.Hour)
+	id, regID := int64(20), int64(1)
+	exp := ra.clk.Now().Add(time.Hour).UnixNano()
+	readyStatus := string(core.StatusReady)
+	order := &corepb.Order{
+		Id:               &id,
+		RegistrationID:   &regID,
+		Expires:          &exp,
+		Names:            []string{"www.review.le.wtf"},
+		V2Authorizations: []int64{1},
+		Status:           &readyStatus,
+	}
+	mockSA := &mockSAHeldOrders{StorageAuthority: mocks.NewStorageAuthority(ra.clk), clk: ra.clk, order: order}
+	ra.SA = mockSA
 
-	authzForChalType := func(domain, chalType string) int64 {
-		template := core.Authorization{
+	testKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "error generating test key")
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		PublicKey:          testKey.PublicKey,
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		DNSNames:           []string{"www.review.le.wtf"},
+	}, testKey)
+	test.AssertNotError(t, err, "Could not create CSR")
+
+	// www.review.le.wtf is on the review list of the test hostname policy, so
+	// the order is held in processing status rather than issued
+	result, err := ra.FinalizeOrder(context.Background(), &rapb.FinalizeOrderRequest{Order: order, Csr: csr})
+	test.AssertNotError(t, err, "FinalizeOrder failed")
+	test.AssertEquals(t, result.GetStatus(), string(core.StatusProcessing))
+	test.AssertEquals(t, result.GetCertificateSerial(), "")
+	test.AssertNotNil(t, mockSA.held, "Order wasn't held")
+	test.AssertEquals(t, mockSA.held.GetOrderID(), id)
+	test.AssertDeepEquals(t, mockSA.held.ReviewNames, []string{"www.review.le.wtf"})
+	test.AssertByteEquals(t, mockSA.held.Csr, csr)
+
+	// Reviewing requires an actor and a justification
+	approve := true
+	actor, justification := "bob", "Verified with the site owner"
+	_, err = ra.ReviewHeldOrder(context.Background(), &rapb.ReviewHeldOrderRequest{OrderID: &id, Approve: &approve})
+	test.AssertError(t, err, "ReviewHeldOrder without an actor didn't fail")
+	test.AssertNotNil(t, mockSA.held, "Order was released by an invalid review")
+
+	// Approving the order issues its certificate
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(12),
+		DNSNames:              []string{"www.review.le.wtf"},
+		NotBefore:             time.Now(),
+		BasicConstraintsValid: true,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, testKey.Public(), testKey)
+	test.AssertNotError(t, err, "Failed to create cert")
+	ra.CA = &mocks.MockCA{PEM: pem.EncodeToMemory(&pem.Block{Bytes: cert})}
+	processingStatus := string(core.StatusProcessing)
+	mockSA.order.Status = &processingStatus
+	result, err = ra.ReviewHeldOrder(context.Background(), &rapb.ReviewHeldOrderRequest{
+		OrderID:       &id,
+		Approve:       &approve,
+		Actor:         &actor,
+		Justification: &justification,
+	})
+	test.AssertNotError(t, err, "ReviewHeldOrder failed")
+	test.AssertEquals(t, result.GetStatus(), string(core.StatusValid))
+	test.AssertEquals(t, result.GetCertificateSerial(), core.SerialToString(big.NewInt(12)))
+	test.Assert(t, mockSA.failed == nil, "Approved order was failed")
+
+	// An order can only be reviewed while it's held
+	_, err = ra.ReviewHeldOrder(context.Background(), &rapb.ReviewHeldOrderRequest{
+		OrderID:       &id,
+		Approve:       &approve,
+		Actor:         &actor,
+		Justification: &justification,
+	})
+	test.AssertError(t, err, "ReviewHeldOrder of an order that isn't held didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	// Rejecting the order fails it with a rejectedIdentifier problem
+	mockSA.held = &sapb.HeldOrder{OrderID: &id, RegistrationID: &regID, ReviewNames: []string{"www.review.le.wtf"}, Csr: csr}
+	reject := false
+	result, err = ra.ReviewHeldOrder(context.Background(), &rapb.ReviewHeldOrderRequest{
+		OrderID:       &id,
+		Approve:       &reject,
+		Actor:         &actor,
+		Justification: &justification,
+	})
+	test.AssertNotError(t, err, "ReviewHeldOrder failed")
+	test.AssertEquals(t, result.GetStatus(), string(core.StatusInvalid))
+	test.AssertNotNil(t, mockSA.failed, "Rejected order wasn't failed")
+	test.AssertEquals(t, mockSA.failed.Error.GetProblemType(), "rejectedIdentifier")
+	test.AssertContains(t, mockSA.failed.Error.GetDetail(), "www.review.le.wtf")
+}
+
+type mockSAStaleProcessingOrders struct {
+	*mocks.StorageAuthority
+
+	failed  []int64
+	deleted []int64
+}
+
+func (msa *mockSAStaleProcessingOrders) GetStaleProcessingOrders(_ context.Context, _ *sapb.GetStaleProcessingOrdersRequest) (*sapb.OrderIDs, error) {
+	return &sapb.OrderIDs{Ids: []int64{1, 2, 3, 10}}, nil
+}
+
+func (msa *mockSAStaleProcessingOrders) DeleteProcessingOrder(_ context.Context, req *sapb.OrderRequest) (*corepb.Empty, error) {
+	msa.deleted = append(msa.deleted, req.GetId())
+	return &corepb.Empty{}, nil
+}
+
+func (msa *mockSAStaleProcessingOrders) SetOrderError(_ context.Context, order *corepb.Order) error {
+	msa.failed = append(msa.failed, order.GetId())
+	return nil
+}
+
+func TestFailStaleProcessingOrders(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	// mocks.StorageAuthority's GetOrder treats ID 2 as missing, ID 1 as valid,
+	// ID 3 as an error and ID 10 as processing, so only order 10 should be
+	// failed. The rows for the missing and valid orders are deleted, while
+	// order 3 is left to be retried.
+	mockSA := &mockSAStaleProcessingOrders{StorageAuthority: mocks.NewStorageAuthority(ra.clk)}
+	ra.SA = mockSA
+
+	failed, err := ra.FailStaleProcessingOrders(context.Background())
+	test.AssertNotError(t, err, "FailStaleProcessingOrders failed")
+	test.AssertEquals(t, failed, 1)
+	test.AssertDeepEquals(t, mockSA.failed, []int64{10})
+	test.AssertDeepEquals(t, mockSA.deleted, []int64{1, 2})
+}
+
+func TestNewOrderAutoRenewal(t *testing.T) {
+	// Orders selecting a profile are stored by the real SA
+	err := features.Set(map[string]bool{"StoreCertificateProfileName": true})
+	test.AssertNotError(t, err, "Failed to enable feature")
+	defer features.Reset()
+
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ra.authorizationLifetime = 30 * 24 * time.Hour
+	ra.AutoRenewal = AutoRenewalConfig{
+		Profile:     "short-lived",
+		MinLifetime: 24 * time.Hour,
+		MaxLifetime: 7 * 24 * time.Hour,
+		MaxDuration: 15 * 24 * time.Hour,
+	}
+
+	regA := int64(1)
+	now := fc.Now()
+	ns := func(t time.Time) *int64 {
+		n := t.UnixNano()
+		return &n
+	}
+	week := int64(7 * 24 * time.Hour)
+	hour := int64(time.Hour)
+	eightDays := int64(8 * 24 * time.Hour)
+	yes := true
+
+	testCases := []struct {
+		Name        string
+		AutoRenewal *corepb.AutoRenewal
+		ExpectedErr string
+	}{
+		{
+			Name:        "start-date in the future",
+			AutoRenewal: &corepb.AutoRenewal{StartDate: ns(now.Add(time.Hour)), EndDate: ns(now.Add(24 * time.Hour)), Lifetime: &week},
+			ExpectedErr: "auto-renewal start-date must not be in the future",
+		},
+		{
+			Name:        "end-date in the past",
+			AutoRenewal: &corepb.AutoRenewal{EndDate: ns(now.Add(-time.Hour)), Lifetime: &week},
+			ExpectedErr: "auto-renewal end-date must be in the future",
+		},
+		{
+			Name:        "end-date too far in the future",
+			AutoRenewal: &corepb.AutoRenewal{EndDate: ns(now.Add(16 * 24 * time.Hour)), Lifetime: &week},
+			ExpectedErr: "auto-renewal end-date must be at most 1296000 seconds from now",
+		},
+		{
+			Name:        "lifetime too short",
+			AutoRenewal: &corepb.AutoRenewal{EndDate: ns(now.Add(24 * time.Hour)), Lifetime: &hour},
+			ExpectedErr: "auto-renewal lifetime must be at least 86400 seconds",
+		},
+		{
+			Name:        "lifetime too long",
+			AutoRenewal: &corepb.AutoRenewal{EndDate: ns(now.Add(24 * time.Hour)), Lifetime: &eightDays},
+			ExpectedErr: "auto-renewal lifetime must be at most 604800 seconds",
+		},
+		{
+			Name:        "allow-certificate-get not supported",
+			AutoRenewal: &corepb.AutoRenewal{EndDate: ns(now.Add(24 * time.Hour)), Lifetime: &week, AllowCertificateGet: &yes},
+			ExpectedErr: "auto-renewal allow-certificate-get is not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+				RegistrationID: &regA,
+				Names:          []string{"star.example.com"},
+				AutoRenewal:    tc.AutoRenewal,
+			})
+			test.AssertError(t, err, "NewOrder with invalid auto-renewal didn't fail")
+			test.Assert(t, berrors.Is(err, berrors.Malformed), "Wrong error type")
+			test.AssertEquals(t, err.Error(), tc.ExpectedErr)
+		})
+	}
+
+	// A STAR order gets the configured profile and a start date of now if none
+	// was requested
+	end := now.Add(10 * 24 * time.Hour)
+	order, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID: &regA,
+		Names:          []string{"star.example.com"},
+		AutoRenewal:    &corepb.AutoRenewal{EndDate: ns(end), Lifetime: &week},
+	})
+	test.AssertNotError(t, err, "NewOrder with auto-renewal failed")
+	test.AssertNotNil(t, order.AutoRenewal, "STAR order had no auto-renewal")
+	test.AssertEquals(t, order.GetCertProfile(), "short-lived")
+	test.AssertEquals(t, order.AutoRenewal.GetStartDate(), now.UnixNano())
+	test.AssertEquals(t, order.AutoRenewal.GetEndDate(), end.UnixNano())
+	test.AssertEquals(t, order.AutoRenewal.GetLifetime(), week)
+
+	// An identical STAR order is never reused
+	second, err := ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID: &regA,
+		Names:          []string{"star.example.com"},
+		AutoRenewal:    &corepb.AutoRenewal{EndDate: ns(end), Lifetime: &week},
+	})
+	test.AssertNotError(t, err, "NewOrder with auto-renewal failed")
+	test.AssertNotEquals(t, second.GetId(), order.GetId())
+
+	// Auto-renewal can't be combined with a replacement order
+	replaces := "0000000000000000000000000000000000b2"
+	_, err = ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID: &regA,
+		Names:          []string{"star.example.com"},
+		AutoRenewal:    &corepb.AutoRenewal{EndDate: ns(end), Lifetime: &week},
+		Replaces:       &replaces,
+	})
+	test.AssertError(t, err, "NewOrder with auto-renewal and replaces didn't fail")
+
+	// Without a configured maximum duration STAR orders aren't supported
+	ra.AutoRenewal = AutoRenewalConfig{}
+	_, err = ra.NewOrder(context.Background(), &rapb.NewOrderRequest{
+		RegistrationID: &regA,
+		Names:          []string{"star.example.com"},
+		AutoRenewal:    &corepb.AutoRenewal{EndDate: ns(end), Lifetime: &week},
+	})
+	test.AssertError(t, err, "NewOrder with unsupported auto-renewal didn't fail")
+	test.AssertEquals(t, err.Error(), "auto-renewal orders are not supported")
+}
+
+func TestOnlyDNSChallenges(t *testing.T) {
+	chall := func(typ core.AcmeChallenge) *corepb.Challenge {
+		s := string(typ)
+		return &corepb.Challenge{Type: &s}
+	}
+
+	testCases := []struct {
+		Name       string
+		Challenges []*corepb.Challenge
+		Expected   bool
+	}{
+		{
+			Name:     "No challenges",
+			Expected: false,
+		},
+		{
+			Name:       "DNS-01",
+			Challenges: []*corepb.Challenge{chall(core.ChallengeTypeDNS01)},
+			Expected:   true,
+		},
+		{
+			Name:       "DNS-01 and DNS-ACCOUNT-01",
+			Challenges: []*corepb.Challenge{chall(core.ChallengeTypeDNS01), chall(core.ChallengeTypeDNSAccount01)},
+			Expected:   true,
+		},
+		{
+			Name:       "DNS-ACCOUNT-01 and HTTP-01",
+			Challenges: []*corepb.Challenge{chall(core.ChallengeTypeDNSAccount01), chall(core.ChallengeTypeHTTP01)},
+			Expected:   false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			test.AssertEquals(t, onlyDNSChallenges(tc.Challenges), tc.Expected)
+		})
+	}
+}
+
+type mockSAAutoRenewals struct {
+	*mocks.StorageAuthority
+
+	canceled []int64
+	renewed  []int64
+	fetched  []int64
+	// claimedElsewhere are the IDs of due orders that another RA has claimed.
+	claimedElsewhere map[int64]bool
+}
+
+func (msa *mockSAAutoRenewals) GetDueAutoRenewals(_ context.Context, req *sapb.GetDueAutoRenewalsRequest) (*sapb.DueAutoRenewals, error) {
+	id, otherID := int64(11), int64(13)
+	return &sapb.DueAutoRenewals{
+		Renewals: []*sapb.DueAutoRenewal{
+			{OrderID: &id, Csr: ExampleCSR.Raw, NextIssuance: req.Now},
+			{OrderID: &otherID, Csr: ExampleCSR.Raw, NextIssuance: req.Now},
+		},
+	}, nil
+}
+
+func (msa *mockSAAutoRenewals) GetOrder(ctx context.Context, req *sapb.OrderRequest) (*corepb.Order, error) {
+	msa.fetched = append(msa.fetched, req.GetId())
+	return msa.StorageAuthority.GetOrder(ctx, req)
+}
+
+func (msa *mockSAAutoRenewals) ClaimAutoRenewal(_ context.Context, req *sapb.ClaimAutoRenewalRequest) (*sapb.Exists, error) {
+	claimed := !msa.claimedElsewhere[req.GetOrderID()]
+	return &sapb.Exists{Exists: &claimed}, nil
+}
+
+func (msa *mockSAAutoRenewals) GetValidOrderAuthorizations2(_ context.Context, _ *sapb.GetValidOrderAuthorizationsRequest) (*sapb.Authorizations, error) {
+	return &sapb.Authorizations{}, nil
+}
+
+func (msa *mockSAAutoRenewals) SetAutoRenewalCertificate(_ context.Context, req *sapb.SetAutoRenewalCertificateRequest) (*corepb.Empty, error) {
+	msa.renewed = append(msa.renewed, req.GetOrderID())
+	return &corepb.Empty{}, nil
+}
+
+func (msa *mockSAAutoRenewals) CancelAutoRenewal(_ context.Context, req *sapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	msa.canceled = append(msa.canceled, req.GetOrderID())
+	return &corepb.Empty{}, nil
+}
+
+func TestProcessAutoRenewals(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	mockSA := &mockSAAutoRenewals{
+		StorageAuthority: mocks.NewStorageAuthority(ra.clk),
+		claimedElsewhere: map[int64]bool{13: true},
+	}
+	ra.SA = mockSA
+
+	// The due STAR order no longer has valid authorizations for its names, so
+	// no certificate should be issued and its auto-renewal should be canceled.
+	// The other due order was claimed by another RA, so is left alone.
+	renewed, err := ra.ProcessAutoRenewals(context.Background())
+	test.AssertNotError(t, err, "ProcessAutoRenewals failed")
+	test.AssertEquals(t, renewed, 0)
+	test.AssertEquals(t, len(mockSA.renewed), 0)
+	test.AssertDeepEquals(t, mockSA.canceled, []int64{11})
+	test.AssertDeepEquals(t, mockSA.fetched, []int64{11})
+}
+
+// mockSAStarOrder serves a single valid STAR order that is always due a
+// renewal, and counts the certificates issued for its names.
+type mockSAStarOrder struct {
+	*mocks.StorageAuthority
+	clk   clock.Clock
+	order *corepb.Order
+	csr   []byte
+
+	issued   int64
+	canceled bool
+}
+
+func (msa *mockSAStarOrder) GetDueAutoRenewals(_ context.Context, req *sapb.GetDueAutoRenewalsRequest) (*sapb.DueAutoRenewals, error) {
+	return &sapb.DueAutoRenewals{
+		Renewals: []*sapb.DueAutoRenewal{{OrderID: msa.order.Id, Csr: msa.csr, NextIssuance: req.Now}},
+	}, nil
+}
+
+func (msa *mockSAStarOrder) ClaimAutoRenewal(_ context.Context, _ *sapb.ClaimAutoRenewalRequest) (*sapb.Exists, error) {
+	claimed := true
+	return &sapb.Exists{Exists: &claimed}, nil
+}
+
+func (msa *mockSAStarOrder) GetOrder(_ context.Context, _ *sapb.OrderRequest) (*corepb.Order, error) {
+	return proto.Clone(msa.order).(*corepb.Order), nil
+}
+
+func (msa *mockSAStarOrder) GetValidOrderAuthorizations2(_ context.Context, _ *sapb.GetValidOrderAuthorizationsRequest) (*sapb.Authorizations, error) {
+	exp := msa.clk.Now().Add(time.Hour)
+	name := msa.order.Names[0]
+	authzPB, err := bgrpc.AuthzToPB(core.Authorization{
+		ID:             "1",
+		Identifier:     identifier.DNSIdentifier(name),
+		RegistrationID: msa.order.GetRegistrationID(),
+		Status:         core.StatusValid,
+		Expires:        &exp,
+		Challenges: []core.Challenge{
+			{Type: core.ChallengeTypeDNS01, Status: core.StatusValid, Token: core.NewToken()},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.Authorizations{Authz: []*sapb.Authorizations_MapElement{{Domain: &name, Authz: authzPB}}}, nil
+}
+
+func (msa *mockSAStarOrder) CountFQDNSets(_ context.Context, _ time.Duration, _ []string) (int64, time.Time, error) {
+	return msa.issued, msa.clk.Now(), nil
+}
+
+func (msa *mockSAStarOrder) SetAutoRenewalCertificate(_ context.Context, _ *sapb.SetAutoRenewalCertificateRequest) (*corepb.Empty, error) {
+	msa.issued++
+	return &corepb.Empty{}, nil
+}
+
+func (msa *mockSAStarOrder) CancelAutoRenewal(_ context.Context, _ *sapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	msa.canceled = true
+	return &corepb.Empty{}, nil
+}
+
+func TestProcessAutoRenewalsRateLimits(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	// The STAR order's first certificate used up its FQDN set's limit.
+	ra.rlPolicies = &dummyRateLimitConfig{
+		CertificatesPerFQDNSetPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 1,
+			Window:    cmd.ConfigDuration{Duration: 7 * 24 * time.Hour},
+		},
+	}
+	testKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "error generating test key")
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		PublicKey:          testKey.PublicKey,
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		DNSNames:           []string{"star.le.wtf"},
+	}, testKey)
+	test.AssertNotError(t, err, "Could not create CSR")
+	// issueNow has the CA issue a day long certificate from now on.
+	issueNow := func(serial int64) {
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(serial),
+			DNSNames:              []string{"star.le.wtf"},
+			NotBefore:             fc.Now(),
+			NotAfter:              fc.Now().Add(24 * time.Hour),
+			BasicConstraintsValid: true,
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+		cert, err := x509.CreateCertificate(rand.Reader, template, template, testKey.Public(), testKey)
+		test.AssertNotError(t, err, "Failed to create cert")
+		ra.CA = &mocks.MockCA{PEM: pem.EncodeToMemory(&pem.Block{Bytes: cert})}
+	}
+
+	id, regID := int64(30), int64(1)
+	exp := fc.Now().Add(30 * 24 * time.Hour).UnixNano()
+	end := fc.Now().Add(14 * 24 * time.Hour).UnixNano()
+	lifetime := int64(24 * time.Hour)
+	validStatus := string(core.StatusValid)
+	serial := core.SerialToString(big.NewInt(12))
+	mockSA := &mockSAStarOrder{
+		StorageAuthority: mocks.NewStorageAuthority(fc),
+		clk:              fc,
+		order: &corepb.Order{
+			Id:                &id,
+			RegistrationID:    &regID,
+			Expires:           &exp,
+			Names:             []string{"star.le.wtf"},
+			V2Authorizations:  []int64{1},
+			Status:            &validStatus,
+			CertificateSerial: &serial,
+			AutoRenewal:       &corepb.AutoRenewal{EndDate: &end, Lifetime: &lifetime, CurrentSerial: &serial},
+		},
+		csr:    csr,
+		issued: 1,
+	}
+	ra.SA = mockSA
+
+	// Renewals every half lifetime go well past the limit, but they were
+	// counted when the order was created, so none of them are refused.
+	for i := 1; i <= 5; i++ {
+		fc.Add(12 * time.Hour)
+		issueNow(int64(12 + i))
+		renewed, err := ra.ProcessAutoRenewals(context.Background())
+		test.AssertNotError(t, err, "ProcessAutoRenewals failed")
+		test.AssertEquals(t, renewed, 1)
+		test.AssertEquals(t, mockSA.issued, int64(1+i))
+	}
+	test.Assert(t, !mockSA.canceled, "STAR order was canceled")
+
+	// Finalizing a STAR order, issuing its first certificate, is still limited.
+	parsedCSR, err := x509.ParseCertificateRequest(csr)
+	test.AssertNotError(t, err, "Could not parse CSR")
+	first := proto.Clone(mockSA.order.AutoRenewal).(*corepb.AutoRenewal)
+	first.CurrentSerial = nil
+	_, err = ra.issueCertificate(ctx, core.CertificateRequest{Bytes: csr, CSR: parsedCSR}, accountID(regID), orderID(id), nil, first, "")
+	test.AssertError(t, err, "FQDN set limit wasn't enforced")
+	test.Assert(t, berrors.Is(err, berrors.RateLimit), "Expected a RateLimit error")
+}
+
+func TestCancelAutoRenewal(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	mockSA := &mockSAAutoRenewals{StorageAuthority: mocks.NewStorageAuthority(ra.clk)}
+	ra.SA = mockSA
+
+	regA := int64(1)
+	regB := int64(2)
+	id := func(i int64) *int64 { return &i }
+
+	// mocks.StorageAuthority's GetOrder treats ID 1 as a valid order, ID 11 as a
+	// valid STAR order and ID 12 as a pending STAR order, all for reg ID 1.
+	testCases := []struct {
+		Name        string
+		Req         *rapb.CancelAutoRenewalRequest
+		ExpectedErr berrors.ErrorType
+	}{
+		{
+			Name:        "Not a STAR order",
+			Req:         &rapb.CancelAutoRenewalRequest{OrderID: id(1), RegistrationID: &regA},
+			ExpectedErr: berrors.AutoRenewalCancellationInvalid,
+		},
+		{
+			Name:        "Pending STAR order",
+			Req:         &rapb.CancelAutoRenewalRequest{OrderID: id(12), RegistrationID: &regA},
+			ExpectedErr: berrors.AutoRenewalCancellationInvalid,
+		},
+		{
+			Name:        "Wrong account",
+			Req:         &rapb.CancelAutoRenewalRequest{OrderID: id(11), RegistrationID: &regB},
+			ExpectedErr: berrors.NotFound,
+		},
+		{
+			Name:        "Missing order",
+			Req:         &rapb.CancelAutoRenewalRequest{OrderID: id(2), RegistrationID: &regA},
+			ExpectedErr: berrors.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := ra.CancelAutoRenewal(context.Background(), tc.Req)
+			test.AssertError(t, err, "CancelAutoRenewal didn't fail")
+			test.Assert(t, berrors.Is(err, tc.ExpectedErr), "Wrong error type")
+		})
+	}
+	test.AssertEquals(t, len(mockSA.canceled), 0)
+
+	_, err := ra.CancelAutoRenewal(context.Background(), &rapb.CancelAutoRenewalRequest{OrderID: id(11), RegistrationID: &regA})
+	test.AssertNotError(t, err, "CancelAutoRenewal failed")
+	test.AssertDeepEquals(t, mockSA.canceled, []int64{11})
+}
+
+func TestFinalizeOrderWildcard(t *testing.T) {
+	_, sa, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	// Pick an expiry in the future
+	exp := ra.clk.Now().Add(365 * 24 * time.Hour)
+
+	testKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "Error creating test RSA key")
+	wildcardCSR, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		PublicKey:          testKey.PublicKey,
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		DNSNames:           []string{"*.zombo.com"},
+	}, testKey)
+	test.AssertNotError(t, err, "Error creating CSR with wildcard DNS name")
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1337),
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(0, 0, 1),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		Subject:               pkix.Name{CommonName: "*.zombo.com"},
+		DNSNames:              []string{"*.zombo.com"},
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, testKey.Public(), testKey)
+	test.AssertNotError(t, err, "Error creating test certificate")
+
+	certPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: certBytes,
+	})
+
+	// Set up a mock CA capable of giving back a cert for the wildcardCSR above
+	ca := &mocks.MockCA{
+		PEM: certPEM,
+	}
+	ra.CA = ca
+
+	// Create a new order for a wildcard domain
+	orderNames := []string{"*.zombo.com"}
+	wildcardOrderRequest := &rapb.NewOrderRequest{
+		RegistrationID: &Registration.ID,
+		Names:          orderNames,
+	}
+	order, err := ra.NewOrder(context.Background(), wildcardOrderRequest)
+	test.AssertNotError(t, err, "NewOrder failed for wildcard domain order")
+
+	// Create one standard finalized authorization for Registration.ID for zombo.com
+	_ = createFinalizedAuthorization(t, sa, "zombo.com", exp, "valid")
+
+	// Finalizing the order should *not* work since the existing validated authz
+	// is not a special DNS-01-Wildcard challenge authz, so the order will be
+	// "pending" not "ready".
+	finalizeReq := &rapb.FinalizeOrderRequest{
+		Order: order,
+		Csr:   wildcardCSR,
+	}
+	_, err = ra.FinalizeOrder(context.Background(), finalizeReq)
+	test.AssertError(t, err, "FinalizeOrder did not fail for unauthorized "+
+		"wildcard order")
+	test.AssertEquals(t, err.Error(),
+		`Order's status ("pending") is not acceptable for finalization`)
+
+	// Creating another order for the wildcard name
+	validOrder, err := ra.NewOrder(context.Background(), wildcardOrderRequest)
+	test.AssertNotError(t, err, "NewOrder failed for wildcard domain order")
+	test.AssertEquals(t, numAuthorizations(validOrder), 1)
+	// We expect to be able to get the authorization by ID
+	_, err = sa.GetAuthorization2(ctx, &sapb.AuthorizationID2{Id: &validOrder.V2Authorizations[0]})
+	test.AssertNotError(t, err, "sa.GetAuthorization2 failed")
+
+	// Finalize the authorization with the challenge validated
+	status := string(core.StatusValid)
+	attempted := string(core.ChallengeTypeDNS01)
+	expInt := ra.clk.Now().Add(time.Hour * 24 * 7).UnixNano()
+	err = sa.FinalizeAuthorization2(ctx, &sapb.FinalizeAuthorizationRequest{
+		Id:        &validOrder.V2Authorizations[0],
+		Status:    &status,
+		Expires:   &expInt,
+		Attempted: &attempted,
+	})
+	test.AssertNotError(t, err, "sa.FinalizeAuthorization2 failed")
+
+	// Refresh the order so the SA sets its status
+	validOrder, err = sa.GetOrder(ctx, &sapb.OrderRequest{
+		Id: validOrder.Id,
+	})
+	test.AssertNotError(t, err, "Could not refresh valid order from SA")
+
+	// Now it should be possible to finalize the order
+	finalizeReq = &rapb.FinalizeOrderRequest{
+		Order: validOrder,
+		Csr:   wildcardCSR,
+	}
+	_, err = ra.FinalizeOrder(context.Background(), finalizeReq)
+	test.AssertNotError(t, err, "FinalizeOrder failed for authorized "+
+		"wildcard order")
+}
+
+func TestIssueCertificateAuditLog(t *testing.T) {
+	_, sa, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	// Set up order and authz expiries
+	ra.orderLifetime = 24 * time.Hour
+	exp := ra.clk.Now().Add(24 * time.Hour)
+
+	authzForChalType := func(domain, chalType string) int64 {
+		template := core.Authorization{
 			Identifier: identifier.ACMEIdentifier{
 				Type:  "dns",
 				Value: domain,
@@ -3468,6 +4587,44 @@ func TestValidChallengeStillGood(t *testing.T) {
 	test.Assert(t, !ra.authzValidChallengeEnabled(&core.Authorization{Challenges: []core.Challenge{{Status: core.StatusValid, Type: core.ChallengeTypeDNS01}}}), "ra.authzValidChallengeEnabled didn't fail with disabled challenge")
 }
 
+func TestValidChallengeAllowedByPolicy(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	// The test hostname policy only allows DNS-01 for dns-01-only.le.wtf and
+	// its subdomains
+	validAuthz := func(name string, wildcard bool, challType core.AcmeChallenge) *core.Authorization {
+		return &core.Authorization{
+			Identifier: identifier.DNSIdentifier(name),
+			Wildcard:   wildcard,
+			Challenges: []core.Challenge{{Status: core.StatusValid, Type: challType}},
+		}
+	}
+	test.Assert(t, ra.authzValidChallengeEnabled(validAuthz("www.le.wtf", false, core.ChallengeTypeHTTP01)),
+		"ra.authzValidChallengeEnabled failed for an allowed challenge")
+	test.Assert(t, !ra.authzValidChallengeEnabled(validAuthz("www.dns-01-only.le.wtf", false, core.ChallengeTypeHTTP01)),
+		"ra.authzValidChallengeEnabled didn't fail for a challenge disallowed by policy")
+	test.Assert(t, ra.authzValidChallengeEnabled(validAuthz("www.dns-01-only.le.wtf", false, core.ChallengeTypeDNS01)),
+		"ra.authzValidChallengeEnabled failed for a challenge allowed by policy")
+	test.Assert(t, ra.authzValidChallengeEnabled(validAuthz("dns-01-only.le.wtf", true, core.ChallengeTypeDNS01)),
+		"ra.authzValidChallengeEnabled failed for a wildcard challenge allowed by policy")
+
+	validAuthzPB := func(challType core.AcmeChallenge) *corepb.Authorization {
+		status := string(core.StatusValid)
+		typ := string(challType)
+		return &corepb.Authorization{
+			Status:     &status,
+			Challenges: []*corepb.Challenge{{Status: &status, Type: &typ}},
+		}
+	}
+	test.Assert(t, !ra.authzPBValidChallengeAllowed(validAuthzPB(core.ChallengeTypeHTTP01), "www.dns-01-only.le.wtf"),
+		"ra.authzPBValidChallengeAllowed didn't fail for a challenge disallowed by policy")
+	test.Assert(t, ra.authzPBValidChallengeAllowed(validAuthzPB(core.ChallengeTypeDNS01), "*.dns-01-only.le.wtf"),
+		"ra.authzPBValidChallengeAllowed failed for a challenge allowed by policy")
+	test.Assert(t, !ra.authzPBValidChallengeAllowed(validAuthzPB(core.ChallengeTypeHTTP01), "*.le.wtf"),
+		"ra.authzPBValidChallengeAllowed didn't fail for an HTTP-01 challenge of a wildcard")
+}
+
 func TestPerformValidationBadChallengeType(t *testing.T) {
 	_, _, ra, fc, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -3516,7 +4673,7 @@ func TestCTPolicyMeasurements(t *testing.T) {
 	ra := NewRegistrationAuthorityImpl(fc,
 		log,
 		stats,
-		1, testKeyPolicy, 0, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, ctp, nil, nil)
+		1, testKeyPolicy, 0, true, 300*24*time.Hour, 7*24*time.Hour, nil, noopCAA{}, 0, time.Minute, ctp, nil, nil)
 	ra.SA = ssa
 	ra.CA = ca
 
@@ -3530,7 +4687,7 @@ func TestCTPolicyMeasurements(t *testing.T) {
 
 	_, err := ra.issueCertificate(ctx, core.CertificateRequest{
 		CSR: ExampleCSR,
-	}, accountID(Registration.ID), 0)
+	}, accountID(Registration.ID), 0, nil, nil, "")
 	test.AssertError(t, err, "ra.issueCertificate didn't fail when CTPolicy.GetSCTs timed out")
 	test.AssertEquals(t, test.CountHistogramSamples(ra.ctpolicyResults.With(prometheus.Labels{"result": "failure"})), 1)
 }
@@ -3750,7 +4907,7 @@ func TestIssueCertificateInnerErrs(t *testing.T) {
 			// Mock the CA
 			ra.CA = tc.Mock
 			// Attempt issuance
-			_, err = ra.issueCertificateInner(ctx, req, accountID(Registration.ID), orderID(*order.Id), logEvent)
+			_, err = ra.issueCertificateInner(ctx, req, accountID(Registration.ID), orderID(*order.Id), nil, nil, "", logEvent)
 			// We expect all of the testcases to fail because all use mocked CAs that deliberately error
 			test.AssertError(t, err, "issueCertificateInner with failing mock CA did not fail")
 			// If there is an expected `error` then match the error message
@@ -3826,6 +4983,74 @@ func TestDisableNewV1Validations(t *testing.T) {
 	test.AssertEquals(t, err.Error(), "Validations for new domains are disabled in the V1 API (https://community.letsencrypt.org/t/end-of-life-plan-for-acmev1/88430)")
 }
 
+func TestNewPreAuthorization(t *testing.T) {
+	_, sa, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	identType := string(identifier.DNS)
+	newReq := func(value string) *rapb.NewPreAuthorizationRequest {
+		return &rapb.NewPreAuthorizationRequest{
+			RegistrationID:  &Registration.ID,
+			IdentifierType:  &identType,
+			IdentifierValue: &value,
+		}
+	}
+
+	authzPB, err := ra.NewPreAuthorization(ctx, newReq("Not-Example.com"))
+	test.AssertNotError(t, err, "NewPreAuthorization failed")
+	authz, err := bgrpc.PBToAuthz(authzPB)
+	test.AssertNotError(t, err, "PBToAuthz failed")
+	test.AssertEquals(t, authz.Identifier.Value, "not-example.com")
+	test.AssertEquals(t, authz.Status, core.StatusPending)
+	test.AssertEquals(t, authz.RegistrationID, Registration.ID)
+	assertAuthzEqual(t, authz, getAuthorization(t, authz.ID, sa))
+
+	// A second pre-authorization for the same identifier reuses the pending authz
+	authzPB, err = ra.NewPreAuthorization(ctx, newReq("not-example.com"))
+	test.AssertNotError(t, err, "NewPreAuthorization failed")
+	test.AssertEquals(t, *authzPB.Id, authz.ID)
+
+	_, err = ra.NewPreAuthorization(ctx, newReq("*.not-example.com"))
+	test.AssertError(t, err, "NewPreAuthorization didn't fail for a wildcard identifier")
+	test.AssertEquals(t, berrors.Is(err, berrors.Malformed), true)
+
+	// Pre-authorization is an ACMEv2 feature and isn't subject to the ACMEv1
+	// new validations restriction
+	_ = features.Set(map[string]bool{"V1DisableNewValidations": true})
+	defer features.Reset()
+	ra.SA = &mockSAPreviousValidations{existsDomain: "bloop-example.com"}
+	_, err = ra.NewPreAuthorization(ctx, newReq("bloop-not-example.com"))
+	test.AssertNotError(t, err, "NewPreAuthorization failed with V1DisableNewValidations")
+}
+
+func TestNewPreAuthorizationRateLimit(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ra.rlPolicies = &dummyRateLimitConfig{
+		PendingAuthorizationsPerAccountPolicy: ratelimit.RateLimitPolicy{
+			Threshold: 1,
+			Window:    cmd.ConfigDuration{Duration: 24 * 90 * time.Hour},
+		},
+	}
+
+	identType := string(identifier.DNS)
+	for i, value := range []string{"a.not-example.com", "b.not-example.com"} {
+		value := value
+		_, err := ra.NewPreAuthorization(ctx, &rapb.NewPreAuthorizationRequest{
+			RegistrationID:  &Registration.ID,
+			IdentifierType:  &identType,
+			IdentifierValue: &value,
+		})
+		if i == 0 {
+			test.AssertNotError(t, err, "NewPreAuthorization failed")
+		} else {
+			test.AssertError(t, err, "Pending authorizations rate limit not enforced")
+			test.AssertEquals(t, berrors.Is(err, berrors.RateLimit), true)
+		}
+	}
+}
+
 func TestNewOrderMaxNames(t *testing.T) {
 	_, _, ra, _, cleanUp := initAuthorities(t)
 	defer cleanUp()
@@ -3969,3 +5194,667 @@ func TestRevocationAddBlockedKey(t *testing.T) {
 	test.AssertEquals(t, test.CountCounterVec(
 		"reason", "keyCompromise", ra.revocationReasonCounter), 2)
 }
+
+// mockSAWithOverrides is a mock SA that stores rate limit overrides in memory.
+type mockSAWithOverrides struct {
+	mocks.StorageAuthority
+	overrides []*corepb.RateLimitOverride
+}
+
+func (m *mockSAWithOverrides) AddRateLimitOverride(_ context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	id := int64(len(m.overrides) + 1)
+	req.Id = &id
+	m.overrides = append(m.overrides, req)
+	return req, nil
+}
+
+func (m *mockSAWithOverrides) GetRateLimitOverrides(_ context.Context, _ *sapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	return &corepb.RateLimitOverrides{Overrides: m.overrides}, nil
+}
+
+func (m *mockSAWithOverrides) ExpireRateLimitOverride(_ context.Context, req *sapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	for i, o := range m.overrides {
+		if o.GetId() == req.GetId() {
+			m.overrides = append(m.overrides[:i], m.overrides[i+1:]...)
+			return &corepb.Empty{}, nil
+		}
+	}
+	return nil, berrors.NotFoundError("no unexpired rate limit override found with ID %d", req.GetId())
+}
+
+func TestRateLimitOverrides(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ra.rlPolicies = ratelimit.New()
+	err := ra.rlPolicies.LoadPolicies([]byte(`
+certificatesPerName:
+  window: 2160h
+  threshold: 2
+newOrdersPerAccount:
+  window: 3h
+  threshold: 300
+`))
+	test.AssertNotError(t, err, "loading policies")
+	mockSA := &mockSAWithOverrides{}
+	ra.SA = mockSA
+	ctx := context.Background()
+
+	override := func(limit, key string, regID, threshold int64, expires time.Time) *corepb.RateLimitOverride {
+		owner := "alice"
+		justification := "Big hosting provider"
+		expiresNS := expires.UnixNano()
+		return &corepb.RateLimitOverride{
+			Limit:          &limit,
+			Key:            &key,
+			RegistrationID: &regID,
+			Threshold:      &threshold,
+			Owner:          &owner,
+			Justification:  &justification,
+			Expires:        &expiresNS,
+		}
+	}
+	future := fc.Now().Add(time.Hour)
+
+	invalid := []struct {
+		name     string
+		override *corepb.RateLimitOverride
+	}{
+		{"unknown limit", override("bogus", "example.com", 0, 10, future)},
+		{"no key or registration", override("certificatesPerName", "", 0, 10, future)},
+		{"key and registration", override("certificatesPerName", "example.com", 1, 10, future)},
+		{"key for an account limit", override("newOrdersPerAccount", "example.com", 0, 10, future)},
+		{"registration for an IP limit", override("registrationsPerIP", "", 1, 10, future)},
+		{"negative threshold", override("certificatesPerName", "example.com", 0, -1, future)},
+		{"expired", override("certificatesPerName", "example.com", 0, 10, fc.Now())},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ra.AddRateLimitOverride(ctx, tc.override)
+			test.AssertError(t, err, "AddRateLimitOverride didn't fail")
+			test.Assert(t, berrors.Is(err, berrors.Malformed), "Expected a Malformed error")
+		})
+	}
+	test.AssertEquals(t, len(mockSA.overrides), 0)
+
+	// Added overrides are applied to the RA's limits straight away
+	byKey, err := ra.AddRateLimitOverride(ctx, override("certificatesPerName", "example.com", 0, 50, future))
+	test.AssertNotError(t, err, "AddRateLimitOverride failed")
+	_, err = ra.AddRateLimitOverride(ctx, override("newOrdersPerAccount", "", 101, 600, future))
+	test.AssertNotError(t, err, "AddRateLimitOverride failed")
+	certsPerName := ra.rlPolicies.CertificatesPerName()
+	test.AssertEquals(t, certsPerName.GetThreshold("example.com", 1), 50)
+	newOrders := ra.rlPolicies.NewOrdersPerAccount()
+	test.AssertEquals(t, newOrders.GetThreshold("", 101), 600)
+
+	_, err = ra.ExpireRateLimitOverride(ctx, &rapb.ExpireRateLimitOverrideRequest{Id: byKey.Id})
+	test.AssertError(t, err, "ExpireRateLimitOverride without an actor didn't fail")
+	actor := "bob"
+	justification := "No longer needed"
+	_, err = ra.ExpireRateLimitOverride(ctx, &rapb.ExpireRateLimitOverrideRequest{Id: byKey.Id, Actor: &actor, Justification: &justification})
+	test.AssertNotError(t, err, "ExpireRateLimitOverride failed")
+	certsPerName = ra.rlPolicies.CertificatesPerName()
+	test.AssertEquals(t, certsPerName.GetThreshold("example.com", 1), 2)
+
+	overrides, err := ra.GetRateLimitOverrides(ctx, &rapb.GetRateLimitOverridesRequest{})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 1)
+
+	// Overrides changed elsewhere are picked up on refresh
+	mockSA.overrides = nil
+	err = ra.RefreshRateLimitOverrides(ctx)
+	test.AssertNotError(t, err, "RefreshRateLimitOverrides failed")
+	newOrders = ra.rlPolicies.NewOrdersPerAccount()
+	test.AssertEquals(t, newOrders.GetThreshold("", 101), 300)
+}
+
+func TestAuthzReusePolicy(t *testing.T) {
+	now := time.Date(2020, 10, 18, 12, 0, 0, 0, time.UTC)
+	policy := AuthzReusePolicy{
+		MaxAge: map[core.AcmeChallenge]time.Duration{
+			core.ChallengeTypeHTTP01: 24 * time.Hour,
+		},
+		NoReuseSuffixes: []string{"highrisk.com"},
+	}
+
+	testCases := []struct {
+		name      string
+		challType core.AcmeChallenge
+		age       time.Duration
+		reusable  bool
+	}{
+		{"example.com", core.ChallengeTypeHTTP01, time.Hour, true},
+		{"example.com", core.ChallengeTypeHTTP01, 25 * time.Hour, false},
+		// Challenge types without a MaxAge are reusable for any age
+		{"example.com", core.ChallengeTypeDNS01, 25 * 24 * time.Hour, true},
+		{"*.example.com", core.ChallengeTypeDNS01, 25 * 24 * time.Hour, true},
+		// Names under a NoReuseSuffixes entry are never reusable
+		{"highrisk.com", core.ChallengeTypeDNS01, time.Minute, false},
+		{"www.highrisk.com", core.ChallengeTypeDNS01, time.Minute, false},
+		{"*.highrisk.com", core.ChallengeTypeDNS01, time.Minute, false},
+		{"nothighrisk.com", core.ChallengeTypeDNS01, time.Minute, true},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s %s %s", tc.name, tc.challType, tc.age), func(t *testing.T) {
+			validated := now.Add(-tc.age)
+			test.AssertEquals(t, policy.reusable(tc.name, tc.challType, &validated, now), tc.reusable)
+		})
+	}
+
+	// Authzs without a recorded validation time are only reusable for
+	// challenge types without a MaxAge
+	test.Assert(t, !policy.reusable("example.com", core.ChallengeTypeHTTP01, nil, now), "Authz without a validation time was reusable despite a MaxAge")
+	test.Assert(t, policy.reusable("example.com", core.ChallengeTypeDNS01, nil, now), "Authz without a validation time wasn't reusable without a MaxAge")
+
+	policy.NoWildcardReuse = true
+	test.Assert(t, !policy.reusable("*.example.com", core.ChallengeTypeDNS01, &now, now), "Wildcard authz was reusable with NoWildcardReuse")
+	test.Assert(t, policy.reusable("example.com", core.ChallengeTypeDNS01, &now, now), "Non-wildcard authz wasn't reusable with NoWildcardReuse")
+
+	// The zero value allows any reuse
+	test.Assert(t, AuthzReusePolicy{}.reusable("*.highrisk.com", core.ChallengeTypeHTTP01, nil, now), "Authz wasn't reusable with the zero policy")
+}
+
+// mockSAWithValidAuthz is a mock SA that returns a valid authz with ID 2 for
+// "zombo.com", validated with challType at validated and expiring at expires.
+type mockSAWithValidAuthz struct {
+	mocks.StorageAuthority
+	challType core.AcmeChallenge
+	validated *time.Time
+	expires   time.Time
+}
+
+func (msa *mockSAWithValidAuthz) GetAuthorizations2(_ context.Context, req *sapb.GetAuthorizationsRequest) (*sapb.Authorizations, error) {
+	return sa.AuthzMapToPB(map[string]*core.Authorization{
+		"zombo.com": {
+			ID:             "2",
+			Identifier:     identifier.DNSIdentifier("zombo.com"),
+			RegistrationID: *req.RegistrationID,
+			Status:         core.StatusValid,
+			Expires:        &msa.expires,
+			Challenges: []core.Challenge{
+				{Type: msa.challType, Status: core.StatusValid, Validated: msa.validated},
+			},
+		},
+	})
+}
+
+func (msa *mockSAWithValidAuthz) NewAuthorizations2(_ context.Context, _ *sapb.AddPendingAuthorizationsRequest) (*sapb.Authorization2IDs, error) {
+	return &sapb.Authorization2IDs{Ids: []int64{5}}, nil
+}
+
+func TestNewOrderAuthzReusePolicy(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	ctx := context.Background()
+	regA := int64(1)
+	orderReq := &rapb.NewOrderRequest{
+		RegistrationID: &regA,
+		Names:          []string{"zombo.com"},
+	}
+	// The authz was validated two days ago
+	validated := fc.Now().Add(-48 * time.Hour)
+	mockSA := &mockSAWithValidAuthz{
+		challType: core.ChallengeTypeHTTP01,
+		validated: &validated,
+		expires:   fc.Now().Add(ra.authorizationLifetime),
+	}
+	ra.SA = mockSA
+	reused := func() bool {
+		order, err := ra.NewOrder(ctx, orderReq)
+		test.AssertNotError(t, err, "NewOrder failed")
+		test.AssertEquals(t, numAuthorizations(order), 1)
+		return order.V2Authorizations[0] == 2
+	}
+
+	test.Assert(t, reused(), "Valid authz wasn't reused without a reuse policy")
+
+	ra.AuthzReuse = AuthzReusePolicy{MaxAge: map[core.AcmeChallenge]time.Duration{core.ChallengeTypeDNS01: 24 * time.Hour}}
+	test.Assert(t, reused(), "Valid authz was reused despite a MaxAge for another challenge type")
+
+	ra.AuthzReuse = AuthzReusePolicy{MaxAge: map[core.AcmeChallenge]time.Duration{core.ChallengeTypeHTTP01: 24 * time.Hour}}
+	test.Assert(t, !reused(), "Valid authz older than its MaxAge was reused")
+
+	ra.AuthzReuse = AuthzReusePolicy{MaxAge: map[core.AcmeChallenge]time.Duration{core.ChallengeTypeHTTP01: 72 * time.Hour}}
+	test.Assert(t, reused(), "Valid authz younger than its MaxAge wasn't reused")
+
+	// The age of an authz without a recorded validation time is unknown, so
+	// it isn't reused, however long its authorization lifetime remains
+	mockSA.validated = nil
+	test.Assert(t, !reused(), "Valid authz without a validation time was reused despite a MaxAge")
+
+	ra.AuthzReuse = AuthzReusePolicy{NoReuseSuffixes: []string{"zombo.com"}}
+	test.Assert(t, !reused(), "Valid authz for a NoReuseSuffixes name was reused")
+}
+
+func TestCheckAuthorizationsMaxAge(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	// The authz was validated two hours ago, so CAA doesn't need rechecking
+	expires := fc.Now().Add(ra.authorizationLifetime).Add(-2 * time.Hour)
+	validated := fc.Now().Add(-2 * time.Hour)
+	authzs := map[string]*core.Authorization{
+		"zombo.com": {
+			ID:         "1",
+			Identifier: identifier.DNSIdentifier("zombo.com"),
+			Status:     core.StatusValid,
+			Expires:    &expires,
+			Challenges: []core.Challenge{{Type: core.ChallengeTypeHTTP01, Status: core.StatusValid, Validated: &validated}},
+		},
+	}
+	err := ra.checkAuthorizationsCAA(context.Background(), []string{"zombo.com"}, authzs, 1, fc.Now())
+	test.AssertNotError(t, err, "checkAuthorizationsCAA failed")
+
+	ra.AuthzReuse = AuthzReusePolicy{MaxAge: map[core.AcmeChallenge]time.Duration{core.ChallengeTypeHTTP01: time.Hour}}
+	err = ra.checkAuthorizationsCAA(context.Background(), []string{"zombo.com"}, authzs, 1, fc.Now())
+	test.AssertError(t, err, "checkAuthorizationsCAA didn't fail for an authz older than its MaxAge")
+	test.Assert(t, berrors.Is(err, berrors.Unauthorized), "Expected an Unauthorized error")
+
+	ra.AuthzReuse = AuthzReusePolicy{MaxAge: map[core.AcmeChallenge]time.Duration{core.ChallengeTypeHTTP01: 3 * time.Hour}}
+	err = ra.checkAuthorizationsCAA(context.Background(), []string{"zombo.com"}, authzs, 1, fc.Now())
+	test.AssertNotError(t, err, "checkAuthorizationsCAA failed for an authz younger than its MaxAge")
+
+	authzs["zombo.com"].Challenges[0].Validated = nil
+	err = ra.checkAuthorizationsCAA(context.Background(), []string{"zombo.com"}, authzs, 1, fc.Now())
+	test.AssertError(t, err, "checkAuthorizationsCAA didn't fail for an authz without a validation time")
+}
+
+func TestValidationRetryPolicy(t *testing.T) {
+	now := time.Date(2020, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	// The zero value allows no retries
+	_, retry := ValidationRetryPolicy{}.retryAfter(1, now)
+	test.Assert(t, !retry, "The zero ValidationRetryPolicy allowed a retry")
+
+	policy := ValidationRetryPolicy{MaxAttempts: 5, Backoff: time.Minute, MaxBackoff: 3 * time.Minute}
+	for failed, backoff := range map[int]time.Duration{
+		1: time.Minute,
+		2: 2 * time.Minute,
+		3: 3 * time.Minute,
+		4: 3 * time.Minute,
+	} {
+		retryAfter, retry := policy.retryAfter(failed, now)
+		test.Assert(t, retry, fmt.Sprintf("Retry after %d failed attempts wasn't allowed", failed))
+		test.AssertEquals(t, retryAfter, now.Add(backoff))
+	}
+	_, retry = policy.retryAfter(5, now)
+	test.Assert(t, !retry, "Retry after MaxAttempts failed attempts was allowed")
+
+	policy.MaxBackoff = 0
+	retryAfter, _ := policy.retryAfter(4, now)
+	test.AssertEquals(t, retryAfter, now.Add(8*time.Minute))
+}
+
+// mockSARecordingValidations is a mock SA that sends the results of the
+// validations the RA records on recorded. If failedErr is set, failed attempts
+// aren't recorded and RecordFailedValidation2 returns it.
+type mockSARecordingValidations struct {
+	mocks.StorageAuthority
+	recorded  chan interface{}
+	failedErr error
+}
+
+func (msa *mockSARecordingValidations) FinalizeAuthorization2(_ context.Context, req *sapb.FinalizeAuthorizationRequest) error {
+	msa.recorded <- req
+	return nil
+}
+
+func (msa *mockSARecordingValidations) RecordFailedValidation2(_ context.Context, req *sapb.RecordFailedValidationRequest) error {
+	if msa.failedErr != nil {
+		return msa.failedErr
+	}
+	msa.recorded <- req
+	return nil
+}
+
+func (msa *mockSARecordingValidations) AddValidationAttempt(_ context.Context, req *corepb.ValidationAttempt) (*corepb.Empty, error) {
+	msa.recorded <- req
+	return &corepb.Empty{}, nil
+}
+
+func TestPerformValidationRetry(t *testing.T) {
+	va, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	msa := &mockSARecordingValidations{recorded: make(chan interface{}, 1)}
+	ra.SA = msa
+	ra.ValidationRetries = ValidationRetryPolicy{MaxAttempts: 2, Backoff: time.Minute}
+	va.ResultReturn = &vapb.ValidationResult{
+		Problems: &corepb.ProblemDetails{
+			ProblemType: proto.String("connection"),
+			Detail:      proto.String("it went bad captain"),
+		},
+	}
+
+	expires := fc.Now().Add(time.Hour)
+	authz := core.Authorization{
+		ID:             "1",
+		Identifier:     identifier.DNSIdentifier("example.com"),
+		RegistrationID: 1,
+		Status:         core.StatusPending,
+		Expires:        &expires,
+		Challenges: []core.Challenge{
+			{Type: core.ChallengeTypeHTTP01, Status: core.StatusPending, Token: core.NewToken()},
+		},
+	}
+	validate := func() interface{} {
+		authzPB, err := bgrpc.AuthzToPB(authz)
+		test.AssertNotError(t, err, "AuthzToPB failed")
+		challIdx := int64(0)
+		_, err = ra.PerformValidation(ctx, &rapb.PerformValidationRequest{
+			Authz:          authzPB,
+			ChallengeIndex: &challIdx,
+		})
+		test.AssertNotError(t, err, "PerformValidation failed")
+		<-va.request
+		select {
+		case req := <-msa.recorded:
+			return req
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for the RA to record the validation")
+		}
+		return nil
+	}
+
+	// The first failed attempt leaves the authz pending, to be retried after
+	// the backoff
+	failed, ok := validate().(*sapb.RecordFailedValidationRequest)
+	test.Assert(t, ok, "First failed attempt wasn't recorded as a failed attempt")
+	test.AssertEquals(t, *failed.Attempted, string(core.ChallengeTypeHTTP01))
+	test.AssertEquals(t, *failed.ValidationError.Detail, "it went bad captain")
+	test.AssertEquals(t, time.Unix(0, *failed.RetryAfter).UTC(), fc.Now().Add(time.Minute).UTC())
+
+	// Retrying before the backoff has passed is refused
+	retryAfter := time.Unix(0, *failed.RetryAfter)
+	authz.Challenges[0].Attempts = 1
+	authz.Challenges[0].RetryAfter = &retryAfter
+	authzPB, err := bgrpc.AuthzToPB(authz)
+	test.AssertNotError(t, err, "AuthzToPB failed")
+	challIdx := int64(0)
+	_, err = ra.PerformValidation(ctx, &rapb.PerformValidationRequest{
+		Authz:          authzPB,
+		ChallengeIndex: &challIdx,
+	})
+	test.AssertError(t, err, "PerformValidation didn't fail before the backoff had passed")
+	test.Assert(t, berrors.Is(err, berrors.RateLimit), "Expected a RateLimit error")
+	test.AssertEquals(t, err.(*berrors.BoulderError).RetryAfter, time.Minute)
+
+	// The last attempt invalidates the authz
+	fc.Add(time.Minute)
+	finalized, ok := validate().(*sapb.FinalizeAuthorizationRequest)
+	test.Assert(t, ok, "Last failed attempt wasn't recorded as a final validation")
+	test.AssertEquals(t, *finalized.Status, string(core.StatusInvalid))
+
+	// A failed attempt that can't be recorded, e.g. because the SA doesn't
+	// store failed attempts, invalidates the authz rather than leave it pending
+	msa.failedErr = berrors.InternalServerError("failed validation attempts are not stored")
+	authz.Challenges[0].Attempts = 0
+	authz.Challenges[0].RetryAfter = nil
+	finalized, ok = validate().(*sapb.FinalizeAuthorizationRequest)
+	test.Assert(t, ok, "Unrecorded failed attempt wasn't recorded as a final validation")
+	test.AssertEquals(t, *finalized.Status, string(core.StatusInvalid))
+}
+
+func TestPerformValidationStoresAttempt(t *testing.T) {
+	va, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+
+	err := features.Set(map[string]bool{"StoreValidationAttempts": true})
+	test.AssertNotError(t, err, "Failed to set feature flags")
+	defer features.Reset()
+
+	msa := &mockSARecordingValidations{recorded: make(chan interface{}, 2)}
+	ra.SA = msa
+	remoteHost := "remote.va"
+	va.ResultReturn = &vapb.ValidationResult{
+		Records: []*corepb.ValidationRecord{
+			{
+				AddressUsed: []byte("192.168.0.1"),
+				Hostname:    proto.String("example.com"),
+				Port:        proto.String("53"),
+				Url:         proto.String("example.com"),
+			},
+		},
+		RemoteResults: []*corepb.RemoteValidationResult{{VaHostname: &remoteHost}},
+		CaaRecords:    []string{`example.com.	3600	IN	CAA	0 issue "letsencrypt.org"`},
+	}
+
+	expires := fc.Now().Add(time.Hour)
+	authzPB, err := bgrpc.AuthzToPB(core.Authorization{
+		ID:             "1",
+		Identifier:     identifier.DNSIdentifier("example.com"),
+		RegistrationID: 1,
+		Status:         core.StatusPending,
+		Expires:        &expires,
+		Challenges: []core.Challenge{
+			{Type: core.ChallengeTypeDNS01, Status: core.StatusPending, Token: core.NewToken()},
+		},
+	})
+	test.AssertNotError(t, err, "AuthzToPB failed")
+	challIdx := int64(0)
+	_, err = ra.PerformValidation(ctx, &rapb.PerformValidationRequest{
+		Authz:          authzPB,
+		ChallengeIndex: &challIdx,
+	})
+	test.AssertNotError(t, err, "PerformValidation failed")
+	<-va.request
+
+	var attempt *corepb.ValidationAttempt
+	for i := 0; i < 2 && attempt == nil; i++ {
+		select {
+		case req := <-msa.recorded:
+			attempt, _ = req.(*corepb.ValidationAttempt)
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for the RA to record the validation attempt")
+		}
+	}
+	test.AssertNotNil(t, attempt, "Validation attempt wasn't recorded")
+	test.AssertEquals(t, *attempt.AuthzID, int64(1))
+	test.AssertEquals(t, *attempt.ChallengeType, string(core.ChallengeTypeDNS01))
+	test.AssertEquals(t, *attempt.Status, string(core.StatusValid))
+	test.AssertEquals(t, *attempt.AttemptedAt, fc.Now().UnixNano())
+	test.AssertEquals(t, len(attempt.ValidationRecords), 1)
+	test.AssertEquals(t, len(attempt.RemoteResults), 1)
+	test.AssertEquals(t, *attempt.RemoteResults[0].VaHostname, remoteHost)
+	test.AssertDeepEquals(t, attempt.CaaRecords, va.ResultReturn.CaaRecords)
+}
+
+// mockSAWithAccountScope is a mock SA that restricts registration 1 to
+// example.com and 127.0.0.1.
+type mockSAWithAccountScope struct {
+	mocks.StorageAuthority
+}
+
+func (msa *mockSAWithAccountScope) GetAccountScope(_ context.Context, req *sapb.RegistrationID) (*sapb.AccountScope, error) {
+	scope := &sapb.AccountScope{RegistrationID: req.Id}
+	if req.GetId() == 1 {
+		scope.Suffixes = []string{"127.0.0.1", "example.com"}
+	}
+	return scope, nil
+}
+
+func TestCheckAccountScope(t *testing.T) {
+	_, _, ra, _, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	ra.SA = &mockSAWithAccountScope{}
+
+	idents := []identifier.ACMEIdentifier{
+		identifier.DNSIdentifier("example.com"),
+		identifier.DNSIdentifier("www.example.com"),
+		identifier.DNSIdentifier("*.example.com"),
+		identifier.IPIdentifier(net.ParseIP("127.0.0.1")),
+	}
+	outOfScope := []identifier.ACMEIdentifier{
+		identifier.DNSIdentifier("notexample.com"),
+		identifier.DNSIdentifier("example.com.evil.com"),
+		identifier.IPIdentifier(net.ParseIP("127.0.0.2")),
+	}
+
+	// Scopes aren't enforced unless the feature is enabled
+	err := ra.checkAccountScope(ctx, 1, outOfScope)
+	test.AssertNotError(t, err, "checkAccountScope failed with EnforceAccountScopes disabled")
+
+	err = features.Set(map[string]bool{"EnforceAccountScopes": true})
+	test.AssertNotError(t, err, "Failed to set feature flags")
+	defer features.Reset()
+
+	err = ra.checkAccountScope(ctx, 1, idents)
+	test.AssertNotError(t, err, "checkAccountScope failed for identifiers in scope")
+
+	// Each identifier out of scope has its own sub-error
+	err = ra.checkAccountScope(ctx, 1, append(idents, outOfScope...))
+	test.AssertError(t, err, "checkAccountScope didn't fail for identifiers out of scope")
+	test.Assert(t, berrors.Is(err, berrors.RejectedIdentifier), "Expected a RejectedIdentifier error")
+	test.AssertEquals(t, err.Error(), `Cannot issue for "notexample.com": account is not allowed to issue for this identifier (and 2 more problems. Refer to sub-problems for more information.)`)
+	subErrors := err.(*berrors.BoulderError).SubErrors
+	test.AssertEquals(t, len(subErrors), 3)
+	for i, subErr := range subErrors {
+		test.AssertEquals(t, subErr.Identifier, outOfScope[i])
+		test.AssertEquals(t, subErr.Type, berrors.RejectedIdentifier)
+	}
+
+	// Accounts without a scope may issue for anything
+	err = ra.checkAccountScope(ctx, 2, outOfScope)
+	test.AssertNotError(t, err, "checkAccountScope failed for an account without a scope")
+}
+
+func TestAccountScopeEnforced(t *testing.T) {
+	_, _, ra, fc, cleanUp := initAuthorities(t)
+	defer cleanUp()
+	ra.SA = &mockSAWithAccountScope{}
+
+	err := features.Set(map[string]bool{"EnforceAccountScopes": true})
+	test.AssertNotError(t, err, "Failed to set feature flags")
+	defer features.Reset()
+
+	regID := int64(1)
+	_, err = ra.NewOrder(ctx, &rapb.NewOrderRequest{
+		RegistrationID: &regID,
+		Names:          []string{"www.example.com", "not-example.com"},
+	})
+	test.AssertError(t, err, "NewOrder didn't fail for a name out of the account's scope")
+	test.Assert(t, berrors.Is(err, berrors.RejectedIdentifier), "Expected a RejectedIdentifier error")
+	test.AssertEquals(t, len(err.(*berrors.BoulderError).SubErrors), 1)
+	test.AssertEquals(t, err.(*berrors.BoulderError).SubErrors[0].Identifier.Value, "not-example.com")
+
+	expires := fc.Now().Add(time.Hour)
+	authzPB, err := bgrpc.AuthzToPB(core.Authorization{
+		ID:             "1",
+		Identifier:     identifier.DNSIdentifier("not-example.com"),
+		RegistrationID: regID,
+		Status:         core.StatusPending,
+		Expires:        &expires,
+		Challenges: []core.Challenge{
+			{Type: core.ChallengeTypeDNS01, Status: core.StatusPending, Token: core.NewToken()},
+		},
+	})
+	test.AssertNotError(t, err, "AuthzToPB failed")
+	challIdx := int64(0)
+	_, err = ra.PerformValidation(ctx, &rapb.PerformValidationRequest{
+		Authz:          authzPB,
+		ChallengeIndex: &challIdx,
+	})
+	test.AssertError(t, err, "PerformValidation didn't fail for a name out of the account's scope")
+	test.Assert(t, berrors.Is(err, berrors.RejectedIdentifier), "Expected a RejectedIdentifier error")
+
+	identType := string(identifier.DNS)
+	identValue := "Not-Example.com"
+	_, err = ra.NewPreAuthorization(ctx, &rapb.NewPreAuthorizationRequest{
+		RegistrationID:  &regID,
+		IdentifierType:  &identType,
+		IdentifierValue: &identValue,
+	})
+	test.AssertError(t, err, "NewPreAuthorization didn't fail for a name out of the account's scope")
+	test.Assert(t, berrors.Is(err, berrors.RejectedIdentifier), "Expected a RejectedIdentifier error")
+
+	// Orders created before the account was restricted can't be finalized
+	testKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "error generating test key")
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		PublicKey:          testKey.PublicKey,
+		SignatureAlgorithm: x509.SHA256WithRSA,
+		DNSNames:           []string{"www.example.com", "not-example.com"},
+	}, testKey)
+	test.AssertNotError(t, err, "Error creating CSR")
+	orderID := int64(1)
+	readyStatus := string(core.StatusReady)
+	_, err = ra.FinalizeOrder(ctx, &rapb.FinalizeOrderRequest{
+		Order: &corepb.Order{
+			Id:             &orderID,
+			RegistrationID: &regID,
+			Status:         &readyStatus,
+			Names:          []string{"www.example.com", "not-example.com"},
+		},
+		Csr: csr,
+	})
+	test.AssertError(t, err, "FinalizeOrder didn't fail for a name out of the account's scope")
+	test.Assert(t, berrors.Is(err, berrors.RejectedIdentifier), "Expected a RejectedIdentifier error")
+}
+
+func TestMatchesCSRProfile(t *testing.T) {
+	fc := clock.NewFake()
+	ra := &RegistrationAuthorityImpl{
+		clk: fc,
+		CertProfiles: map[string]CertProfile{
+			"tlsserver-no-cn": {OmitCommonName: true, OmitClientAuth: true},
+		},
+	}
+
+	testKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "generating test key")
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "example.com"},
+		DNSNames: []string{"example.com"},
+	}, testKey)
+	test.AssertNotError(t, err, "creating CSR")
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	test.AssertNotError(t, err, "parsing CSR")
+
+	issue := func(commonName string, eku []x509.ExtKeyUsage) *x509.Certificate {
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: commonName},
+			DNSNames:              []string{"example.com"},
+			NotBefore:             fc.Now(),
+			NotAfter:              fc.Now().Add(time.Hour),
+			BasicConstraintsValid: true,
+			ExtKeyUsage:           eku,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, testKey.Public(), testKey)
+		test.AssertNotError(t, err, "creating certificate")
+		cert, err := x509.ParseCertificate(der)
+		test.AssertNotError(t, err, "parsing certificate")
+		return cert
+	}
+	defaultCert := issue("example.com", []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth})
+	noCNCert := issue("", []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth})
+	serverAuthCert := issue("example.com", []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth})
+	profileCert := issue("", []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth})
+
+	testCases := []struct {
+		name        string
+		cert        *x509.Certificate
+		profile     string
+		expectedErr string
+	}{
+		{"default profile", defaultCert, "", ""},
+		{"default profile without CommonName", noCNCert, "", "generated certificate CommonName doesn't match CSR CommonName"},
+		{"default profile without client auth", serverAuthCert, "", "generated certificate doesn't have correct key usage extensions"},
+		{"unconfigured profile without CommonName", noCNCert, "short-lived", "generated certificate CommonName doesn't match CSR CommonName"},
+		{"omitting profile", profileCert, "tlsserver-no-cn", ""},
+		{"omitting profile with CommonName", serverAuthCert, "tlsserver-no-cn", `generated certificate has a CommonName but profile "tlsserver-no-cn" omits it`},
+		{"omitting profile with client auth", noCNCert, "tlsserver-no-cn", "generated certificate doesn't have correct key usage extensions"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ra.MatchesCSR(tc.cert, csr, tc.profile)
+			if tc.expectedErr == "" {
+				test.AssertNotError(t, err, "MatchesCSR failed")
+			} else {
+				test.AssertError(t, err, "MatchesCSR didn't fail")
+				test.AssertEquals(t, err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"time"
+)
+
+// Decision is the result of checking a request against a token bucket.
+type Decision struct {
+	// Allowed is true if the request is within the limit.
+	Allowed bool
+
+	// Remaining is the number of requests that could be made right now, after
+	// this one if it was allowed.
+	Remaining int64
+
+	// RetryIn is how long until the request would be allowed. It is zero if
+	// the request was allowed.
+	RetryIn time.Duration
+
+	// ResetIn is how long until the bucket is full again, i.e. until its whole
+	// threshold of requests could be made at once.
+	ResetIn time.Duration
+
+	// newTAT is the theoretical arrival time to store for the bucket if the
+	// request is allowed and its cost is spent.
+	newTAT time.Time
+}
+
+// maybeSpend uses the Generic Cell Rate Algorithm (GCRA) to decide whether
+// cost requests may be made from a bucket which refills at a rate of burst
+// requests per period and holds at most burst requests. The state of a bucket
+// is its theoretical arrival time (TAT): the time at which the bucket would be
+// full again if no more requests were made. A zero tat is a full bucket.
+func maybeSpend(now time.Time, burst int64, period time.Duration, tat time.Time, cost int64) *Decision {
+	if burst <= 0 {
+		// A threshold of zero, e.g. from an override, allows no requests.
+		return &Decision{Allowed: false, RetryIn: period, ResetIn: period, newTAT: tat}
+	}
+	emissionInterval := period / time.Duration(burst)
+
+	// A TAT in the past is a full bucket.
+	if tat.Before(now) {
+		tat = now
+	}
+
+	newTAT := tat.Add(emissionInterval * time.Duration(cost))
+	// The request is allowed if the bucket doesn't end up holding more than
+	// burst requests' worth of time, i.e. newTAT is at most period from now.
+	difference := now.Sub(newTAT.Add(-period))
+
+	if cost > burst || difference < 0 {
+		retryIn := -difference
+		if cost > burst {
+			// The request can never be allowed.
+			retryIn = period
+		}
+		return &Decision{
+			Allowed:   false,
+			Remaining: int64(now.Sub(tat.Add(-period)) / emissionInterval),
+			RetryIn:   retryIn,
+			ResetIn:   tat.Sub(now),
+			newTAT:    tat,
+		}
+	}
+
+	return &Decision{
+		Allowed:   true,
+		Remaining: int64(difference / emissionInterval),
+		RetryIn:   0,
+		ResetIn:   newTAT.Sub(now),
+		newTAT:    newTAT,
+	}
+}
@@ -0,0 +1,186 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jmhodges/clock"
+)
+
+// Name identifies one of the rate limits of a Limits. Names match the keys of
+// the rate limit policy YAML.
+type Name string
+
+const (
+	CertificatesPerName             Name = "certificatesPerName"
+	RegistrationsPerIP              Name = "registrationsPerIP"
+	RegistrationsPerIPRange         Name = "registrationsPerIPRange"
+	PendingAuthorizationsPerAccount Name = "pendingAuthorizationsPerAccount"
+	InvalidAuthorizationsPerAccount Name = "invalidAuthorizationsPerAccount"
+	CertificatesPerFQDNSet          Name = "certificatesPerFQDNSet"
+	PendingOrdersPerAccount         Name = "pendingOrdersPerAccount"
+	NewOrdersPerAccount             Name = "newOrdersPerAccount"
+)
+
+// Policy returns the RateLimitPolicy of limits with the given name.
+func Policy(limits Limits, name Name) (RateLimitPolicy, error) {
+	switch name {
+	case CertificatesPerName:
+		return limits.CertificatesPerName(), nil
+	case RegistrationsPerIP:
+		return limits.RegistrationsPerIP(), nil
+	case RegistrationsPerIPRange:
+		return limits.RegistrationsPerIPRange(), nil
+	case PendingAuthorizationsPerAccount:
+		return limits.PendingAuthorizationsPerAccount(), nil
+	case InvalidAuthorizationsPerAccount:
+		return limits.InvalidAuthorizationsPerAccount(), nil
+	case CertificatesPerFQDNSet:
+		return limits.CertificatesPerFQDNSet(), nil
+	case PendingOrdersPerAccount:
+		return limits.PendingOrdersPerAccount(), nil
+	case NewOrdersPerAccount:
+		return limits.NewOrdersPerAccount(), nil
+	}
+	return RateLimitPolicy{}, fmt.Errorf("unknown rate limit %q", name)
+}
+
+// Limiter enforces the policies of a Limits as token buckets, rather than by
+// counting items within a window. A policy with a threshold of N per window W
+// becomes a bucket holding at most N tokens that refills at N tokens per W, so
+// that a burst of N requests is allowed and is then followed by one request
+// every W/N. The overrides of a policy set the size of the bucket the same way
+// they set the threshold. Bucket state is kept in a Source.
+type Limiter struct {
+	clk    clock.Clock
+	source Source
+	limits Limits
+}
+
+// NewLimiter returns a Limiter for the policies of limits, which keeps bucket
+// state in source.
+func NewLimiter(clk clock.Clock, source Source, limits Limits) *Limiter {
+	return &Limiter{
+		clk:    clk,
+		source: source,
+		limits: limits,
+	}
+}
+
+// bucketKey returns the key in the Source for the bucket of the limit named
+// name. Limits with a meaningful key, like a name or an IP, have a bucket per
+// key, while the other limits have a bucket per registration ID.
+func bucketKey(name Name, key string, regID int64) string {
+	if key == "" {
+		key = strconv.FormatInt(regID, 10)
+	}
+	return string(name) + ":" + key
+}
+
+// maxSpendAttempts is the number of times Spend reads a bucket and tries to
+// update it before giving up, when other requests keep updating it first.
+const maxSpendAttempts = 5
+
+// decide gets the state of a bucket and decides whether cost requests may be
+// made from it. It returns a nil Decision if the limit isn't enabled, and the
+// TAT the decision was based on, which is zero if the bucket had no state.
+func (l *Limiter) decide(ctx context.Context, name Name, key string, regID int64, cost int64) (*Decision, time.Time, error) {
+	policy, err := Policy(l.limits, name)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if !policy.Enabled() {
+		return nil, time.Time{}, nil
+	}
+	tat, err := l.source.Get(ctx, bucketKey(name, key, regID))
+	if err != nil && err != ErrBucketNotFound {
+		return nil, time.Time{}, err
+	}
+	threshold := int64(policy.GetThreshold(key, regID))
+	return maybeSpend(l.clk.Now(), threshold, policy.Window.Duration, tat, cost), tat, nil
+}
+
+// Check returns whether cost requests may be made under the limit named name
+// for key and regID, without spending them. key is the key the limit's
+// overrides are keyed by, e.g. a domain name for CertificatesPerName, or
+// empty for limits that are only overridden by registration ID. If the limit
+// isn't enabled the request is always allowed.
+func (l *Limiter) Check(ctx context.Context, name Name, key string, regID int64, cost int64) (*Decision, error) {
+	d, _, err := l.decide(ctx, name, key, regID, cost)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return &Decision{Allowed: true}, nil
+	}
+	return d, nil
+}
+
+// Spend is like Check but, if the requests are allowed, also spends them from
+// the bucket. The bucket is only updated if no other request has updated it
+// since it was read, otherwise the decision is made again from its new state,
+// so that concurrent requests can't spend the same tokens.
+func (l *Limiter) Spend(ctx context.Context, name Name, key string, regID int64, cost int64) (*Decision, error) {
+	for attempt := 0; attempt < maxSpendAttempts; attempt++ {
+		d, tat, err := l.decide(ctx, name, key, regID, cost)
+		if err != nil {
+			return nil, err
+		}
+		if d == nil {
+			return &Decision{Allowed: true}, nil
+		}
+		if !d.Allowed {
+			return d, nil
+		}
+		swapped, err := l.source.CompareAndSet(ctx, bucketKey(name, key, regID), tat, d.newTAT, d.ResetIn)
+		if err != nil {
+			return nil, err
+		}
+		if swapped {
+			return d, nil
+		}
+	}
+	return nil, fmt.Errorf("spending from %q: bucket was updated concurrently %d times", bucketKey(name, key, regID), maxSpendAttempts)
+}
+
+// Refund gives back cost requests spent from the bucket of the limit named
+// name for key and regID, e.g. because what they were spent on failed. A
+// bucket is never refilled past full.
+func (l *Limiter) Refund(ctx context.Context, name Name, key string, regID int64, cost int64) error {
+	policy, err := Policy(l.limits, name)
+	if err != nil {
+		return err
+	}
+	threshold := int64(policy.GetThreshold(key, regID))
+	if !policy.Enabled() || threshold <= 0 {
+		return nil
+	}
+	emissionInterval := policy.Window.Duration / time.Duration(threshold)
+	bucket := bucketKey(name, key, regID)
+	for attempt := 0; attempt < maxSpendAttempts; attempt++ {
+		tat, err := l.source.Get(ctx, bucket)
+		if err == ErrBucketNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		now := l.clk.Now()
+		if !tat.After(now) {
+			return nil
+		}
+		newTAT := tat.Add(-emissionInterval * time.Duration(cost))
+		if newTAT.Before(now) {
+			newTAT = now
+		}
+		swapped, err := l.source.CompareAndSet(ctx, bucket, tat, newTAT, newTAT.Sub(now))
+		if err != nil {
+			return err
+		}
+		if swapped {
+			return nil
+		}
+	}
+	return fmt.Errorf("refunding to %q: bucket was updated concurrently %d times", bucket, maxSpendAttempts)
+}
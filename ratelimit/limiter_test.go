@@ -0,0 +1,238 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmhodges/clock"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestMaybeSpend(t *testing.T) {
+	now := time.Date(2020, 10, 18, 0, 0, 0, 0, time.UTC)
+	period := 10 * time.Hour
+
+	// A full bucket of 10 allows a burst of 10 and then refills one request per
+	// hour.
+	var tat time.Time
+	for i := int64(9); i >= 0; i-- {
+		d := maybeSpend(now, 10, period, tat, 1)
+		test.Assert(t, d.Allowed, "request within burst was denied")
+		test.AssertEquals(t, d.Remaining, i)
+		test.AssertEquals(t, d.RetryIn, time.Duration(0))
+		tat = d.newTAT
+	}
+	d := maybeSpend(now, 10, period, tat, 1)
+	test.Assert(t, !d.Allowed, "request beyond burst was allowed")
+	test.AssertEquals(t, d.Remaining, int64(0))
+	test.AssertEquals(t, d.RetryIn, time.Hour)
+	test.AssertEquals(t, d.ResetIn, period)
+
+	d = maybeSpend(now.Add(time.Hour), 10, period, tat, 1)
+	test.Assert(t, d.Allowed, "request after refill was denied")
+	test.AssertEquals(t, d.Remaining, int64(0))
+
+	// Several requests can be spent at once, but never more than the burst.
+	d = maybeSpend(now, 10, period, time.Time{}, 4)
+	test.Assert(t, d.Allowed, "request for 4 was denied")
+	test.AssertEquals(t, d.Remaining, int64(6))
+	test.AssertEquals(t, d.ResetIn, 4*time.Hour)
+	d = maybeSpend(now, 10, period, time.Time{}, 11)
+	test.Assert(t, !d.Allowed, "request for more than the burst was allowed")
+
+	// A burst of zero allows nothing.
+	d = maybeSpend(now, 0, period, time.Time{}, 1)
+	test.Assert(t, !d.Allowed, "request with zero burst was allowed")
+	test.AssertEquals(t, d.RetryIn, period)
+}
+
+func newTestLimiter(t *testing.T) (*Limiter, clock.FakeClock) {
+	limits := New()
+	err := limits.LoadPolicies([]byte(`
+certificatesPerName:
+  window: 2h
+  threshold: 2
+  overrides:
+    bigissuer.com: 4
+  registrationOverrides:
+    101: 3
+newOrdersPerAccount:
+  window: 1h
+  threshold: 1
+`))
+	test.AssertNotError(t, err, "loading policies")
+	fc := clock.NewFake()
+	fc.Set(time.Date(2020, 10, 18, 0, 0, 0, 0, time.UTC))
+	return NewLimiter(fc, NewInmemSource(fc), limits), fc
+}
+
+func TestLimiterSpend(t *testing.T) {
+	l, fc := newTestLimiter(t)
+	ctx := context.Background()
+
+	spendAll := func(name Name, key string, regID int64) int {
+		allowed := 0
+		for i := 0; i < 10; i++ {
+			d, err := l.Spend(ctx, name, key, regID, 1)
+			test.AssertNotError(t, err, "Spend failed")
+			if !d.Allowed {
+				break
+			}
+			allowed++
+		}
+		return allowed
+	}
+
+	test.AssertEquals(t, spendAll(CertificatesPerName, "example.com", 1), 2)
+	// Each key has its own bucket
+	test.AssertEquals(t, spendAll(CertificatesPerName, "example.net", 1), 2)
+	// Overrides by key and by registration set the size of the bucket
+	test.AssertEquals(t, spendAll(CertificatesPerName, "bigissuer.com", 1), 4)
+	test.AssertEquals(t, spendAll(CertificatesPerName, "example.org", 101), 3)
+
+	// Limits without a key have a bucket per registration ID
+	test.AssertEquals(t, spendAll(NewOrdersPerAccount, "", 1), 1)
+	test.AssertEquals(t, spendAll(NewOrdersPerAccount, "", 2), 1)
+
+	// Limits that aren't enabled always allow requests
+	test.AssertEquals(t, spendAll(CertificatesPerFQDNSet, "example.com", 1), 10)
+
+	// After a full window the buckets are full again
+	fc.Add(2 * time.Hour)
+	test.AssertEquals(t, spendAll(CertificatesPerName, "example.com", 1), 2)
+	test.AssertEquals(t, spendAll(NewOrdersPerAccount, "", 1), 1)
+
+	_, err := l.Spend(ctx, Name("bogus"), "", 1, 1)
+	test.AssertError(t, err, "Spend for an unknown limit didn't fail")
+}
+
+func TestLimiterCheck(t *testing.T) {
+	l, fc := newTestLimiter(t)
+	ctx := context.Background()
+
+	// Checking doesn't spend
+	for i := 0; i < 5; i++ {
+		d, err := l.Check(ctx, CertificatesPerName, "example.com", 1, 1)
+		test.AssertNotError(t, err, "Check failed")
+		test.Assert(t, d.Allowed, "Check was denied")
+		test.AssertEquals(t, d.Remaining, int64(1))
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := l.Spend(ctx, CertificatesPerName, "example.com", 1, 1)
+		test.AssertNotError(t, err, "Spend failed")
+	}
+	d, err := l.Check(ctx, CertificatesPerName, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Check failed")
+	test.Assert(t, !d.Allowed, "Check of an empty bucket was allowed")
+	test.AssertEquals(t, d.RetryIn, time.Hour)
+	test.AssertEquals(t, d.ResetIn, 2*time.Hour)
+
+	fc.Add(time.Hour)
+	d, err = l.Check(ctx, CertificatesPerName, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Check failed")
+	test.Assert(t, d.Allowed, "Check after refill was denied")
+}
+
+func TestLimiterRefund(t *testing.T) {
+	l, fc := newTestLimiter(t)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := l.Spend(ctx, CertificatesPerName, "example.com", 1, 1)
+		test.AssertNotError(t, err, "Spend failed")
+	}
+	err := l.Refund(ctx, CertificatesPerName, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Refund failed")
+	d, err := l.Spend(ctx, CertificatesPerName, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Spend failed")
+	test.Assert(t, d.Allowed, "Spend of a refunded request was denied")
+	test.AssertEquals(t, d.Remaining, int64(0))
+
+	// A bucket isn't refilled past full
+	fc.Add(2 * time.Hour)
+	for i := 0; i < 3; i++ {
+		err = l.Refund(ctx, CertificatesPerName, "example.com", 1, 1)
+		test.AssertNotError(t, err, "Refund failed")
+	}
+	d, err = l.Check(ctx, CertificatesPerName, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Check failed")
+	test.AssertEquals(t, d.Remaining, int64(1))
+
+	// Refunding to a limit that isn't enabled does nothing
+	err = l.Refund(ctx, CertificatesPerFQDNSet, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Refund to a disabled limit failed")
+}
+
+// racingSource is a Source where another request spends from a bucket
+// between each Spend reading it and updating it, for the first races
+// updates. If contended is set every update finds the bucket changed.
+type racingSource struct {
+	Source
+	races     int
+	contended bool
+}
+
+func (s *racingSource) CompareAndSet(ctx context.Context, bucketKey string, old, tat time.Time, ttl time.Duration) (bool, error) {
+	if s.contended {
+		return false, nil
+	}
+	if s.races > 0 {
+		s.races--
+		err := s.Source.Set(ctx, bucketKey, tat, ttl)
+		if err != nil {
+			return false, err
+		}
+	}
+	return s.Source.CompareAndSet(ctx, bucketKey, old, tat, ttl)
+}
+
+func TestLimiterSpendConcurrent(t *testing.T) {
+	l, fc := newTestLimiter(t)
+	ctx := context.Background()
+	source := &racingSource{Source: NewInmemSource(fc), races: 1}
+	l.source = source
+
+	// The token spent by the other request isn't spent again
+	d, err := l.Spend(ctx, CertificatesPerName, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Spend failed")
+	test.Assert(t, d.Allowed, "Spend within the limit was denied")
+	test.AssertEquals(t, d.Remaining, int64(0))
+	d, err = l.Spend(ctx, CertificatesPerName, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Spend failed")
+	test.Assert(t, !d.Allowed, "Spend beyond the limit was allowed")
+
+	// Spend gives up if the bucket keeps changing
+	source.contended = true
+	_, err = l.Spend(ctx, CertificatesPerName, "example.net", 1, 1)
+	test.AssertError(t, err, "Spend of a contended bucket didn't fail")
+}
+
+func TestInmemSourceExpiry(t *testing.T) {
+	fc := clock.NewFake()
+	source := NewInmemSource(fc)
+	ctx := context.Background()
+
+	_, err := source.Get(ctx, "key")
+	test.AssertEquals(t, err, ErrBucketNotFound)
+
+	tat := fc.Now().Add(time.Minute)
+	err = source.Set(ctx, "key", tat, time.Minute)
+	test.AssertNotError(t, err, "Set failed")
+	got, err := source.Get(ctx, "key")
+	test.AssertNotError(t, err, "Get failed")
+	test.Assert(t, got.Equal(tat), "Get returned the wrong TAT")
+
+	fc.Add(time.Minute)
+	_, err = source.Get(ctx, "key")
+	test.AssertEquals(t, err, ErrBucketNotFound)
+
+	err = source.Set(ctx, "key", tat, time.Minute)
+	test.AssertNotError(t, err, "Set failed")
+	err = source.Delete(ctx, "key")
+	test.AssertNotError(t, err, "Delete failed")
+	_, err = source.Get(ctx, "key")
+	test.AssertEquals(t, err, ErrBucketNotFound)
+}
@@ -1,6 +1,7 @@
 package ratelimit
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -21,15 +22,32 @@ type Limits interface {
 	PendingOrdersPerAccount() RateLimitPolicy
 	NewOrdersPerAccount() RateLimitPolicy
 	LoadPolicies(contents []byte) error
+	SetOverrides(overrides []Override) error
+}
+
+// Override sets the threshold of the limit named Limit for either Key or
+// RegistrationID, in addition to the overrides in the policy file. Exactly one
+// of Key and RegistrationID should be set.
+type Override struct {
+	Limit          Name
+	Key            string
+	RegistrationID int64
+	Threshold      int
 }
 
 // limitsImpl is an unexported implementation of the Limits interface. It acts
 // as a container for a rateLimitConfig and a mutex. This allows the inner
 // rateLimitConfig pointer to be updated safely when the overall configuration
-// changes (e.g. due to a reload of the policy file)
+// changes (e.g. due to a reload of the policy file or of the overrides).
 type limitsImpl struct {
 	sync.RWMutex
 	rlPolicy *rateLimitConfig
+
+	// loaded is the configuration from the policy file and overrides are the
+	// overrides set with SetOverrides. rlPolicy is the result of merging the
+	// two, and is rebuilt whenever either changes.
+	loaded    *rateLimitConfig
+	overrides []Override
 }
 
 func (r *limitsImpl) CertificatesPerName() RateLimitPolicy {
@@ -114,11 +132,71 @@ func (r *limitsImpl) LoadPolicies(contents []byte) error {
 	}
 
 	r.Lock()
-	r.rlPolicy = &newPolicy
-	r.Unlock()
+	defer r.Unlock()
+	merged, err := mergeOverrides(&newPolicy, r.overrides)
+	if err != nil {
+		return err
+	}
+	r.loaded = &newPolicy
+	r.rlPolicy = merged
+	return nil
+}
+
+// SetOverrides replaces the overrides applied on top of the policy file, e.g.
+// with those stored in the database. Where an override and the policy file
+// both set a threshold for the same key or registration, the override wins.
+func (r *limitsImpl) SetOverrides(overrides []Override) error {
+	r.Lock()
+	defer r.Unlock()
+	loaded := r.loaded
+	if loaded == nil {
+		// Nothing to apply the overrides to yet, but check them anyway so
+		// that a later LoadPolicies can't fail because of them.
+		loaded = &rateLimitConfig{}
+	}
+	merged, err := mergeOverrides(loaded, overrides)
+	if err != nil {
+		return err
+	}
+	if r.loaded != nil {
+		r.rlPolicy = merged
+	}
+	r.overrides = overrides
 	return nil
 }
 
+// mergeOverrides returns a copy of config with overrides added to the
+// overrides of its policies. The override maps of config are not modified.
+func mergeOverrides(config *rateLimitConfig, overrides []Override) (*rateLimitConfig, error) {
+	merged := *config
+	cloned := make(map[Name]bool)
+	for _, o := range overrides {
+		policy := merged.policy(o.Limit)
+		if policy == nil {
+			return nil, fmt.Errorf("override for unknown rate limit %q", o.Limit)
+		}
+		if !cloned[o.Limit] {
+			keyOverrides := make(map[string]int, len(policy.Overrides))
+			for k, v := range policy.Overrides {
+				keyOverrides[k] = v
+			}
+			regOverrides := make(map[int64]int, len(policy.RegistrationOverrides))
+			for k, v := range policy.RegistrationOverrides {
+				regOverrides[k] = v
+			}
+			policy.Overrides = keyOverrides
+			policy.RegistrationOverrides = regOverrides
+			cloned[o.Limit] = true
+		}
+		if o.Key != "" {
+			policy.Overrides[o.Key] = o.Threshold
+		} else {
+			policy.RegistrationOverrides[o.RegistrationID] = o.Threshold
+		}
+	}
+	return &merged, nil
+}
+
 func New() Limits {
 	return &limitsImpl{}
 }
@@ -159,6 +237,30 @@ type rateLimitConfig struct {
 	CertificatesPerFQDNSet RateLimitPolicy `yaml:"certificatesPerFQDNSet"`
 }
 
+// policy returns a pointer to the policy of c with the given name, or nil if
+// there is none.
+func (c *rateLimitConfig) policy(name Name) *RateLimitPolicy {
+	switch name {
+	case CertificatesPerName:
+		return &c.CertificatesPerName
+	case RegistrationsPerIP:
+		return &c.RegistrationsPerIP
+	case RegistrationsPerIPRange:
+		return &c.RegistrationsPerIPRange
+	case PendingAuthorizationsPerAccount:
+		return &c.PendingAuthorizationsPerAccount
+	case InvalidAuthorizationsPerAccount:
+		return &c.InvalidAuthorizationsPerAccount
+	case CertificatesPerFQDNSet:
+		return &c.CertificatesPerFQDNSet
+	case PendingOrdersPerAccount:
+		return &c.PendingOrdersPerAccount
+	case NewOrdersPerAccount:
+		return &c.NewOrdersPerAccount
+	}
+	return nil
+}
+
 // RateLimitPolicy describes a general limiting policy
 type RateLimitPolicy struct {
 	// How long to count items for
@@ -179,6 +281,10 @@ type RateLimitPolicy struct {
 	// than the default. If both key-based and registration-based overrides are
 	// available, the registration-based on takes priority.
 	RegistrationOverrides map[int64]int `yaml:"registrationOverrides"`
+	// Shadow puts the limit in dry-run mode: requests that exceed it are
+	// reported, so the effect of a new or tightened limit can be seen, but
+	// are not rejected.
+	Shadow bool `yaml:"shadow"`
 }
 
 // Enabled returns true iff the RateLimitPolicy is enabled.
@@ -178,3 +178,87 @@ func TestLoadPolicies(t *testing.T) {
 	test.AssertEquals(t, emptyPolicy.PendingAuthorizationsPerAccount().Threshold, 0)
 	test.AssertEquals(t, emptyPolicy.CertificatesPerFQDNSet().Threshold, 0)
 }
+
+func TestLoadPoliciesShadow(t *testing.T) {
+	policy := New()
+	err := policy.LoadPolicies([]byte(`
+certificatesPerName:
+  window: 2160h
+  threshold: 2
+  shadow: true
+newOrdersPerAccount:
+  window: 3h
+  threshold: 300
+`))
+	test.AssertNotError(t, err, "Failed to parse policies")
+	test.Assert(t, policy.CertificatesPerName().Shadow, "certificatesPerName isn't in shadow mode")
+	test.Assert(t, !policy.NewOrdersPerAccount().Shadow, "newOrdersPerAccount is in shadow mode")
+}
+
+func TestSetOverrides(t *testing.T) {
+	policy := New()
+
+	// Overrides set before the policy file is loaded are applied once it is
+	err := policy.SetOverrides([]Override{
+		{Limit: CertificatesPerName, Key: "example.com", Threshold: 50},
+	})
+	test.AssertNotError(t, err, "SetOverrides failed")
+	test.AssertEquals(t, policy.CertificatesPerName().Threshold, 0)
+
+	policyContent := []byte(`
+certificatesPerName:
+  window: 2160h
+  threshold: 2
+  overrides:
+    example.com: 10
+    example.net: 20
+  registrationOverrides:
+    101: 1000
+newOrdersPerAccount:
+  window: 3h
+  threshold: 300
+`)
+	err = policy.LoadPolicies(policyContent)
+	test.AssertNotError(t, err, "Failed to parse policies")
+	certsPerName := policy.CertificatesPerName()
+	test.AssertEquals(t, certsPerName.GetThreshold("example.com", 1), 50)
+	test.AssertEquals(t, certsPerName.GetThreshold("example.net", 1), 20)
+
+	// New overrides replace the old ones, and win over the policy file
+	err = policy.SetOverrides([]Override{
+		{Limit: CertificatesPerName, Key: "example.org", Threshold: 30},
+		{Limit: NewOrdersPerAccount, RegistrationID: 102, Threshold: 600},
+	})
+	test.AssertNotError(t, err, "SetOverrides failed")
+	certsPerName = policy.CertificatesPerName()
+	test.AssertDeepEquals(t, certsPerName.Overrides, map[string]int{
+		"example.com": 10,
+		"example.net": 20,
+		"example.org": 30,
+	})
+	test.AssertDeepEquals(t, certsPerName.RegistrationOverrides, map[int64]int{101: 1000})
+	newOrders := policy.NewOrdersPerAccount()
+	test.AssertEquals(t, newOrders.GetThreshold("", 102), 600)
+	test.AssertEquals(t, newOrders.GetThreshold("", 103), 300)
+
+	// The policies returned earlier aren't modified by later overrides
+	err = policy.SetOverrides(nil)
+	test.AssertNotError(t, err, "SetOverrides failed")
+	test.AssertEquals(t, certsPerName.Overrides["example.org"], 30)
+	test.AssertEquals(t, len(policy.CertificatesPerName().Overrides), 2)
+
+	// Reloading the policy file keeps the overrides
+	err = policy.SetOverrides([]Override{
+		{Limit: CertificatesPerName, RegistrationID: 101, Threshold: 5},
+	})
+	test.AssertNotError(t, err, "SetOverrides failed")
+	err = policy.LoadPolicies(policyContent)
+	test.AssertNotError(t, err, "Failed to parse policies")
+	certsPerName = policy.CertificatesPerName()
+	test.AssertEquals(t, certsPerName.GetThreshold("", 101), 5)
+
+	// An override for an unknown limit is an error and changes nothing
+	err = policy.SetOverrides([]Override{{Limit: "bogus", Key: "example.com", Threshold: 1}})
+	test.AssertError(t, err, "SetOverrides with an unknown limit didn't fail")
+	test.AssertEquals(t, policy.CertificatesPerName().RegistrationOverrides[101], 5)
+}
@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jmhodges/clock"
+)
+
+// ErrBucketNotFound is returned by a Source when it has no state for a bucket.
+// A bucket without state is full.
+var ErrBucketNotFound = errors.New("bucket not found")
+
+// Source is a key-value store holding the state of token buckets, which is the
+// theoretical arrival time (TAT) of each bucket.
+type Source interface {
+	// Get returns the TAT stored for bucketKey, or ErrBucketNotFound if there
+	// is none.
+	Get(ctx context.Context, bucketKey string) (time.Time, error)
+
+	// Set stores the TAT for bucketKey. The source may forget it after ttl,
+	// when the bucket will be full again.
+	Set(ctx context.Context, bucketKey string, tat time.Time, ttl time.Duration) error
+
+	// CompareAndSet is like Set, but only stores tat if the TAT stored for
+	// bucketKey is still old, or there is still none if old is the zero time.
+	// It returns false, without storing anything, if the stored TAT has
+	// changed.
+	CompareAndSet(ctx context.Context, bucketKey string, old, tat time.Time, ttl time.Duration) (bool, error)
+
+	// Delete removes the TAT stored for bucketKey, making the bucket full.
+	Delete(ctx context.Context, bucketKey string) error
+}
+
+// inmemSource is a Source that keeps bucket state in memory. It is only
+// suitable for tests and for a single RA, since the state isn't shared, and
+// expired state is only dropped when its bucket is next set.
+type inmemSource struct {
+	sync.RWMutex
+	clk clock.Clock
+	m   map[string]inmemEntry
+}
+
+type inmemEntry struct {
+	tat     time.Time
+	expires time.Time
+}
+
+// NewInmemSource returns a Source that keeps bucket state in memory, using clk
+// to expire it.
+func NewInmemSource(clk clock.Clock) Source {
+	return &inmemSource{clk: clk, m: make(map[string]inmemEntry)}
+}
+
+func (s *inmemSource) Get(_ context.Context, bucketKey string) (time.Time, error) {
+	s.RLock()
+	defer s.RUnlock()
+	entry, ok := s.m[bucketKey]
+	if !ok || !s.clk.Now().Before(entry.expires) {
+		return time.Time{}, ErrBucketNotFound
+	}
+	return entry.tat, nil
+}
+
+func (s *inmemSource) Set(_ context.Context, bucketKey string, tat time.Time, ttl time.Duration) error {
+	s.Lock()
+	defer s.Unlock()
+	s.m[bucketKey] = inmemEntry{tat: tat, expires: s.clk.Now().Add(ttl)}
+	return nil
+}
+
+func (s *inmemSource) CompareAndSet(_ context.Context, bucketKey string, old, tat time.Time, ttl time.Duration) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+	var current time.Time
+	entry, ok := s.m[bucketKey]
+	if ok && s.clk.Now().Before(entry.expires) {
+		current = entry.tat
+	}
+	if !current.Equal(old) {
+		return false, nil
+	}
+	s.m[bucketKey] = inmemEntry{tat: tat, expires: s.clk.Now().Add(ttl)}
+	return true, nil
+}
+
+func (s *inmemSource) Delete(_ context.Context, bucketKey string) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.m, bucketKey)
+	return nil
+}
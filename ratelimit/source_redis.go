@@ -0,0 +1,287 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RedisSource is a Source that keeps bucket state in a server speaking the
+// Redis protocol (RESP), so that it is shared by every RA using that server.
+// Each TAT is stored as a decimal count of nanoseconds since the Unix epoch,
+// with an expiry so that the server forgets buckets once they are full.
+type RedisSource struct {
+	addr     string
+	password string
+	timeout  time.Duration
+
+	// mu protects conn and r, and serializes commands on the connection.
+	mu   sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// NewRedisSource returns a RedisSource for the server at addr. If password is
+// non-empty it is used to AUTH each new connection. timeout bounds each
+// command, in addition to any deadline of the context it is called with.
+func NewRedisSource(addr, password string, timeout time.Duration) *RedisSource {
+	return &RedisSource{
+		addr:     addr,
+		password: password,
+		timeout:  timeout,
+	}
+}
+
+// redisError is an error reply from the server.
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+func (s *RedisSource) Get(ctx context.Context, bucketKey string) (time.Time, error) {
+	reply, err := s.do(ctx, "GET", bucketKey)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parseTAT(bucketKey, reply)
+}
+
+// parseTAT parses the reply to a GET of bucketKey.
+func parseTAT(bucketKey string, reply interface{}) (time.Time, error) {
+	if reply == nil {
+		return time.Time{}, ErrBucketNotFound
+	}
+	value, ok := reply.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("redis: unexpected reply to GET: %v", reply)
+	}
+	ns, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("redis: malformed TAT for %q: %s", bucketKey, err)
+	}
+	return time.Unix(0, ns), nil
+}
+
+func (s *RedisSource) Set(ctx context.Context, bucketKey string, tat time.Time, ttl time.Duration) error {
+	_, err := s.do(ctx, setArgs(bucketKey, tat, ttl)...)
+	return err
+}
+
+// setArgs returns the SET command storing tat for bucketKey with an expiry of
+// ttl.
+func setArgs(bucketKey string, tat time.Time, ttl time.Duration) []string {
+	ms := ttl.Milliseconds()
+	if ms < 1 {
+		ms = 1
+	}
+	return []string{"SET", bucketKey, strconv.FormatInt(tat.UnixNano(), 10), "PX", strconv.FormatInt(ms, 10)}
+}
+
+// CompareAndSet uses an optimistic transaction: the key is WATCHed before it
+// is read, so that the server aborts the SET, and EXEC replies with nil, if
+// another client changes it in between. The connection is held throughout,
+// since WATCH applies to the connection it is sent on.
+func (s *RedisSource) CompareAndSet(ctx context.Context, bucketKey string, old, tat time.Time, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deadline := s.deadline(ctx)
+
+	_, err := s.command(deadline, "WATCH", bucketKey)
+	if err != nil {
+		return false, err
+	}
+	reply, err := s.command(deadline, "GET", bucketKey)
+	if err != nil {
+		s.abort(deadline, "UNWATCH")
+		return false, err
+	}
+	current, err := parseTAT(bucketKey, reply)
+	if err != nil && err != ErrBucketNotFound {
+		s.abort(deadline, "UNWATCH")
+		return false, err
+	}
+	if !current.Equal(old) {
+		_, err = s.command(deadline, "UNWATCH")
+		return false, err
+	}
+
+	_, err = s.command(deadline, "MULTI")
+	if err != nil {
+		s.abort(deadline, "UNWATCH")
+		return false, err
+	}
+	_, err = s.command(deadline, setArgs(bucketKey, tat, ttl)...)
+	if err != nil {
+		s.abort(deadline, "DISCARD")
+		return false, err
+	}
+	reply, err = s.command(deadline, "EXEC")
+	if err != nil {
+		return false, err
+	}
+	return reply != nil, nil
+}
+
+func (s *RedisSource) Delete(ctx context.Context, bucketKey string) error {
+	_, err := s.do(ctx, "DEL", bucketKey)
+	return err
+}
+
+// do sends a command to the server and returns its reply: nil, a string, an
+// int64 or a []interface{} of those. An error reply is returned as a
+// redisError.
+func (s *RedisSource) do(ctx context.Context, args ...string) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.command(s.deadline(ctx), args...)
+}
+
+// deadline returns the deadline for a command called with ctx, or the zero
+// time for none.
+func (s *RedisSource) deadline(ctx context.Context) time.Time {
+	var deadline time.Time
+	if s.timeout > 0 {
+		deadline = time.Now().Add(s.timeout)
+	}
+	if d, ok := ctx.Deadline(); ok && (deadline.IsZero() || d.Before(deadline)) {
+		deadline = d
+	}
+	return deadline
+}
+
+// command is like do, but must be called with mu held. Any error other than
+// an error reply closes the connection so that the next command uses a new
+// one.
+func (s *RedisSource) command(deadline time.Time, args ...string) (interface{}, error) {
+	if s.conn == nil {
+		err := s.connect(deadline)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reply, err := s.roundTrip(deadline, args)
+	if err != nil {
+		var redisErr redisError
+		if !errors.As(err, &redisErr) {
+			s.conn.Close()
+			s.conn = nil
+			s.r = nil
+		}
+		return nil, err
+	}
+	return reply, nil
+}
+
+// abort sends cmd to end a transaction that failed part way through, so that
+// it doesn't affect the next command on the connection. If the connection was
+// closed by the failure there is nothing to end. It must be called with mu
+// held.
+func (s *RedisSource) abort(deadline time.Time, cmd string) {
+	if s.conn == nil {
+		return
+	}
+	_, _ = s.command(deadline, cmd)
+}
+
+// connect dials the server and authenticates if needed. It must be called with
+// mu held.
+func (s *RedisSource) connect(deadline time.Time) error {
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.Dial("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("redis: %s", err)
+	}
+	s.conn = conn
+	s.r = bufio.NewReader(conn)
+	if s.password != "" {
+		_, err = s.roundTrip(deadline, []string{"AUTH", s.password})
+		if err != nil {
+			s.conn.Close()
+			s.conn = nil
+			s.r = nil
+			return err
+		}
+	}
+	return nil
+}
+
+// roundTrip writes a command as a RESP array of bulk strings and reads its
+// reply. It must be called with mu held.
+func (s *RedisSource) roundTrip(deadline time.Time, args []string) (interface{}, error) {
+	err := s.conn.SetDeadline(deadline)
+	if err != nil {
+		return nil, err
+	}
+	cmd := fmt.Sprintf("*%d\r\n", len(args))
+	for _, arg := range args {
+		cmd += fmt.Sprintf("$%d\r\n%s\r\n", len(arg), arg)
+	}
+	_, err = io.WriteString(s.conn, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return readRESP(s.r)
+}
+
+// readRESP reads one RESP value from r.
+func readRESP(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	kind, body := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return body, nil
+	case '-':
+		return nil, redisError(body)
+	case ':':
+		n, err := strconv.ParseInt(body, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("redis: malformed integer reply %q", body)
+		}
+		return n, nil
+	case '$':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, fmt.Errorf("redis: malformed bulk string length %q", body)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, n+2)
+		_, err = io.ReadFull(r, buf)
+		if err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, fmt.Errorf("redis: malformed array length %q", body)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		values := make([]interface{}, n)
+		for i := range values {
+			values[i], err = readRESP(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("redis: unknown reply type %q", kind)
+}
@@ -0,0 +1,268 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/test"
+)
+
+// fakeRedis is a minimal server speaking the Redis protocol, which supports
+// the commands RedisSource uses. It ignores expiry.
+type fakeRedis struct {
+	sync.Mutex
+	password string
+	values   map[string]string
+	// versions counts the changes to each key, so that EXEC can tell whether
+	// a WATCHed key has changed.
+	versions map[string]int
+	commands []string
+	listener net.Listener
+	// beforeExec, if set, is called with the lock held before each EXEC, to
+	// simulate another client changing a key during a transaction.
+	beforeExec func()
+}
+
+func newFakeRedis(t *testing.T, password string) *fakeRedis {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "listening")
+	fr := &fakeRedis{password: password, values: make(map[string]string), versions: make(map[string]int), listener: l}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go fr.serve(conn)
+		}
+	}()
+	return fr
+}
+
+// set stores value for key. It must be called with the lock held.
+func (fr *fakeRedis) set(key, value string) {
+	fr.values[key] = value
+	fr.versions[key]++
+}
+
+// run executes a command other than a transaction command and returns its
+// reply. It must be called with the lock held.
+func (fr *fakeRedis) run(cmd string, args []interface{}) string {
+	switch cmd {
+	case "GET":
+		v, ok := fr.values[args[1].(string)]
+		if ok {
+			return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+		}
+		return "$-1\r\n"
+	case "SET":
+		fr.set(args[1].(string), args[2].(string))
+		return "+OK\r\n"
+	case "DEL":
+		delete(fr.values, args[1].(string))
+		fr.versions[args[1].(string)]++
+		return ":1\r\n"
+	}
+	return fmt.Sprintf("-ERR unknown command '%s'\r\n", cmd)
+}
+
+func (fr *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	authed := fr.password == ""
+	// The state of this connection's transaction: the version of each WATCHed
+	// key when it was watched, and the commands queued since MULTI.
+	var watched map[string]int
+	var queued [][]interface{}
+	inMulti := false
+	for {
+		req, err := readRESP(r)
+		if err != nil {
+			return
+		}
+		args, ok := req.([]interface{})
+		if !ok || len(args) == 0 {
+			fmt.Fprint(conn, "-ERR bad request\r\n")
+			continue
+		}
+		cmd := strings.ToUpper(args[0].(string))
+		fr.Lock()
+		fr.commands = append(fr.commands, cmd)
+		switch {
+		case cmd == "AUTH":
+			if args[1].(string) == fr.password {
+				authed = true
+				fmt.Fprint(conn, "+OK\r\n")
+			} else {
+				fmt.Fprint(conn, "-WRONGPASS invalid password\r\n")
+			}
+		case !authed:
+			fmt.Fprint(conn, "-NOAUTH Authentication required.\r\n")
+		case cmd == "WATCH":
+			if watched == nil {
+				watched = make(map[string]int)
+			}
+			watched[args[1].(string)] = fr.versions[args[1].(string)]
+			fmt.Fprint(conn, "+OK\r\n")
+		case cmd == "UNWATCH":
+			watched = nil
+			fmt.Fprint(conn, "+OK\r\n")
+		case cmd == "MULTI":
+			inMulti = true
+			fmt.Fprint(conn, "+OK\r\n")
+		case cmd == "DISCARD":
+			inMulti, queued, watched = false, nil, nil
+			fmt.Fprint(conn, "+OK\r\n")
+		case cmd == "EXEC":
+			if fr.beforeExec != nil {
+				fr.beforeExec()
+			}
+			aborted := false
+			for key, version := range watched {
+				if fr.versions[key] != version {
+					aborted = true
+				}
+			}
+			if aborted {
+				fmt.Fprint(conn, "*-1\r\n")
+			} else {
+				reply := fmt.Sprintf("*%d\r\n", len(queued))
+				for _, q := range queued {
+					reply += fr.run(strings.ToUpper(q[0].(string)), q)
+				}
+				fmt.Fprint(conn, reply)
+			}
+			inMulti, queued, watched = false, nil, nil
+		case inMulti:
+			queued = append(queued, args)
+			fmt.Fprint(conn, "+QUEUED\r\n")
+		default:
+			fmt.Fprint(conn, fr.run(cmd, args))
+		}
+		fr.Unlock()
+	}
+}
+
+func TestRedisSource(t *testing.T) {
+	fr := newFakeRedis(t, "hunter2")
+	defer fr.listener.Close()
+	ctx := context.Background()
+
+	source := NewRedisSource(fr.listener.Addr().String(), "hunter2", time.Second)
+
+	_, err := source.Get(ctx, "certificatesPerName:example.com")
+	test.AssertEquals(t, err, ErrBucketNotFound)
+
+	tat := time.Date(2020, 10, 18, 1, 2, 3, 4, time.UTC)
+	err = source.Set(ctx, "certificatesPerName:example.com", tat, time.Hour)
+	test.AssertNotError(t, err, "Set failed")
+	got, err := source.Get(ctx, "certificatesPerName:example.com")
+	test.AssertNotError(t, err, "Get failed")
+	test.Assert(t, got.Equal(tat), "Get returned the wrong TAT")
+
+	err = source.Delete(ctx, "certificatesPerName:example.com")
+	test.AssertNotError(t, err, "Delete failed")
+	_, err = source.Get(ctx, "certificatesPerName:example.com")
+	test.AssertEquals(t, err, ErrBucketNotFound)
+
+	// The connection is authenticated once and then reused
+	fr.Lock()
+	test.AssertDeepEquals(t, fr.commands, []string{"AUTH", "GET", "SET", "GET", "DEL", "GET"})
+	fr.Unlock()
+
+	// A malformed TAT is an error
+	fr.Lock()
+	fr.values["bad"] = "not a number"
+	fr.Unlock()
+	_, err = source.Get(ctx, "bad")
+	test.AssertError(t, err, "Get of a malformed TAT didn't fail")
+}
+
+func TestRedisSourceCompareAndSet(t *testing.T) {
+	fr := newFakeRedis(t, "")
+	defer fr.listener.Close()
+	ctx := context.Background()
+
+	source := NewRedisSource(fr.listener.Addr().String(), "", time.Second)
+	first := time.Date(2020, 10, 18, 1, 2, 3, 4, time.UTC)
+	second := first.Add(time.Hour)
+
+	// A bucket without state is only set if none was expected
+	swapped, err := source.CompareAndSet(ctx, "key", first, second, time.Hour)
+	test.AssertNotError(t, err, "CompareAndSet failed")
+	test.Assert(t, !swapped, "CompareAndSet of a missing bucket swapped")
+	swapped, err = source.CompareAndSet(ctx, "key", time.Time{}, first, time.Hour)
+	test.AssertNotError(t, err, "CompareAndSet failed")
+	test.Assert(t, swapped, "CompareAndSet of a missing bucket didn't swap")
+
+	swapped, err = source.CompareAndSet(ctx, "key", time.Time{}, second, time.Hour)
+	test.AssertNotError(t, err, "CompareAndSet failed")
+	test.Assert(t, !swapped, "CompareAndSet with a stale TAT swapped")
+	swapped, err = source.CompareAndSet(ctx, "key", first, second, time.Hour)
+	test.AssertNotError(t, err, "CompareAndSet failed")
+	test.Assert(t, swapped, "CompareAndSet with the current TAT didn't swap")
+	got, err := source.Get(ctx, "key")
+	test.AssertNotError(t, err, "Get failed")
+	test.Assert(t, got.Equal(second), "Get returned the wrong TAT")
+
+	// A change by another client between the read and the write aborts the
+	// transaction
+	fr.Lock()
+	fr.beforeExec = func() {
+		fr.set("key", "1")
+	}
+	fr.Unlock()
+	swapped, err = source.CompareAndSet(ctx, "key", second, first, time.Hour)
+	test.AssertNotError(t, err, "CompareAndSet failed")
+	test.Assert(t, !swapped, "CompareAndSet swapped despite a concurrent change")
+	got, err = source.Get(ctx, "key")
+	test.AssertNotError(t, err, "Get failed")
+	test.AssertEquals(t, got.UnixNano(), int64(1))
+}
+
+func TestRedisSourceErrors(t *testing.T) {
+	fr := newFakeRedis(t, "hunter2")
+	defer fr.listener.Close()
+	ctx := context.Background()
+
+	source := NewRedisSource(fr.listener.Addr().String(), "wrong", time.Second)
+	_, err := source.Get(ctx, "key")
+	test.AssertError(t, err, "Get with the wrong password didn't fail")
+	test.AssertEquals(t, err.Error(), "redis: WRONGPASS invalid password")
+
+	source = NewRedisSource(fr.listener.Addr().String(), "", time.Second)
+	_, err = source.Get(ctx, "key")
+	test.AssertError(t, err, "Get without a password didn't fail")
+	test.AssertEquals(t, err.Error(), "redis: NOAUTH Authentication required.")
+
+	// An unreachable server is an error, and is retried on the next command
+	addr := fr.listener.Addr().String()
+	fr.listener.Close()
+	source = NewRedisSource(addr, "hunter2", time.Second)
+	_, err = source.Get(ctx, "key")
+	test.AssertError(t, err, "Get from a closed server didn't fail")
+}
+
+func TestLimiterWithRedisSource(t *testing.T) {
+	fr := newFakeRedis(t, "")
+	defer fr.listener.Close()
+	ctx := context.Background()
+
+	l, _ := newTestLimiter(t)
+	l.source = NewRedisSource(fr.listener.Addr().String(), "", time.Second)
+
+	for i := 0; i < 2; i++ {
+		d, err := l.Spend(ctx, CertificatesPerName, "example.com", 1, 1)
+		test.AssertNotError(t, err, "Spend failed")
+		test.Assert(t, d.Allowed, "Spend within the limit was denied")
+	}
+	d, err := l.Spend(ctx, CertificatesPerName, "example.com", 1, 1)
+	test.AssertNotError(t, err, "Spend failed")
+	test.Assert(t, !d.Allowed, "Spend beyond the limit was allowed")
+}
@@ -0,0 +1,22 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `externalAccountKeys` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `keyID` varchar(255) NOT NULL,
+    `hmacKey` varbinary(255) NOT NULL,
+    `createdAt` datetime NOT NULL,
+    `revokedAt` datetime DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `keyID` (`keyID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+ALTER TABLE `registrations` ADD COLUMN `externalAccountID` varchar(255) NOT NULL DEFAULT '';
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE `registrations` DROP COLUMN `externalAccountID`;
+
+DROP TABLE `externalAccountKeys`;
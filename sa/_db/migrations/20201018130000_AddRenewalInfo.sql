@@ -0,0 +1,31 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `replacementOrders` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `serial` varchar(255) NOT NULL,
+    `orderID` bigint(20) NOT NULL,
+    `orderExpires` datetime NOT NULL,
+    `replaced` boolean DEFAULT false,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `serial` (`serial`),
+    KEY `orderID_idx` (`orderID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `renewalInfoOverrides` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `serial` varchar(255) NOT NULL,
+    `windowStart` datetime NOT NULL,
+    `windowEnd` datetime NOT NULL,
+    `updatedAt` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `serial` (`serial`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `renewalInfoOverrides`;
+
+DROP TABLE `replacementOrders`;
@@ -0,0 +1,15 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `processingOrders` (
+    `orderID` bigint(20) NOT NULL,
+    `beganProcessing` datetime NOT NULL,
+    PRIMARY KEY (`orderID`),
+    KEY `beganProcessing_idx` (`beganProcessing`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `processingOrders`;
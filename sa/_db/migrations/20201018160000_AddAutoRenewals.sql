@@ -0,0 +1,23 @@
+
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `autoRenewals` (
+    `orderID` bigint(20) NOT NULL,
+    `registrationID` bigint(20) NOT NULL,
+    `startDate` datetime NOT NULL,
+    `endDate` datetime NOT NULL,
+    `lifetime` bigint(20) NOT NULL,
+    `allowCertificateGet` tinyint(1) NOT NULL DEFAULT 0,
+    `csr` mediumblob,
+    `currentSerial` varchar(255) NOT NULL DEFAULT '',
+    `nextIssuance` datetime NOT NULL,
+    `canceled` tinyint(1) NOT NULL DEFAULT 0,
+    PRIMARY KEY (`orderID`),
+    KEY `nextIssuance_idx` (`nextIssuance`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `autoRenewals`;
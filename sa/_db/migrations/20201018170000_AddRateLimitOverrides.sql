@@ -0,0 +1,36 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `rateLimitOverrides` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `limitName` varchar(255) NOT NULL,
+    `overrideKey` varchar(255) NOT NULL DEFAULT '',
+    `registrationID` bigint(20) NOT NULL DEFAULT 0,
+    `threshold` bigint(20) NOT NULL,
+    `owner` varchar(255) NOT NULL,
+    `justification` text NOT NULL,
+    `created` datetime NOT NULL,
+    `expires` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `expires_idx` (`expires`),
+    KEY `limitName_overrideKey_registrationID_idx` (`limitName`, `overrideKey`, `registrationID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `rateLimitOverrideHistory` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `overrideID` bigint(20) NOT NULL,
+    `action` varchar(32) NOT NULL,
+    `actor` varchar(255) NOT NULL,
+    `justification` text NOT NULL,
+    `threshold` bigint(20) NOT NULL,
+    `expires` datetime NOT NULL,
+    `changed` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `overrideID_idx` (`overrideID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `rateLimitOverrideHistory`;
+DROP TABLE `rateLimitOverrides`;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+ALTER TABLE `authz2` ADD COLUMN (
+  `failedAttempts` MEDIUMBLOB DEFAULT NULL,
+  `retryAfter` DATETIME DEFAULT NULL
+);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE `authz2` DROP COLUMN `failedAttempts`, DROP COLUMN `retryAfter`;
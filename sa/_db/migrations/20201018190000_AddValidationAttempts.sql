@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `validationAttempts` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `authzID` bigint(20) NOT NULL,
+    `registrationID` bigint(20) NOT NULL,
+    `identifierValue` varchar(255) NOT NULL,
+    `challengeType` tinyint(4) NOT NULL,
+    `attemptedAt` datetime NOT NULL,
+    `status` tinyint(4) NOT NULL,
+    `validationRecords` mediumblob DEFAULT NULL,
+    `validationError` mediumblob DEFAULT NULL,
+    `remoteResults` mediumblob DEFAULT NULL,
+    `caaRecords` mediumblob DEFAULT NULL,
+    PRIMARY KEY (`id`),
+    KEY `authzID_idx` (`authzID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `validationAttempts`;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `heldOrders` (
+    `orderID` bigint(20) NOT NULL,
+    `registrationID` bigint(20) NOT NULL,
+    `reviewNames` mediumblob NOT NULL,
+    `csr` mediumblob NOT NULL,
+    `heldAt` datetime NOT NULL,
+    PRIMARY KEY (`orderID`),
+    KEY `heldAt_idx` (`heldAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `heldOrders`;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE `accountScopes` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `registrationID` bigint(20) NOT NULL,
+    `suffix` varchar(255) NOT NULL,
+    `created` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `registrationID_suffix_idx` (`registrationID`, `suffix`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `accountScopeHistory` (
+    `id` bigint(20) NOT NULL AUTO_INCREMENT,
+    `registrationID` bigint(20) NOT NULL,
+    `suffix` varchar(255) NOT NULL,
+    `action` varchar(32) NOT NULL,
+    `actor` varchar(255) NOT NULL,
+    `justification` text NOT NULL,
+    `changed` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `registrationID_idx` (`registrationID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE `accountScopeHistory`;
+DROP TABLE `accountScopes`;
@@ -9,6 +9,7 @@ import (
 
 	"github.com/letsencrypt/boulder/core"
 	boulderDB "github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/features"
 	blog "github.com/letsencrypt/boulder/log"
 )
 
@@ -116,6 +117,9 @@ func (log *SQLLogger) Printf(format string, v ...interface{}) {
 // effect in Insert() where the inserted object has its id field set to the
 // autoincremented value that resulted from the insert. See
 // https://godoc.org/github.com/coopernurse/gorp#DbMap.Insert
+//
+// Columns that are only stored when a feature is enabled are left out of the
+// table map otherwise, so features must be set before the map is constructed.
 func initTables(dbMap *gorp.DbMap) {
 	var regTable *gorp.TableMap
 	regTable = dbMap.AddTableWithName(regModel{}, "registrations").SetKeys(true, "ID")
@@ -123,6 +127,9 @@ func initTables(dbMap *gorp.DbMap) {
 	regTable.SetVersionCol("LockCol")
 	regTable.ColMap("Key").SetNotNull(true)
 	regTable.ColMap("KeySHA256").SetNotNull(true).SetUnique(true)
+	if !features.Enabled(features.StoreExternalAccountID) {
+		regTable.ColMap("ExternalAccountID").SetTransient(true)
+	}
 	dbMap.AddTableWithName(authzModel{}, "authz").SetKeys(false, "ID")
 	dbMap.AddTableWithName(challModel{}, "challenges").SetKeys(true, "ID")
 	dbMap.AddTableWithName(issuedNameModel{}, "issuedNames").SetKeys(true, "ID")
@@ -130,13 +137,34 @@ func initTables(dbMap *gorp.DbMap) {
 	dbMap.AddTableWithName(core.CertificateStatus{}, "certificateStatus").SetKeys(false, "Serial")
 	dbMap.AddTableWithName(core.CRL{}, "crls").SetKeys(false, "Serial")
 	dbMap.AddTableWithName(core.FQDNSet{}, "fqdnSets").SetKeys(true, "ID")
-	dbMap.AddTableWithName(orderModel{}, "orders").SetKeys(true, "ID")
+	ordersTable := dbMap.AddTableWithName(orderModel{}, "orders").SetKeys(true, "ID")
+	if !features.Enabled(features.StoreCertificateProfileName) {
+		ordersTable.ColMap("CertificateProfileName").SetTransient(true)
+	}
 	dbMap.AddTableWithName(orderToAuthzModel{}, "orderToAuthz").SetKeys(false, "OrderID", "AuthzID")
 	dbMap.AddTableWithName(requestedNameModel{}, "requestedNames").SetKeys(false, "OrderID")
 	dbMap.AddTableWithName(orderFQDNSet{}, "orderFqdnSets").SetKeys(true, "ID")
-	dbMap.AddTableWithName(authzModel{}, "authz2").SetKeys(true, "ID")
+	authz2Table := dbMap.AddTableWithName(authzModel{}, "authz2").SetKeys(true, "ID")
+	if !features.Enabled(features.StoreAuthzRetries) {
+		authz2Table.ColMap("FailedAttempts").SetTransient(true)
+		authz2Table.ColMap("RetryAfter").SetTransient(true)
+	}
+	if !features.Enabled(features.StoreAuthzAttemptedAt) {
+		authz2Table.ColMap("AttemptedAt").SetTransient(true)
+	}
 	dbMap.AddTableWithName(orderToAuthzModel{}, "orderToAuthz2").SetKeys(false, "OrderID", "AuthzID")
 	dbMap.AddTableWithName(recordedSerialModel{}, "serials").SetKeys(true, "ID")
 	dbMap.AddTableWithName(precertificateModel{}, "precertificates").SetKeys(true, "ID")
 	dbMap.AddTableWithName(keyHashModel{}, "keyHashToSerial").SetKeys(true, "ID")
+	dbMap.AddTableWithName(externalAccountKeyModel{}, "externalAccountKeys").SetKeys(true, "ID")
+	dbMap.AddTableWithName(replacementOrderModel{}, "replacementOrders").SetKeys(true, "ID")
+	dbMap.AddTableWithName(renewalInfoOverrideModel{}, "renewalInfoOverrides").SetKeys(true, "ID")
+	dbMap.AddTableWithName(processingOrderModel{}, "processingOrders").SetKeys(false, "OrderID")
+	dbMap.AddTableWithName(heldOrderModel{}, "heldOrders").SetKeys(false, "OrderID")
+	dbMap.AddTableWithName(autoRenewalModel{}, "autoRenewals").SetKeys(false, "OrderID")
+	dbMap.AddTableWithName(rateLimitOverrideModel{}, "rateLimitOverrides").SetKeys(true, "ID")
+	dbMap.AddTableWithName(rateLimitOverrideHistoryModel{}, "rateLimitOverrideHistory").SetKeys(true, "ID")
+	dbMap.AddTableWithName(validationAttemptModel{}, "validationAttempts").SetKeys(true, "ID")
+	dbMap.AddTableWithName(accountScopeModel{}, "accountScopes").SetKeys(true, "ID")
+	dbMap.AddTableWithName(accountScopeHistoryModel{}, "accountScopeHistory").SetKeys(true, "ID")
 }
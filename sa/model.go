@@ -16,8 +16,11 @@ import (
 	"github.com/letsencrypt/boulder/core"
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/db"
+	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/grpc"
+	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
 // errBadJSON is an error type returned when a json.Unmarshal performed by the
@@ -49,14 +52,24 @@ func badJSONError(msg string, jsonData []byte, err error) error {
 	}
 }
 
-const regFields = "id, jwk, jwk_sha256, contact, agreement, initialIP, createdAt, LockCol, status"
+const regFieldsNoExternalAccount = "id, jwk, jwk_sha256, contact, agreement, initialIP, createdAt, LockCol, status"
+const regFieldsWithExternalAccount = regFieldsNoExternalAccount + ", externalAccountID"
+
+// regFields returns the registrations fields to select. The external account
+// ID is only selected if the StoreExternalAccountID feature is enabled.
+func regFields() string {
+	if features.Enabled(features.StoreExternalAccountID) {
+		return regFieldsWithExternalAccount
+	}
+	return regFieldsNoExternalAccount
+}
 
 // selectRegistration selects all fields of one registration model
 func selectRegistration(s db.OneSelector, q string, args ...interface{}) (*regModel, error) {
 	var model regModel
 	err := s.SelectOne(
 		&model,
-		"SELECT "+regFields+" FROM registrations "+q,
+		"SELECT "+regFields()+" FROM registrations "+q,
 		args...,
 	)
 	return &model, err
@@ -164,6 +177,9 @@ type regModel struct {
 	CreatedAt time.Time `db:"createdAt"`
 	LockCol   int64
 	Status    string `db:"status"`
+	// ExternalAccountID is the key ID of the external account binding used to
+	// create the registration, or the empty string if none was used.
+	ExternalAccountID string `db:"externalAccountID"`
 }
 
 // challModel is the description of a core.Challenge in the database
@@ -212,6 +228,8 @@ func registrationToModel(r *core.Registration) (*regModel, error) {
 		InitialIP: []byte(r.InitialIP.To16()),
 		CreatedAt: r.CreatedAt,
 		Status:    string(r.Status),
+
+		ExternalAccountID: r.ExternalAccountID,
 	}
 
 	return &rm, nil
@@ -244,6 +262,8 @@ func modelToRegistration(reg *regModel) (core.Registration, error) {
 		InitialIP: net.IP(reg.InitialIP),
 		CreatedAt: reg.CreatedAt,
 		Status:    core.AcmeStatus(reg.Status),
+
+		ExternalAccountID: reg.ExternalAccountID,
 	}
 
 	return r, nil
@@ -306,6 +326,9 @@ type orderModel struct {
 	Error             []byte
 	CertificateSerial string
 	BeganProcessing   bool
+	// CertificateProfileName is the name of the certificate profile selected
+	// for the order, or empty for the default profile.
+	CertificateProfileName string
 }
 
 type requestedNameModel struct {
@@ -321,11 +344,12 @@ type orderToAuthzModel struct {
 
 func orderToModel(order *corepb.Order) (*orderModel, error) {
 	om := &orderModel{
-		ID:              *order.Id,
-		RegistrationID:  *order.RegistrationID,
-		Expires:         time.Unix(0, *order.Expires),
-		Created:         time.Unix(0, *order.Created),
-		BeganProcessing: *order.BeganProcessing,
+		ID:                     *order.Id,
+		RegistrationID:         *order.RegistrationID,
+		Expires:                time.Unix(0, *order.Expires),
+		Created:                time.Unix(0, *order.Created),
+		BeganProcessing:        *order.BeganProcessing,
+		CertificateProfileName: order.GetCertProfile(),
 	}
 	if order.CertificateSerial != nil {
 		om.CertificateSerial = *order.CertificateSerial
@@ -355,6 +379,9 @@ func modelToOrder(om *orderModel) (*corepb.Order, error) {
 		CertificateSerial: &om.CertificateSerial,
 		BeganProcessing:   &om.BeganProcessing,
 	}
+	if om.CertificateProfileName != "" {
+		order.CertProfile = &om.CertificateProfileName
+	}
 	if len(om.Error) > 0 {
 		var problem corepb.ProblemDetails
 		err := json.Unmarshal(om.Error, &problem)
@@ -370,23 +397,27 @@ func modelToOrder(om *orderModel) (*corepb.Order, error) {
 }
 
 var challTypeToUint = map[string]uint8{
-	"http-01":     0,
-	"dns-01":      1,
-	"tls-alpn-01": 2,
+	"http-01":        0,
+	"dns-01":         1,
+	"tls-alpn-01":    2,
+	"dns-account-01": 3,
 }
 
 var uintToChallType = map[uint8]string{
 	0: "http-01",
 	1: "dns-01",
 	2: "tls-alpn-01",
+	3: "dns-account-01",
 }
 
 var identifierTypeToUint = map[string]uint8{
 	"dns": 0,
+	"ip":  1,
 }
 
 var uintToIdentifierType = map[uint8]string{
 	0: "dns",
+	1: "ip",
 }
 
 var statusToUint = map[string]uint8{
@@ -409,7 +440,23 @@ func statusUint(status core.AcmeStatus) uint8 {
 	return statusToUint[string(status)]
 }
 
-const authzFields = "id, identifierType, identifierValue, registrationID, status, expires, challenges, attempted, token, validationError, validationRecord"
+const authzFieldsBase = "id, identifierType, identifierValue, registrationID, status, expires, challenges, attempted, token, validationError, validationRecord"
+const authzFieldsRetries = ", failedAttempts, retryAfter"
+const authzFieldsAttemptedAt = ", attemptedAt"
+
+// authzFields returns the authz2 fields to select. The fields holding failed
+// attempts are only selected if the StoreAuthzRetries feature is enabled, and
+// the time an authz was finalized only if the StoreAuthzAttemptedAt feature is.
+func authzFields() string {
+	fields := authzFieldsBase
+	if features.Enabled(features.StoreAuthzRetries) {
+		fields += authzFieldsRetries
+	}
+	if features.Enabled(features.StoreAuthzAttemptedAt) {
+		fields += authzFieldsAttemptedAt
+	}
+	return fields
+}
 
 type authzModel struct {
 	ID               int64     `db:"id"`
@@ -423,6 +470,21 @@ type authzModel struct {
 	Token            []byte    `db:"token"`
 	ValidationError  []byte    `db:"validationError"`
 	ValidationRecord []byte    `db:"validationRecord"`
+	// FailedAttempts is a JSON list of the failed attempts of a pending authz
+	// that were retried, and RetryAfter is when it may next be retried.
+	FailedAttempts []byte     `db:"failedAttempts"`
+	RetryAfter     *time.Time `db:"retryAfter"`
+	// AttemptedAt is when the authz was finalized as valid or invalid.
+	AttemptedAt *time.Time `db:"attemptedAt"`
+}
+
+// failedAttempt is a failed validation attempt of an authz that was allowed to
+// be retried.
+type failedAttempt struct {
+	Attempted        core.AcmeChallenge      `json:"attempted"`
+	AttemptedAt      time.Time               `json:"attemptedAt"`
+	ValidationRecord []core.ValidationRecord `json:"validationRecord,omitempty"`
+	ValidationError  *probs.ProblemDetails   `json:"validationError"`
 }
 
 // hasMultipleNonPendingChallenges checks if a slice of challenges contains
@@ -445,7 +507,16 @@ func hasMultipleNonPendingChallenges(challenges []*corepb.Challenge) bool {
 // authzModel storage representation.
 func authzPBToModel(authz *corepb.Authorization) (*authzModel, error) {
 	expires := time.Unix(0, *authz.Expires).UTC()
+	identType := string(identifier.DNS)
+	if authz.GetIdentifierType() != "" {
+		identType = authz.GetIdentifierType()
+	}
+	identTypeUint, ok := identifierTypeToUint[identType]
+	if !ok {
+		return nil, fmt.Errorf("unknown identifier type: %q", identType)
+	}
 	am := &authzModel{
+		IdentifierType:  identTypeUint,
 		IdentifierValue: *authz.Identifier,
 		RegistrationID:  *authz.RegistrationID,
 		Status:          statusToUint[*authz.Status],
@@ -545,6 +616,10 @@ func populateAttemptedFields(am authzModel, challenge *corepb.Challenge) error {
 		// If the error is empty the challenge must be valid.
 		status := string(core.StatusValid)
 		challenge.Status = &status
+		if am.AttemptedAt != nil {
+			validated := am.AttemptedAt.UTC().UnixNano()
+			challenge.Validated = &validated
+		}
 	}
 	var records []core.ValidationRecord
 	err := json.Unmarshal(am.ValidationRecord, &records)
@@ -564,14 +639,57 @@ func populateAttemptedFields(am authzModel, challenge *corepb.Challenge) error {
 	return nil
 }
 
+// populateRetryFields sets the retry state of the failed attempts of a pending
+// authz on each of its challenges, along with the error and validation records
+// of the latest attempt on the challenge of its type.
+func populateRetryFields(am authzModel, challenges []*corepb.Challenge) error {
+	var attempts []failedAttempt
+	err := json.Unmarshal(am.FailedAttempts, &attempts)
+	if err != nil {
+		return badJSONError(
+			"failed to unmarshal authz2 model's failed attempts",
+			am.FailedAttempts,
+			err)
+	}
+	if len(attempts) == 0 {
+		return nil
+	}
+	latest := attempts[len(attempts)-1]
+	count := int64(len(attempts))
+	for _, challenge := range challenges {
+		challenge.Attempts = &count
+		if am.RetryAfter != nil {
+			retryAfter := am.RetryAfter.UTC().UnixNano()
+			challenge.RetryAfter = &retryAfter
+		}
+		if core.AcmeChallenge(*challenge.Type) != latest.Attempted {
+			continue
+		}
+		challenge.Error, err = grpc.ProblemDetailsToPB(latest.ValidationError)
+		if err != nil {
+			return err
+		}
+		challenge.Validationrecords = make([]*corepb.ValidationRecord, len(latest.ValidationRecord))
+		for i, r := range latest.ValidationRecord {
+			challenge.Validationrecords[i], err = grpc.ValidationRecordToPB(r)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func modelToAuthzPB(am authzModel) (*corepb.Authorization, error) {
 	expires := am.Expires.UTC().UnixNano()
 	id := fmt.Sprintf("%d", am.ID)
 	status := uintToStatus[am.Status]
+	identType := uintToIdentifierType[am.IdentifierType]
 	pb := &corepb.Authorization{
 		Id:             &id,
 		Status:         &status,
 		Identifier:     &am.IdentifierValue,
+		IdentifierType: &identType,
 		RegistrationID: &am.RegistrationID,
 		Expires:        &expires,
 	}
@@ -611,6 +729,13 @@ func modelToAuthzPB(am authzModel) (*corepb.Authorization, error) {
 			}
 		}
 	}
+	// Failed attempts that were retried leave the authz pending, with none of
+	// its challenges attempted.
+	if am.Attempted == nil && len(am.FailedAttempts) != 0 {
+		if err := populateRetryFields(am, pb.Challenges); err != nil {
+			return nil, err
+		}
+	}
 	return pb, nil
 }
 
@@ -625,3 +750,389 @@ var stringToSourceInt = map[string]int{
 	"API":           1,
 	"admin-revoker": 2,
 }
+
+// externalAccountKeyModel represents a row in the externalAccountKeys table.
+// Each row holds the MAC key used to verify the external account binding
+// (RFC 8555 Section 7.3.4) presented by clients when creating an account.
+type externalAccountKeyModel struct {
+	ID        int64      `db:"id"`
+	KeyID     string     `db:"keyID"`
+	HMACKey   []byte     `db:"hmacKey"`
+	CreatedAt time.Time  `db:"createdAt"`
+	RevokedAt *time.Time `db:"revokedAt"`
+}
+
+const externalAccountKeyFields = "id, keyID, hmacKey, createdAt, revokedAt"
+
+func externalAccountKeyModelToPB(model *externalAccountKeyModel) *sapb.ExternalAccountKey {
+	created := model.CreatedAt.UnixNano()
+	var revoked int64
+	if model.RevokedAt != nil {
+		revoked = model.RevokedAt.UnixNano()
+	}
+	return &sapb.ExternalAccountKey{
+		KeyID:   &model.KeyID,
+		HmacKey: model.HMACKey,
+		Created: &created,
+		Revoked: &revoked,
+	}
+}
+
+// replacementOrderModel represents a row in the replacementOrders table. Each
+// row links an order to the serial of the certificate it replaces, as indicated
+// by the "replaces" field of a draft-ietf-acme-ari new-order request. Replaced
+// is set once the order has been finalized.
+type replacementOrderModel struct {
+	ID           int64     `db:"id"`
+	Serial       string    `db:"serial"`
+	OrderID      int64     `db:"orderID"`
+	OrderExpires time.Time `db:"orderExpires"`
+	Replaced     bool      `db:"replaced"`
+}
+
+// renewalInfoOverrideModel represents a row in the renewalInfoOverrides table.
+// Each row holds an administratively set suggested renewal window for a
+// certificate serial, used in place of the computed window.
+type renewalInfoOverrideModel struct {
+	ID          int64     `db:"id"`
+	Serial      string    `db:"serial"`
+	WindowStart time.Time `db:"windowStart"`
+	WindowEnd   time.Time `db:"windowEnd"`
+	UpdatedAt   time.Time `db:"updatedAt"`
+}
+
+// processingOrderModel represents a row in the processingOrders table. Each row
+// records when an order began processing, and is removed once the order has
+// been finalized or has had an error set. Rows that remain for too long belong
+// to orders whose issuance was abandoned.
+type processingOrderModel struct {
+	OrderID         int64     `db:"orderID"`
+	BeganProcessing time.Time `db:"beganProcessing"`
+}
+
+const heldOrderFields = "orderID, registrationID, reviewNames, csr, heldAt"
+
+// heldOrderModel represents a row in the heldOrders table. Each row holds an
+// order whose finalization is waiting for an operator to review the names that
+// the hostname policy requires review for, stored as a JSON list, along with
+// the CSR to issue from if the order is approved.
+type heldOrderModel struct {
+	OrderID        int64     `db:"orderID"`
+	RegistrationID int64     `db:"registrationID"`
+	ReviewNames    []byte    `db:"reviewNames"`
+	CSR            []byte    `db:"csr"`
+	HeldAt         time.Time `db:"heldAt"`
+}
+
+func heldOrderModelToPB(hm *heldOrderModel) (*sapb.HeldOrder, error) {
+	var names []string
+	err := json.Unmarshal(hm.ReviewNames, &names)
+	if err != nil {
+		return nil, badJSONError("failed to unmarshal JSON to held order review names", hm.ReviewNames, err)
+	}
+	heldAt := hm.HeldAt.UnixNano()
+	return &sapb.HeldOrder{
+		OrderID:        &hm.OrderID,
+		RegistrationID: &hm.RegistrationID,
+		ReviewNames:    names,
+		Csr:            hm.CSR,
+		HeldAt:         &heldAt,
+	}, nil
+}
+
+// autoRenewalModel represents a row in the autoRenewals table, which holds the
+// auto-renewal parameters of STAR orders (RFC 8739). Once the order's first
+// certificate has been issued the row also records the CSR certificates are
+// re-issued from, the serial of the current certificate and when the next
+// certificate is due.
+type autoRenewalModel struct {
+	OrderID             int64     `db:"orderID"`
+	RegistrationID      int64     `db:"registrationID"`
+	StartDate           time.Time `db:"startDate"`
+	EndDate             time.Time `db:"endDate"`
+	Lifetime            int64     `db:"lifetime"` // Nanoseconds
+	AllowCertificateGet bool      `db:"allowCertificateGet"`
+	CSR                 []byte    `db:"csr"`
+	CurrentSerial       string    `db:"currentSerial"`
+	NextIssuance        time.Time `db:"nextIssuance"`
+	Canceled            bool      `db:"canceled"`
+}
+
+func autoRenewalModelToPB(am *autoRenewalModel) *corepb.AutoRenewal {
+	startDate := am.StartDate.UnixNano()
+	endDate := am.EndDate.UnixNano()
+	return &corepb.AutoRenewal{
+		StartDate:           &startDate,
+		EndDate:             &endDate,
+		Lifetime:            &am.Lifetime,
+		AllowCertificateGet: &am.AllowCertificateGet,
+		CurrentSerial:       &am.CurrentSerial,
+		Canceled:            &am.Canceled,
+	}
+}
+
+// rateLimitOverrideModel represents a row in the rateLimitOverrides table. Each
+// row sets the threshold of a rate limit for either a key (OverrideKey) or a
+// registration, until it expires. Rows are never deleted: an override is
+// removed by setting its expiry to the time it was removed.
+type rateLimitOverrideModel struct {
+	ID             int64     `db:"id"`
+	LimitName      string    `db:"limitName"`
+	OverrideKey    string    `db:"overrideKey"`
+	RegistrationID int64     `db:"registrationID"`
+	Threshold      int64     `db:"threshold"`
+	Owner          string    `db:"owner"`
+	Justification  string    `db:"justification"`
+	Created        time.Time `db:"created"`
+	Expires        time.Time `db:"expires"`
+}
+
+func rateLimitOverrideModelToPB(om *rateLimitOverrideModel) *corepb.RateLimitOverride {
+	created := om.Created.UnixNano()
+	expires := om.Expires.UnixNano()
+	return &corepb.RateLimitOverride{
+		Id:             &om.ID,
+		Limit:          &om.LimitName,
+		Key:            &om.OverrideKey,
+		RegistrationID: &om.RegistrationID,
+		Threshold:      &om.Threshold,
+		Owner:          &om.Owner,
+		Justification:  &om.Justification,
+		Created:        &created,
+		Expires:        &expires,
+	}
+}
+
+// Actions recorded in the rateLimitOverrideHistory table.
+const (
+	overrideActionAdd    = "add"
+	overrideActionExpire = "expire"
+)
+
+// rateLimitOverrideHistoryModel represents a row in the
+// rateLimitOverrideHistory table, which records who added or expired each
+// rate limit override, when and why.
+type rateLimitOverrideHistoryModel struct {
+	ID            int64     `db:"id"`
+	OverrideID    int64     `db:"overrideID"`
+	Action        string    `db:"action"`
+	Actor         string    `db:"actor"`
+	Justification string    `db:"justification"`
+	Threshold     int64     `db:"threshold"`
+	Expires       time.Time `db:"expires"`
+	Changed       time.Time `db:"changed"`
+}
+
+const validationAttemptFields = "id, authzID, registrationID, identifierValue, challengeType, attemptedAt, status, validationRecords, validationError, remoteResults, caaRecords"
+
+// validationAttemptModel represents a row in the validationAttempts table,
+// which records what the VA saw during each validation attempt of an authz2,
+// whether or not it was the attempt that finalized it. The validation records,
+// error, remote VA results and CAA records are stored as JSON.
+type validationAttemptModel struct {
+	ID                int64     `db:"id"`
+	AuthzID           int64     `db:"authzID"`
+	RegistrationID    int64     `db:"registrationID"`
+	IdentifierValue   string    `db:"identifierValue"`
+	ChallengeType     uint8     `db:"challengeType"`
+	AttemptedAt       time.Time `db:"attemptedAt"`
+	Status            uint8     `db:"status"`
+	ValidationRecords []byte    `db:"validationRecords"`
+	ValidationError   []byte    `db:"validationError"`
+	RemoteResults     []byte    `db:"remoteResults"`
+	CAARecords        []byte    `db:"caaRecords"`
+}
+
+// remoteValidationResult is the result of a remote VA, as stored in the
+// remoteResults of a validationAttemptModel.
+type remoteValidationResult struct {
+	VAHostname        string                  `json:"vaHostname"`
+	ValidationRecords []core.ValidationRecord `json:"validationRecords,omitempty"`
+	Problem           *probs.ProblemDetails   `json:"problem,omitempty"`
+}
+
+// validationRecordsFromPB converts validation records from protobufs.
+func validationRecordsFromPB(recordsPB []*corepb.ValidationRecord) ([]core.ValidationRecord, error) {
+	var records []core.ValidationRecord
+	for _, recordPB := range recordsPB {
+		record, err := grpc.PBToValidationRecord(recordPB)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// validationRecordsToPB converts validation records to protobufs.
+func validationRecordsToPB(records []core.ValidationRecord) ([]*corepb.ValidationRecord, error) {
+	var recordsPB []*corepb.ValidationRecord
+	for _, record := range records {
+		recordPB, err := grpc.ValidationRecordToPB(record)
+		if err != nil {
+			return nil, err
+		}
+		recordsPB = append(recordsPB, recordPB)
+	}
+	return recordsPB, nil
+}
+
+func validationAttemptPBToModel(pb *corepb.ValidationAttempt) (*validationAttemptModel, error) {
+	challType, ok := challTypeToUint[pb.GetChallengeType()]
+	if !ok {
+		return nil, fmt.Errorf("unknown challenge type %q", pb.GetChallengeType())
+	}
+	status, ok := statusToUint[pb.GetStatus()]
+	if !ok {
+		return nil, fmt.Errorf("unknown status %q", pb.GetStatus())
+	}
+	records, err := validationRecordsFromPB(pb.ValidationRecords)
+	if err != nil {
+		return nil, err
+	}
+	recordsJSON, err := json.Marshal(records)
+	if err != nil {
+		return nil, err
+	}
+	var errorJSON []byte
+	if pb.ValidationError != nil {
+		prob, err := grpc.PBToProblemDetails(pb.ValidationError)
+		if err != nil {
+			return nil, err
+		}
+		errorJSON, err = json.Marshal(prob)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var remoteResults []remoteValidationResult
+	for _, resultPB := range pb.RemoteResults {
+		records, err := validationRecordsFromPB(resultPB.ValidationRecords)
+		if err != nil {
+			return nil, err
+		}
+		prob, err := grpc.PBToProblemDetails(resultPB.Problem)
+		if err != nil {
+			return nil, err
+		}
+		remoteResults = append(remoteResults, remoteValidationResult{
+			VAHostname:        resultPB.GetVaHostname(),
+			ValidationRecords: records,
+			Problem:           prob,
+		})
+	}
+	remoteJSON, err := json.Marshal(remoteResults)
+	if err != nil {
+		return nil, err
+	}
+	caaJSON, err := json.Marshal(pb.CaaRecords)
+	if err != nil {
+		return nil, err
+	}
+	return &validationAttemptModel{
+		AuthzID:           pb.GetAuthzID(),
+		RegistrationID:    pb.GetRegistrationID(),
+		IdentifierValue:   pb.GetIdentifier(),
+		ChallengeType:     challType,
+		AttemptedAt:       time.Unix(0, pb.GetAttemptedAt()).UTC(),
+		Status:            status,
+		ValidationRecords: recordsJSON,
+		ValidationError:   errorJSON,
+		RemoteResults:     remoteJSON,
+		CAARecords:        caaJSON,
+	}, nil
+}
+
+func validationAttemptModelToPB(am *validationAttemptModel) (*corepb.ValidationAttempt, error) {
+	challType := uintToChallType[am.ChallengeType]
+	status := uintToStatus[am.Status]
+	attemptedAt := am.AttemptedAt.UnixNano()
+	pb := &corepb.ValidationAttempt{
+		Id:             &am.ID,
+		AuthzID:        &am.AuthzID,
+		RegistrationID: &am.RegistrationID,
+		Identifier:     &am.IdentifierValue,
+		ChallengeType:  &challType,
+		AttemptedAt:    &attemptedAt,
+		Status:         &status,
+	}
+	var records []core.ValidationRecord
+	if len(am.ValidationRecords) != 0 {
+		err := json.Unmarshal(am.ValidationRecords, &records)
+		if err != nil {
+			return nil, badJSONError("failed to unmarshal validation attempt's validation records", am.ValidationRecords, err)
+		}
+	}
+	var err error
+	pb.ValidationRecords, err = validationRecordsToPB(records)
+	if err != nil {
+		return nil, err
+	}
+	if len(am.ValidationError) != 0 {
+		var prob probs.ProblemDetails
+		err := json.Unmarshal(am.ValidationError, &prob)
+		if err != nil {
+			return nil, badJSONError("failed to unmarshal validation attempt's validation error", am.ValidationError, err)
+		}
+		pb.ValidationError, err = grpc.ProblemDetailsToPB(&prob)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var remoteResults []remoteValidationResult
+	if len(am.RemoteResults) != 0 {
+		err := json.Unmarshal(am.RemoteResults, &remoteResults)
+		if err != nil {
+			return nil, badJSONError("failed to unmarshal validation attempt's remote results", am.RemoteResults, err)
+		}
+	}
+	for i := range remoteResults {
+		resultPB := &corepb.RemoteValidationResult{VaHostname: &remoteResults[i].VAHostname}
+		resultPB.ValidationRecords, err = validationRecordsToPB(remoteResults[i].ValidationRecords)
+		if err != nil {
+			return nil, err
+		}
+		resultPB.Problem, err = grpc.ProblemDetailsToPB(remoteResults[i].Problem)
+		if err != nil {
+			return nil, err
+		}
+		pb.RemoteResults = append(pb.RemoteResults, resultPB)
+	}
+	if len(am.CAARecords) != 0 {
+		err := json.Unmarshal(am.CAARecords, &pb.CaaRecords)
+		if err != nil {
+			return nil, badJSONError("failed to unmarshal validation attempt's CAA records", am.CAARecords, err)
+		}
+	}
+	return pb, nil
+}
+
+// accountScopeModel represents a row in the accountScopes table. Each row
+// allows an account to issue for a domain and its subdomains. Accounts without
+// any rows may issue for any domain.
+type accountScopeModel struct {
+	ID             int64     `db:"id"`
+	RegistrationID int64     `db:"registrationID"`
+	Suffix         string    `db:"suffix"`
+	Created        time.Time `db:"created"`
+}
+
+// Actions recorded in the accountScopeHistory table.
+const (
+	scopeActionAdd    = "add"
+	scopeActionRemove = "remove"
+	scopeActionClear  = "clear"
+)
+
+// accountScopeHistoryModel represents a row in the accountScopeHistory table,
+// which records who added or removed each domain of an account's scope, when
+// and why.
+type accountScopeHistoryModel struct {
+	ID             int64     `db:"id"`
+	RegistrationID int64     `db:"registrationID"`
+	Suffix         string    `db:"suffix"`
+	Action         string    `db:"action"`
+	Actor          string    `db:"actor"`
+	Justification  string    `db:"justification"`
+	Changed        time.Time `db:"changed"`
+}
@@ -1,7 +1,10 @@
 package sa
 
 import (
+	"encoding/json"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/probs"
@@ -155,6 +158,52 @@ func TestAuthzModel(t *testing.T) {
 	test.AssertError(t, err, "authzPBToModel didn't fail with multiple non-pending challenges")
 }
 
+func TestAuthzModelIdentifierType(t *testing.T) {
+	id := "1"
+	ident := "64.112.117.1"
+	identType := "ip"
+	reg := int64(1)
+	status := string(core.StatusPending)
+	expires := int64(1234)
+	challType := string(core.ChallengeTypeHTTP01)
+	token := "MTIz"
+	authzPB := &corepb.Authorization{
+		Id:             &id,
+		Identifier:     &ident,
+		IdentifierType: &identType,
+		RegistrationID: &reg,
+		Status:         &status,
+		Expires:        &expires,
+		Challenges: []*corepb.Challenge{
+			{
+				Type:   &challType,
+				Status: &status,
+				Token:  &token,
+			},
+		},
+	}
+
+	model, err := authzPBToModel(authzPB)
+	test.AssertNotError(t, err, "authzPBToModel failed")
+	test.AssertEquals(t, model.IdentifierType, identifierTypeToUint["ip"])
+
+	authzPBOut, err := modelToAuthzPB(*model)
+	test.AssertNotError(t, err, "modelToAuthzPB failed")
+	test.AssertEquals(t, authzPBOut.GetIdentifierType(), "ip")
+	test.AssertEquals(t, authzPBOut.GetIdentifier(), ident)
+
+	// An absent identifier type is a DNS identifier
+	authzPB.IdentifierType = nil
+	model, err = authzPBToModel(authzPB)
+	test.AssertNotError(t, err, "authzPBToModel failed")
+	test.AssertEquals(t, model.IdentifierType, identifierTypeToUint["dns"])
+
+	unknownType := "email"
+	authzPB.IdentifierType = &unknownType
+	_, err = authzPBToModel(authzPB)
+	test.AssertError(t, err, "authzPBToModel didn't fail with an unknown identifier type")
+}
+
 // TestModelToChallengeBadJSON tests that converting a challenge model with an
 // invalid validation error field or validation record field produces the
 // expected bad JSON error.
@@ -235,3 +284,112 @@ func TestPopulateAttemptedFieldsBadJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthzModelFailedAttempts(t *testing.T) {
+	retryAfter := time.Date(2020, 10, 18, 1, 2, 3, 0, time.UTC)
+	attempts, err := json.Marshal([]failedAttempt{
+		{
+			Attempted:       core.ChallengeTypeDNS01,
+			AttemptedAt:     retryAfter.Add(-2 * time.Hour),
+			ValidationError: probs.DNS("first"),
+		},
+		{
+			Attempted:   core.ChallengeTypeHTTP01,
+			AttemptedAt: retryAfter.Add(-time.Hour),
+			ValidationRecord: []core.ValidationRecord{
+				{Hostname: "example.com", Port: "80", URL: "http://example.com", AddressUsed: net.ParseIP("1.2.3.4")},
+			},
+			ValidationError: probs.ConnectionFailure("second"),
+		},
+	})
+	test.AssertNotError(t, err, "marshaling failed attempts")
+	model := authzModel{
+		ID:              1,
+		IdentifierValue: "example.com",
+		RegistrationID:  1,
+		Status:          statusUint(core.StatusPending),
+		Expires:         retryAfter.Add(time.Hour),
+		Challenges:      1<<challTypeToUint[string(core.ChallengeTypeHTTP01)] | 1<<challTypeToUint[string(core.ChallengeTypeDNS01)],
+		Token:           []byte("123"),
+		FailedAttempts:  attempts,
+		RetryAfter:      &retryAfter,
+	}
+
+	authzPB, err := modelToAuthzPB(model)
+	test.AssertNotError(t, err, "modelToAuthzPB failed")
+	test.AssertEquals(t, len(authzPB.Challenges), 2)
+	for _, chall := range authzPB.Challenges {
+		// Every challenge is still pending and reports the retry state
+		test.AssertEquals(t, *chall.Status, string(core.StatusPending))
+		test.AssertEquals(t, *chall.Attempts, int64(2))
+		test.AssertEquals(t, *chall.RetryAfter, retryAfter.UnixNano())
+		// Only the challenge of the latest attempt has its error and records
+		if *chall.Type == string(core.ChallengeTypeHTTP01) {
+			test.AssertEquals(t, *chall.Error.Detail, "second")
+			test.AssertEquals(t, len(chall.Validationrecords), 1)
+		} else {
+			test.Assert(t, chall.Error == nil, "challenge of an earlier attempt had an error")
+			test.AssertEquals(t, len(chall.Validationrecords), 0)
+		}
+	}
+
+	model.FailedAttempts = []byte(`{`)
+	_, err = modelToAuthzPB(model)
+	test.AssertError(t, err, "modelToAuthzPB didn't fail with bad failed attempts JSON")
+	_, ok := err.(errBadJSON)
+	test.Assert(t, ok, "expected an errBadJSON")
+}
+
+func TestValidationAttemptModel(t *testing.T) {
+	authzID, regID := int64(7), int64(1)
+	ident, challType, status := "example.com", string(core.ChallengeTypeHTTP01), string(core.StatusInvalid)
+	attemptedAt := time.Date(2020, 10, 18, 1, 2, 3, 0, time.UTC).UnixNano()
+	hostname, port, url := "example.com", "80", "http://example.com"
+	remoteA, remoteB := "va-remote-a", "va-remote-b"
+	prob, err := grpc.ProblemDetailsToPB(probs.ConnectionFailure("weewoo"))
+	test.AssertNotError(t, err, "grpc.ProblemDetailsToPB failed")
+	records := []*corepb.ValidationRecord{
+		{
+			Hostname:          &hostname,
+			Port:              &port,
+			AddressUsed:       []byte("1.2.3.4"),
+			Url:               &url,
+			AddressesResolved: [][]byte{{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4}},
+			AddressesTried:    [][]byte{},
+		},
+	}
+	attempt := &corepb.ValidationAttempt{
+		AuthzID:           &authzID,
+		RegistrationID:    &regID,
+		Identifier:        &ident,
+		ChallengeType:     &challType,
+		AttemptedAt:       &attemptedAt,
+		Status:            &status,
+		ValidationRecords: records,
+		ValidationError:   prob,
+		RemoteResults: []*corepb.RemoteValidationResult{
+			{VaHostname: &remoteA, ValidationRecords: records},
+			{VaHostname: &remoteB, Problem: prob},
+		},
+		CaaRecords: []string{`example.com.	3600	IN	CAA	0 issue "letsencrypt.org"`},
+	}
+
+	model, err := validationAttemptPBToModel(attempt)
+	test.AssertNotError(t, err, "validationAttemptPBToModel failed")
+	model.ID = 3
+	out, err := validationAttemptModelToPB(model)
+	test.AssertNotError(t, err, "validationAttemptModelToPB failed")
+	attempt.Id = &model.ID
+	test.AssertDeepEquals(t, out, attempt)
+
+	badType := "tls-sni-01"
+	attempt.ChallengeType = &badType
+	_, err = validationAttemptPBToModel(attempt)
+	test.AssertError(t, err, "validationAttemptPBToModel didn't fail with an unknown challenge type")
+
+	model.RemoteResults = []byte(`{`)
+	_, err = validationAttemptModelToPB(model)
+	test.AssertError(t, err, "validationAttemptModelToPB didn't fail with bad remote results JSON")
+	_, ok := err.(errBadJSON)
+	test.Assert(t, ok, "expected an errBadJSON")
+}
@@ -2,6 +2,7 @@ package sa
 
 import (
 	"context"
+	"net"
 	"strings"
 	"time"
 
@@ -11,7 +12,11 @@ import (
 
 // baseDomain returns the eTLD+1 of a domain name for the purpose of rate
 // limiting. For a domain name that is itself an eTLD, it returns its input.
+// For an IP address, it also returns its input.
 func baseDomain(name string) string {
+	if net.ParseIP(name) != nil {
+		return name
+	}
 	eTLDPlusOne, err := publicsuffix.Domain(name)
 	if err != nil {
 		// publicsuffix.Domain will return an error if the input name is itself a
@@ -61,18 +66,19 @@ func (ssa *SQLStorageAuthority) addCertificatesPerName(
 
 // countCertificates returns, for a single domain, the count of
 // certificates issued in the given time range for that domain's eTLD+1 (aka
-// base domain). It uses the certificatesPerName table to make this lookup fast.
+// base domain), and the time of the oldest bucket counted. It uses the
+// certificatesPerName table to make this lookup fast.
 func (ssa *SQLStorageAuthority) countCertificates(
 	dbMap db.Selector,
 	domain string,
 	earliest,
 	latest time.Time,
-) (int, error) {
+) (int, time.Time, error) {
 	base := baseDomain(domain)
-	var counts []int
+	var buckets []countBucket
 	_, err := dbMap.Select(
-		&counts,
-		`SELECT count FROM certificatesPerName
+		&buckets,
+		`SELECT time, count FROM certificatesPerName
 		 WHERE eTLDPlusOne = :baseDomain AND
 		 time > :earliest AND
 		 time <= :latest`,
@@ -83,15 +89,12 @@ func (ssa *SQLStorageAuthority) countCertificates(
 		})
 	if err != nil {
 		if db.IsNoRows(err) {
-			return 0, nil
+			return 0, time.Time{}, nil
 		}
-		return 0, err
+		return 0, time.Time{}, err
 	}
-	var total int
-	for _, count := range counts {
-		total += count
-	}
-	return total, nil
+	total, oldest := sumBuckets(buckets)
+	return total, oldest, nil
 }
 
 // addNewOrdersRateLimit adds 1 to the rate limit count for the provided ID,
@@ -112,12 +115,12 @@ func addNewOrdersRateLimit(ctx context.Context, dbMap db.SelectExecer, regID int
 }
 
 // countNewOrders returns the count of orders created in the given time range
-// for the given registration ID
-func countNewOrders(ctx context.Context, dbMap db.Selector, regID int64, earliest, latest time.Time) (int, error) {
-	var counts []int
+// for the given registration ID, and the time of the oldest bucket counted.
+func countNewOrders(ctx context.Context, dbMap db.Selector, regID int64, earliest, latest time.Time) (int, time.Time, error) {
+	var buckets []countBucket
 	_, err := dbMap.Select(
-		&counts,
-		`SELECT count FROM newOrdersRL
+		&buckets,
+		`SELECT time, count FROM newOrdersRL
 		WHERE regID = :regID AND
 		time > :earliest AND
 		time <= :latest`,
@@ -129,13 +132,56 @@ func countNewOrders(ctx context.Context, dbMap db.Selector, regID int64, earlies
 	)
 	if err != nil {
 		if db.IsNoRows(err) {
-			return 0, nil
+			return 0, time.Time{}, nil
 		}
-		return 0, err
+		return 0, time.Time{}, err
 	}
+	total, oldest := sumBuckets(buckets)
+	return total, oldest, nil
+}
+
+// countBucket is a row of a table counting events towards a rate limit in
+// buckets of time.
+type countBucket struct {
+	Time  time.Time
+	Count int
+}
+
+// sumBuckets returns the total count of buckets and the time of the oldest of
+// them, or the zero time if there are none.
+func sumBuckets(buckets []countBucket) (int, time.Time) {
 	var total int
-	for _, count := range counts {
-		total += count
+	var oldest time.Time
+	for _, bucket := range buckets {
+		total += bucket.Count
+		if oldest.IsZero() || bucket.Time.Before(oldest) {
+			oldest = bucket.Time
+		}
+	}
+	return total, oldest
+}
+
+// countResult is the number of rows counted towards a rate limit, and the
+// time of the oldest of them, which is NULL if there were none.
+type countResult struct {
+	Count    int64
+	Earliest *time.Time
+}
+
+// earliest returns the time of the oldest row counted, or the zero time if
+// there were none.
+func (cr countResult) earliest() time.Time {
+	if cr.Earliest == nil {
+		return time.Time{}
+	}
+	return *cr.Earliest
+}
+
+// earliestToNanos converts the time of the oldest event counted towards a rate
+// limit to Unix nanoseconds for an RPC response, with 0 for the zero time.
+func earliestToNanos(earliest time.Time) int64 {
+	if earliest.IsZero() {
+		return 0
 	}
-	return total, nil
+	return earliest.UnixNano()
 }
@@ -74,7 +74,7 @@ func TestCertsPerNameRateLimitTable(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.caseName, func(t *testing.T) {
-			count, err := sa.countCertificatesByName(sa.dbMap, tc.domainName, aprilFirst.Add(-1*time.Second), aprilFirst.Add(aWeek))
+			count, _, err := sa.countCertificatesByName(sa.dbMap, tc.domainName, aprilFirst.Add(-1*time.Second), aprilFirst.Add(aWeek))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -103,14 +103,17 @@ func TestNewOrdersRateLimitTable(t *testing.T) {
 		test.AssertNotError(t, tx.Commit(), "failed to commit tx")
 	}
 
-	count, err := countNewOrders(context.Background(), sa.dbMap, zeroCountRegID, start, start.Add(time.Minute*10))
+	count, oldest, err := countNewOrders(context.Background(), sa.dbMap, zeroCountRegID, start, start.Add(time.Minute*10))
 	test.AssertNotError(t, err, "countNewOrders failed")
 	test.AssertEquals(t, count, 0)
+	test.Assert(t, oldest.IsZero(), "Got an oldest bucket when none were counted")
 
-	count, err = countNewOrders(context.Background(), sa.dbMap, manyCountRegID, start, start.Add(time.Minute*10))
+	count, oldest, err = countNewOrders(context.Background(), sa.dbMap, manyCountRegID, start, start.Add(time.Minute*10))
 	test.AssertNotError(t, err, "countNewOrders failed")
 	test.AssertEquals(t, count, 65)
-	count, err = countNewOrders(context.Background(), sa.dbMap, manyCountRegID, start.Add(time.Minute*5), start.Add(time.Minute*10))
+	test.AssertEquals(t, oldest.Unix(), start.Add(time.Minute).Unix())
+	count, oldest, err = countNewOrders(context.Background(), sa.dbMap, manyCountRegID, start.Add(time.Minute*5), start.Add(time.Minute*10))
 	test.AssertNotError(t, err, "countNewOrders failed")
 	test.AssertEquals(t, count, 45)
+	test.AssertEquals(t, oldest.Unix(), start.Add(time.Minute*6).Unix())
 }
@@ -29,7 +29,7 @@ import (
 	sapb "github.com/letsencrypt/boulder/sa/proto"
 )
 
-type certCountFunc func(db db.Selector, domain string, earliest, latest time.Time) (int, error)
+type certCountFunc func(db db.Selector, domain string, earliest, latest time.Time) (int, time.Time, error)
 
 // SQLStorageAuthority defines a Storage Authority
 type SQLStorageAuthority struct {
@@ -178,12 +178,12 @@ func ipRange(ip net.IP) (net.IP, net.IP) {
 }
 
 // CountRegistrationsByIP returns the number of registrations created in the
-// time range for a single IP address.
-func (ssa *SQLStorageAuthority) CountRegistrationsByIP(ctx context.Context, ip net.IP, earliest time.Time, latest time.Time) (int, error) {
-	var count int64
+// time range for a single IP address, and when the oldest of them was created.
+func (ssa *SQLStorageAuthority) CountRegistrationsByIP(ctx context.Context, ip net.IP, earliest time.Time, latest time.Time) (int, time.Time, error) {
+	var result countResult
 	err := ssa.dbMap.WithContext(ctx).SelectOne(
-		&count,
-		`SELECT COUNT(1) FROM registrations
+		&result,
+		`SELECT COUNT(1) AS count, MIN(createdAt) AS earliest FROM registrations
 		 WHERE
 		 initialIP = :ip AND
 		 :earliest < createdAt AND
@@ -194,21 +194,22 @@ func (ssa *SQLStorageAuthority) CountRegistrationsByIP(ctx context.Context, ip n
 			"latest":   latest,
 		})
 	if err != nil {
-		return -1, err
+		return -1, time.Time{}, err
 	}
-	return int(count), nil
+	return int(result.Count), result.earliest(), nil
 }
 
 // CountRegistrationsByIPRange returns the number of registrations created in
 // the time range in an IP range. For IPv4 addresses, that range is limited to
 // the single IP. For IPv6 addresses, that range is a /48, since it's not
-// uncommon for one person to have a /48 to themselves.
-func (ssa *SQLStorageAuthority) CountRegistrationsByIPRange(ctx context.Context, ip net.IP, earliest time.Time, latest time.Time) (int, error) {
-	var count int64
+// uncommon for one person to have a /48 to themselves. It also returns when
+// the oldest of the registrations was created.
+func (ssa *SQLStorageAuthority) CountRegistrationsByIPRange(ctx context.Context, ip net.IP, earliest time.Time, latest time.Time) (int, time.Time, error) {
+	var result countResult
 	beginIP, endIP := ipRange(ip)
 	err := ssa.dbMap.WithContext(ctx).SelectOne(
-		&count,
-		`SELECT COUNT(1) FROM registrations
+		&result,
+		`SELECT COUNT(1) AS count, MIN(createdAt) AS earliest FROM registrations
 		 WHERE
 		 :beginIP <= initialIP AND
 		 initialIP < :endIP AND
@@ -221,23 +222,25 @@ func (ssa *SQLStorageAuthority) CountRegistrationsByIPRange(ctx context.Context,
 			"endIP":    []byte(endIP),
 		})
 	if err != nil {
-		return -1, err
+		return -1, time.Time{}, err
 	}
-	return int(count), nil
+	return int(result.Count), result.earliest(), nil
 }
 
 // CountCertificatesByNames counts, for each input domain, the number of
 // certificates issued in the given time range for that domain and its
 // subdomains. It returns a map from domains to counts, which is guaranteed to
-// contain an entry for each input domain, so long as err is nil.
+// contain an entry for each input domain, so long as err is nil. Each entry
+// also has the issuance time of the oldest certificate counted.
 // Queries will be run in parallel. If any of them error, only one error will
 // be returned.
 func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, domains []string, earliest, latest time.Time) ([]*sapb.CountByNames_MapElement, error) {
 	work := make(chan string, len(domains))
 	type result struct {
-		err    error
-		count  int
-		domain string
+		err      error
+		count    int
+		earliest time.Time
+		domain   string
 	}
 	results := make(chan result, len(domains))
 	for _, domain := range domains {
@@ -261,7 +264,7 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, do
 					return
 				default:
 				}
-				currentCount, err := ssa.countCertificatesByName(
+				currentCount, currentEarliest, err := ssa.countCertificatesByName(
 					ssa.dbMap.WithContext(ctx), domain, earliest, latest)
 				if err != nil {
 					results <- result{err: err}
@@ -270,8 +273,9 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, do
 					return
 				}
 				results <- result{
-					count:  currentCount,
-					domain: domain,
+					count:    currentCount,
+					earliest: currentEarliest,
+					domain:   domain,
 				}
 			}
 		}()
@@ -285,9 +289,11 @@ func (ssa *SQLStorageAuthority) CountCertificatesByNames(ctx context.Context, do
 		}
 		name := string(r.domain)
 		pbCount := int64(r.count)
+		pbEarliest := earliestToNanos(r.earliest)
 		ret = append(ret, &sapb.CountByNames_MapElement{
-			Name:  &name,
-			Count: &pbCount,
+			Name:     &name,
+			Count:    &pbCount,
+			Earliest: &pbEarliest,
 		})
 	}
 	return ret, nil
@@ -336,8 +342,13 @@ func (ssa *SQLStorageAuthority) GetCertificateStatus(ctx context.Context, serial
 	return certStatus, nil
 }
 
-// NewRegistration stores a new Registration
+// NewRegistration stores a new Registration. One created with an external
+// account binding can only be stored if the StoreExternalAccountID feature is
+// enabled.
 func (ssa *SQLStorageAuthority) NewRegistration(ctx context.Context, reg core.Registration) (core.Registration, error) {
+	if reg.ExternalAccountID != "" && !features.Enabled(features.StoreExternalAccountID) {
+		return reg, berrors.InternalServerError("external account bindings are not stored without the StoreExternalAccountID feature")
+	}
 	reg.CreatedAt = ssa.clk.Now()
 	rm, err := registrationToModel(&reg)
 	if err != nil {
@@ -425,14 +436,15 @@ func (ssa *SQLStorageAuthority) AddCertificate(
 		}
 
 		// NOTE(@cpu): When we collect up names to check if an FQDN set exists (e.g.
-		// that it is a renewal) we use just the DNSNames from the certificate and
-		// ignore the Subject Common Name (if any). This is a safe assumption because
-		// if a certificate we issued were to have a Subj. CN not present as a SAN it
-		// would be a misissuance and miscalculating whether the cert is a renewal or
-		// not for the purpose of rate limiting is the least of our troubles.
+		// that it is a renewal) we use just the DNSNames and IPAddresses from the
+		// certificate and ignore the Subject Common Name (if any). This is a safe
+		// assumption because if a certificate we issued were to have a Subj. CN not
+		// present as a SAN it would be a misissuance and miscalculating whether the
+		// cert is a renewal or not for the purpose of rate limiting is the least of
+		// our troubles.
 		isRenewal, err := ssa.checkFQDNSetExists(
 			txWithCtx.SelectOne,
-			parsedCertificate.DNSNames)
+			certNames(parsedCertificate))
 		if err != nil {
 			return nil, err
 		}
@@ -463,7 +475,7 @@ func (ssa *SQLStorageAuthority) AddCertificate(
 		// don't count against the certificatesPerName limit.
 		if !isRenewal {
 			timeToTheHour := parsedCertificate.NotBefore.Round(time.Hour)
-			if err := ssa.addCertificatesPerName(ctx, txWithCtx, parsedCertificate.DNSNames, timeToTheHour); err != nil {
+			if err := ssa.addCertificatesPerName(ctx, txWithCtx, certNames(parsedCertificate), timeToTheHour); err != nil {
 				return nil, err
 			}
 		}
@@ -472,7 +484,7 @@ func (ssa *SQLStorageAuthority) AddCertificate(
 		// limits are calculated correctly.
 		if err := addFQDNSet(
 			txWithCtx,
-			parsedCertificate.DNSNames,
+			certNames(parsedCertificate),
 			core.SerialToString(parsedCertificate.SerialNumber),
 			parsedCertificate.NotBefore,
 			parsedCertificate.NotAfter,
@@ -492,14 +504,14 @@ func (ssa *SQLStorageAuthority) AddCertificate(
 	return digest, nil
 }
 
-func (ssa *SQLStorageAuthority) CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, error) {
+func (ssa *SQLStorageAuthority) CountOrders(ctx context.Context, acctID int64, earliest, latest time.Time) (int, time.Time, error) {
 	if features.Enabled(features.FasterNewOrdersRateLimit) {
 		return countNewOrders(ctx, ssa.dbMap, acctID, earliest, latest)
 	}
 
-	var count int
-	err := ssa.dbMap.WithContext(ctx).SelectOne(&count,
-		`SELECT count(1) FROM orders
+	var result countResult
+	err := ssa.dbMap.WithContext(ctx).SelectOne(&result,
+		`SELECT count(1) AS count, MIN(created) AS earliest FROM orders
 		WHERE registrationID = :acctID AND
 		created >= :windowLeft AND
 		created < :windowRight`,
@@ -509,9 +521,9 @@ func (ssa *SQLStorageAuthority) CountOrders(ctx context.Context, acctID int64, e
 			"windowRight": latest,
 		})
 	if err != nil {
-		return 0, err
+		return 0, time.Time{}, err
 	}
-	return count, nil
+	return int(result.Count), result.earliest(), nil
 }
 
 func hashNames(names []string) []byte {
@@ -575,13 +587,33 @@ func deleteOrderFQDNSet(
 	return nil
 }
 
+// deleteProcessingOrder deletes the processingOrders row for an order, if
+// there is one. Orders that began processing before the table existed, or that
+// failed before they began processing, have no row.
+func deleteProcessingOrder(db db.Execer, orderID int64) error {
+	_, err := db.Exec(
+		"DELETE FROM processingOrders WHERE orderID = ?",
+		orderID)
+	if err != nil {
+		return berrors.InternalServerError("error deleting processing order")
+	}
+	return nil
+}
+
+// certNames returns the DNS names and IP addresses of a certificate as one list
+// of names, which is the form used for FQDN sets and rate limits.
+func certNames(cert *x509.Certificate) []string {
+	return core.UniqueLowerNamesAndIPs(cert.DNSNames, cert.IPAddresses)
+}
+
 func addIssuedNames(db db.Execer, cert *x509.Certificate, isRenewal bool) error {
-	if len(cert.DNSNames) == 0 {
-		return berrors.InternalServerError("certificate has no DNSNames")
+	names := certNames(cert)
+	if len(names) == 0 {
+		return berrors.InternalServerError("certificate has no DNSNames or IPAddresses")
 	}
 	var qmarks []string
 	var values []interface{}
-	for _, name := range cert.DNSNames {
+	for _, name := range names {
 		values = append(values,
 			ReverseName(name),
 			core.SerialToString(cert.SerialNumber),
@@ -595,18 +627,18 @@ func addIssuedNames(db db.Execer, cert *x509.Certificate, isRenewal bool) error
 }
 
 // CountFQDNSets returns the number of sets with hash |setHash| within the window
-// |window|
-func (ssa *SQLStorageAuthority) CountFQDNSets(ctx context.Context, window time.Duration, names []string) (int64, error) {
-	var count int64
+// |window|, and when the oldest of them was issued.
+func (ssa *SQLStorageAuthority) CountFQDNSets(ctx context.Context, window time.Duration, names []string) (int64, time.Time, error) {
+	var result countResult
 	err := ssa.dbMap.WithContext(ctx).SelectOne(
-		&count,
-		`SELECT COUNT(1) FROM fqdnSets
+		&result,
+		`SELECT COUNT(1) AS count, MIN(issued) AS earliest FROM fqdnSets
 		WHERE setHash = ?
 		AND issued > ?`,
 		hashNames(names),
 		ssa.clk.Now().Add(-window),
 	)
-	return count, err
+	return result.Count, result.earliest(), err
 }
 
 // setHash is a []byte representing the hash of an FQDN Set
@@ -842,12 +874,18 @@ func (ssa *SQLStorageAuthority) DeactivateAuthorization2(ctx context.Context, re
 	return &corepb.Empty{}, nil
 }
 
-// NewOrder adds a new v2 style order to the database
+// NewOrder adds a new v2 style order to the database. Orders selecting a
+// certificate profile can only be stored with the StoreCertificateProfileName
+// feature enabled.
 func (ssa *SQLStorageAuthority) NewOrder(ctx context.Context, req *corepb.Order) (*corepb.Order, error) {
+	if req.GetCertProfile() != "" && !features.Enabled(features.StoreCertificateProfileName) {
+		return nil, berrors.InternalServerError("certificate profiles are not stored without the StoreCertificateProfileName feature")
+	}
 	order := &orderModel{
-		RegistrationID: *req.RegistrationID,
-		Expires:        time.Unix(0, *req.Expires),
-		Created:        ssa.clk.Now(),
+		RegistrationID:         *req.RegistrationID,
+		Expires:                time.Unix(0, *req.Expires),
+		Created:                ssa.clk.Now(),
+		CertificateProfileName: req.GetCertProfile(),
 	}
 
 	output, overallError := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
@@ -881,6 +919,32 @@ func (ssa *SQLStorageAuthority) NewOrder(ctx context.Context, req *corepb.Order)
 			return nil, err
 		}
 
+		// Record the auto-renewal parameters of STAR orders. The next issuance
+		// time is set properly once the first certificate has been issued.
+		if ar := req.AutoRenewal; ar != nil {
+			if err := txWithCtx.Insert(&autoRenewalModel{
+				OrderID:             order.ID,
+				RegistrationID:      order.RegistrationID,
+				StartDate:           time.Unix(0, ar.GetStartDate()),
+				EndDate:             time.Unix(0, ar.GetEndDate()),
+				Lifetime:            ar.GetLifetime(),
+				AllowCertificateGet: ar.GetAllowCertificateGet(),
+				NextIssuance:        time.Unix(0, ar.GetEndDate()),
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		// Link the order to the certificate it replaces, if any. Only one order
+		// may claim a certificate at a time, which the unique key on serial
+		// enforces; the claims of orders that expired without being finalized
+		// are released first.
+		if req.GetReplaces() != "" {
+			if err := claimReplacement(txWithCtx, req.GetReplaces(), order.ID, order.Expires, ssa.clk.Now()); err != nil {
+				return nil, err
+			}
+		}
+
 		if features.Enabled(features.FasterNewOrdersRateLimit) {
 			// Increment the order creation count
 			if err := addNewOrdersRateLimit(ctx, txWithCtx, *req.RegistrationID, ssa.clk.Now().Truncate(time.Minute)); err != nil {
@@ -939,6 +1003,16 @@ func (ssa *SQLStorageAuthority) SetOrderProcessing(ctx context.Context, req *cor
 			return nil, berrors.OrderNotReadyError("Order was already processing. This may indicate your client finalized the same order multiple times, possibly due to a client bug.")
 		}
 
+		// Record when the order began processing so that it can be failed if
+		// issuance is abandoned.
+		err = txWithCtx.Insert(&processingOrderModel{
+			OrderID:         *req.Id,
+			BeganProcessing: ssa.clk.Now(),
+		})
+		if err != nil {
+			return nil, berrors.InternalServerError("error recording order processing start")
+		}
+
 		return nil, nil
 	})
 	return overallError
@@ -967,6 +1041,19 @@ func (ssa *SQLStorageAuthority) SetOrderError(ctx context.Context, order *corepb
 			return nil, berrors.InternalServerError("no order updated with new error field")
 		}
 
+		if err := deleteProcessingOrder(txWithCtx, om.ID); err != nil {
+			return nil, err
+		}
+
+		// A failed order can't replace a certificate, so release its claim on
+		// the certificate for another order.
+		_, err = txWithCtx.Exec(
+			"DELETE FROM replacementOrders WHERE orderID = ? AND replaced = false",
+			om.ID)
+		if err != nil {
+			return nil, berrors.InternalServerError("error releasing replacement order")
+		}
+
 		return nil, nil
 	})
 	return overallError
@@ -1000,11 +1087,69 @@ func (ssa *SQLStorageAuthority) FinalizeOrder(ctx context.Context, req *corepb.O
 			return nil, err
 		}
 
+		if err := deleteProcessingOrder(txWithCtx, *req.Id); err != nil {
+			return nil, err
+		}
+
+		// If the order replaces a certificate, that certificate has now been
+		// replaced. The order must still hold its claim on the certificate.
+		if req.GetReplaces() != "" {
+			result, err := txWithCtx.Exec(
+				"UPDATE replacementOrders SET replaced = true WHERE orderID = ? AND serial = ? AND replaced = false",
+				*req.Id,
+				req.GetReplaces())
+			if err != nil {
+				return nil, berrors.InternalServerError("error updating replacement order for finalization")
+			}
+			n, err := result.RowsAffected()
+			if err != nil || n == 0 {
+				return nil, berrors.InternalServerError("order no longer replaces certificate %s", req.GetReplaces())
+			}
+		}
+
 		return nil, nil
 	})
 	return overallError
 }
 
+// claimReplacement records that the order with the given ID and expiry
+// replaces the certificate with the given serial. A claim held by an order that
+// expired before now without being finalized is released first. If another
+// order has claimed or replaced the certificate a Duplicate error is returned.
+func claimReplacement(tx db.Executor, serial string, orderID int64, orderExpires time.Time, now time.Time) error {
+	_, err := tx.Exec(
+		"DELETE FROM replacementOrders WHERE serial = ? AND replaced = false AND orderExpires <= ?",
+		serial,
+		now)
+	if err != nil {
+		return err
+	}
+	err = tx.Insert(&replacementOrderModel{
+		Serial:       serial,
+		OrderID:      orderID,
+		OrderExpires: orderExpires,
+	})
+	if db.IsDuplicate(err) {
+		return berrors.DuplicateError("certificate %s has already been replaced or is being replaced by another order", serial)
+	}
+	return err
+}
+
+// replacesForOrder retrieves the serial of the certificate an order replaces,
+// or the empty string if the order doesn't replace a certificate.
+func (ssa *SQLStorageAuthority) replacesForOrder(ctx context.Context, orderID int64) (string, error) {
+	var serials []string
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&serials,
+		"SELECT serial FROM replacementOrders WHERE orderID = ? LIMIT 1",
+		orderID,
+	)
+	if err != nil || len(serials) == 0 {
+		return "", err
+	}
+	return serials[0], nil
+}
+
 // authzForOrder retrieves the authorization IDs for an order. It returns these
 // IDs in two slices: one for v1 style authorizations, and another for
 // v2 style authorizations.
@@ -1050,7 +1195,17 @@ func (ssa *SQLStorageAuthority) GetOrder(ctx context.Context, req *sapb.OrderReq
 	if err != nil {
 		return nil, err
 	}
+	autoRenewal, err := ssa.autoRenewalForOrder(ctx, *order.Id)
+	if err != nil {
+		return nil, err
+	}
+	// STAR orders whose first certificate has been issued outlive their
+	// expiry: they stay available until the last certificate issued for them
+	// could have expired, so that the star-certificate URL keeps working.
 	orderExp := time.Unix(0, *order.Expires)
+	if autoRenewal != nil && autoRenewal.CurrentSerial != "" {
+		orderExp = autoRenewal.EndDate.Add(time.Duration(autoRenewal.Lifetime))
+	}
 	if orderExp.Before(ssa.clk.Now()) {
 		return nil, berrors.NotFoundError("no order found for ID %d", *req.Id)
 	}
@@ -1074,6 +1229,28 @@ func (ssa *SQLStorageAuthority) GetOrder(ctx context.Context, req *sapb.OrderReq
 	}
 	order.Names = reversedNames
 
+	replaces, err := ssa.replacesForOrder(ctx, *order.Id)
+	if err != nil {
+		return nil, err
+	}
+	if replaces != "" {
+		order.Replaces = &replaces
+	}
+
+	if autoRenewal != nil {
+		order.AutoRenewal = autoRenewalModelToPB(autoRenewal)
+		// A STAR order with a certificate stays valid, independent of its
+		// authorizations, until its auto-renewal is canceled.
+		if autoRenewal.CurrentSerial != "" {
+			status := string(core.StatusValid)
+			if autoRenewal.Canceled {
+				status = string(core.StatusCanceled)
+			}
+			order.Status = &status
+			return order, nil
+		}
+	}
+
 	// Calculate the status for the order
 	status, err := ssa.statusForOrder(ctx, order)
 	if err != nil {
@@ -1084,6 +1261,22 @@ func (ssa *SQLStorageAuthority) GetOrder(ctx context.Context, req *sapb.OrderReq
 	return order, nil
 }
 
+// autoRenewalForOrder retrieves the auto-renewal parameters of a STAR order, or
+// nil if the order isn't a STAR order.
+func (ssa *SQLStorageAuthority) autoRenewalForOrder(ctx context.Context, orderID int64) (*autoRenewalModel, error) {
+	obj, err := ssa.dbMap.WithContext(ctx).Get(autoRenewalModel{}, orderID)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	return obj.(*autoRenewalModel), nil
+}
+
 // statusForOrder examines the status of a provided order's authorizations to
 // determine what the overall status of the order should be. In summary:
 //   * If the order has an error, the order is invalid
@@ -1310,6 +1503,336 @@ func (ssa *SQLStorageAuthority) GetOrderForNames(
 	return order, nil
 }
 
+// GetOrdersForAccount returns the IDs of the unexpired orders belonging to the
+// given account, in ascending order. Only orders with an ID greater than
+// req.AfterID are returned, and at most req.Limit of them, so that callers can
+// page through an account's orders.
+func (ssa *SQLStorageAuthority) GetOrdersForAccount(ctx context.Context, req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	if req == nil || req.AcctID == nil || req.Now == nil || req.Limit == nil || *req.Limit <= 0 {
+		return nil, errIncompleteRequest
+	}
+	var ids []int64
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&ids,
+		`SELECT id FROM orders
+		WHERE registrationID = :acctID AND
+		expires > :now AND
+		id > :afterID
+		ORDER BY id ASC
+		LIMIT :limit`,
+		map[string]interface{}{
+			"acctID":  *req.AcctID,
+			"now":     time.Unix(0, *req.Now),
+			"afterID": req.GetAfterID(),
+			"limit":   *req.Limit,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.OrderIDs{Ids: ids}, nil
+}
+
+// GetStaleProcessingOrders returns the IDs of up to req.Limit orders that
+// began processing before req.BeganBefore and have not yet been finalized or
+// had an error set.
+func (ssa *SQLStorageAuthority) GetStaleProcessingOrders(ctx context.Context, req *sapb.GetStaleProcessingOrdersRequest) (*sapb.OrderIDs, error) {
+	if req == nil || req.BeganBefore == nil || req.Limit == nil || *req.Limit <= 0 {
+		return nil, errIncompleteRequest
+	}
+	var ids []int64
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&ids,
+		`SELECT orderID FROM processingOrders
+		WHERE beganProcessing < ?
+		ORDER BY beganProcessing ASC
+		LIMIT ?`,
+		time.Unix(0, *req.BeganBefore),
+		*req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.OrderIDs{Ids: ids}, nil
+}
+
+// DeleteProcessingOrder deletes the processingOrders row for an order, so
+// that it's no longer returned by GetStaleProcessingOrders. It's used for
+// orders that no longer need to be failed, e.g. because they have since been
+// deleted or finalized.
+func (ssa *SQLStorageAuthority) DeleteProcessingOrder(ctx context.Context, req *sapb.OrderRequest) (*corepb.Empty, error) {
+	if req == nil || req.Id == nil {
+		return nil, errIncompleteRequest
+	}
+	err := deleteProcessingOrder(ssa.dbMap.WithContext(ctx), *req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// HoldOrder records that an order in processing status is waiting for an
+// operator to review it before it's issued a certificate, along with the CSR
+// to issue from. Held orders are no longer considered to be processing, so
+// they aren't failed by the RA as stale however long the review takes.
+func (ssa *SQLStorageAuthority) HoldOrder(ctx context.Context, req *sapb.HeldOrder) (*corepb.Empty, error) {
+	if req == nil || req.OrderID == nil || req.RegistrationID == nil || len(req.ReviewNames) == 0 || len(req.Csr) == 0 {
+		return nil, errIncompleteRequest
+	}
+	namesJSON, err := json.Marshal(req.ReviewNames)
+	if err != nil {
+		return nil, err
+	}
+	_, err = db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		err := txWithCtx.Insert(&heldOrderModel{
+			OrderID:        *req.OrderID,
+			RegistrationID: *req.RegistrationID,
+			ReviewNames:    namesJSON,
+			CSR:            req.Csr,
+			HeldAt:         ssa.clk.Now(),
+		})
+		if err != nil {
+			if db.IsDuplicate(err) {
+				return nil, berrors.DuplicateError("order ID %d is already held", *req.OrderID)
+			}
+			return nil, err
+		}
+		return nil, deleteProcessingOrder(txWithCtx, *req.OrderID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// ReleaseHeldOrder removes an order from the held orders once it has been
+// reviewed, returning it along with its CSR. The order is recorded as
+// processing again from now, so that it's failed as stale if it's approved
+// but the RA stops before issuing its certificate. Only one caller can
+// release a held order; others get a NotFound error.
+//
+// The order is checked in the same transaction: if it no longer exists, has
+// been failed or finalized, or expired while it was held, it can't be issued a
+// certificate. It's still removed from the held orders, so that it doesn't
+// stay waiting for review, but it isn't made processing again and an error
+// saying why is returned instead.
+func (ssa *SQLStorageAuthority) ReleaseHeldOrder(ctx context.Context, req *sapb.ReleaseHeldOrderRequest) (*sapb.HeldOrder, error) {
+	if req == nil || req.OrderID == nil {
+		return nil, errIncompleteRequest
+	}
+	// released is the result of the transaction. An order that can't be
+	// issued is reported with err rather than by failing the transaction, so
+	// that its removal from the held orders is committed.
+	type released struct {
+		held *sapb.HeldOrder
+		err  error
+	}
+	result, err := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		var hm heldOrderModel
+		err := txWithCtx.SelectOne(
+			&hm,
+			"SELECT "+heldOrderFields+" FROM heldOrders WHERE orderID = ?",
+			*req.OrderID)
+		if err != nil {
+			if db.IsNoRows(err) {
+				return nil, berrors.NotFoundError("no held order found for order ID %d", *req.OrderID)
+			}
+			return nil, err
+		}
+		omObj, err := txWithCtx.Get(orderModel{}, *req.OrderID)
+		if err != nil && !db.IsNoRows(err) {
+			return nil, err
+		}
+		res, err := txWithCtx.Exec("DELETE FROM heldOrders WHERE orderID = ?", *req.OrderID)
+		if err != nil {
+			return nil, err
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return nil, berrors.NotFoundError("no held order found for order ID %d", *req.OrderID)
+		}
+
+		if omObj == nil {
+			return released{err: berrors.NotFoundError("no order found for ID %d", *req.OrderID)}, nil
+		}
+		om := omObj.(*orderModel)
+		switch {
+		case om.RegistrationID != hm.RegistrationID:
+			return nil, berrors.InternalServerError("held order %d doesn't match its order's registration ID", *req.OrderID)
+		case om.Error != nil || om.CertificateSerial != "" || !om.BeganProcessing:
+			return released{err: berrors.OrderNotReadyError("order %d is no longer processing", *req.OrderID)}, nil
+		case !om.Expires.After(ssa.clk.Now()):
+			return released{err: berrors.OrderNotReadyError("order %d expired while it was held", *req.OrderID)}, nil
+		}
+
+		err = txWithCtx.Insert(&processingOrderModel{
+			OrderID:         *req.OrderID,
+			BeganProcessing: ssa.clk.Now(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		held, err := heldOrderModelToPB(&hm)
+		if err != nil {
+			return nil, err
+		}
+		return released{held: held}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := result.(released)
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.held, nil
+}
+
+// GetHeldOrders returns up to req.Limit orders that are waiting to be
+// reviewed, oldest first. Their CSRs aren't returned.
+func (ssa *SQLStorageAuthority) GetHeldOrders(ctx context.Context, req *sapb.GetHeldOrdersRequest) (*sapb.HeldOrders, error) {
+	if req == nil || req.Limit == nil || *req.Limit <= 0 {
+		return nil, errIncompleteRequest
+	}
+	var models []heldOrderModel
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&models,
+		`SELECT orderID, registrationID, reviewNames, heldAt FROM heldOrders
+		ORDER BY heldAt ASC
+		LIMIT ?`,
+		*req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &sapb.HeldOrders{}
+	for i := range models {
+		order, err := heldOrderModelToPB(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Orders = append(resp.Orders, order)
+	}
+	return resp, nil
+}
+
+// GetDueAutoRenewals returns up to req.Limit STAR orders that are due a new
+// certificate at req.Now, along with the CSR to issue it from. Orders are only
+// due once their first certificate has been issued, and only until their
+// auto-renewal is canceled or reaches its end date. Each order must be claimed
+// with ClaimAutoRenewal before it is issued a certificate, since every RA looks
+// for due orders.
+func (ssa *SQLStorageAuthority) GetDueAutoRenewals(ctx context.Context, req *sapb.GetDueAutoRenewalsRequest) (*sapb.DueAutoRenewals, error) {
+	if req == nil || req.Now == nil || req.Limit == nil || *req.Limit <= 0 {
+		return nil, errIncompleteRequest
+	}
+	now := time.Unix(0, *req.Now)
+	var models []autoRenewalModel
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&models,
+		`SELECT orderID, registrationID, startDate, endDate, lifetime,
+		allowCertificateGet, csr, currentSerial, nextIssuance, canceled
+		FROM autoRenewals
+		WHERE nextIssuance <= ? AND
+		endDate > ? AND
+		currentSerial != '' AND
+		canceled = false
+		ORDER BY nextIssuance ASC
+		LIMIT ?`,
+		now,
+		now,
+		*req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &sapb.DueAutoRenewals{}
+	for _, m := range models {
+		m := m
+		nextIssuance := m.NextIssuance.UnixNano()
+		resp.Renewals = append(resp.Renewals, &sapb.DueAutoRenewal{
+			OrderID:      &m.OrderID,
+			Csr:          m.CSR,
+			NextIssuance: &nextIssuance,
+		})
+	}
+	return resp, nil
+}
+
+// SetAutoRenewalCertificate records a newly issued certificate for a STAR
+// order and when the order is next due a certificate. The CSR is only stored
+// when it is provided, i.e. when the order's first certificate is issued.
+func (ssa *SQLStorageAuthority) SetAutoRenewalCertificate(ctx context.Context, req *sapb.SetAutoRenewalCertificateRequest) (*corepb.Empty, error) {
+	if req == nil || req.OrderID == nil || req.Serial == nil || *req.Serial == "" || req.NextIssuance == nil {
+		return nil, errIncompleteRequest
+	}
+	// A nil CSR leaves the stored CSR as-is.
+	var csr []byte
+	if len(req.Csr) > 0 {
+		csr = req.Csr
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		`UPDATE autoRenewals
+		SET currentSerial = ?, nextIssuance = ?, csr = COALESCE(?, csr)
+		WHERE orderID = ?`,
+		*req.Serial,
+		time.Unix(0, *req.NextIssuance),
+		csr,
+		*req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return nil, berrors.NotFoundError("no auto-renewal found for order ID %d", *req.OrderID)
+	}
+	return &corepb.Empty{}, nil
+}
+
+// ClaimAutoRenewal claims a due STAR order for the calling RA to issue its
+// next certificate, by moving its next issuance time to req.ClaimUntil. The
+// claim only succeeds if the next issuance time is still the req.NextIssuance
+// returned by GetDueAutoRenewals, i.e. if no other RA has claimed the order or
+// issued it a certificate since. If the claiming RA fails to issue a
+// certificate the order is due again once the claim expires.
+func (ssa *SQLStorageAuthority) ClaimAutoRenewal(ctx context.Context, req *sapb.ClaimAutoRenewalRequest) (*sapb.Exists, error) {
+	if req == nil || req.OrderID == nil || req.NextIssuance == nil || req.ClaimUntil == nil {
+		return nil, errIncompleteRequest
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		`UPDATE autoRenewals
+		SET nextIssuance = ?
+		WHERE orderID = ? AND nextIssuance = ?`,
+		time.Unix(0, *req.ClaimUntil),
+		*req.OrderID,
+		time.Unix(0, *req.NextIssuance))
+	if err != nil {
+		return nil, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	claimed := n == 1
+	return &sapb.Exists{Exists: &claimed}, nil
+}
+
+// CancelAutoRenewal stops a STAR order from being issued any more
+// certificates. Canceling an already canceled auto-renewal is not an error.
+func (ssa *SQLStorageAuthority) CancelAutoRenewal(ctx context.Context, req *sapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	if req == nil || req.OrderID == nil {
+		return nil, errIncompleteRequest
+	}
+	ar, err := ssa.autoRenewalForOrder(ctx, *req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if ar == nil {
+		return nil, berrors.NotFoundError("no auto-renewal found for order ID %d", *req.OrderID)
+	}
+	_, err = ssa.dbMap.WithContext(ctx).Exec(
+		"UPDATE autoRenewals SET canceled = true WHERE orderID = ?",
+		*req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
 func AuthzMapToPB(m map[string]*core.Authorization) (*sapb.Authorizations, error) {
 	resp := &sapb.Authorizations{}
 	for k, v := range m {
@@ -1381,7 +1904,8 @@ func authzModelMapToPB(m map[string]authzModel) (*sapb.Authorizations, error) {
 // This method will look in both the v2 and v1 authorizations tables for authorizations but will
 // always prefer v2 authorizations. This method will only return authorizations created using the
 // WFE v2 API (in GetAuthorizations this feature was, now somewhat confusingly, called RequireV2Authzs).
-// This method is intended to deprecate GetAuthorizations. This method only supports DNS identifier types.
+// This method is intended to deprecate GetAuthorizations. This method supports DNS and IP identifier
+// types, the "domains" in the request may be IP addresses.
 func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sapb.GetAuthorizationsRequest) (*sapb.Authorizations, error) {
 	var authzModels []authzModel
 	params := []interface{}{
@@ -1390,6 +1914,7 @@ func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sap
 		statusUint(core.StatusPending),
 		time.Unix(0, *req.Now),
 		identifierTypeToUint[string(identifier.DNS)],
+		identifierTypeToUint[string(identifier.IP)],
 	}
 	qmarks := make([]string, len(req.Domains))
 	for i, n := range req.Domains {
@@ -1402,9 +1927,9 @@ func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sap
 			WHERE registrationID = ? AND
 			status IN (?,?) AND
 			expires > ? AND
-			identifierType = ? AND
+			identifierType IN (?,?) AND
 			identifierValue IN (%s)`,
-		authzFields,
+		authzFields(),
 		strings.Join(qmarks, ","),
 	)
 	_, err := ssa.dbMap.Select(
@@ -1472,7 +1997,9 @@ func (ssa *SQLStorageAuthority) GetAuthorizations2(ctx context.Context, req *sap
 // FinalizeAuthorization2 moves a pending authorization to either the valid or invalid status. If
 // the authorization is being moved to invalid the validationError field must be set. If the
 // authorization is being moved to valid the validationRecord and expires fields must be set.
-// This method is intended to deprecate the FinalizeAuthorization method.
+// This method is intended to deprecate the FinalizeAuthorization method. The
+// attemptedAt field is only stored if the StoreAuthzAttemptedAt feature is
+// enabled.
 func (ssa *SQLStorageAuthority) FinalizeAuthorization2(ctx context.Context, req *sapb.FinalizeAuthorizationRequest) error {
 	if *req.Status != string(core.StatusValid) && *req.Status != string(core.StatusInvalid) {
 		return berrors.InternalServerError("authorization must have status valid or invalid")
@@ -1482,7 +2009,12 @@ func (ssa *SQLStorageAuthority) FinalizeAuthorization2(ctx context.Context, req
 		attempted = :attempted,
 		validationRecord = :validationRecord,
 		validationError = :validationError,
-		expires = :expires
+		expires = :expires`
+	if features.Enabled(features.StoreAuthzAttemptedAt) {
+		query += `,
+		attemptedAt = :attemptedAt`
+	}
+	query += `
 		WHERE id = :id AND status = :pending`
 	var validationRecords []core.ValidationRecord
 	for _, recordPB := range req.ValidationRecords {
@@ -1519,6 +2051,14 @@ func (ssa *SQLStorageAuthority) FinalizeAuthorization2(ctx context.Context, req
 		// which should result in a NULL field
 		"validationError": veJSON,
 	}
+	if features.Enabled(features.StoreAuthzAttemptedAt) {
+		var attemptedAt *time.Time
+		if req.AttemptedAt != nil {
+			t := time.Unix(0, *req.AttemptedAt).UTC()
+			attemptedAt = &t
+		}
+		params["attemptedAt"] = attemptedAt
+	}
 
 	res, err := ssa.dbMap.Exec(query, params)
 	if err != nil {
@@ -1536,6 +2076,67 @@ func (ssa *SQLStorageAuthority) FinalizeAuthorization2(ctx context.Context, req
 	return nil
 }
 
+// RecordFailedValidation2 adds a failed validation attempt to a pending
+// authorization which may be retried, leaving it pending, and sets when it may
+// next be retried. Failed attempts can only be stored if the StoreAuthzRetries
+// feature is enabled.
+func (ssa *SQLStorageAuthority) RecordFailedValidation2(ctx context.Context, req *sapb.RecordFailedValidationRequest) error {
+	if !features.Enabled(features.StoreAuthzRetries) {
+		return berrors.InternalServerError("failed validation attempts are not stored without the StoreAuthzRetries feature")
+	}
+	var validationRecords []core.ValidationRecord
+	for _, recordPB := range req.ValidationRecords {
+		record, err := bgrpc.PBToValidationRecord(recordPB)
+		if err != nil {
+			return err
+		}
+		validationRecords = append(validationRecords, record)
+	}
+	validationError, err := bgrpc.PBToProblemDetails(req.ValidationError)
+	if err != nil {
+		return err
+	}
+	attempt := failedAttempt{
+		Attempted:        core.AcmeChallenge(*req.Attempted),
+		AttemptedAt:      time.Unix(0, *req.AttemptedAt).UTC(),
+		ValidationRecord: validationRecords,
+		ValidationError:  validationError,
+	}
+	_, err = db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		var am authzModel
+		err := txWithCtx.SelectOne(
+			&am,
+			fmt.Sprintf(`SELECT %s FROM authz2
+			WHERE id = ? AND status = ? AND attempted IS NULL`, authzFields()),
+			*req.Id,
+			statusUint(core.StatusPending))
+		if err != nil {
+			if db.IsNoRows(err) {
+				return nil, berrors.NotFoundError("pending authorization with id %d not found", *req.Id)
+			}
+			return nil, err
+		}
+		var attempts []failedAttempt
+		if len(am.FailedAttempts) != 0 {
+			err = json.Unmarshal(am.FailedAttempts, &attempts)
+			if err != nil {
+				return nil, badJSONError("failed to unmarshal authz2 model's failed attempts", am.FailedAttempts, err)
+			}
+		}
+		failedAttempts, err := json.Marshal(append(attempts, attempt))
+		if err != nil {
+			return nil, err
+		}
+		_, err = txWithCtx.Exec(
+			"UPDATE authz2 SET failedAttempts = ?, retryAfter = ? WHERE id = ?",
+			failedAttempts,
+			time.Unix(0, *req.RetryAfter).UTC(),
+			*req.Id)
+		return nil, err
+	})
+	return err
+}
+
 // RevokeCertificate stores revocation information about a certificate. It will only store this
 // information if the certificate is not already marked as revoked.
 func (ssa *SQLStorageAuthority) RevokeCertificate(ctx context.Context, req *sapb.RevokeCertificateRequest) error {
@@ -1573,7 +2174,7 @@ func (ssa *SQLStorageAuthority) RevokeCertificate(ctx context.Context, req *sapb
 
 // GetPendingAuthorization2 returns the most recent Pending authorization with
 // the given identifier, if available. This method is intended to deprecate
-// GetPendingAuthorization. This method only supports DNS identifier types.
+// GetPendingAuthorization. This method supports DNS and IP identifier types.
 func (ssa *SQLStorageAuthority) GetPendingAuthorization2(ctx context.Context, req *sapb.GetPendingAuthorizationRequest) (*corepb.Authorization, error) {
 	var am authzModel
 	err := ssa.dbMap.WithContext(ctx).SelectOne(
@@ -1582,15 +2183,15 @@ func (ssa *SQLStorageAuthority) GetPendingAuthorization2(ctx context.Context, re
 			registrationID = :regID AND
 			status = :status AND
 			expires > :validUntil AND
-			identifierType = :dnsType AND
+			identifierType = :identType AND
 			identifierValue = :ident
 			ORDER BY expires ASC
-			LIMIT 1 `, authzFields),
+			LIMIT 1 `, authzFields()),
 		map[string]interface{}{
 			"regID":      *req.RegistrationID,
 			"status":     statusUint(core.StatusPending),
 			"validUntil": time.Unix(0, *req.ValidUntil),
-			"dnsType":    identifierTypeToUint[string(identifier.DNS)],
+			"identType":  identifierTypeToUint[req.GetIdentifierType()],
 			"ident":      *req.IdentifierValue,
 		},
 	)
@@ -1637,7 +2238,7 @@ func (ssa *SQLStorageAuthority) GetValidOrderAuthorizations2(ctx context.Context
 			authz2.expires > :expires AND
 			authz2.status = :status AND
 			orderToAuthz2.orderID = :orderID`,
-			authzFields,
+			authzFields(),
 		),
 		map[string]interface{}{
 			"regID":   *req.AcctID,
@@ -1652,7 +2253,8 @@ func (ssa *SQLStorageAuthority) GetValidOrderAuthorizations2(ctx context.Context
 
 	byName := make(map[string]authzModel)
 	for _, am := range ams {
-		if uintToIdentifierType[am.IdentifierType] != string(identifier.DNS) {
+		identType := uintToIdentifierType[am.IdentifierType]
+		if identType != string(identifier.DNS) && identType != string(identifier.IP) {
 			return nil, fmt.Errorf("unknown identifier type: %q on authz id %d", am.IdentifierType, am.ID)
 		}
 		existing, present := byName[am.IdentifierValue]
@@ -1665,22 +2267,24 @@ func (ssa *SQLStorageAuthority) GetValidOrderAuthorizations2(ctx context.Context
 }
 
 // CountInvalidAuthorizations2 counts invalid authorizations for a user expiring
-// in a given time range. This method is intended to deprecate CountInvalidAuthorizations.
-// This method only supports DNS identifier types.
+// in a given time range, and returns the earliest expiry among them. This
+// method is intended to deprecate CountInvalidAuthorizations.
+// This method supports DNS and IP identifier types, the "hostname" in the request
+// may be an IP address.
 func (ssa *SQLStorageAuthority) CountInvalidAuthorizations2(ctx context.Context, req *sapb.CountInvalidAuthorizationsRequest) (*sapb.Count, error) {
-	var count int64
+	var result countResult
 	err := ssa.dbMap.WithContext(ctx).SelectOne(
-		&count,
-		`SELECT COUNT(1) FROM authz2 WHERE
+		&result,
+		`SELECT COUNT(1) AS count, MIN(expires) AS earliest FROM authz2 WHERE
 		registrationID = :regID AND
 		status = :status AND
 		expires > :expiresEarliest AND
 		expires <= :expiresLatest AND
-		identifierType = :dnsType AND
+		identifierType = :identType AND
 		identifierValue = :ident`,
 		map[string]interface{}{
 			"regID":           *req.RegistrationID,
-			"dnsType":         identifierTypeToUint[string(identifier.DNS)],
+			"identType":       identifierTypeToUint[string(identifier.ForName(*req.Hostname).Type)],
 			"ident":           *req.Hostname,
 			"expiresEarliest": time.Unix(0, *req.Range.Earliest),
 			"expiresLatest":   time.Unix(0, *req.Range.Latest),
@@ -1690,13 +2294,14 @@ func (ssa *SQLStorageAuthority) CountInvalidAuthorizations2(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return &sapb.Count{Count: &count}, nil
+	earliest := earliestToNanos(result.earliest())
+	return &sapb.Count{Count: &result.Count, Earliest: &earliest}, nil
 }
 
 // GetValidAuthorizations2 returns the latest authorization for all
 // domain names that the account has authorizations for. This method is
-// intended to deprecate GetValidAuthorizations. This method only supports
-// DNS identifier types.
+// intended to deprecate GetValidAuthorizations. This method supports DNS and
+// IP identifier types, the "domains" in the request may be IP addresses.
 func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req *sapb.GetValidAuthorizationsRequest) (*sapb.Authorizations, error) {
 	var authzModels []authzModel
 	params := []interface{}{
@@ -1704,6 +2309,7 @@ func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req
 		statusUint(core.StatusValid),
 		time.Unix(0, *req.Now),
 		identifierTypeToUint[string(identifier.DNS)],
+		identifierTypeToUint[string(identifier.IP)],
 	}
 	qmarks := make([]string, len(req.Domains))
 	for i, n := range req.Domains {
@@ -1717,9 +2323,9 @@ func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req
 			registrationID = ? AND
 			status = ? AND
 			expires > ? AND
-			identifierType = ? AND
+			identifierType IN (?,?) AND
 			identifierValue IN (%s)`,
-			authzFields,
+			authzFields(),
 			strings.Join(qmarks, ","),
 		),
 		params...,
@@ -1730,8 +2336,9 @@ func (ssa *SQLStorageAuthority) GetValidAuthorizations2(ctx context.Context, req
 
 	authzMap := make(map[string]authzModel, len(authzModels))
 	for _, am := range authzModels {
-		// Only allow DNS identifiers
-		if uintToIdentifierType[am.IdentifierType] != string(identifier.DNS) {
+		// Only allow DNS and IP identifiers
+		identType := uintToIdentifierType[am.IdentifierType]
+		if identType != string(identifier.DNS) && identType != string(identifier.IP) {
 			continue
 		}
 		// If there is an existing authorization in the map only replace it with one
@@ -1811,3 +2418,522 @@ func (ssa *SQLStorageAuthority) KeyBlocked(ctx context.Context, req *sapb.KeyBlo
 	exists = true
 	return &sapb.Exists{Exists: &exists}, nil
 }
+
+// GetExternalAccountKey returns the external account binding key with the given
+// key ID. Revoked keys are returned with a non-zero Revoked timestamp and it is
+// the caller's responsibility to reject them.
+func (ssa *SQLStorageAuthority) GetExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*sapb.ExternalAccountKey, error) {
+	if req == nil || req.KeyID == nil || *req.KeyID == "" {
+		return nil, errIncompleteRequest
+	}
+	var model externalAccountKeyModel
+	err := ssa.dbMap.WithContext(ctx).SelectOne(
+		&model,
+		"SELECT "+externalAccountKeyFields+" FROM externalAccountKeys WHERE keyID = ?",
+		*req.KeyID,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("external account key %q not found", *req.KeyID)
+		}
+		return nil, err
+	}
+	return externalAccountKeyModelToPB(&model), nil
+}
+
+// AddExternalAccountKey stores a new external account binding key. The key ID
+// must not already be in use.
+func (ssa *SQLStorageAuthority) AddExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKey) (*corepb.Empty, error) {
+	if req == nil || req.KeyID == nil || *req.KeyID == "" || len(req.HmacKey) == 0 || req.Created == nil {
+		return nil, errIncompleteRequest
+	}
+	err := ssa.dbMap.WithContext(ctx).Insert(&externalAccountKeyModel{
+		KeyID:     *req.KeyID,
+		HMACKey:   req.HmacKey,
+		CreatedAt: time.Unix(0, *req.Created),
+	})
+	if err != nil {
+		if db.IsDuplicate(err) {
+			return nil, berrors.DuplicateError("external account key %q already exists", *req.KeyID)
+		}
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// RevokeExternalAccountKey marks an external account binding key as revoked so
+// that it can no longer be used to create new accounts. Accounts previously
+// created with the key are unaffected.
+func (ssa *SQLStorageAuthority) RevokeExternalAccountKey(ctx context.Context, req *sapb.ExternalAccountKeyID) (*corepb.Empty, error) {
+	if req == nil || req.KeyID == nil || *req.KeyID == "" {
+		return nil, errIncompleteRequest
+	}
+	result, err := ssa.dbMap.WithContext(ctx).Exec(
+		"UPDATE externalAccountKeys SET revokedAt = ? WHERE keyID = ? AND revokedAt IS NULL",
+		ssa.clk.Now(),
+		*req.KeyID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, berrors.NotFoundError("no unrevoked external account key %q found", *req.KeyID)
+	}
+	return &corepb.Empty{}, nil
+}
+
+// ReplacementOrderExists returns whether the certificate with the given serial
+// has already been replaced, i.e. whether an order indicating that it replaces
+// the certificate has been finalized, or is claimed by an unexpired order that
+// may yet replace it.
+func (ssa *SQLStorageAuthority) ReplacementOrderExists(ctx context.Context, req *sapb.Serial) (*sapb.Exists, error) {
+	if req == nil || req.Serial == nil || *req.Serial == "" {
+		return nil, errIncompleteRequest
+	}
+	var count int64
+	err := ssa.dbMap.WithContext(ctx).SelectOne(
+		&count,
+		"SELECT COUNT(*) FROM replacementOrders WHERE serial = ? AND (replaced = true OR orderExpires > ?)",
+		*req.Serial,
+		ssa.clk.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	exists := count > 0
+	return &sapb.Exists{Exists: &exists}, nil
+}
+
+// GetRenewalInfoOverride returns the administratively set suggested renewal
+// window for the certificate with the given serial. A NotFound error is
+// returned if there is no override for the serial.
+func (ssa *SQLStorageAuthority) GetRenewalInfoOverride(ctx context.Context, req *sapb.Serial) (*sapb.RenewalInfoOverride, error) {
+	if req == nil || req.Serial == nil || *req.Serial == "" {
+		return nil, errIncompleteRequest
+	}
+	var model renewalInfoOverrideModel
+	err := ssa.dbMap.WithContext(ctx).SelectOne(
+		&model,
+		"SELECT id, serial, windowStart, windowEnd, updatedAt FROM renewalInfoOverrides WHERE serial = ?",
+		*req.Serial,
+	)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, berrors.NotFoundError("no renewal info override for serial %q", *req.Serial)
+		}
+		return nil, err
+	}
+	start := model.WindowStart.UnixNano()
+	end := model.WindowEnd.UnixNano()
+	return &sapb.RenewalInfoOverride{
+		Serial:      &model.Serial,
+		WindowStart: &start,
+		WindowEnd:   &end,
+	}, nil
+}
+
+// AddRenewalInfoOverride sets the suggested renewal window for the certificate
+// with the given serial, replacing any existing override for the serial.
+func (ssa *SQLStorageAuthority) AddRenewalInfoOverride(ctx context.Context, req *sapb.RenewalInfoOverride) (*corepb.Empty, error) {
+	if req == nil || req.Serial == nil || *req.Serial == "" || req.WindowStart == nil || req.WindowEnd == nil {
+		return nil, errIncompleteRequest
+	}
+	if *req.WindowEnd <= *req.WindowStart {
+		return nil, berrors.MalformedError("renewal window must end after it starts")
+	}
+	_, err := ssa.dbMap.WithContext(ctx).Exec(`
+		INSERT INTO renewalInfoOverrides (serial, windowStart, windowEnd, updatedAt)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE windowStart = VALUES(windowStart), windowEnd = VALUES(windowEnd), updatedAt = VALUES(updatedAt)`,
+		*req.Serial,
+		time.Unix(0, *req.WindowStart),
+		time.Unix(0, *req.WindowEnd),
+		ssa.clk.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// rateLimitOverrideFields are the columns of the rateLimitOverrides table, in
+// the order of rateLimitOverrideModel.
+const rateLimitOverrideFields = "id, limitName, overrideKey, registrationID, threshold, owner, justification, created, expires"
+
+// GetRateLimitOverrides returns the rate limit overrides that haven't expired,
+// or all of them if req.IncludeExpired is set, ordered by ID.
+func (ssa *SQLStorageAuthority) GetRateLimitOverrides(ctx context.Context, req *sapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	if req == nil {
+		return nil, errIncompleteRequest
+	}
+	var models []rateLimitOverrideModel
+	var err error
+	if req.IncludeExpired != nil && *req.IncludeExpired {
+		_, err = ssa.dbMap.WithContext(ctx).Select(
+			&models,
+			"SELECT "+rateLimitOverrideFields+" FROM rateLimitOverrides ORDER BY id")
+	} else {
+		_, err = ssa.dbMap.WithContext(ctx).Select(
+			&models,
+			"SELECT "+rateLimitOverrideFields+" FROM rateLimitOverrides WHERE expires > ? ORDER BY id",
+			ssa.clk.Now())
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &corepb.RateLimitOverrides{}
+	for i := range models {
+		resp.Overrides = append(resp.Overrides, rateLimitOverrideModelToPB(&models[i]))
+	}
+	return resp, nil
+}
+
+// AddRateLimitOverride stores a new rate limit override and records its
+// addition in the override history, with the override's owner as the actor.
+// An unexpired override for the same limit and key or registration is
+// expired, since only one can apply at a time. The stored override is
+// returned with its ID and creation time.
+func (ssa *SQLStorageAuthority) AddRateLimitOverride(ctx context.Context, req *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	if req == nil || req.Limit == nil || *req.Limit == "" || req.Threshold == nil ||
+		req.Owner == nil || *req.Owner == "" || req.Justification == nil || *req.Justification == "" || req.Expires == nil {
+		return nil, errIncompleteRequest
+	}
+	var key string
+	if req.Key != nil {
+		key = *req.Key
+	}
+	var regID int64
+	if req.RegistrationID != nil {
+		regID = *req.RegistrationID
+	}
+	if (key == "") == (regID == 0) {
+		return nil, berrors.MalformedError("rate limit override must have exactly one of a key or a registration ID")
+	}
+
+	now := ssa.clk.Now()
+	override := &rateLimitOverrideModel{
+		LimitName:      *req.Limit,
+		OverrideKey:    key,
+		RegistrationID: regID,
+		Threshold:      *req.Threshold,
+		Owner:          *req.Owner,
+		Justification:  *req.Justification,
+		Created:        now,
+		Expires:        time.Unix(0, *req.Expires),
+	}
+	_, err := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		var replaced []rateLimitOverrideModel
+		_, err := txWithCtx.Select(
+			&replaced,
+			"SELECT "+rateLimitOverrideFields+` FROM rateLimitOverrides
+			WHERE limitName = ? AND overrideKey = ? AND registrationID = ? AND expires > ?`,
+			override.LimitName,
+			override.OverrideKey,
+			override.RegistrationID,
+			now)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range replaced {
+			err = expireRateLimitOverride(txWithCtx, r, *req.Owner, fmt.Sprintf("replaced: %s", *req.Justification), now)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = txWithCtx.Insert(override)
+		if err != nil {
+			return nil, err
+		}
+		return nil, txWithCtx.Insert(&rateLimitOverrideHistoryModel{
+			OverrideID:    override.ID,
+			Action:        overrideActionAdd,
+			Actor:         override.Owner,
+			Justification: override.Justification,
+			Threshold:     override.Threshold,
+			Expires:       override.Expires,
+			Changed:       now,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rateLimitOverrideModelToPB(override), nil
+}
+
+// ExpireRateLimitOverride expires the rate limit override with the given ID
+// immediately and records who expired it and why in the override history.
+// Expiring an override that has already expired is a NotFound error.
+func (ssa *SQLStorageAuthority) ExpireRateLimitOverride(ctx context.Context, req *sapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	if req == nil || req.Id == nil || req.Actor == nil || *req.Actor == "" || req.Justification == nil || *req.Justification == "" {
+		return nil, errIncompleteRequest
+	}
+	now := ssa.clk.Now()
+	_, err := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		var override rateLimitOverrideModel
+		err := txWithCtx.SelectOne(
+			&override,
+			"SELECT "+rateLimitOverrideFields+" FROM rateLimitOverrides WHERE id = ? AND expires > ?",
+			*req.Id,
+			now)
+		if err != nil {
+			if db.IsNoRows(err) {
+				return nil, berrors.NotFoundError("no unexpired rate limit override found with ID %d", *req.Id)
+			}
+			return nil, err
+		}
+		return nil, expireRateLimitOverride(txWithCtx, override, *req.Actor, *req.Justification, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// expireRateLimitOverride sets the expiry of override to now and records the
+// change in the override history.
+func expireRateLimitOverride(tx db.Executor, override rateLimitOverrideModel, actor, justification string, now time.Time) error {
+	_, err := tx.Exec(
+		"UPDATE rateLimitOverrides SET expires = ? WHERE id = ?",
+		now,
+		override.ID)
+	if err != nil {
+		return err
+	}
+	return tx.Insert(&rateLimitOverrideHistoryModel{
+		OverrideID:    override.ID,
+		Action:        overrideActionExpire,
+		Actor:         actor,
+		Justification: justification,
+		Threshold:     override.Threshold,
+		Expires:       now,
+		Changed:       now,
+	})
+}
+
+// AddValidationAttempt stores what the VA saw during a validation attempt of
+// an authorization.
+func (ssa *SQLStorageAuthority) AddValidationAttempt(ctx context.Context, req *corepb.ValidationAttempt) (*corepb.Empty, error) {
+	if req == nil || req.AuthzID == nil || req.RegistrationID == nil || req.Identifier == nil ||
+		req.ChallengeType == nil || req.AttemptedAt == nil || req.Status == nil {
+		return nil, errIncompleteRequest
+	}
+	am, err := validationAttemptPBToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	err = ssa.dbMap.WithContext(ctx).Insert(am)
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// GetValidationAttempts returns the stored validation attempts of an
+// authorization, oldest first.
+func (ssa *SQLStorageAuthority) GetValidationAttempts(ctx context.Context, req *sapb.GetValidationAttemptsRequest) (*corepb.ValidationAttempts, error) {
+	if req == nil || req.AuthzID == nil {
+		return nil, errIncompleteRequest
+	}
+	var models []validationAttemptModel
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&models,
+		"SELECT "+validationAttemptFields+" FROM validationAttempts WHERE authzID = ? ORDER BY attemptedAt, id",
+		*req.AuthzID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &corepb.ValidationAttempts{}
+	for i := range models {
+		attempt, err := validationAttemptModelToPB(&models[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Attempts = append(resp.Attempts, attempt)
+	}
+	return resp, nil
+}
+
+// GetAccountScope returns the domains that an account may issue for, along
+// with their subdomains. An account without any may issue for any domain.
+func (ssa *SQLStorageAuthority) GetAccountScope(ctx context.Context, req *sapb.RegistrationID) (*sapb.AccountScope, error) {
+	if req == nil || req.Id == nil {
+		return nil, errIncompleteRequest
+	}
+	var suffixes []string
+	_, err := ssa.dbMap.WithContext(ctx).Select(
+		&suffixes,
+		"SELECT suffix FROM accountScopes WHERE registrationID = ? ORDER BY suffix",
+		*req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &sapb.AccountScope{RegistrationID: req.Id, Suffixes: suffixes}, nil
+}
+
+// AddAccountScope allows an account to issue for the given domains and their
+// subdomains, and records who added each domain and why in the scope history.
+// Once an account has any, it may no longer issue for other domains. Domains
+// the account is already allowed to issue for are ignored.
+func (ssa *SQLStorageAuthority) AddAccountScope(ctx context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	if !accountScopeChangeValid(req) {
+		return nil, errIncompleteRequest
+	}
+	now := ssa.clk.Now()
+	_, err := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		for _, suffix := range req.Suffixes {
+			err := txWithCtx.Insert(&accountScopeModel{
+				RegistrationID: *req.RegistrationID,
+				Suffix:         suffix,
+				Created:        now,
+			})
+			if db.IsDuplicate(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			err = recordAccountScopeChange(txWithCtx, *req.RegistrationID, suffix, scopeActionAdd, *req.Actor, *req.Justification, now)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// RemoveAccountScope stops an account from issuing for the given domains, and
+// records who removed each domain and why in the scope history. It returns a
+// NotFound error if the account wasn't allowed to issue for any of the given
+// domains. Since an account without any domains may issue for any domain,
+// removing all of an account's domains is rejected: ClearAccountScope must be
+// used instead.
+func (ssa *SQLStorageAuthority) RemoveAccountScope(ctx context.Context, req *sapb.AccountScopeChange) (*corepb.Empty, error) {
+	if !accountScopeChangeValid(req) {
+		return nil, errIncompleteRequest
+	}
+	now := ssa.clk.Now()
+	_, err := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		var removed int64
+		for _, suffix := range req.Suffixes {
+			result, err := txWithCtx.Exec(
+				"DELETE FROM accountScopes WHERE registrationID = ? AND suffix = ?",
+				*req.RegistrationID,
+				suffix)
+			if err != nil {
+				return nil, err
+			}
+			n, err := result.RowsAffected()
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				continue
+			}
+			removed += n
+			err = recordAccountScopeChange(txWithCtx, *req.RegistrationID, suffix, scopeActionRemove, *req.Actor, *req.Justification, now)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if removed == 0 {
+			return nil, berrors.NotFoundError("no allowed domains to remove found for registration ID %d", *req.RegistrationID)
+		}
+		var remaining int64
+		err := txWithCtx.SelectOne(
+			&remaining,
+			"SELECT COUNT(*) FROM accountScopes WHERE registrationID = ?",
+			*req.RegistrationID)
+		if err != nil {
+			return nil, err
+		}
+		if remaining == 0 {
+			return nil, berrors.MalformedError(
+				"removing every allowed domain would let registration ID %d issue for any domain; clear its scope instead",
+				*req.RegistrationID)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// ClearAccountScope removes all of the domains an account is allowed to issue
+// for, letting it issue for any domain again, and records who cleared each
+// domain and why in the scope history. It returns a NotFound error if the
+// account wasn't restricted.
+func (ssa *SQLStorageAuthority) ClearAccountScope(ctx context.Context, req *sapb.ClearAccountScopeRequest) (*corepb.Empty, error) {
+	if req == nil || req.RegistrationID == nil || req.Actor == nil || *req.Actor == "" ||
+		req.Justification == nil || *req.Justification == "" {
+		return nil, errIncompleteRequest
+	}
+	now := ssa.clk.Now()
+	_, err := db.WithTransaction(ctx, ssa.dbMap, func(txWithCtx db.Executor) (interface{}, error) {
+		var suffixes []string
+		_, err := txWithCtx.Select(
+			&suffixes,
+			"SELECT suffix FROM accountScopes WHERE registrationID = ?",
+			*req.RegistrationID)
+		if err != nil {
+			return nil, err
+		}
+		if len(suffixes) == 0 {
+			return nil, berrors.NotFoundError("no allowed domains to clear found for registration ID %d", *req.RegistrationID)
+		}
+		_, err = txWithCtx.Exec(
+			"DELETE FROM accountScopes WHERE registrationID = ?",
+			*req.RegistrationID)
+		if err != nil {
+			return nil, err
+		}
+		for _, suffix := range suffixes {
+			err = recordAccountScopeChange(txWithCtx, *req.RegistrationID, suffix, scopeActionClear, *req.Actor, *req.Justification, now)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &corepb.Empty{}, nil
+}
+
+// accountScopeChangeValid returns whether req names an account, at least one
+// non-empty domain, and who is making the change and why.
+func accountScopeChangeValid(req *sapb.AccountScopeChange) bool {
+	if req == nil || req.RegistrationID == nil || len(req.Suffixes) == 0 ||
+		req.Actor == nil || *req.Actor == "" || req.Justification == nil || *req.Justification == "" {
+		return false
+	}
+	for _, suffix := range req.Suffixes {
+		if suffix == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// recordAccountScopeChange records a change to an account's scope in the
+// scope history.
+func recordAccountScopeChange(tx db.Executor, regID int64, suffix, action, actor, justification string, now time.Time) error {
+	return tx.Insert(&accountScopeHistoryModel{
+		RegistrationID: regID,
+		Suffix:         suffix,
+		Action:         action,
+		Actor:          actor,
+		Justification:  justification,
+		Changed:        now,
+	})
+}
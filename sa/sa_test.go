@@ -42,7 +42,18 @@ var ctx = context.Background()
 // initSA constructs a SQLStorageAuthority and a clean up function
 // that should be defer'ed to the end of the test.
 func initSA(t *testing.T) (*SQLStorageAuthority, clock.FakeClock, func()) {
+	return initSAWithFeatures(t, nil)
+}
+
+// initSAWithFeatures is like initSA, but enables the given features before the
+// SA's DbMap is created, since some of them decide which columns it maps. The
+// features stay enabled until they're reset.
+func initSAWithFeatures(t *testing.T, flags map[string]bool) (*SQLStorageAuthority, clock.FakeClock, func()) {
 	features.Reset()
+	err := features.Set(flags)
+	if err != nil {
+		t.Fatalf("Failed to set features: %s", err)
+	}
 
 	dbMap, err := NewDbMap(vars.DBConnSA, 0)
 	if err != nil {
@@ -165,6 +176,30 @@ func TestAddRegistration(t *testing.T) {
 	test.AssertError(t, err, "Registration object for invalid key was returned")
 }
 
+func TestAddRegistrationExternalAccount(t *testing.T) {
+	sa, _, cleanUp := initSA(t)
+	defer cleanUp()
+
+	reg := core.Registration{
+		Key:               satest.GoodJWK(),
+		InitialIP:         net.ParseIP("43.34.43.34"),
+		ExternalAccountID: "kid-1",
+	}
+
+	// Without the StoreExternalAccountID feature the binding can't be stored
+	_, err := sa.NewRegistration(ctx, reg)
+	test.AssertError(t, err, "NewRegistration with an external account didn't fail")
+
+	sa, _, cleanUp = initSAWithFeatures(t, map[string]bool{"StoreExternalAccountID": true})
+	defer cleanUp()
+	defer features.Reset()
+	created, err := sa.NewRegistration(ctx, reg)
+	test.AssertNotError(t, err, "NewRegistration with an external account failed")
+	dbReg, err := sa.GetRegistration(ctx, created.ID)
+	test.AssertNotError(t, err, "GetRegistration failed")
+	test.AssertEquals(t, dbReg.ExternalAccountID, "kid-1")
+}
+
 func TestNoSuchRegistrationErrors(t *testing.T) {
 	sa, _, cleanUp := initSA(t)
 	defer cleanUp()
@@ -301,7 +336,7 @@ func TestCountCertificatesByNames(t *testing.T) {
 	interlocker.Add(len(names))
 	sa.parallelismPerRPC = len(names)
 	oldCertCountFunc := sa.countCertificatesByName
-	sa.countCertificatesByName = func(sel db.Selector, domain string, earliest, latest time.Time) (int, error) {
+	sa.countCertificatesByName = func(sel db.Selector, domain string, earliest, latest time.Time) (int, time.Time, error) {
 		interlocker.Done()
 		interlocker.Wait()
 		return oldCertCountFunc(sel, domain, earliest, latest)
@@ -360,27 +395,29 @@ func TestCountRegistrationsByIP(t *testing.T) {
 
 	// There should be 0 registrations for an IPv4 address we didn't add
 	// a registration for
-	count, err := sa.CountRegistrationsByIP(ctx, net.ParseIP("1.1.1.1"), earliest, latest)
+	count, oldest, err := sa.CountRegistrationsByIP(ctx, net.ParseIP("1.1.1.1"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 0)
+	test.Assert(t, oldest.IsZero(), "Got an oldest registration when none were counted")
 	// There should be 1 registration for the IPv4 address we did add
-	// a registration for
-	count, err = sa.CountRegistrationsByIP(ctx, net.ParseIP("43.34.43.34"), earliest, latest)
+	// a registration for, created now
+	count, oldest, err = sa.CountRegistrationsByIP(ctx, net.ParseIP("43.34.43.34"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 1)
+	test.AssertEquals(t, oldest.Unix(), fc.Now().Unix())
 	// There should be 1 registration for the first IPv6 address we added
 	// a registration for
-	count, err = sa.CountRegistrationsByIP(ctx, net.ParseIP("2001:cdba:1234:5678:9101:1121:3257:9652"), earliest, latest)
+	count, _, err = sa.CountRegistrationsByIP(ctx, net.ParseIP("2001:cdba:1234:5678:9101:1121:3257:9652"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 1)
 	// There should be 1 registration for the second IPv6 address we added
 	// a registration for as well
-	count, err = sa.CountRegistrationsByIP(ctx, net.ParseIP("2001:cdba:1234:5678:9101:1121:3257:9653"), earliest, latest)
+	count, _, err = sa.CountRegistrationsByIP(ctx, net.ParseIP("2001:cdba:1234:5678:9101:1121:3257:9653"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 1)
 	// There should be 0 registrations for an IPv6 address in the same /48 as the
 	// two IPv6 addresses with registrations
-	count, err = sa.CountRegistrationsByIP(ctx, net.ParseIP("2001:cdba:1234:0000:0000:0000:0000:0000"), earliest, latest)
+	count, _, err = sa.CountRegistrationsByIP(ctx, net.ParseIP("2001:cdba:1234:0000:0000:0000:0000:0000"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 0)
 }
@@ -417,27 +454,27 @@ func TestCountRegistrationsByIPRange(t *testing.T) {
 
 	// There should be 0 registrations in the range for an IPv4 address we didn't
 	// add a registration for
-	count, err := sa.CountRegistrationsByIPRange(ctx, net.ParseIP("1.1.1.1"), earliest, latest)
+	count, _, err := sa.CountRegistrationsByIPRange(ctx, net.ParseIP("1.1.1.1"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 0)
 	// There should be 1 registration in the range for the IPv4 address we did
 	// add a registration for
-	count, err = sa.CountRegistrationsByIPRange(ctx, net.ParseIP("43.34.43.34"), earliest, latest)
+	count, _, err = sa.CountRegistrationsByIPRange(ctx, net.ParseIP("43.34.43.34"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 1)
 	// There should be 2 registrations in the range for the first IPv6 address we added
 	// a registration for because it's in the same /48
-	count, err = sa.CountRegistrationsByIPRange(ctx, net.ParseIP("2001:cdba:1234:5678:9101:1121:3257:9652"), earliest, latest)
+	count, _, err = sa.CountRegistrationsByIPRange(ctx, net.ParseIP("2001:cdba:1234:5678:9101:1121:3257:9652"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 2)
 	// There should be 2 registrations in the range for the second IPv6 address
 	// we added a registration for as well, because it too is in the same /48
-	count, err = sa.CountRegistrationsByIPRange(ctx, net.ParseIP("2001:cdba:1234:5678:9101:1121:3257:9653"), earliest, latest)
+	count, _, err = sa.CountRegistrationsByIPRange(ctx, net.ParseIP("2001:cdba:1234:5678:9101:1121:3257:9653"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 2)
 	// There should also be 2 registrations in the range for an arbitrary IPv6 address in
 	// the same /48 as the registrations we added
-	count, err = sa.CountRegistrationsByIPRange(ctx, net.ParseIP("2001:cdba:1234:0000:0000:0000:0000:0000"), earliest, latest)
+	count, _, err = sa.CountRegistrationsByIPRange(ctx, net.ParseIP("2001:cdba:1234:0000:0000:0000:0000:0000"), earliest, latest)
 	test.AssertNotError(t, err, "Failed to count registrations")
 	test.AssertEquals(t, count, 2)
 }
@@ -457,12 +494,13 @@ func TestFQDNSets(t *testing.T) {
 
 	// only one valid
 	threeHours := time.Hour * 3
-	count, err := sa.CountFQDNSets(ctx, threeHours, names)
+	count, oldest, err := sa.CountFQDNSets(ctx, threeHours, names)
 	test.AssertNotError(t, err, "Failed to count name sets")
 	test.AssertEquals(t, count, int64(1))
+	test.AssertEquals(t, oldest.Unix(), issued.Unix())
 
 	// check hash isn't affected by changing name order/casing
-	count, err = sa.CountFQDNSets(ctx, threeHours, []string{"b.example.com", "A.example.COM"})
+	count, _, err = sa.CountFQDNSets(ctx, threeHours, []string{"b.example.com", "A.example.COM"})
 	test.AssertNotError(t, err, "Failed to count name sets")
 	test.AssertEquals(t, count, int64(1))
 
@@ -474,7 +512,7 @@ func TestFQDNSets(t *testing.T) {
 	test.AssertNotError(t, tx.Commit(), "Failed to commit transaction")
 
 	// only two valid
-	count, err = sa.CountFQDNSets(ctx, threeHours, names)
+	count, _, err = sa.CountFQDNSets(ctx, threeHours, names)
 	test.AssertNotError(t, err, "Failed to count name sets")
 	test.AssertEquals(t, count, int64(2))
 
@@ -491,10 +529,11 @@ func TestFQDNSets(t *testing.T) {
 	test.AssertNotError(t, err, "Failed to add name set")
 	test.AssertNotError(t, tx.Commit(), "Failed to commit transaction")
 
-	// only two valid
-	count, err = sa.CountFQDNSets(ctx, threeHours, names)
+	// only two valid, and the expired set isn't the oldest counted
+	count, oldest, err = sa.CountFQDNSets(ctx, threeHours, names)
 	test.AssertNotError(t, err, "Failed to count name sets")
 	test.AssertEquals(t, count, int64(2))
+	test.AssertEquals(t, oldest.Unix(), issued.Unix())
 }
 
 func TestFQDNSetsExists(t *testing.T) {
@@ -1002,6 +1041,194 @@ func TestSetOrderProcessing(t *testing.T) {
 	}
 }
 
+func TestGetStaleProcessingOrders(t *testing.T) {
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	expires := fc.Now().Add(time.Hour)
+	authzID := createFinalizedAuthorization(t, sa, "example.com", expires, "valid")
+
+	newOrder := func() *corepb.Order {
+		orderExpiry := sa.clk.Now().Add(365 * 24 * time.Hour).UnixNano()
+		order, err := sa.NewOrder(context.Background(), &corepb.Order{
+			RegistrationID:   &reg.ID,
+			Expires:          &orderExpiry,
+			Names:            []string{"example.com"},
+			V2Authorizations: []int64{authzID},
+		})
+		test.AssertNotError(t, err, "NewOrder failed")
+		return order
+	}
+
+	// Begin processing three orders an hour apart
+	var orders []*corepb.Order
+	for i := 0; i < 3; i++ {
+		order := newOrder()
+		err = sa.SetOrderProcessing(context.Background(), order)
+		test.AssertNotError(t, err, "SetOrderProcessing failed")
+		orders = append(orders, order)
+		fc.Add(time.Hour)
+	}
+
+	limit := int64(10)
+	getStale := func(beganBefore time.Time) []int64 {
+		beganBeforeNano := beganBefore.UnixNano()
+		resp, err := sa.GetStaleProcessingOrders(context.Background(), &sapb.GetStaleProcessingOrdersRequest{
+			BeganBefore: &beganBeforeNano,
+			Limit:       &limit,
+		})
+		test.AssertNotError(t, err, "GetStaleProcessingOrders failed")
+		return resp.Ids
+	}
+
+	// Only the two orders that began processing more than 90 minutes ago are
+	// stale, oldest first
+	test.AssertDeepEquals(t, getStale(fc.Now().Add(-90*time.Minute)), []int64{*orders[0].Id, *orders[1].Id})
+
+	// Finalizing or failing an order means it is no longer processing
+	serial := "eat.serial.for.breakfast"
+	orders[0].CertificateSerial = &serial
+	err = sa.FinalizeOrder(context.Background(), orders[0])
+	test.AssertNotError(t, err, "FinalizeOrder failed")
+	problemType, detail := "serverInternal", "oops"
+	orders[1].Error = &corepb.ProblemDetails{ProblemType: &problemType, Detail: &detail}
+	err = sa.SetOrderError(context.Background(), orders[1])
+	test.AssertNotError(t, err, "SetOrderError failed")
+	test.AssertDeepEquals(t, getStale(fc.Now()), []int64{*orders[2].Id})
+
+	// So does deleting its processing row
+	_, err = sa.DeleteProcessingOrder(context.Background(), &sapb.OrderRequest{Id: orders[2].Id})
+	test.AssertNotError(t, err, "DeleteProcessingOrder failed")
+	test.AssertEquals(t, len(getStale(fc.Now())), 0)
+
+	// Incomplete requests are rejected
+	_, err = sa.GetStaleProcessingOrders(context.Background(), &sapb.GetStaleProcessingOrdersRequest{})
+	test.AssertError(t, err, "GetStaleProcessingOrders accepted an incomplete request")
+	_, err = sa.DeleteProcessingOrder(context.Background(), &sapb.OrderRequest{})
+	test.AssertError(t, err, "DeleteProcessingOrder accepted an incomplete request")
+}
+
+func TestHeldOrders(t *testing.T) {
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	expires := fc.Now().Add(time.Hour)
+	authzID := createFinalizedAuthorization(t, sa, "bigbank.com", expires, "valid")
+	orderExpiry := fc.Now().Add(365 * 24 * time.Hour).UnixNano()
+	order, err := sa.NewOrder(context.Background(), &corepb.Order{
+		RegistrationID:   &reg.ID,
+		Expires:          &orderExpiry,
+		Names:            []string{"bigbank.com"},
+		V2Authorizations: []int64{authzID},
+	})
+	test.AssertNotError(t, err, "NewOrder failed")
+	err = sa.SetOrderProcessing(context.Background(), order)
+	test.AssertNotError(t, err, "SetOrderProcessing failed")
+
+	limit := int64(10)
+	getStale := func() []int64 {
+		beganBefore := fc.Now().Add(time.Hour).UnixNano()
+		resp, err := sa.GetStaleProcessingOrders(context.Background(), &sapb.GetStaleProcessingOrdersRequest{
+			BeganBefore: &beganBefore,
+			Limit:       &limit,
+		})
+		test.AssertNotError(t, err, "GetStaleProcessingOrders failed")
+		return resp.Ids
+	}
+	test.AssertDeepEquals(t, getStale(), []int64{*order.Id})
+
+	// Holding the order means it is no longer considered to be processing
+	csr := []byte("not really a CSR")
+	held := &sapb.HeldOrder{
+		OrderID:        order.Id,
+		RegistrationID: &reg.ID,
+		ReviewNames:    []string{"bigbank.com"},
+		Csr:            csr,
+	}
+	_, err = sa.HoldOrder(context.Background(), held)
+	test.AssertNotError(t, err, "HoldOrder failed")
+	test.AssertEquals(t, len(getStale()), 0)
+	_, err = sa.HoldOrder(context.Background(), held)
+	test.AssertError(t, err, "HoldOrder of an order that is already held didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.Duplicate), "Expected a Duplicate error")
+
+	// The order stays in processing status while it's held
+	useV2Authzs := true
+	stored, err := sa.GetOrder(context.Background(), &sapb.OrderRequest{Id: order.Id, UseV2Authorizations: &useV2Authzs})
+	test.AssertNotError(t, err, "GetOrder failed")
+	test.AssertEquals(t, *stored.Status, string(core.StatusProcessing))
+
+	resp, err := sa.GetHeldOrders(context.Background(), &sapb.GetHeldOrdersRequest{Limit: &limit})
+	test.AssertNotError(t, err, "GetHeldOrders failed")
+	test.AssertEquals(t, len(resp.Orders), 1)
+	test.AssertEquals(t, *resp.Orders[0].OrderID, *order.Id)
+	test.AssertEquals(t, *resp.Orders[0].RegistrationID, reg.ID)
+	test.AssertDeepEquals(t, resp.Orders[0].ReviewNames, []string{"bigbank.com"})
+	test.AssertEquals(t, *resp.Orders[0].HeldAt, fc.Now().UnixNano())
+	test.AssertEquals(t, len(resp.Orders[0].Csr), 0)
+
+	// Releasing the order returns its CSR and makes it processing again, and
+	// it can only be released once
+	released, err := sa.ReleaseHeldOrder(context.Background(), &sapb.ReleaseHeldOrderRequest{OrderID: order.Id})
+	test.AssertNotError(t, err, "ReleaseHeldOrder failed")
+	test.AssertByteEquals(t, released.Csr, csr)
+	test.AssertDeepEquals(t, released.ReviewNames, []string{"bigbank.com"})
+	test.AssertDeepEquals(t, getStale(), []int64{*order.Id})
+	_, err = sa.ReleaseHeldOrder(context.Background(), &sapb.ReleaseHeldOrderRequest{OrderID: order.Id})
+	test.AssertError(t, err, "ReleaseHeldOrder of an order that isn't held didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	resp, err = sa.GetHeldOrders(context.Background(), &sapb.GetHeldOrdersRequest{Limit: &limit})
+	test.AssertNotError(t, err, "GetHeldOrders failed")
+	test.AssertEquals(t, len(resp.Orders), 0)
+
+	// An order that expires while it's held is removed from review when it's
+	// released, without being made processing again
+	shortExpiry := fc.Now().Add(time.Hour).UnixNano()
+	expiring, err := sa.NewOrder(context.Background(), &corepb.Order{
+		RegistrationID:   &reg.ID,
+		Expires:          &shortExpiry,
+		Names:            []string{"bigbank.com"},
+		V2Authorizations: []int64{authzID},
+	})
+	test.AssertNotError(t, err, "NewOrder failed")
+	err = sa.SetOrderProcessing(context.Background(), expiring)
+	test.AssertNotError(t, err, "SetOrderProcessing failed")
+	_, err = sa.HoldOrder(context.Background(), &sapb.HeldOrder{
+		OrderID:        expiring.Id,
+		RegistrationID: &reg.ID,
+		ReviewNames:    []string{"bigbank.com"},
+		Csr:            csr,
+	})
+	test.AssertNotError(t, err, "HoldOrder failed")
+	fc.Add(2 * time.Hour)
+	_, err = sa.ReleaseHeldOrder(context.Background(), &sapb.ReleaseHeldOrderRequest{OrderID: expiring.Id})
+	test.AssertError(t, err, "ReleaseHeldOrder of an expired order didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.OrderNotReady), "Expected an OrderNotReady error")
+	resp, err = sa.GetHeldOrders(context.Background(), &sapb.GetHeldOrdersRequest{Limit: &limit})
+	test.AssertNotError(t, err, "GetHeldOrders failed")
+	test.AssertEquals(t, len(resp.Orders), 0)
+	test.AssertEquals(t, len(getStale()), 1)
+
+	// Incomplete requests are rejected
+	_, err = sa.HoldOrder(context.Background(), &sapb.HeldOrder{OrderID: order.Id, RegistrationID: &reg.ID})
+	test.AssertError(t, err, "HoldOrder accepted an incomplete request")
+	_, err = sa.GetHeldOrders(context.Background(), &sapb.GetHeldOrdersRequest{})
+	test.AssertError(t, err, "GetHeldOrders accepted an incomplete request")
+}
+
 func TestFinalizeOrder(t *testing.T) {
 	sa, fc, cleanup := initSA(t)
 	defer cleanup()
@@ -1108,6 +1335,52 @@ func TestOrder(t *testing.T) {
 	test.AssertDeepEquals(t, storedOrder, expectedOrder)
 }
 
+func TestOrderCertProfile(t *testing.T) {
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	authzID := createPendingAuthorization(t, sa, "example.com", fc.Now().Add(time.Hour))
+	expires := fc.Now().Add(2 * time.Hour).UnixNano()
+	profile := "short-lived"
+	req := &corepb.Order{
+		RegistrationID:   &reg.ID,
+		Expires:          &expires,
+		Names:            []string{"example.com"},
+		V2Authorizations: []int64{authzID},
+		CertProfile:      &profile,
+	}
+
+	// Without the StoreCertificateProfileName feature the profile can't be
+	// stored
+	_, err = sa.NewOrder(context.Background(), req)
+	test.AssertError(t, err, "sa.NewOrder with a certificate profile didn't fail")
+
+	sa, fc, cleanup = initSAWithFeatures(t, map[string]bool{"StoreCertificateProfileName": true})
+	defer cleanup()
+	defer features.Reset()
+	reg, err = sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+	authzID = createPendingAuthorization(t, sa, "example.com", fc.Now().Add(time.Hour))
+	req.RegistrationID = &reg.ID
+	req.V2Authorizations = []int64{authzID}
+	order, err := sa.NewOrder(context.Background(), req)
+	test.AssertNotError(t, err, "sa.NewOrder failed")
+
+	// The selected profile should be stored with the order
+	storedOrder, err := sa.GetOrder(context.Background(), &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "sa.GetOrder failed")
+	test.AssertEquals(t, storedOrder.GetCertProfile(), "short-lived")
+}
+
 // TestGetAuthorizationNoRows ensures that the GetAuthorization function returns
 // the correct error when there are no results for the provided ID.
 func TestGetAuthorizationNoRows(t *testing.T) {
@@ -1197,7 +1470,7 @@ func TestCountOrders(t *testing.T) {
 	latest := now.Add(time.Second)
 
 	// Counting new orders for a reg ID that doesn't exist should return 0
-	count, err := sa.CountOrders(ctx, 12345, earliest, latest)
+	count, _, err := sa.CountOrders(ctx, 12345, earliest, latest)
 	test.AssertNotError(t, err, "Couldn't count new orders for fake reg ID")
 	test.AssertEquals(t, count, 0)
 
@@ -1215,7 +1488,7 @@ func TestCountOrders(t *testing.T) {
 	test.AssertNotError(t, err, "Couldn't create new pending order")
 
 	// Counting new orders for the reg ID should now yield 1
-	count, err = sa.CountOrders(ctx, reg.ID, earliest, latest)
+	count, _, err = sa.CountOrders(ctx, reg.ID, earliest, latest)
 	test.AssertNotError(t, err, "Couldn't count new orders for reg ID")
 	test.AssertEquals(t, count, 1)
 
@@ -1223,7 +1496,7 @@ func TestCountOrders(t *testing.T) {
 	// count to 0
 	earliest = time.Unix(0, *order.Created).Add(time.Minute)
 	latest = earliest.Add(time.Hour)
-	count, err = sa.CountOrders(ctx, reg.ID, earliest, latest)
+	count, _, err = sa.CountOrders(ctx, reg.ID, earliest, latest)
 	test.AssertNotError(t, err, "Couldn't count new orders for reg ID")
 	test.AssertEquals(t, count, 0)
 }
@@ -1951,6 +2224,123 @@ func TestFinalizeAuthorization2(t *testing.T) {
 	test.AssertDeepEquals(t, dbVer.Challenges[0].Error, prob)
 }
 
+func TestFinalizeAuthorization2AttemptedAt(t *testing.T) {
+	sa, fc, cleanUp := initSAWithFeatures(t, map[string]bool{"StoreAuthzAttemptedAt": true})
+	defer cleanUp()
+	defer features.Reset()
+
+	reg := satest.CreateWorkingRegistration(t, sa)
+	authzID := createPendingAuthorization(t, sa, "aaa", fc.Now().Add(time.Hour))
+
+	valid := string(core.StatusValid)
+	challType := string(core.ChallengeTypeHTTP01)
+	expires := fc.Now().Add(time.Hour * 2).UTC().UnixNano()
+	attemptedAt := fc.Now().Add(-time.Minute).UTC().UnixNano()
+	err := sa.FinalizeAuthorization2(context.Background(), &sapb.FinalizeAuthorizationRequest{
+		Id:          &authzID,
+		Status:      &valid,
+		Expires:     &expires,
+		Attempted:   &challType,
+		AttemptedAt: &attemptedAt,
+	})
+	test.AssertNotError(t, err, "sa.FinalizeAuthorization2 failed")
+
+	// The time the authz was finalized is the validated time of its valid
+	// challenge
+	dbVer, err := sa.GetAuthorization2(context.Background(), &sapb.AuthorizationID2{Id: &authzID})
+	test.AssertNotError(t, err, "sa.GetAuthorization2 failed")
+	for _, chall := range dbVer.Challenges {
+		if chall.GetType() == challType {
+			test.AssertEquals(t, chall.GetValidated(), attemptedAt)
+		} else {
+			test.AssertEquals(t, chall.Validated, (*int64)(nil))
+		}
+	}
+
+	now := fc.Now().UnixNano()
+	authzs, err := sa.GetValidAuthorizations2(context.Background(), &sapb.GetValidAuthorizationsRequest{
+		RegistrationID: &reg.ID,
+		Domains:        []string{"aaa"},
+		Now:            &now,
+	})
+	test.AssertNotError(t, err, "sa.GetValidAuthorizations2 failed")
+	test.AssertEquals(t, len(authzs.Authz), 1)
+	for _, chall := range authzs.Authz[0].Authz.Challenges {
+		if chall.GetType() == challType {
+			test.AssertEquals(t, chall.GetValidated(), attemptedAt)
+		}
+	}
+}
+
+func TestRecordFailedValidation2(t *testing.T) {
+	sa, fc, cleanUp := initSAWithFeatures(t, map[string]bool{"StoreAuthzRetries": true})
+	defer cleanUp()
+	defer features.Reset()
+
+	authzID := createPendingAuthorization(t, sa, "example.com", fc.Now().Add(time.Hour))
+
+	challType := string(core.ChallengeTypeHTTP01)
+	attemptedAt := fc.Now().UnixNano()
+	retryAfter := fc.Now().Add(time.Minute).UnixNano()
+	for _, detail := range []string{"first", "second"} {
+		prob, _ := bgrpc.ProblemDetailsToPB(probs.ConnectionFailure(detail))
+		err := sa.RecordFailedValidation2(context.Background(), &sapb.RecordFailedValidationRequest{
+			Id:              &authzID,
+			Attempted:       &challType,
+			AttemptedAt:     &attemptedAt,
+			ValidationError: prob,
+			RetryAfter:      &retryAfter,
+		})
+		test.AssertNotError(t, err, "sa.RecordFailedValidation2 failed")
+	}
+
+	dbVer, err := sa.GetAuthorization2(context.Background(), &sapb.AuthorizationID2{Id: &authzID})
+	test.AssertNotError(t, err, "sa.GetAuthorization2 failed")
+	test.AssertEquals(t, *dbVer.Status, string(core.StatusPending))
+	for _, chall := range dbVer.Challenges {
+		test.AssertEquals(t, *chall.Status, string(core.StatusPending))
+		test.AssertEquals(t, *chall.Attempts, int64(2))
+		test.AssertEquals(t, *chall.RetryAfter, retryAfter)
+		if *chall.Type == challType {
+			test.AssertEquals(t, *chall.Error.Detail, "second")
+		}
+	}
+
+	// Once the authz is finalized its failed attempts can't be added to
+	invalid := string(core.StatusInvalid)
+	expires := fc.Now().Add(time.Hour).UnixNano()
+	prob, _ := bgrpc.ProblemDetailsToPB(probs.ConnectionFailure("third"))
+	err = sa.FinalizeAuthorization2(context.Background(), &sapb.FinalizeAuthorizationRequest{
+		Id:              &authzID,
+		ValidationError: prob,
+		Status:          &invalid,
+		Attempted:       &challType,
+		Expires:         &expires,
+	})
+	test.AssertNotError(t, err, "sa.FinalizeAuthorization2 failed")
+	err = sa.RecordFailedValidation2(context.Background(), &sapb.RecordFailedValidationRequest{
+		Id:              &authzID,
+		Attempted:       &challType,
+		AttemptedAt:     &attemptedAt,
+		ValidationError: prob,
+		RetryAfter:      &retryAfter,
+	})
+	test.AssertError(t, err, "sa.RecordFailedValidation2 didn't fail for a finalized authz")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	// Without the StoreAuthzRetries feature failed attempts can't be stored
+	features.Reset()
+	authzID = createPendingAuthorization(t, sa, "example.net", fc.Now().Add(time.Hour))
+	err = sa.RecordFailedValidation2(context.Background(), &sapb.RecordFailedValidationRequest{
+		Id:              &authzID,
+		Attempted:       &challType,
+		AttemptedAt:     &attemptedAt,
+		ValidationError: prob,
+		RetryAfter:      &retryAfter,
+	})
+	test.AssertError(t, err, "sa.RecordFailedValidation2 didn't fail without the StoreAuthzRetries feature")
+}
+
 func TestGetPendingAuthorization2(t *testing.T) {
 	sa, fc, cleanUp := initSA(t)
 	defer cleanUp()
@@ -2319,3 +2709,537 @@ func TestBlockedKeyRevokedBy(t *testing.T) {
 	})
 	test.AssertNotError(t, err, "AddBlockedKey failed")
 }
+
+func TestExternalAccountKeys(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	keyID := "kid-1"
+	_, err := sa.GetExternalAccountKey(context.Background(), &sapb.ExternalAccountKeyID{KeyID: &keyID})
+	test.AssertError(t, err, "GetExternalAccountKey didn't fail for an unknown key")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	created := fc.Now().UnixNano()
+	hmacKey := []byte{1, 2, 3, 4}
+	_, err = sa.AddExternalAccountKey(context.Background(), &sapb.ExternalAccountKey{
+		KeyID:   &keyID,
+		HmacKey: hmacKey,
+		Created: &created,
+	})
+	test.AssertNotError(t, err, "AddExternalAccountKey failed")
+	_, err = sa.AddExternalAccountKey(context.Background(), &sapb.ExternalAccountKey{
+		KeyID:   &keyID,
+		HmacKey: hmacKey,
+		Created: &created,
+	})
+	test.AssertError(t, err, "AddExternalAccountKey didn't fail with duplicate key ID")
+	test.Assert(t, berrors.Is(err, berrors.Duplicate), "Expected a Duplicate error")
+
+	key, err := sa.GetExternalAccountKey(context.Background(), &sapb.ExternalAccountKeyID{KeyID: &keyID})
+	test.AssertNotError(t, err, "GetExternalAccountKey failed")
+	test.AssertEquals(t, key.GetKeyID(), keyID)
+	test.AssertByteEquals(t, key.GetHmacKey(), hmacKey)
+	test.AssertEquals(t, key.GetCreated(), created)
+	test.AssertEquals(t, key.GetRevoked(), int64(0))
+
+	fc.Add(time.Hour)
+	_, err = sa.RevokeExternalAccountKey(context.Background(), &sapb.ExternalAccountKeyID{KeyID: &keyID})
+	test.AssertNotError(t, err, "RevokeExternalAccountKey failed")
+	_, err = sa.RevokeExternalAccountKey(context.Background(), &sapb.ExternalAccountKeyID{KeyID: &keyID})
+	test.AssertError(t, err, "RevokeExternalAccountKey didn't fail for an already revoked key")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	key, err = sa.GetExternalAccountKey(context.Background(), &sapb.ExternalAccountKeyID{KeyID: &keyID})
+	test.AssertNotError(t, err, "GetExternalAccountKey failed")
+	test.AssertEquals(t, key.GetRevoked(), fc.Now().UnixNano())
+}
+
+func TestClaimAutoRenewal(t *testing.T) {
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	authzID := createFinalizedAuthorization(t, sa, "example.com", fc.Now().Add(48*time.Hour), "valid")
+	orderExpiry := fc.Now().Add(time.Hour).UnixNano()
+	start := fc.Now().UnixNano()
+	end := fc.Now().Add(24 * time.Hour).UnixNano()
+	lifetime := int64(6 * time.Hour)
+	allowGet := false
+	order, err := sa.NewOrder(ctx, &corepb.Order{
+		RegistrationID:   &reg.ID,
+		Expires:          &orderExpiry,
+		Names:            []string{"example.com"},
+		V2Authorizations: []int64{authzID},
+		AutoRenewal: &corepb.AutoRenewal{
+			StartDate:           &start,
+			EndDate:             &end,
+			Lifetime:            &lifetime,
+			AllowCertificateGet: &allowGet,
+		},
+	})
+	test.AssertNotError(t, err, "NewOrder failed")
+
+	serial := "0000000000000000000000000000000000aa"
+	nextIssuance := fc.Now().Add(3 * time.Hour).UnixNano()
+	_, err = sa.SetAutoRenewalCertificate(ctx, &sapb.SetAutoRenewalCertificateRequest{
+		OrderID:      order.Id,
+		Serial:       &serial,
+		Csr:          []byte{1, 2, 3},
+		NextIssuance: &nextIssuance,
+	})
+	test.AssertNotError(t, err, "SetAutoRenewalCertificate failed")
+
+	fc.Add(3 * time.Hour)
+	now := fc.Now().UnixNano()
+	limit := int64(10)
+	due, err := sa.GetDueAutoRenewals(ctx, &sapb.GetDueAutoRenewalsRequest{Now: &now, Limit: &limit})
+	test.AssertNotError(t, err, "GetDueAutoRenewals failed")
+	test.AssertEquals(t, len(due.Renewals), 1)
+	test.AssertEquals(t, due.Renewals[0].GetNextIssuance(), nextIssuance)
+
+	// Only the first of two RAs that found the order due may claim it
+	claimUntil := fc.Now().Add(10 * time.Minute).UnixNano()
+	claimReq := &sapb.ClaimAutoRenewalRequest{
+		OrderID:      order.Id,
+		NextIssuance: due.Renewals[0].NextIssuance,
+		ClaimUntil:   &claimUntil,
+	}
+	claimed, err := sa.ClaimAutoRenewal(ctx, claimReq)
+	test.AssertNotError(t, err, "ClaimAutoRenewal failed")
+	test.Assert(t, claimed.GetExists(), "Expected the order to be claimed")
+	claimed, err = sa.ClaimAutoRenewal(ctx, claimReq)
+	test.AssertNotError(t, err, "ClaimAutoRenewal failed")
+	test.Assert(t, !claimed.GetExists(), "Expected the order to already be claimed")
+
+	// A claimed order isn't due until its claim expires
+	due, err = sa.GetDueAutoRenewals(ctx, &sapb.GetDueAutoRenewalsRequest{Now: &now, Limit: &limit})
+	test.AssertNotError(t, err, "GetDueAutoRenewals failed")
+	test.AssertEquals(t, len(due.Renewals), 0)
+	fc.Add(10 * time.Minute)
+	now = fc.Now().UnixNano()
+	due, err = sa.GetDueAutoRenewals(ctx, &sapb.GetDueAutoRenewalsRequest{Now: &now, Limit: &limit})
+	test.AssertNotError(t, err, "GetDueAutoRenewals failed")
+	test.AssertEquals(t, len(due.Renewals), 1)
+}
+
+func TestReplacementOrder(t *testing.T) {
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	replaces := "0000000000000000000000000000000000ee"
+	exists, err := sa.ReplacementOrderExists(ctx, &sapb.Serial{Serial: &replaces})
+	test.AssertNotError(t, err, "ReplacementOrderExists failed")
+	test.Assert(t, !exists.GetExists(), "Expected no replacement order to exist")
+
+	authzID := createFinalizedAuthorization(t, sa, "example.com", fc.Now().Add(24*time.Hour), "valid")
+	newReplacementOrder := func(expires time.Time) (*corepb.Order, error) {
+		orderExpiry := expires.UnixNano()
+		return sa.NewOrder(ctx, &corepb.Order{
+			RegistrationID:   &reg.ID,
+			Expires:          &orderExpiry,
+			Names:            []string{"example.com"},
+			V2Authorizations: []int64{authzID},
+			Replaces:         &replaces,
+		})
+	}
+
+	// An order that expires without being finalized releases its claim on the
+	// certificate once it has expired
+	_, err = newReplacementOrder(fc.Now().Add(time.Hour))
+	test.AssertNotError(t, err, "NewOrder failed")
+	_, err = newReplacementOrder(fc.Now().Add(time.Hour))
+	test.AssertError(t, err, "NewOrder didn't fail for a claimed certificate")
+	test.Assert(t, berrors.Is(err, berrors.Duplicate), "Expected a Duplicate error")
+	fc.Add(2 * time.Hour)
+	exists, err = sa.ReplacementOrderExists(ctx, &sapb.Serial{Serial: &replaces})
+	test.AssertNotError(t, err, "ReplacementOrderExists failed")
+	test.Assert(t, !exists.GetExists(), "Expected no replacement order to exist after expiry")
+
+	// So does an order that fails
+	failed, err := newReplacementOrder(fc.Now().Add(time.Hour))
+	test.AssertNotError(t, err, "NewOrder failed")
+	problemType, detail := "serverInternal", "oops"
+	failed.Error = &corepb.ProblemDetails{ProblemType: &problemType, Detail: &detail}
+	err = sa.SetOrderError(ctx, failed)
+	test.AssertNotError(t, err, "SetOrderError failed")
+
+	order, err := newReplacementOrder(fc.Now().Add(365 * 24 * time.Hour))
+	test.AssertNotError(t, err, "NewOrder failed")
+
+	storedOrder, err := sa.GetOrder(ctx, &sapb.OrderRequest{Id: order.Id})
+	test.AssertNotError(t, err, "GetOrder failed")
+	test.AssertEquals(t, storedOrder.GetReplaces(), replaces)
+
+	// A pending replacement order holds the claim on the certificate
+	exists, err = sa.ReplacementOrderExists(ctx, &sapb.Serial{Serial: &replaces})
+	test.AssertNotError(t, err, "ReplacementOrderExists failed")
+	test.Assert(t, exists.GetExists(), "Expected a pending replacement order to exist")
+
+	err = sa.SetOrderProcessing(ctx, storedOrder)
+	test.AssertNotError(t, err, "SetOrderProcessing failed")
+	serial := "0000000000000000000000000000000000ff"
+	storedOrder.CertificateSerial = &serial
+	err = sa.FinalizeOrder(ctx, storedOrder)
+	test.AssertNotError(t, err, "FinalizeOrder failed")
+
+	exists, err = sa.ReplacementOrderExists(ctx, &sapb.Serial{Serial: &replaces})
+	test.AssertNotError(t, err, "ReplacementOrderExists failed")
+	test.Assert(t, exists.GetExists(), "Expected a finalized replacement order to exist")
+}
+
+func TestRenewalInfoOverride(t *testing.T) {
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	serial := "0000000000000000000000000000000000ee"
+	_, err := sa.GetRenewalInfoOverride(ctx, &sapb.Serial{Serial: &serial})
+	test.AssertError(t, err, "GetRenewalInfoOverride didn't fail for an unknown serial")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	start := fc.Now().UnixNano()
+	end := fc.Now().Add(time.Hour).UnixNano()
+	_, err = sa.AddRenewalInfoOverride(ctx, &sapb.RenewalInfoOverride{
+		Serial:      &serial,
+		WindowStart: &end,
+		WindowEnd:   &start,
+	})
+	test.AssertError(t, err, "AddRenewalInfoOverride didn't fail for an inverted window")
+	test.Assert(t, berrors.Is(err, berrors.Malformed), "Expected a Malformed error")
+
+	_, err = sa.AddRenewalInfoOverride(ctx, &sapb.RenewalInfoOverride{
+		Serial:      &serial,
+		WindowStart: &start,
+		WindowEnd:   &end,
+	})
+	test.AssertNotError(t, err, "AddRenewalInfoOverride failed")
+	override, err := sa.GetRenewalInfoOverride(ctx, &sapb.Serial{Serial: &serial})
+	test.AssertNotError(t, err, "GetRenewalInfoOverride failed")
+	test.AssertEquals(t, override.GetWindowStart(), start)
+	test.AssertEquals(t, override.GetWindowEnd(), end)
+
+	// Adding a second override for the same serial replaces the first
+	later := fc.Now().Add(2 * time.Hour).UnixNano()
+	_, err = sa.AddRenewalInfoOverride(ctx, &sapb.RenewalInfoOverride{
+		Serial:      &serial,
+		WindowStart: &end,
+		WindowEnd:   &later,
+	})
+	test.AssertNotError(t, err, "AddRenewalInfoOverride failed to replace existing override")
+	override, err = sa.GetRenewalInfoOverride(ctx, &sapb.Serial{Serial: &serial})
+	test.AssertNotError(t, err, "GetRenewalInfoOverride failed")
+	test.AssertEquals(t, override.GetWindowStart(), end)
+	test.AssertEquals(t, override.GetWindowEnd(), later)
+}
+
+func TestGetOrdersForAccount(t *testing.T) {
+	sa, fc, cleanup := initSA(t)
+	defer cleanup()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	authzID := createPendingAuthorization(t, sa, "example.com", fc.Now().Add(48*time.Hour))
+	newOrder := func(expires time.Time) int64 {
+		orderExpiry := expires.UnixNano()
+		order, err := sa.NewOrder(ctx, &corepb.Order{
+			RegistrationID:   &reg.ID,
+			Expires:          &orderExpiry,
+			Names:            []string{"example.com"},
+			V2Authorizations: []int64{authzID},
+		})
+		test.AssertNotError(t, err, "NewOrder failed")
+		return order.GetId()
+	}
+	first := newOrder(fc.Now().Add(time.Hour))
+	second := newOrder(fc.Now().Add(24 * time.Hour))
+	third := newOrder(fc.Now().Add(24 * time.Hour))
+
+	now := fc.Now().UnixNano()
+	limit := int64(2)
+	ids, err := sa.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID: &reg.ID,
+		Now:    &now,
+		Limit:  &limit,
+	})
+	test.AssertNotError(t, err, "GetOrdersForAccount failed")
+	test.AssertDeepEquals(t, ids.Ids, []int64{first, second})
+
+	// The next page should start after the last order of the previous one
+	ids, err = sa.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID:  &reg.ID,
+		Now:     &now,
+		AfterID: &second,
+		Limit:   &limit,
+	})
+	test.AssertNotError(t, err, "GetOrdersForAccount failed")
+	test.AssertDeepEquals(t, ids.Ids, []int64{third})
+
+	// Expired orders should not be returned
+	now = fc.Now().Add(2 * time.Hour).UnixNano()
+	ids, err = sa.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID: &reg.ID,
+		Now:    &now,
+		Limit:  &limit,
+	})
+	test.AssertNotError(t, err, "GetOrdersForAccount failed")
+	test.AssertDeepEquals(t, ids.Ids, []int64{second, third})
+
+	// Another account should have no orders
+	otherAcct := reg.ID + 1
+	ids, err = sa.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID: &otherAcct,
+		Now:    &now,
+		Limit:  &limit,
+	})
+	test.AssertNotError(t, err, "GetOrdersForAccount failed")
+	test.AssertEquals(t, len(ids.Ids), 0)
+}
+
+func TestRateLimitOverrides(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+	ctx := context.Background()
+
+	newOverride := func(key string, regID, threshold int64, owner string, expires time.Time) *corepb.RateLimitOverride {
+		limit := "certificatesPerName"
+		justification := "Big hosting provider"
+		expiresNS := expires.UnixNano()
+		return &corepb.RateLimitOverride{
+			Limit:          &limit,
+			Key:            &key,
+			RegistrationID: &regID,
+			Threshold:      &threshold,
+			Owner:          &owner,
+			Justification:  &justification,
+			Expires:        &expiresNS,
+		}
+	}
+	includeExpired := true
+	actor := "carol"
+	justification := "Done"
+
+	overrides, err := sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 0)
+
+	// An override must be for exactly one of a key and a registration
+	_, err = sa.AddRateLimitOverride(ctx, newOverride("example.com", 1, 500, "alice", fc.Now().Add(time.Hour)))
+	test.AssertError(t, err, "AddRateLimitOverride with a key and a registration ID didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.Malformed), "Expected a Malformed error")
+
+	byKey, err := sa.AddRateLimitOverride(ctx, newOverride("example.com", 0, 500, "alice", fc.Now().Add(time.Hour)))
+	test.AssertNotError(t, err, "AddRateLimitOverride failed")
+	test.Assert(t, byKey.GetId() != 0, "AddRateLimitOverride didn't return an ID")
+	test.AssertEquals(t, byKey.GetCreated(), fc.Now().UnixNano())
+	byReg, err := sa.AddRateLimitOverride(ctx, newOverride("", 101, 1000, "alice", fc.Now().Add(2*time.Hour)))
+	test.AssertNotError(t, err, "AddRateLimitOverride failed")
+
+	overrides, err = sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 2)
+	test.AssertEquals(t, overrides.Overrides[0].GetId(), byKey.GetId())
+	test.AssertEquals(t, overrides.Overrides[0].GetKey(), "example.com")
+	test.AssertEquals(t, overrides.Overrides[0].GetThreshold(), int64(500))
+	test.AssertEquals(t, overrides.Overrides[0].GetOwner(), "alice")
+	test.AssertEquals(t, overrides.Overrides[1].GetRegistrationID(), int64(101))
+
+	// Adding an override for the same key expires the previous one
+	fc.Add(time.Minute)
+	replacement, err := sa.AddRateLimitOverride(ctx, newOverride("example.com", 0, 750, "bob", fc.Now().Add(time.Hour)))
+	test.AssertNotError(t, err, "AddRateLimitOverride failed")
+	overrides, err = sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 2)
+	test.AssertEquals(t, overrides.Overrides[0].GetId(), byReg.GetId())
+	test.AssertEquals(t, overrides.Overrides[1].GetId(), replacement.GetId())
+
+	_, err = sa.ExpireRateLimitOverride(ctx, &sapb.ExpireRateLimitOverrideRequest{Id: byKey.Id, Actor: &actor, Justification: &justification})
+	test.AssertError(t, err, "ExpireRateLimitOverride of an expired override didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+	_, err = sa.ExpireRateLimitOverride(ctx, &sapb.ExpireRateLimitOverrideRequest{Id: byReg.Id, Actor: &actor, Justification: &justification})
+	test.AssertNotError(t, err, "ExpireRateLimitOverride failed")
+
+	overrides, err = sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 1)
+	test.AssertEquals(t, overrides.Overrides[0].GetId(), replacement.GetId())
+	overrides, err = sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{IncludeExpired: &includeExpired})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 3)
+	test.AssertEquals(t, overrides.Overrides[1].GetExpires(), fc.Now().UnixNano())
+
+	// Overrides that reach their expiry are no longer returned
+	fc.Add(time.Hour)
+	overrides, err = sa.GetRateLimitOverrides(ctx, &sapb.GetRateLimitOverridesRequest{})
+	test.AssertNotError(t, err, "GetRateLimitOverrides failed")
+	test.AssertEquals(t, len(overrides.Overrides), 0)
+
+	// Every change is recorded in the history
+	var history []rateLimitOverrideHistoryModel
+	_, err = sa.dbMap.Select(&history, "SELECT * FROM rateLimitOverrideHistory ORDER BY id")
+	test.AssertNotError(t, err, "selecting override history")
+	test.AssertEquals(t, len(history), 5)
+	test.AssertEquals(t, history[2].Action, overrideActionExpire)
+	test.AssertEquals(t, history[2].OverrideID, byKey.GetId())
+	test.AssertEquals(t, history[2].Actor, "bob")
+	test.AssertEquals(t, history[3].Action, overrideActionAdd)
+	test.AssertEquals(t, history[3].OverrideID, replacement.GetId())
+	test.AssertEquals(t, history[4].Action, overrideActionExpire)
+	test.AssertEquals(t, history[4].Actor, "carol")
+	test.AssertEquals(t, history[4].Justification, "Done")
+}
+
+func TestValidationAttempts(t *testing.T) {
+	sa, fc, cleanUp := initSA(t)
+	defer cleanUp()
+
+	authzID, regID := int64(7), int64(1)
+	ident, challType := "example.com", string(core.ChallengeTypeDNS01)
+	remote := "va-remote-a"
+	prob, _ := bgrpc.ProblemDetailsToPB(probs.DNS("SERVFAIL"))
+	add := func(status string, attemptedAt time.Time, validationError *corepb.ProblemDetails) {
+		t.Helper()
+		attemptedAtNS := attemptedAt.UnixNano()
+		_, err := sa.AddValidationAttempt(context.Background(), &corepb.ValidationAttempt{
+			AuthzID:         &authzID,
+			RegistrationID:  &regID,
+			Identifier:      &ident,
+			ChallengeType:   &challType,
+			AttemptedAt:     &attemptedAtNS,
+			Status:          &status,
+			ValidationError: validationError,
+			RemoteResults:   []*corepb.RemoteValidationResult{{VaHostname: &remote}},
+			CaaRecords:      []string{`example.com.	3600	IN	CAA	0 issue "letsencrypt.org"`},
+		})
+		test.AssertNotError(t, err, "sa.AddValidationAttempt failed")
+	}
+	add(string(core.StatusValid), fc.Now().Add(time.Minute), nil)
+	add(string(core.StatusInvalid), fc.Now(), prob)
+
+	resp, err := sa.GetValidationAttempts(context.Background(), &sapb.GetValidationAttemptsRequest{AuthzID: &authzID})
+	test.AssertNotError(t, err, "sa.GetValidationAttempts failed")
+	test.AssertEquals(t, len(resp.Attempts), 2)
+	// Attempts are returned oldest first
+	test.AssertEquals(t, resp.Attempts[0].GetStatus(), string(core.StatusInvalid))
+	test.AssertDeepEquals(t, resp.Attempts[0].ValidationError, prob)
+	test.AssertEquals(t, resp.Attempts[1].GetStatus(), string(core.StatusValid))
+	test.AssertEquals(t, resp.Attempts[1].RemoteResults[0].GetVaHostname(), remote)
+	test.AssertEquals(t, len(resp.Attempts[1].CaaRecords), 1)
+
+	otherID := int64(8)
+	resp, err = sa.GetValidationAttempts(context.Background(), &sapb.GetValidationAttemptsRequest{AuthzID: &otherID})
+	test.AssertNotError(t, err, "sa.GetValidationAttempts failed")
+	test.AssertEquals(t, len(resp.Attempts), 0)
+
+	_, err = sa.AddValidationAttempt(context.Background(), &corepb.ValidationAttempt{AuthzID: &authzID})
+	test.AssertError(t, err, "sa.AddValidationAttempt didn't fail for an incomplete attempt")
+}
+
+func TestAddRemoveAccountScope(t *testing.T) {
+	sa, _, cleanup := initSA(t)
+	defer cleanup()
+
+	reg, err := sa.NewRegistration(ctx, core.Registration{
+		Key:       &jose.JSONWebKey{Key: &rsa.PublicKey{N: big.NewInt(1), E: 1}},
+		InitialIP: net.ParseIP("42.42.42.42"),
+	})
+	test.AssertNotError(t, err, "Couldn't create test registration")
+
+	getScope := func() []string {
+		scope, err := sa.GetAccountScope(ctx, &sapb.RegistrationID{Id: &reg.ID})
+		test.AssertNotError(t, err, "GetAccountScope failed")
+		test.AssertEquals(t, *scope.RegistrationID, reg.ID)
+		return scope.Suffixes
+	}
+	change := func(actor string, suffixes ...string) *sapb.AccountScopeChange {
+		justification := "testing"
+		return &sapb.AccountScopeChange{
+			RegistrationID: &reg.ID,
+			Suffixes:       suffixes,
+			Actor:          &actor,
+			Justification:  &justification,
+		}
+	}
+	test.AssertEquals(t, len(getScope()), 0)
+
+	// Adding a domain the account is already restricted to isn't an error
+	_, err = sa.AddAccountScope(ctx, change("alice", "example.org", "example.com"))
+	test.AssertNotError(t, err, "AddAccountScope failed")
+	_, err = sa.AddAccountScope(ctx, change("alice", "example.com", "example.net"))
+	test.AssertNotError(t, err, "AddAccountScope failed")
+	test.AssertDeepEquals(t, getScope(), []string{"example.com", "example.net", "example.org"})
+
+	// Other accounts aren't restricted
+	otherID := reg.ID + 1
+	scope, err := sa.GetAccountScope(ctx, &sapb.RegistrationID{Id: &otherID})
+	test.AssertNotError(t, err, "GetAccountScope failed")
+	test.AssertEquals(t, len(scope.Suffixes), 0)
+
+	_, err = sa.RemoveAccountScope(ctx, change("bob", "example.com", "example.edu"))
+	test.AssertNotError(t, err, "RemoveAccountScope failed")
+	test.AssertDeepEquals(t, getScope(), []string{"example.net", "example.org"})
+	_, err = sa.RemoveAccountScope(ctx, change("bob", "example.com"))
+	test.AssertError(t, err, "RemoveAccountScope of a domain the account isn't restricted to didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	// Removing every remaining domain would lift the restriction, so it's
+	// rejected and nothing is removed
+	_, err = sa.RemoveAccountScope(ctx, change("bob", "example.net", "example.org"))
+	test.AssertError(t, err, "RemoveAccountScope of every domain didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.Malformed), "Expected a Malformed error")
+	test.AssertDeepEquals(t, getScope(), []string{"example.net", "example.org"})
+
+	// Clearing the scope lifts the restriction
+	actor := "carol"
+	justification := "no longer needed"
+	_, err = sa.ClearAccountScope(ctx, &sapb.ClearAccountScopeRequest{RegistrationID: &reg.ID, Actor: &actor, Justification: &justification})
+	test.AssertNotError(t, err, "ClearAccountScope failed")
+	test.AssertEquals(t, len(getScope()), 0)
+	_, err = sa.ClearAccountScope(ctx, &sapb.ClearAccountScopeRequest{RegistrationID: &reg.ID, Actor: &actor, Justification: &justification})
+	test.AssertError(t, err, "ClearAccountScope of an unrestricted account didn't fail")
+	test.Assert(t, berrors.Is(err, berrors.NotFound), "Expected a NotFound error")
+
+	// Every change is recorded in the history
+	var history []accountScopeHistoryModel
+	_, err = sa.dbMap.Select(&history, "SELECT * FROM accountScopeHistory ORDER BY id")
+	test.AssertNotError(t, err, "selecting account scope history")
+	test.AssertEquals(t, len(history), 6)
+	for i, suffix := range []string{"example.org", "example.com", "example.net"} {
+		test.AssertEquals(t, history[i].Suffix, suffix)
+		test.AssertEquals(t, history[i].Action, scopeActionAdd)
+		test.AssertEquals(t, history[i].Actor, "alice")
+	}
+	test.AssertEquals(t, history[3].Suffix, "example.com")
+	test.AssertEquals(t, history[3].Action, scopeActionRemove)
+	test.AssertEquals(t, history[3].Actor, "bob")
+	test.AssertEquals(t, history[4].Action, scopeActionClear)
+	test.AssertEquals(t, history[4].Actor, "carol")
+	test.AssertEquals(t, history[4].Justification, "no longer needed")
+	test.AssertEquals(t, history[5].Action, scopeActionClear)
+
+	// Incomplete requests are rejected
+	_, err = sa.AddAccountScope(ctx, change("alice"))
+	test.AssertError(t, err, "AddAccountScope accepted an incomplete request")
+	_, err = sa.AddAccountScope(ctx, change("alice", ""))
+	test.AssertError(t, err, "AddAccountScope accepted an empty domain")
+	_, err = sa.AddAccountScope(ctx, change("", "example.com"))
+	test.AssertError(t, err, "AddAccountScope accepted a change without an actor")
+	_, err = sa.ClearAccountScope(ctx, &sapb.ClearAccountScopeRequest{RegistrationID: &reg.ID})
+	test.AssertError(t, err, "ClearAccountScope accepted an incomplete request")
+	_, err = sa.GetAccountScope(ctx, &sapb.RegistrationID{})
+	test.AssertError(t, err, "GetAccountScope accepted an incomplete request")
+}
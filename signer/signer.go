@@ -14,6 +14,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -38,12 +39,17 @@ type IssuanceRequest struct {
 	NotBefore time.Time
 	NotAfter  time.Time
 
-	CommonName string
-	DNSNames   []string
+	CommonName  string
+	DNSNames    []string
+	IPAddresses []net.IP
 
 	IncludeMustStaple bool
 	IncludeCTPoison   bool
 	SCTList           []ct.SignedCertificateTimestamp
+
+	// Profile is the name of the issuance profile to use. If empty the
+	// signer's default profile is used.
+	Profile string
 }
 
 type signingProfile struct {
@@ -55,6 +61,9 @@ type signingProfile struct {
 	allowSCTList    bool
 	allowCommonName bool
 
+	omitCommonName bool
+	omitClientAuth bool
+
 	sigAlg    x509.SignatureAlgorithm
 	ocspURL   string
 	crlURL    string
@@ -63,6 +72,7 @@ type signingProfile struct {
 
 	maxBackdate time.Duration
 	maxValidity time.Duration
+	validity    time.Duration
 }
 
 // PolicyQualifier describes a policy qualifier
@@ -86,12 +96,22 @@ type ProfileConfig struct {
 	AllowSCTList    bool
 	AllowCommonName bool
 
+	// OmitCommonName causes the subject common name to be left out of issued
+	// certificates, even if one is requested.
+	OmitCommonName bool
+	// OmitClientAuth causes the TLS client authentication extended key usage
+	// to be left out of issued certificates, leaving only server authentication.
+	OmitClientAuth bool
+
 	IssuerURL           string
 	OCSPURL             string
 	CRLURL              string
 	Policies            []PolicyInformation
 	MaxValidityPeriod   cmd.ConfigDuration
 	MaxValidityBackdate cmd.ConfigDuration
+	// ValidityPeriod is how long certificates issued with this profile are
+	// valid for. If zero the validity period is left up to the caller.
+	ValidityPeriod cmd.ConfigDuration
 }
 
 func parseOID(oidStr string) (asn1.ObjectIdentifier, error) {
@@ -121,11 +141,14 @@ func newProfile(config ProfileConfig) (*signingProfile, error) {
 		allowCTPoison:   config.AllowCTPoison,
 		allowSCTList:    config.AllowSCTList,
 		allowCommonName: config.AllowCommonName,
+		omitCommonName:  config.OmitCommonName,
+		omitClientAuth:  config.OmitClientAuth,
 		issuerURL:       config.IssuerURL,
 		crlURL:          config.CRLURL,
 		ocspURL:         config.OCSPURL,
 		maxBackdate:     config.MaxValidityBackdate.Duration,
 		maxValidity:     config.MaxValidityPeriod.Duration,
+		validity:        config.ValidityPeriod.Duration,
 	}
 	if config.IssuerURL == "" {
 		return nil, errors.New("Issuer URL is required")
@@ -133,6 +156,9 @@ func newProfile(config ProfileConfig) (*signingProfile, error) {
 	if config.OCSPURL == "" {
 		return nil, errors.New("OCSP URL is required")
 	}
+	if sp.validity > sp.maxValidity {
+		return nil, errors.New("validity period is more than the maximum validity period")
+	}
 	if len(config.Policies) > 0 {
 		var policies []policyasn1.PolicyInformation
 		for _, policyConfig := range config.Policies {
@@ -198,7 +224,7 @@ func (p *signingProfile) requestValid(clk clock.Clock, req *IssuanceRequest) err
 		return errors.New("cannot include both ct poison and sct list extensions")
 	}
 
-	if !p.allowCommonName && req.CommonName != "" {
+	if !p.allowCommonName && !p.omitCommonName && req.CommonName != "" {
 		return errors.New("common name cannot be included")
 	}
 
@@ -229,6 +255,10 @@ var defaultEKU = []x509.ExtKeyUsage{
 	x509.ExtKeyUsageClientAuth,
 }
 
+var serverAuthEKU = []x509.ExtKeyUsage{
+	x509.ExtKeyUsageServerAuth,
+}
+
 func (p *signingProfile) generateTemplate(clk clock.Clock) *x509.Certificate {
 	template := &x509.Certificate{
 		SignatureAlgorithm:    p.sigAlg,
@@ -238,6 +268,10 @@ func (p *signingProfile) generateTemplate(clk clock.Clock) *x509.Certificate {
 		BasicConstraintsValid: true,
 	}
 
+	if p.omitClientAuth {
+		template.ExtKeyUsage = serverAuthEKU
+	}
+
 	if p.crlURL != "" {
 		template.CRLDistributionPoints = []string{p.crlURL}
 	}
@@ -251,12 +285,14 @@ func (p *signingProfile) generateTemplate(clk clock.Clock) *x509.Certificate {
 
 // Signer is a certificate signer
 type Signer struct {
-	issuer  *x509.Certificate
-	signer  crypto.Signer
-	profile *signingProfile
-	clk     clock.Clock
-	lintKey crypto.Signer
-	lints   lint.Registry
+	issuer *x509.Certificate
+	signer crypto.Signer
+	// profiles maps profile names to issuance profiles. The default profile
+	// has the empty name.
+	profiles map[string]*signingProfile
+	clk      clock.Clock
+	lintKey  crypto.Signer
+	lints    lint.Registry
 }
 
 // Config contains the information necessary to construct a Signer
@@ -265,15 +301,30 @@ type Config struct {
 	Signer       crypto.Signer
 	IgnoredLints []string
 	Clk          clock.Clock
-	Profile      ProfileConfig
+	// Profile is the default issuance profile, used by requests that don't
+	// name a profile.
+	Profile ProfileConfig
+	// Profiles contains additional issuance profiles, keyed by name.
+	Profiles map[string]ProfileConfig
 }
 
 // NewSigner constructs a Signer from the provided Config
 func NewSigner(config Config) (*Signer, error) {
-	profile, err := newProfile(config.Profile)
+	defaultProfile, err := newProfile(config.Profile)
 	if err != nil {
 		return nil, err
 	}
+	profiles := map[string]*signingProfile{"": defaultProfile}
+	for name, profileConfig := range config.Profiles {
+		if name == "" {
+			return nil, errors.New("profile names must not be empty")
+		}
+		profile, err := newProfile(profileConfig)
+		if err != nil {
+			return nil, fmt.Errorf("profile %q: %s", name, err)
+		}
+		profiles[name] = profile
+	}
 	lints, err := lint.GlobalRegistry().Filter(lint.FilterOptions{
 		ExcludeNames: config.IgnoredLints,
 		ExcludeSources: []lint.LintSource{
@@ -288,13 +339,14 @@ func NewSigner(config Config) (*Signer, error) {
 		return nil, err
 	}
 	var lk crypto.Signer
+	var sigAlg x509.SignatureAlgorithm
 	switch k := config.Issuer.PublicKey.(type) {
 	case *rsa.PublicKey:
 		lk, err = rsa.GenerateKey(rand.Reader, k.Size()*8)
 		if err != nil {
 			return nil, err
 		}
-		profile.sigAlg = x509.SHA256WithRSA
+		sigAlg = x509.SHA256WithRSA
 	case *ecdsa.PublicKey:
 		lk, err = ecdsa.GenerateKey(k.Curve, rand.Reader)
 		if err != nil {
@@ -302,26 +354,50 @@ func NewSigner(config Config) (*Signer, error) {
 		}
 		switch k.Curve {
 		case elliptic.P256():
-			profile.sigAlg = x509.ECDSAWithSHA256
+			sigAlg = x509.ECDSAWithSHA256
 		case elliptic.P384():
-			profile.sigAlg = x509.ECDSAWithSHA384
+			sigAlg = x509.ECDSAWithSHA384
 		default:
 			return nil, fmt.Errorf("unsupported ECDSA curve: %s", k.Curve.Params().Name)
 		}
 	default:
 		return nil, errors.New("unsupported issuer key type")
 	}
+	for _, profile := range profiles {
+		profile.sigAlg = sigAlg
+	}
 	s := &Signer{
-		issuer:  config.Issuer,
-		signer:  config.Signer,
-		clk:     config.Clk,
-		lints:   lints,
-		lintKey: lk,
-		profile: profile,
+		issuer:   config.Issuer,
+		signer:   config.Signer,
+		clk:      config.Clk,
+		lints:    lints,
+		lintKey:  lk,
+		profiles: profiles,
 	}
 	return s, nil
 }
 
+// getProfile returns the named issuance profile, or an error if the signer has
+// no profile with that name.
+func (s *Signer) getProfile(name string) (*signingProfile, error) {
+	profile, ok := s.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown profile %q", name)
+	}
+	return profile, nil
+}
+
+// ProfileValidity returns the validity period of certificates issued with the
+// named profile, or zero if the profile leaves the validity period up to the
+// caller. It returns an error if the signer has no profile with that name.
+func (s *Signer) ProfileValidity(name string) (time.Duration, error) {
+	profile, err := s.getProfile(name)
+	if err != nil {
+		return 0, err
+	}
+	return profile.validity, nil
+}
+
 var ctPoisonExt = pkix.Extension{
 	// OID for CT poison, RFC 6962 (was never assigned a proper id-pe- name)
 	Id:       asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3},
@@ -387,21 +463,27 @@ func generateSKID(pk crypto.PublicKey) ([]byte, error) {
 // zlint. If the linting fails, an error is returned and the certificate
 // is not signed using the issuer's key.
 func (s *Signer) Issue(req *IssuanceRequest) ([]byte, error) {
+	profile, err := s.getProfile(req.Profile)
+	if err != nil {
+		return nil, err
+	}
+
 	// check request is valid according to the issuance profile
-	if err := s.profile.requestValid(s.clk, req); err != nil {
+	if err := profile.requestValid(s.clk, req); err != nil {
 		return nil, err
 	}
 
 	// generate template from the issuance profile
-	template := s.profile.generateTemplate(s.clk)
+	template := profile.generateTemplate(s.clk)
 
 	// populate template from the issuance request
 	template.NotBefore, template.NotAfter = req.NotBefore, req.NotAfter
 	template.SerialNumber = big.NewInt(0).SetBytes(req.Serial)
-	if req.CommonName != "" {
+	if req.CommonName != "" && !profile.omitCommonName {
 		template.Subject.CommonName = req.CommonName
 	}
 	template.DNSNames = req.DNSNames
+	template.IPAddresses = req.IPAddresses
 	template.AuthorityKeyId = s.issuer.SubjectKeyId
 	skid, err := generateSKID(req.PublicKey)
 	if err != nil {
@@ -473,7 +555,8 @@ func containsCTPoison(extensions []pkix.Extension) bool {
 
 // RequestFromPrecert constructs a final certificate IssuanceRequest matching
 // the provided precertificate. It returns an error if the precertificate doesn't
-// contain the CT poison extension.
+// contain the CT poison extension. The caller must set the profile to the one
+// the precertificate was issued with.
 func RequestFromPrecert(precert *x509.Certificate, scts []ct.SignedCertificateTimestamp) (*IssuanceRequest, error) {
 	if !containsCTPoison(precert.Extensions) {
 		return nil, errors.New("provided certificate doesn't contain the CT poison extension")
@@ -485,6 +568,7 @@ func RequestFromPrecert(precert *x509.Certificate, scts []ct.SignedCertificateTi
 		NotAfter:          precert.NotAfter,
 		CommonName:        precert.Subject.CommonName,
 		DNSNames:          precert.DNSNames,
+		IPAddresses:       precert.IPAddresses,
 		IncludeMustStaple: ContainsMustStaple(precert.Extensions),
 		SCTList:           scts,
 	}, nil
@@ -11,6 +11,7 @@ import (
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"math/big"
+	"net"
 	"os"
 	"testing"
 	"time"
@@ -530,6 +531,36 @@ func TestIssueRSA(t *testing.T) {
 	test.AssertEquals(t, cert.KeyUsage, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment)
 }
 
+func TestIssueIPAddresses(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	signer, err := NewSigner(Config{
+		Issuer:       issuerCert,
+		Signer:       issuerSigner,
+		Clk:          fc,
+		Profile:      defaultProfileConfig(),
+		IgnoredLints: []string{"w_ct_sct_policy_count_unsatisfied"},
+	})
+	test.AssertNotError(t, err, "NewSigner failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	certBytes, err := signer.Issue(&IssuanceRequest{
+		PublicKey:   pk.Public(),
+		Serial:      []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		DNSNames:    []string{"example.com"},
+		IPAddresses: []net.IP{net.ParseIP("64.112.117.1"), net.ParseIP("2602:80a:6000::1")},
+		NotBefore:   fc.Now(),
+		NotAfter:    fc.Now().Add(time.Hour),
+	})
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertDeepEquals(t, cert.DNSNames, []string{"example.com"})
+	test.AssertEquals(t, len(cert.IPAddresses), 2)
+	test.Assert(t, cert.IPAddresses[0].Equal(net.ParseIP("64.112.117.1")), "wrong first IP address")
+	test.Assert(t, cert.IPAddresses[1].Equal(net.ParseIP("2602:80a:6000::1")), "wrong second IP address")
+}
+
 func TestIssueCTPoison(t *testing.T) {
 	fc := clock.NewFake()
 	fc.Set(time.Now())
@@ -653,3 +684,85 @@ func TestIssueBadLint(t *testing.T) {
 	test.AssertError(t, err, "Issue didn't fail")
 	test.AssertEquals(t, err.Error(), "tbsCertificate linting failed: w_ct_sct_policy_count_unsatisfied")
 }
+
+func TestNewSignerProfiles(t *testing.T) {
+	config := Config{
+		Issuer:  issuerCert,
+		Signer:  issuerSigner,
+		Clk:     clock.NewFake(),
+		Profile: defaultProfileConfig(),
+	}
+
+	config.Profiles = map[string]ProfileConfig{"": defaultProfileConfig()}
+	_, err := NewSigner(config)
+	test.AssertError(t, err, "NewSigner didn't fail with an empty profile name")
+	test.AssertEquals(t, err.Error(), "profile names must not be empty")
+
+	config.Profiles = map[string]ProfileConfig{"bad": {}}
+	_, err = NewSigner(config)
+	test.AssertError(t, err, "NewSigner didn't fail with an invalid profile")
+	test.AssertEquals(t, err.Error(), `profile "bad": Issuer URL is required`)
+
+	tooLong := defaultProfileConfig()
+	tooLong.ValidityPeriod = cmd.ConfigDuration{Duration: 2 * time.Hour}
+	config.Profiles = map[string]ProfileConfig{"too-long": tooLong}
+	_, err = NewSigner(config)
+	test.AssertError(t, err, "NewSigner didn't fail with a validity period over the maximum")
+
+	shortLived := defaultProfileConfig()
+	shortLived.ValidityPeriod = cmd.ConfigDuration{Duration: time.Minute}
+	config.Profiles = map[string]ProfileConfig{"short-lived": shortLived}
+	signer, err := NewSigner(config)
+	test.AssertNotError(t, err, "NewSigner failed")
+	validity, err := signer.ProfileValidity("short-lived")
+	test.AssertNotError(t, err, "ProfileValidity failed")
+	test.AssertEquals(t, validity, time.Minute)
+	validity, err = signer.ProfileValidity("")
+	test.AssertNotError(t, err, "ProfileValidity failed")
+	test.AssertEquals(t, validity, time.Duration(0))
+	_, err = signer.ProfileValidity("unknown")
+	test.AssertError(t, err, "ProfileValidity didn't fail for an unknown profile")
+}
+
+func TestIssueProfile(t *testing.T) {
+	fc := clock.NewFake()
+	fc.Set(time.Now())
+	noCN := defaultProfileConfig()
+	noCN.AllowCommonName = false
+	noCN.OmitCommonName = true
+	noCN.OmitClientAuth = true
+	noCN.Policies = []PolicyInformation{{OID: "2.23.140.1.2.1"}}
+	signer, err := NewSigner(Config{
+		Issuer:       issuerCert,
+		Signer:       issuerSigner,
+		Clk:          fc,
+		Profile:      defaultProfileConfig(),
+		Profiles:     map[string]ProfileConfig{"tlsserver-no-cn": noCN},
+		IgnoredLints: []string{"w_ct_sct_policy_count_unsatisfied"},
+	})
+	test.AssertNotError(t, err, "NewSigner failed")
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "failed to generate test key")
+	req := &IssuanceRequest{
+		PublicKey:  pk.Public(),
+		Serial:     []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		CommonName: "example.com",
+		DNSNames:   []string{"example.com"},
+		NotBefore:  fc.Now(),
+		NotAfter:   fc.Now().Add(time.Hour),
+		Profile:    "tlsserver-no-cn",
+	}
+	certBytes, err := signer.Issue(req)
+	test.AssertNotError(t, err, "Issue failed")
+	cert, err := x509.ParseCertificate(certBytes)
+	test.AssertNotError(t, err, "failed to parse certificate")
+	test.AssertEquals(t, cert.Subject.CommonName, "")
+	test.AssertDeepEquals(t, cert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth})
+	test.AssertEquals(t, len(cert.PolicyIdentifiers), 1)
+	test.Assert(t, cert.PolicyIdentifiers[0].Equal(asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1}), "wrong policy")
+
+	req.Profile = "unknown"
+	_, err = signer.Issue(req)
+	test.AssertError(t, err, "Issue didn't fail with an unknown profile")
+	test.AssertEquals(t, err.Error(), `unknown profile "unknown"`)
+}
@@ -9,7 +9,7 @@ command -v minica >/dev/null 2>&1 || {
   exit 1;
 }
 
-for HOSTNAME in admin-revoker.boulder expiration-mailer.boulder \
+for HOSTNAME in admin.boulder admin-revoker.boulder expiration-mailer.boulder \
   ocsp-updater.boulder orphan-finder.boulder wfe.boulder akamai-purger.boulder nonce.boulder \
   bad-key-revoker.boulder ; do
   minica -domains ${HOSTNAME}
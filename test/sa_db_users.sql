@@ -33,6 +33,17 @@ GRANT SELECT,INSERT ON precertificates TO 'sa'@'localhost';
 GRANT SELECT,INSERT ON keyHashToSerial TO 'sa'@'localhost';
 GRANT SELECT,INSERT ON blockedKeys TO 'sa'@'localhost';
 GRANT SELECT,INSERT,UPDATE ON newOrdersRL TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON externalAccountKeys TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE,DELETE ON replacementOrders TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON renewalInfoOverrides TO 'sa'@'localhost';
+GRANT SELECT,INSERT,DELETE ON processingOrders TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON autoRenewals TO 'sa'@'localhost';
+GRANT SELECT,INSERT,UPDATE ON rateLimitOverrides TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON rateLimitOverrideHistory TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON validationAttempts TO 'sa'@'localhost';
+GRANT SELECT,INSERT,DELETE ON heldOrders TO 'sa'@'localhost';
+GRANT SELECT,INSERT,DELETE ON accountScopes TO 'sa'@'localhost';
+GRANT SELECT,INSERT ON accountScopeHistory TO 'sa'@'localhost';
 
 -- OCSP Responder
 GRANT SELECT ON certificateStatus TO 'ocsp_resp'@'localhost';
@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"strings"
 	"sync"
 
+	"github.com/letsencrypt/boulder/bdns"
+	"github.com/letsencrypt/boulder/canceled"
 	corepb "github.com/letsencrypt/boulder/core/proto"
 	"github.com/letsencrypt/boulder/features"
+	bgrpc "github.com/letsencrypt/boulder/grpc"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
 	vapb "github.com/letsencrypt/boulder/va/proto"
@@ -20,6 +24,11 @@ type caaParams struct {
 	validationMethod string
 }
 
+// IsCAAValid checks requested CAA records from a VA, and recursively any RemoteVAs.
+// If the `EnforceMultiCAA` feature is enabled the remote VAs must agree that
+// issuance is permitted according to the same `maxRemoteFailures` threshold
+// used for validations. If only `MultiVAFullResults` is enabled the remote
+// results are collected and logged but not enforced.
 func (va *ValidationAuthorityImpl) IsCAAValid(ctx context.Context, req *vapb.IsCAAValidRequest) (*vapb.IsCAAValidResponse, error) {
 	acmeID := identifier.ACMEIdentifier{
 		Type:  identifier.DNS,
@@ -29,33 +38,136 @@ func (va *ValidationAuthorityImpl) IsCAAValid(ctx context.Context, req *vapb.IsC
 		accountURIID:     req.AccountURIID,
 		validationMethod: req.ValidationMethod,
 	}
-	if prob := va.checkCAA(ctx, acmeID, params); prob != nil {
-		typ := string(prob.Type)
+
+	var remoteResults chan *remoteValidationResult
+	if remoteVACount := len(va.remoteVAs); remoteVACount > 0 &&
+		(features.Enabled(features.EnforceMultiCAA) || features.Enabled(features.MultiVAFullResults)) {
+		remoteResults = make(chan *remoteValidationResult, remoteVACount)
+		go va.performRemoteCAACheck(ctx, req, remoteResults)
+	}
+
+	prob := va.checkCAA(ctx, acmeID, params)
+	if prob != nil {
 		detail := fmt.Sprintf("While processing CAA for %s: %s", req.Domain, prob.Detail)
-		return &vapb.IsCAAValidResponse{
-			Problem: &corepb.ProblemDetails{
-				ProblemType: &typ,
-				Detail:      &detail,
-			},
-		}, nil
+		return caaResponse(prob.Type, detail), nil
+	}
+
+	if remoteResults != nil {
+		if !features.Enabled(features.EnforceMultiCAA) {
+			// Collect and log the remote results in a separate go routine to avoid
+			// blocking the primary VA.
+			go func() {
+				_ = va.processRemoteResults(
+					req.Domain,
+					req.AccountURIID,
+					req.ValidationMethod,
+					va.remoteCAACheck(),
+					prob,
+					remoteResults,
+					len(va.remoteVAs))
+			}()
+		} else {
+			remoteProb := va.processRemoteResults(
+				req.Domain,
+				req.AccountURIID,
+				req.ValidationMethod,
+				va.remoteCAACheck(),
+				prob,
+				remoteResults,
+				len(va.remoteVAs))
+			if remoteProb != nil {
+				va.log.Infof("CAA check failed due to remote failures: identifier=%v err=%s",
+					req.Domain, remoteProb)
+				va.metrics.remoteCAAFailures.Inc()
+				return caaResponse(remoteProb.Type, remoteProb.Detail), nil
+			}
+		}
 	}
 	return &vapb.IsCAAValidResponse{}, nil
 }
 
+// caaResponse returns an IsCAAValidResponse carrying a problem of the given
+// type and detail.
+func caaResponse(probType probs.ProblemType, detail string) *vapb.IsCAAValidResponse {
+	typ := string(probType)
+	return &vapb.IsCAAValidResponse{
+		Problem: &corepb.ProblemDetails{
+			ProblemType: &typ,
+			Detail:      &detail,
+		},
+	}
+}
+
+// performRemoteCAACheck calls `IsCAAValid` for each of the configured
+// remoteVAs in a random order, in separate go-routines, writing a
+// `remoteValidationResult` for each to the provided `results` chan. Like
+// `performRemoteValidation` a cancelled RPC is not logged, and any other RPC
+// error is treated as an internal server problem.
+func (va *ValidationAuthorityImpl) performRemoteCAACheck(
+	ctx context.Context,
+	req *vapb.IsCAAValidRequest,
+	results chan *remoteValidationResult) {
+	for _, i := range rand.Perm(len(va.remoteVAs)) {
+		remoteVA := va.remoteVAs[i]
+		go func(rva RemoteVA) {
+			result := &remoteValidationResult{
+				VAHostname: rva.Address,
+			}
+			res, err := rva.IsCAAValid(ctx, req)
+			if err != nil && canceled.Is(err) {
+				result.Problem = probs.ServerInternal("Remote IsCAAValid RPC canceled")
+			} else if err != nil {
+				va.log.Errf("Remote VA %q.IsCAAValid failed: %s", rva.Address, err)
+				result.Problem = probs.ServerInternal("Remote IsCAAValid RPC failed")
+			} else if res.Problem != nil {
+				prob, err := bgrpc.PBToProblemDetails(res.Problem)
+				if err != nil {
+					va.log.Infof("Remote VA %q.IsCAAValid returned malformed problem: %s", rva.Address, err)
+					result.Problem = probs.ServerInternal(
+						fmt.Sprintf("Remote IsCAAValid RPC returned malformed result: %s", err))
+				} else {
+					va.log.Infof("Remote VA %q.IsCAAValid returned problem: %s", rva.Address, prob)
+					result.Problem = prob
+				}
+			}
+			results <- result
+		}(remoteVA)
+	}
+}
+
 // checkCAA performs a CAA lookup & validation for the provided identifier. If
 // the CAA lookup & validation fail a problem is returned.
 func (va *ValidationAuthorityImpl) checkCAA(
 	ctx context.Context,
 	identifier identifier.ACMEIdentifier,
 	params *caaParams) *probs.ProblemDetails {
-	present, valid, records, err := va.checkCAARecords(ctx, identifier, params)
+	_, prob := va.checkCAAWithRecords(ctx, identifier, params)
+	return prob
+}
+
+// checkCAAWithRecords is like checkCAA but also returns the CAA records that
+// were checked.
+func (va *ValidationAuthorityImpl) checkCAAWithRecords(
+	ctx context.Context,
+	ident identifier.ACMEIdentifier,
+	params *caaParams) ([]*dns.CAA, *probs.ProblemDetails) {
+	// CAA is only defined for domain names, there are no CAA records to check
+	// for an IP identifier.
+	if ident.Type == identifier.IP {
+		return nil, nil
+	}
+	present, valid, records, dnssec, err := va.checkCAARecords(ctx, ident, params)
 	if err != nil {
-		return probs.DNS(err.Error())
+		if dnssec == bdns.DNSSECBogus {
+			va.log.AuditErrf("Failed to check CAA records for %s, [DNSSEC: %s] Err=%s",
+				ident.Value, dnssec, err)
+		}
+		return nil, probs.DNS(err.Error())
 	}
 
 	recordsStr, err := json.Marshal(&records)
 	if err != nil {
-		return probs.CAA(fmt.Sprintf("CAA records for %s were malformed", identifier.Value))
+		return records, probs.CAA(fmt.Sprintf("CAA records for %s were malformed", ident.Value))
 	}
 
 	accountID, validationMethod := "unknown", "unknown"
@@ -66,12 +178,18 @@ func (va *ValidationAuthorityImpl) checkCAA(
 		validationMethod = params.validationMethod
 	}
 
-	va.log.AuditInfof("Checked CAA records for %s, [Present: %t, Account ID: %s, Challenge: %s, Valid for issuance: %t] Records=%s",
-		identifier.Value, present, accountID, validationMethod, valid, recordsStr)
+	// The DNSSEC status is only included when the VA validates DNSSEC itself.
+	var dnssecStr string
+	if dnssec != bdns.DNSSECUnchecked {
+		dnssecStr = fmt.Sprintf(", DNSSEC: %s", dnssec)
+	}
+
+	va.log.AuditInfof("Checked CAA records for %s, [Present: %t, Account ID: %s, Challenge: %s, Valid for issuance: %t%s] Records=%s",
+		ident.Value, present, accountID, validationMethod, valid, dnssecStr, recordsStr)
 	if !valid {
-		return probs.CAA(fmt.Sprintf("CAA record for %s prevents issuance", identifier.Value))
+		return records, probs.CAA(fmt.Sprintf("CAA record for %s prevents issuance", ident.Value))
 	}
-	return nil
+	return records, nil
 }
 
 // CAASet consists of filtered CAA records
@@ -124,19 +242,25 @@ func newCAASet(CAAs []*dns.CAA) *CAASet {
 type caaResult struct {
 	records []*dns.CAA
 	err     error
+	dnssec  bdns.DNSSECStatus
 }
 
-func parseResults(results []caaResult) (*CAASet, []*dns.CAA, error) {
+// parseResults returns the first non-empty set of CAA records from results,
+// along with the weakest DNSSEC status of the lookups up to and including the
+// one it came from.
+func parseResults(results []caaResult) (*CAASet, []*dns.CAA, bdns.DNSSECStatus, error) {
+	var dnssec bdns.DNSSECStatus
 	// Return first result
 	for _, res := range results {
+		dnssec = bdns.WorseDNSSECStatus(dnssec, res.dnssec)
 		if res.err != nil {
-			return nil, nil, res.err
+			return nil, nil, dnssec, res.err
 		}
 		if len(res.records) > 0 {
-			return newCAASet(res.records), res.records, nil
+			return newCAASet(res.records), res.records, dnssec, nil
 		}
 	}
-	return nil, nil, nil
+	return nil, nil, dnssec, nil
 }
 
 func (va *ValidationAuthorityImpl) parallelCAALookup(ctx context.Context, name string) []caaResult {
@@ -148,7 +272,7 @@ func (va *ValidationAuthorityImpl) parallelCAALookup(ctx context.Context, name s
 		// Start the concurrent DNS lookup.
 		wg.Add(1)
 		go func(name string, r *caaResult) {
-			r.records, r.err = va.dnsClient.LookupCAA(ctx, name)
+			r.records, r.dnssec, r.err = va.dnsClient.LookupCAA(ctx, name)
 			wg.Done()
 		}(strings.Join(labels[i:], "."), &results[i])
 	}
@@ -157,7 +281,7 @@ func (va *ValidationAuthorityImpl) parallelCAALookup(ctx context.Context, name s
 	return results
 }
 
-func (va *ValidationAuthorityImpl) getCAASet(ctx context.Context, hostname string) (*CAASet, []*dns.CAA, error) {
+func (va *ValidationAuthorityImpl) getCAASet(ctx context.Context, hostname string) (*CAASet, []*dns.CAA, bdns.DNSSECStatus, error) {
 	hostname = strings.TrimRight(hostname, ".")
 
 	// See RFC 6844 "Certification Authority Processing" for pseudocode, as
@@ -177,16 +301,17 @@ func (va *ValidationAuthorityImpl) getCAASet(ctx context.Context, hostname strin
 // validates them. If the identifier argument's value has a wildcard prefix then
 // the prefix is stripped and validation will be performed against the base
 // domain, honouring any issueWild CAA records encountered as appropriate.
-// checkCAARecords returns four values: the first is a bool indicating whether
+// checkCAARecords returns five values: the first is a bool indicating whether
 // CAA records were present after filtering for known/supported CAA tags. The
 // second is a bool indicating whether issuance for the identifier is valid. The
 // unmodified *dns.CAA records that were processed/filtered are returned as the
-// third argument. Any  errors encountered are returned as the fourth return
-// value (or nil).
+// third argument, and the DNSSEC status of the lookups they came from as the
+// fourth. Any  errors encountered are returned as the fifth return value (or
+// nil).
 func (va *ValidationAuthorityImpl) checkCAARecords(
 	ctx context.Context,
 	identifier identifier.ACMEIdentifier,
-	params *caaParams) (bool, bool, []*dns.CAA, error) {
+	params *caaParams) (bool, bool, []*dns.CAA, bdns.DNSSECStatus, error) {
 	hostname := strings.ToLower(identifier.Value)
 	// If this is a wildcard name, remove the prefix
 	var wildcard bool
@@ -194,12 +319,12 @@ func (va *ValidationAuthorityImpl) checkCAARecords(
 		hostname = strings.TrimPrefix(identifier.Value, `*.`)
 		wildcard = true
 	}
-	caaSet, records, err := va.getCAASet(ctx, hostname)
+	caaSet, records, dnssec, err := va.getCAASet(ctx, hostname)
 	if err != nil {
-		return false, false, nil, err
+		return false, false, nil, dnssec, err
 	}
 	present, valid := va.validateCAASet(caaSet, wildcard, params)
-	return present, valid, records, nil
+	return present, valid, records, dnssec, nil
 }
 
 func containsMethod(commaSeparatedMethods, method string) bool {
@@ -277,6 +402,9 @@ func (va *ValidationAuthorityImpl) validateCAASet(caaSet *CAASet, wildcard bool,
 			// Check the validationmethods CAA parameter as defined
 			// in section 4 of the draft CAA ACME RFC:
 			// https://tools.ietf.org/html/draft-ietf-acme-caa-04
+			// The value for a method is its ACME challenge type, so e.g.
+			// "dns-account-01" must be listed explicitly and is not implied by
+			// "dns-01".
 			caaMethods, ok := caaParameters["validationmethods"]
 			if ok {
 				if params.validationMethod == "" {
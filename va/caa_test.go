@@ -10,7 +10,9 @@ import (
 	"testing"
 
 	"github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/features"
 	"github.com/letsencrypt/boulder/identifier"
@@ -25,21 +27,27 @@ import (
 // answers for CAA queries.
 type caaMockDNS struct{}
 
-func (mock caaMockDNS) LookupTXT(_ context.Context, hostname string) ([]string, error) {
-	return nil, nil
+func (mock caaMockDNS) LookupTXT(_ context.Context, hostname string) ([]string, bdns.DNSSECStatus, error) {
+	return nil, bdns.DNSSECUnchecked, nil
 }
 
-func (mock caaMockDNS) LookupHost(_ context.Context, hostname string) ([]net.IP, error) {
+func (mock caaMockDNS) LookupHost(_ context.Context, hostname string) ([]net.IP, bdns.DNSSECStatus, error) {
 	ip := net.ParseIP("127.0.0.1")
-	return []net.IP{ip}, nil
+	return []net.IP{ip}, bdns.DNSSECUnchecked, nil
 }
 
-func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA, error) {
+func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA, bdns.DNSSECStatus, error) {
 	var results []*dns.CAA
 	var record dns.CAA
 	switch strings.TrimRight(domain, ".") {
 	case "caa-timeout.com":
-		return nil, fmt.Errorf("error")
+		return nil, bdns.DNSSECUnchecked, fmt.Errorf("error")
+	case "dnssec-bogus.com":
+		return nil, bdns.DNSSECBogus, fmt.Errorf("DNSSEC validation failure")
+	case "dnssec-secure.com":
+		record.Tag = "issue"
+		record.Value = "letsencrypt.org"
+		return []*dns.CAA{&record}, bdns.DNSSECSecure, nil
 	case "reserved.com":
 		record.Tag = "issue"
 		record.Value = "ca.com"
@@ -59,9 +67,9 @@ func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA,
 		results = append(results, &record)
 	case "com":
 		// com has no CAA records.
-		return nil, nil
+		return nil, bdns.DNSSECUnchecked, nil
 	case "servfail.com", "servfail.present.com":
-		return results, fmt.Errorf("SERVFAIL")
+		return results, bdns.DNSSECUnchecked, fmt.Errorf("SERVFAIL")
 	case "multi-crit-present.com":
 		record.Flag = 1
 		record.Tag = "issue"
@@ -105,6 +113,10 @@ func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA,
 		record.Tag = "issue"
 		record.Value = "letsencrypt.org; validationmethods=http-01"
 		results = append(results, &record)
+	case "present-dns-account-only.com":
+		record.Tag = "issue"
+		record.Value = "letsencrypt.org; validationmethods=dns-account-01"
+		results = append(results, &record)
 	case "present-http-or-dns.com":
 		record.Tag = "issue"
 		record.Value = "letsencrypt.org; validationmethods=http-01,dns-01"
@@ -179,7 +191,7 @@ func (mock caaMockDNS) LookupCAA(_ context.Context, domain string) ([]*dns.CAA,
 		record.Value = "letsencrypt.org"
 		results = append(results, &record)
 	}
-	return results, nil
+	return results, bdns.DNSSECUnchecked, nil
 }
 
 func TestCAATimeout(t *testing.T) {
@@ -406,7 +418,7 @@ func TestCAAChecking(t *testing.T) {
 		mockLog.Clear()
 		t.Run(caaTest.Name, func(t *testing.T) {
 			ident := identifier.DNSIdentifier(caaTest.Domain)
-			present, valid, _, err := va.checkCAARecords(ctx, ident, params)
+			present, valid, _, _, err := va.checkCAARecords(ctx, ident, params)
 			if err != nil {
 				t.Errorf("checkCAARecords error for %s: %s", caaTest.Domain, err)
 			}
@@ -424,45 +436,74 @@ func TestCAAChecking(t *testing.T) {
 
 	// present-dns-only.com should now be valid even with http-01
 	ident := identifier.DNSIdentifier("present-dns-only.com")
-	present, valid, _, err := va.checkCAARecords(ctx, ident, params)
+	present, valid, _, _, err := va.checkCAARecords(ctx, ident, params)
 	test.AssertNotError(t, err, "present-dns-only.com")
 	test.Assert(t, present, "Present should be true")
 	test.Assert(t, valid, "Valid should be true")
 
 	// present-incorrect-accounturi.com should now be also be valid
 	ident = identifier.DNSIdentifier("present-incorrect-accounturi.com")
-	present, valid, _, err = va.checkCAARecords(ctx, ident, params)
+	present, valid, _, _, err = va.checkCAARecords(ctx, ident, params)
 	test.AssertNotError(t, err, "present-incorrect-accounturi.com")
 	test.Assert(t, present, "Present should be true")
 	test.Assert(t, valid, "Valid should be true")
 
 	// nil params should be valid, too
-	present, valid, _, err = va.checkCAARecords(ctx, ident, nil)
+	present, valid, _, _, err = va.checkCAARecords(ctx, ident, nil)
 	test.AssertNotError(t, err, "present-dns-only.com")
 	test.Assert(t, present, "Present should be true")
 	test.Assert(t, valid, "Valid should be true")
 
 	ident.Value = "servfail.com"
-	present, valid, _, err = va.checkCAARecords(ctx, ident, nil)
+	present, valid, _, _, err = va.checkCAARecords(ctx, ident, nil)
 	test.AssertError(t, err, "servfail.com")
 	test.Assert(t, !present, "Present should be false")
 	test.Assert(t, !valid, "Valid should be false")
 
-	if _, _, _, err := va.checkCAARecords(ctx, ident, nil); err == nil {
+	if _, _, _, _, err := va.checkCAARecords(ctx, ident, nil); err == nil {
 		t.Errorf("Should have returned error on CAA lookup, but did not: %s", ident.Value)
 	}
 
 	ident.Value = "servfail.present.com"
-	present, valid, _, err = va.checkCAARecords(ctx, ident, nil)
+	present, valid, _, _, err = va.checkCAARecords(ctx, ident, nil)
 	test.AssertError(t, err, "servfail.present.com")
 	test.Assert(t, !present, "Present should be false")
 	test.Assert(t, !valid, "Valid should be false")
 
-	if _, _, _, err := va.checkCAARecords(ctx, ident, nil); err == nil {
+	if _, _, _, _, err := va.checkCAARecords(ctx, ident, nil); err == nil {
 		t.Errorf("Should have returned error on CAA lookup, but did not: %s", ident.Value)
 	}
 }
 
+func TestCAAValidationMethodsDNSAccount01(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+	va.dnsClient = caaMockDNS{}
+	err := features.Set(map[string]bool{"CAAValidationMethods": true})
+	test.AssertNotError(t, err, "Failed to enable feature")
+	defer features.Reset()
+
+	testCases := []struct {
+		Domain string
+		Method core.AcmeChallenge
+		Valid  bool
+	}{
+		{"present-dns-account-only.com", core.ChallengeTypeDNSAccount01, true},
+		{"present-dns-account-only.com", core.ChallengeTypeDNS01, false},
+		{"present-dns-account-only.com", core.ChallengeTypeHTTP01, false},
+		// dns-account-01 is a distinct validation method from dns-01
+		{"present-dns-only.com", core.ChallengeTypeDNSAccount01, false},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s %s", tc.Domain, tc.Method), func(t *testing.T) {
+			params := &caaParams{accountURIID: 123, validationMethod: string(tc.Method)}
+			present, valid, _, _, err := va.checkCAARecords(ctx, identifier.DNSIdentifier(tc.Domain), params)
+			test.AssertNotError(t, err, "checkCAARecords failed")
+			test.Assert(t, present, "Present should be true")
+			test.AssertEquals(t, valid, tc.Valid)
+		})
+	}
+}
+
 func TestCAALogging(t *testing.T) {
 	va, _ := setup(nil, 0, "", nil)
 	va.dnsClient = caaMockDNS{}
@@ -573,6 +614,131 @@ func TestIsCAAValidErrMessage(t *testing.T) {
 	test.AssertEquals(t, *resp.Problem.Detail, fmt.Sprintf("While processing CAA for %s: error", domain))
 }
 
+// TestMultiCAA tests that `va.IsCAAValid` checks CAA from each of the remote
+// VAs, and that their results are enforced according to `maxRemoteFailures`
+// when the `EnforceMultiCAA` feature is enabled.
+func TestMultiCAA(t *testing.T) {
+	// caaRemote returns a remote VA that sees the records from caaMockDNS, and so
+	// forbids issuance for reserved.com.
+	caaRemote := func(ua string) RemoteVA {
+		remoteVA, _ := setupRemote(nil, 0, ua)
+		remoteVA.(*localRemoteVA).remote.dnsClient = caaMockDNS{}
+		return RemoteVA{remoteVA, ua}
+	}
+	// openRemote returns a remote VA that sees no CAA records.
+	openRemote := func(ua string) RemoteVA {
+		remoteVA, _ := setupRemote(nil, 0, ua)
+		return RemoteVA{remoteVA, ua}
+	}
+
+	testCases := []struct {
+		Name         string
+		RemoteVAs    []RemoteVA
+		Features     map[string]bool
+		ExpectedProb *probs.ProblemDetails
+	}{
+		{
+			Name:      "Remote failures not enforced without EnforceMultiCAA",
+			RemoteVAs: []RemoteVA{caaRemote("remote 1"), caaRemote("remote 2")},
+		},
+		{
+			Name:      "Remote failures over threshold",
+			RemoteVAs: []RemoteVA{caaRemote("remote 1"), caaRemote("remote 2")},
+			Features:  map[string]bool{"EnforceMultiCAA": true},
+			ExpectedProb: probs.CAA(
+				"During secondary CAA checking: While processing CAA for reserved.com: " +
+					"CAA record for reserved.com prevents issuance"),
+		},
+		{
+			Name:      "Remote failures within threshold",
+			RemoteVAs: []RemoteVA{caaRemote("remote 1"), openRemote("remote 2")},
+			Features:  map[string]bool{"EnforceMultiCAA": true},
+		},
+		{
+			Name:      "Broken remote within threshold",
+			RemoteVAs: []RemoteVA{{&brokenRemoteVA{}, "broken"}, openRemote("remote 2")},
+			Features:  map[string]bool{"EnforceMultiCAA": true},
+		},
+		{
+			Name:      "Broken remotes over threshold",
+			RemoteVAs: []RemoteVA{{&brokenRemoteVA{}, "broken"}, {&brokenRemoteVA{}, "broken"}},
+			Features:  map[string]bool{"EnforceMultiCAA": true},
+			ExpectedProb: probs.ServerInternal(
+				"During secondary CAA checking: Remote IsCAAValid RPC failed"),
+		},
+		{
+			Name:      "Remote failures over threshold with full results",
+			RemoteVAs: []RemoteVA{caaRemote("remote 1"), caaRemote("remote 2")},
+			Features:  map[string]bool{"EnforceMultiCAA": true, "MultiVAFullResults": true},
+			ExpectedProb: probs.CAA(
+				"During secondary CAA checking: While processing CAA for reserved.com: " +
+					"CAA record for reserved.com prevents issuance"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			va, mockLog := setup(nil, 1, "local", tc.RemoteVAs)
+			if tc.Features != nil {
+				err := features.Set(tc.Features)
+				test.AssertNotError(t, err, "Failed to set feature flags")
+				defer features.Reset()
+			}
+
+			resp, err := va.IsCAAValid(ctx, &vapb.IsCAAValidRequest{
+				Domain:           "reserved.com",
+				ValidationMethod: string(core.ChallengeTypeHTTP01),
+				AccountURIID:     1,
+			})
+			test.AssertNotError(t, err, "Unexpected error calling IsCAAValid")
+			if tc.Features["EnforceMultiCAA"] {
+				// Remote CAA checks are timed separately from remote validations.
+				result := "success"
+				if tc.ExpectedProb != nil {
+					result = "failure"
+				}
+				test.AssertEquals(t, test.CountHistogramSamples(va.metrics.remoteCAACheckTime.With(prometheus.Labels{
+					"result": result,
+				})), 1)
+				test.AssertEquals(t, test.CountHistogramSamples(va.metrics.remoteValidationTime.With(prometheus.Labels{
+					"type":   string(core.ChallengeTypeHTTP01),
+					"result": result,
+				})), 0)
+			}
+			if tc.ExpectedProb == nil {
+				test.Assert(t, resp.Problem == nil, fmt.Sprintf("Unexpected problem: %v", resp.Problem))
+				return
+			}
+			test.AssertNotNil(t, resp.Problem, "Expected a problem, got none")
+			test.AssertEquals(t, *resp.Problem.ProblemType, string(tc.ExpectedProb.Type))
+			test.AssertEquals(t, *resp.Problem.Detail, tc.ExpectedProb.Detail)
+			if tc.Features["MultiVAFullResults"] {
+				test.AssertEquals(t, len(mockLog.GetAllMatching("remoteVADifferentials JSON=.*")), 1)
+			}
+		})
+	}
+}
+
+// TestCAADNSSEC tests that the DNSSEC status of CAA lookups is audit logged,
+// and that lookups that fail DNSSEC validation prevent issuance.
+func TestCAADNSSEC(t *testing.T) {
+	va, mockLog := setup(nil, 0, "", nil)
+	va.dnsClient = caaMockDNS{}
+	params := &caaParams{accountURIID: 1, validationMethod: "http-01"}
+
+	prob := va.checkCAA(ctx, identifier.DNSIdentifier("dnssec-secure.com"), params)
+	test.Assert(t, prob == nil, fmt.Sprintf("Unexpected problem: %v", prob))
+	test.AssertEquals(t, len(mockLog.GetAllMatching(
+		`Checked CAA records for dnssec-secure.com, \[Present: true, Account ID: 1, Challenge: http-01, Valid for issuance: true, DNSSEC: secure\]`)), 1)
+
+	mockLog.Clear()
+	prob = va.checkCAA(ctx, identifier.DNSIdentifier("dnssec-bogus.com"), params)
+	test.AssertNotNil(t, prob, "Expected a problem for a bogus DNSSEC answer")
+	test.AssertEquals(t, prob.Type, probs.DNSProblem)
+	test.AssertEquals(t, len(mockLog.GetAllMatching(
+		`Failed to check CAA records for dnssec-bogus.com, \[DNSSEC: bogus\]`)), 1)
+}
+
 func TestCAAFailure(t *testing.T) {
 	chall := createChallenge(core.ChallengeTypeHTTP01)
 	hs := httpSrv(t, chall.Token)
@@ -581,28 +747,44 @@ func TestCAAFailure(t *testing.T) {
 	va, _ := setup(hs, 0, "", nil)
 	va.dnsClient = caaMockDNS{}
 
-	_, prob := va.validate(ctx, dnsi("reserved.com"), 0, chall)
+	_, _, prob := va.validate(ctx, dnsi("reserved.com"), 0, chall)
 	if prob == nil {
 		t.Fatalf("Expected CAA rejection for reserved.com, got success")
 	}
 	test.AssertEquals(t, prob.Type, probs.CAAProblem)
 }
 
+func TestCAARecordsReturned(t *testing.T) {
+	chall := createChallenge(core.ChallengeTypeHTTP01)
+	hs := httpSrv(t, chall.Token)
+	defer hs.Close()
+
+	va, _ := setup(hs, 0, "", nil)
+	va.dnsClient = caaMockDNS{}
+
+	// The CAA records consulted for a failed check should be returned so they
+	// can be stored with the validation attempt.
+	_, caaRecords, prob := va.validate(ctx, dnsi("reserved.com"), 0, chall)
+	test.AssertNotNil(t, prob, "Expected CAA rejection for reserved.com")
+	test.AssertEquals(t, len(caaRecords), 1)
+	test.Assert(t, strings.Contains(caaRecords[0], "ca.com"), "CAA record missing issuer value")
+}
+
 func TestParseResults(t *testing.T) {
 	r := []caaResult{}
-	s, records, err := parseResults(r)
+	s, records, _, err := parseResults(r)
 	test.Assert(t, s == nil, "set is not nil")
 	test.Assert(t, err == nil, "error is not nil")
 	test.Assert(t, records == nil, "records is not nil")
 	test.AssertNotError(t, err, "no error should be returned")
-	r = []caaResult{{nil, errors.New("")}, {[]*dns.CAA{{Value: "test"}}, nil}}
-	s, records, err = parseResults(r)
+	r = []caaResult{{nil, errors.New(""), ""}, {[]*dns.CAA{{Value: "test"}}, nil, ""}}
+	s, records, _, err = parseResults(r)
 	test.Assert(t, s == nil, "set is not nil")
 	test.AssertEquals(t, err.Error(), "")
 	expected := dns.CAA{Value: "other-test"}
 	test.AssertEquals(t, len(records), 0)
-	r = []caaResult{{[]*dns.CAA{&expected}, nil}, {[]*dns.CAA{{Value: "test"}}, nil}}
-	s, records, err = parseResults(r)
+	r = []caaResult{{[]*dns.CAA{&expected}, nil, ""}, {[]*dns.CAA{{Value: "test"}}, nil, ""}}
+	s, records, _, err = parseResults(r)
 	test.AssertEquals(t, len(s.Unknown), 1)
 	test.Assert(t, s.Unknown[0] == &expected, "Incorrect record returned")
 	test.AssertNotError(t, err, "no error should be returned")
@@ -4,10 +4,13 @@ import (
 	"context"
 	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/base32"
 	"encoding/base64"
 	"fmt"
 	"net"
+	"strings"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/identifier"
@@ -19,18 +22,23 @@ import (
 // resolved. This is the same choice made by the Go internal resolution library
 // used by net/http. If there is an error resolving the hostname, or if no
 // usable IP addresses are available then a berrors.DNSError instance is
-// returned with a nil net.IP slice.
-func (va ValidationAuthorityImpl) getAddrs(ctx context.Context, hostname string) ([]net.IP, error) {
-	addrs, err := va.dnsClient.LookupHost(ctx, hostname)
+// returned with a nil net.IP slice. A hostname that is an IP address, as for
+// an IP identifier, is returned as the only address without a DNS lookup.
+// The DNSSEC status of the answer is returned along with the addresses.
+func (va ValidationAuthorityImpl) getAddrs(ctx context.Context, hostname string) ([]net.IP, bdns.DNSSECStatus, error) {
+	if ip := net.ParseIP(hostname); ip != nil {
+		return []net.IP{ip}, bdns.DNSSECUnchecked, nil
+	}
+	addrs, dnssec, err := va.dnsClient.LookupHost(ctx, hostname)
 	if err != nil {
-		return nil, berrors.DNSError("%v", err)
+		return nil, dnssec, berrors.DNSError("%v", err)
 	}
 
 	if len(addrs) == 0 {
-		return nil, berrors.DNSError("No valid IP addresses found for %s", hostname)
+		return nil, dnssec, berrors.DNSError("No valid IP addresses found for %s", hostname)
 	}
 	va.log.Debugf("Resolved addresses for %s: %s", hostname, addrs)
-	return addrs, nil
+	return addrs, dnssec, nil
 }
 
 // availableAddresses takes a ValidationRecord and splits the AddressesResolved
@@ -52,14 +60,62 @@ func (va *ValidationAuthorityImpl) validateDNS01(ctx context.Context, ident iden
 		return nil, probs.Malformed("Identifier type for DNS was not itself DNS")
 	}
 
+	// Look for the required record in the DNS
+	challengeSubdomain := fmt.Sprintf("%s.%s", core.DNSPrefix, ident.Value)
+	return va.validateTXT(ctx, ident, challengeSubdomain, challenge)
+}
+
+// dnsAccountLabel returns the account-scoped label that prefixes the
+// _acme-challenge label for the dns-account-01 challenge: an underscore
+// followed by the lowercase base32 encoding of the first 10 bytes of the
+// SHA-256 digest of the account URI.
+func dnsAccountLabel(accountURI string) string {
+	digest := sha256.Sum256([]byte(accountURI))
+	return "_" + strings.ToLower(base32.StdEncoding.EncodeToString(digest[:10]))
+}
+
+// validateDNSAccount01 validates a dns-account-01 challenge. It is like DNS-01
+// except that the TXT record is looked up at a label scoped by the URI of the
+// account, so that several accounts can validate the same name independently.
+// An account has one URI per configured account URI prefix (e.g. one for each
+// ACME API version) and we can't know which one the client used, so each is
+// tried in turn.
+func (va *ValidationAuthorityImpl) validateDNSAccount01(ctx context.Context, ident identifier.ACMEIdentifier, regid int64, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
+	if ident.Type != identifier.DNS {
+		va.log.Infof("Identifier type for DNS challenge was not DNS: %s", ident)
+		return nil, probs.Malformed("Identifier type for DNS was not itself DNS")
+	}
+	if regid == 0 {
+		return nil, probs.ServerInternal(fmt.Sprintf("No account ID for %s challenge", challenge.Type))
+	}
+	if len(va.accountURIPrefixes) == 0 {
+		return nil, probs.ServerInternal(fmt.Sprintf("No account URI prefixes configured for %s challenge", challenge.Type))
+	}
+
+	var firstProb *probs.ProblemDetails
+	for _, prefix := range va.accountURIPrefixes {
+		accountURI := fmt.Sprintf("%s%d", prefix, regid)
+		challengeSubdomain := fmt.Sprintf("%s.%s.%s", dnsAccountLabel(accountURI), core.DNSPrefix, ident.Value)
+		records, prob := va.validateTXT(ctx, ident, challengeSubdomain, challenge)
+		if prob == nil {
+			return records, nil
+		}
+		if firstProb == nil {
+			firstProb = prob
+		}
+	}
+	return nil, firstProb
+}
+
+// validateTXT checks that one of the TXT records at challengeSubdomain holds
+// the digest of the challenge's key authorization.
+func (va *ValidationAuthorityImpl) validateTXT(ctx context.Context, ident identifier.ACMEIdentifier, challengeSubdomain string, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
 	// Compute the digest of the key authorization file
 	h := sha256.New()
 	h.Write([]byte(challenge.ProvidedKeyAuthorization))
 	authorizedKeysDigest := base64.RawURLEncoding.EncodeToString(h.Sum(nil))
 
-	// Look for the required record in the DNS
-	challengeSubdomain := fmt.Sprintf("%s.%s", core.DNSPrefix, ident.Value)
-	txts, err := va.dnsClient.LookupTXT(ctx, challengeSubdomain)
+	txts, dnssec, err := va.dnsClient.LookupTXT(ctx, challengeSubdomain)
 	if err != nil {
 		return nil, probs.DNS(err.Error())
 	}
@@ -74,7 +130,7 @@ func (va *ValidationAuthorityImpl) validateDNS01(ctx context.Context, ident iden
 	for _, element := range txts {
 		if subtle.ConstantTimeCompare([]byte(element), []byte(authorizedKeysDigest)) == 1 {
 			// Successful challenge validation
-			return []core.ValidationRecord{{Hostname: ident.Value}}, nil
+			return []core.ValidationRecord{{Hostname: ident.Value, DNSSEC: string(dnssec)}}, nil
 		}
 	}
 
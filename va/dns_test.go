@@ -98,7 +98,7 @@ func TestDNSValidationNotSane(t *testing.T) {
 
 	chall := dnsChallenge()
 	chall.Token = ""
-	_, prob := va.validateChallenge(ctx, dnsi("localhost"), chall)
+	_, prob := va.validateChallenge(ctx, dnsi("localhost"), 1, chall)
 	if prob.Type != probs.MalformedProblem {
 		t.Errorf("Got wrong error type: expected %s, got %s",
 			prob.Type, probs.MalformedProblem)
@@ -108,7 +108,7 @@ func TestDNSValidationNotSane(t *testing.T) {
 	}
 
 	chall.Token = "yfCBb-bRTLz8Wd1C0lTUQK3qlKj3-t2tYGwx5Hj7r_"
-	_, prob = va.validateChallenge(ctx, dnsi("localhost"), chall)
+	_, prob = va.validateChallenge(ctx, dnsi("localhost"), 1, chall)
 	if prob.Type != probs.MalformedProblem {
 		t.Errorf("Got wrong error type: expected %s, got %s",
 			prob.Type, probs.MalformedProblem)
@@ -118,7 +118,7 @@ func TestDNSValidationNotSane(t *testing.T) {
 	}
 
 	chall.ProvidedKeyAuthorization = "a"
-	_, prob = va.validateChallenge(ctx, dnsi("localhost"), chall)
+	_, prob = va.validateChallenge(ctx, dnsi("localhost"), 1, chall)
 	if prob.Type != probs.MalformedProblem {
 		t.Errorf("Got wrong error type: expected %s, got %s",
 			prob.Type, probs.MalformedProblem)
@@ -132,11 +132,20 @@ func TestDNSValidationNotSane(t *testing.T) {
 func TestDNSValidationServFail(t *testing.T) {
 	va, _ := setup(nil, 0, "", nil)
 
-	_, prob := va.validateChallenge(ctx, dnsi("servfail.com"), dnsChallenge())
+	_, prob := va.validateChallenge(ctx, dnsi("servfail.com"), 1, dnsChallenge())
 
 	test.AssertEquals(t, prob.Type, probs.DNSProblem)
 }
 
+func TestDNSValidationDNSSECBogus(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+
+	_, prob := va.validateChallenge(ctx, dnsi("dnssec-bogus.com"), 1, dnsChallenge())
+
+	test.AssertEquals(t, prob.Type, probs.DNSProblem)
+	test.AssertContains(t, prob.Detail, "DNSSEC validation failure")
+}
+
 func TestDNSValidationNoServer(t *testing.T) {
 	va, log := setup(nil, 0, "", nil)
 	va.dnsClient = bdns.NewTestDNSClientImpl(
@@ -147,7 +156,7 @@ func TestDNSValidationNoServer(t *testing.T) {
 		1,
 		log)
 
-	_, prob := va.validateChallenge(ctx, dnsi("localhost"), dnsChallenge())
+	_, prob := va.validateChallenge(ctx, dnsi("localhost"), 1, dnsChallenge())
 
 	test.AssertEquals(t, prob.Type, probs.DNSProblem)
 }
@@ -155,7 +164,7 @@ func TestDNSValidationNoServer(t *testing.T) {
 func TestDNSValidationOK(t *testing.T) {
 	va, _ := setup(nil, 0, "", nil)
 
-	_, prob := va.validateChallenge(ctx, dnsi("good-dns01.com"), dnsChallenge())
+	_, prob := va.validateChallenge(ctx, dnsi("good-dns01.com"), 1, dnsChallenge())
 
 	test.Assert(t, prob == nil, "Should be valid.")
 }
@@ -163,9 +172,57 @@ func TestDNSValidationOK(t *testing.T) {
 func TestDNSValidationNoAuthorityOK(t *testing.T) {
 	va, _ := setup(nil, 0, "", nil)
 
-	_, prob := va.validateChallenge(ctx, dnsi("no-authority-dns01.com"), dnsChallenge())
+	_, prob := va.validateChallenge(ctx, dnsi("no-authority-dns01.com"), 1, dnsChallenge())
+
+	test.Assert(t, prob == nil, "Should be valid.")
+}
+
+func TestDNSAccountLabel(t *testing.T) {
+	test.AssertEquals(t, dnsAccountLabel("http://boulder:4000/acme/reg/1"), "_o7v76rusep3qjnvt")
+}
+
+func TestDNSAccountValidationOK(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+
+	records, prob := va.validateChallenge(ctx, dnsi("good-dns-account01.com"), 1, createChallenge(core.ChallengeTypeDNSAccount01))
 
 	test.Assert(t, prob == nil, "Should be valid.")
+	test.AssertEquals(t, len(records), 1)
+	test.AssertEquals(t, records[0].Hostname, "good-dns-account01.com")
+}
+
+func TestDNSAccountValidationWrongAccount(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+
+	// The TXT record for good-dns-account01.com is scoped to account 1
+	_, prob := va.validateChallenge(ctx, dnsi("good-dns-account01.com"), 2, createChallenge(core.ChallengeTypeDNSAccount01))
+
+	test.AssertNotNil(t, prob, "Successful DNS validation for the wrong account")
+	test.AssertEquals(t, prob.Type, probs.UnauthorizedProblem)
+	test.AssertEquals(t, prob.Detail, "Incorrect TXT record \"hostname\" found at _lqyglbefwm3rlvzy._acme-challenge.good-dns-account01.com")
+
+	// A dns-01 record doesn't satisfy a dns-account-01 challenge
+	_, prob = va.validateChallenge(ctx, dnsi("good-dns01.com"), 1, createChallenge(core.ChallengeTypeDNSAccount01))
+	test.AssertNotNil(t, prob, "Successful DNS validation with a dns-01 record")
+}
+
+func TestDNSAccountValidationNotDNS(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+
+	_, prob := va.validateChallenge(ctx, identifier.IPIdentifier(net.ParseIP("127.0.0.1")), 1, createChallenge(core.ChallengeTypeDNSAccount01))
+
+	test.AssertNotNil(t, prob, "Successful DNS validation for an IP identifier")
+	test.AssertEquals(t, prob.Type, probs.MalformedProblem)
+}
+
+func TestDNSAccountValidationNoAccountURIPrefixes(t *testing.T) {
+	va, _ := setup(nil, 0, "", nil)
+	va.accountURIPrefixes = nil
+
+	_, prob := va.validateChallenge(ctx, dnsi("good-dns-account01.com"), 1, createChallenge(core.ChallengeTypeDNSAccount01))
+
+	test.AssertNotNil(t, prob, "Successful DNS validation without account URI prefixes")
+	test.AssertEquals(t, prob.Type, probs.ServerInternalProblem)
 }
 
 func TestAvailableAddresses(t *testing.T) {
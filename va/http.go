@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/letsencrypt/boulder/bdns"
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/iana"
@@ -172,6 +173,8 @@ type httpValidationTarget struct {
 	query string
 	// all of the IP addresses available for the host
 	available []net.IP
+	// the DNSSEC status of the answer the available IP addresses came from
+	dnssec bdns.DNSSECStatus
 	// the IP addresses that were tried for validation previously that were cycled
 	// out of cur by calls to nextIP()
 	tried []net.IP
@@ -214,7 +217,7 @@ func (va *ValidationAuthorityImpl) newHTTPValidationTarget(
 	path string,
 	query string) (*httpValidationTarget, error) {
 	// Resolve IP addresses for the hostname
-	addrs, err := va.getAddrs(ctx, host)
+	addrs, dnssec, err := va.getAddrs(ctx, host)
 	if err != nil {
 		return nil, err
 	}
@@ -225,6 +228,7 @@ func (va *ValidationAuthorityImpl) newHTTPValidationTarget(
 		path:      path,
 		query:     query,
 		available: addrs,
+		dnssec:    dnssec,
 	}
 
 	// Separate the addresses into the available v4 and v6 addresses
@@ -367,6 +371,7 @@ func (va *ValidationAuthorityImpl) setupHTTPValidation(
 		Hostname:          target.host,
 		Port:              strconv.Itoa(target.port),
 		AddressesResolved: target.available,
+		DNSSEC:            string(target.dnssec),
 		URL:               reqURL,
 	}
 
@@ -443,10 +448,15 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 		return nil, nil, err
 	}
 
-	// Create an initial GET Request
+	// Create an initial GET Request. An IPv6 address (from an IP identifier)
+	// must be bracketed in the URL and therefore in the Host header.
+	urlHost := host
+	if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		urlHost = "[" + host + "]"
+	}
 	initialURL := url.URL{
 		Scheme: "http",
-		Host:   host,
+		Host:   urlHost,
 		Path:   path,
 	}
 	initialReq, err := http.NewRequest("GET", initialURL.String(), nil)
@@ -624,9 +634,9 @@ func (va *ValidationAuthorityImpl) processHTTPValidation(
 }
 
 func (va *ValidationAuthorityImpl) validateHTTP01(ctx context.Context, ident identifier.ACMEIdentifier, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
-	if ident.Type != identifier.DNS {
-		va.log.Infof("Got non-DNS identifier for HTTP validation: %s", ident)
-		return nil, probs.Malformed("Identifier type for HTTP validation was not DNS")
+	if ident.Type != identifier.DNS && ident.Type != identifier.IP {
+		va.log.Infof("Got non-DNS, non-IP identifier for HTTP validation: %s", ident)
+		return nil, probs.Malformed("Identifier type for HTTP validation was not DNS or IP")
 	}
 
 	// Perform the fetch
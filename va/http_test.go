@@ -458,6 +458,24 @@ func TestSetupHTTPValidation(t *testing.T) {
 				timeout: va.singleDialTimeout,
 			},
 		},
+		{
+			Name:        "DNSSEC secure target",
+			InputTarget: mustTarget(t, "dnssec-secure.com", va.httpPort, "/yellow/brick/road"),
+			InputURL:    "http://dnssec-secure.com/yellow/brick/road",
+			ExpectedRecord: core.ValidationRecord{
+				Hostname:          "dnssec-secure.com",
+				Port:              strconv.Itoa(va.httpPort),
+				URL:               "http://dnssec-secure.com/yellow/brick/road",
+				AddressesResolved: []net.IP{net.ParseIP("127.0.0.1")},
+				AddressUsed:       net.ParseIP("127.0.0.1"),
+				DNSSEC:            "secure",
+			},
+			ExpectedDialer: &preresolvedDialer{
+				ip:      net.ParseIP("127.0.0.1"),
+				port:    va.httpPort,
+				timeout: va.singleDialTimeout,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1194,10 +1212,10 @@ func TestHTTP(t *testing.T) {
 	test.AssertEquals(t, len(matchedValidRedirect), 1)
 	test.AssertEquals(t, len(matchedMovedRedirect), 1)
 
-	ipIdentifier := identifier.ACMEIdentifier{Type: identifier.IdentifierType("ip"), Value: "127.0.0.1"}
-	_, prob = va.validateHTTP01(ctx, ipIdentifier, chall)
+	emailIdentifier := identifier.ACMEIdentifier{Type: identifier.IdentifierType("email"), Value: "admin@localhost.com"}
+	_, prob = va.validateHTTP01(ctx, emailIdentifier, chall)
 	if prob == nil {
-		t.Fatalf("IdentifierType IP shouldn't have worked.")
+		t.Fatalf("IdentifierType email shouldn't have worked.")
 	}
 	test.AssertEquals(t, prob.Type, probs.MalformedProblem)
 
@@ -1253,8 +1271,8 @@ type dnsMockReturnsUnroutable struct {
 	*bdns.MockDNSClient
 }
 
-func (mock dnsMockReturnsUnroutable) LookupHost(_ context.Context, hostname string) ([]net.IP, error) {
-	return []net.IP{net.ParseIP("198.51.100.1")}, nil
+func (mock dnsMockReturnsUnroutable) LookupHost(_ context.Context, hostname string) ([]net.IP, bdns.DNSSECStatus, error) {
+	return []net.IP{net.ParseIP("198.51.100.1")}, bdns.DNSSECUnchecked, nil
 }
 
 // TestHTTPDialTimeout tests that we give the proper "Timeout during connect"
@@ -1427,10 +1445,28 @@ func TestValidateHTTP(t *testing.T) {
 
 	va, _ := setup(hs, 0, "", nil)
 
-	_, prob := va.validateChallenge(ctx, dnsi("localhost"), chall)
+	_, prob := va.validateChallenge(ctx, dnsi("localhost"), 1, chall)
 	test.Assert(t, prob == nil, "validation failed")
 }
 
+func TestValidateHTTPIP(t *testing.T) {
+	chall := core.HTTPChallenge01("")
+	setChallengeToken(&chall, core.NewToken())
+
+	hs := httpSrv(t, chall.Token)
+	defer hs.Close()
+
+	va, _ := setup(hs, 0, "", nil)
+
+	// The IP identifier is used directly as the validation target, no DNS
+	// lookup is performed.
+	records, prob := va.validateChallenge(ctx, identifier.ACMEIdentifier{Type: identifier.IP, Value: "127.0.0.1"}, 1, chall)
+	test.Assert(t, prob == nil, fmt.Sprintf("validation failed: %v", prob))
+	test.AssertEquals(t, len(records), 1)
+	test.AssertEquals(t, records[0].Hostname, "127.0.0.1")
+	test.AssertEquals(t, records[0].AddressUsed.String(), "127.0.0.1")
+}
+
 func TestLimitedReader(t *testing.T) {
 	chall := core.HTTPChallenge01("")
 	setChallengeToken(&chall, core.NewToken())
@@ -1439,7 +1475,7 @@ func TestLimitedReader(t *testing.T) {
 	va, _ := setup(hs, 0, "", nil)
 	defer hs.Close()
 
-	_, prob := va.validateChallenge(ctx, dnsi("localhost"), chall)
+	_, prob := va.validateChallenge(ctx, dnsi("localhost"), 1, chall)
 
 	test.AssertEquals(t, prob.Type, probs.UnauthorizedProblem)
 	test.Assert(t, strings.HasPrefix(prob.Detail, "Invalid response from "),
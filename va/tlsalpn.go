@@ -16,6 +16,7 @@ import (
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/identifier"
 	"github.com/letsencrypt/boulder/probs"
+	"github.com/miekg/dns"
 )
 
 const (
@@ -39,7 +40,7 @@ var (
 )
 
 // certNames collects up all of a certificate's subject names (Subject CN and
-// Subject Alternate Names) and reduces them to a unique, sorted set, typically for an
+// Subject Alternate Names, including IP addresses) and reduces them to a unique, sorted set, typically for an
 // error message
 func certNames(cert *x509.Certificate) []string {
 	var names []string
@@ -47,7 +48,7 @@ func certNames(cert *x509.Certificate) []string {
 		names = append(names, cert.Subject.CommonName)
 	}
 	names = append(names, cert.DNSNames...)
-	names = core.UniqueLowerNames(names)
+	names = core.UniqueLowerNamesAndIPs(names, cert.IPAddresses)
 	for i, n := range names {
 		names[i] = replaceInvalidUTF8([]byte(n))
 	}
@@ -58,12 +59,13 @@ func (va *ValidationAuthorityImpl) tryGetTLSCerts(ctx context.Context,
 	identifier identifier.ACMEIdentifier, challenge core.Challenge,
 	tlsConfig *tls.Config) ([]*x509.Certificate, *tls.ConnectionState, []core.ValidationRecord, *probs.ProblemDetails) {
 
-	allAddrs, err := va.getAddrs(ctx, identifier.Value)
+	allAddrs, dnssec, err := va.getAddrs(ctx, identifier.Value)
 	validationRecords := []core.ValidationRecord{
 		{
 			Hostname:          identifier.Value,
 			AddressesResolved: allAddrs,
 			Port:              strconv.Itoa(va.tlsPort),
+			DNSSEC:            string(dnssec),
 		},
 	}
 	if err != nil {
@@ -174,15 +176,40 @@ func (va *ValidationAuthorityImpl) tlsDial(ctx context.Context, hostPort string,
 	return conn, nil
 }
 
-func (va *ValidationAuthorityImpl) validateTLSALPN01(ctx context.Context, identifier identifier.ACMEIdentifier, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
-	if identifier.Type != "dns" {
-		va.log.Info(fmt.Sprintf("Identifier type for TLS-ALPN-01 was not DNS: %s", identifier))
-		return nil, probs.Malformed("Identifier type for TLS-ALPN-01 was not DNS")
+// reverseName returns the reverse DNS name of an IP address, without the
+// trailing dot, as sent in the SNI extension when validating an IP identifier
+// with TLS-ALPN-01 (RFC 8738 Section 6).
+func reverseName(ip net.IP) (string, error) {
+	name, err := dns.ReverseAddr(ip.String())
+	if err != nil {
+		return "", err
 	}
+	return strings.TrimSuffix(name, "."), nil
+}
 
-	certs, cs, validationRecords, problem := va.tryGetTLSCerts(ctx, identifier, challenge, &tls.Config{
+func (va *ValidationAuthorityImpl) validateTLSALPN01(ctx context.Context, ident identifier.ACMEIdentifier, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
+	var ip net.IP
+	serverName := ident.Value
+	switch ident.Type {
+	case identifier.DNS:
+	case identifier.IP:
+		ip = net.ParseIP(ident.Value)
+		if ip == nil {
+			return nil, probs.Malformed("Invalid IP address identifier for TLS-ALPN-01: %q", ident.Value)
+		}
+		var err error
+		serverName, err = reverseName(ip)
+		if err != nil {
+			return nil, probs.Malformed("Invalid IP address identifier for TLS-ALPN-01: %q", ident.Value)
+		}
+	default:
+		va.log.Info(fmt.Sprintf("Identifier type for TLS-ALPN-01 was not DNS or IP: %s", ident))
+		return nil, probs.Malformed("Identifier type for TLS-ALPN-01 was not DNS or IP")
+	}
+
+	certs, cs, validationRecords, problem := va.tryGetTLSCerts(ctx, ident, challenge, &tls.Config{
 		NextProtos: []string{ACMETLS1Protocol},
-		ServerName: identifier.Value,
+		ServerName: serverName,
 	})
 	if problem != nil {
 		return validationRecords, problem
@@ -199,15 +226,22 @@ func (va *ValidationAuthorityImpl) validateTLSALPN01(ctx context.Context, identi
 
 	leafCert := certs[0]
 
-	// Verify SNI - certificate returned must be issued only for the domain we are verifying.
-	if len(leafCert.DNSNames) != 1 || !strings.EqualFold(leafCert.DNSNames[0], identifier.Value) {
+	// Verify SNI - certificate returned must be issued only for the domain or IP
+	// address we are verifying.
+	var matches bool
+	if ip != nil {
+		matches = len(leafCert.DNSNames) == 0 && len(leafCert.IPAddresses) == 1 && leafCert.IPAddresses[0].Equal(ip)
+	} else {
+		matches = len(leafCert.DNSNames) == 1 && strings.EqualFold(leafCert.DNSNames[0], ident.Value)
+	}
+	if !matches {
 		hostPort := net.JoinHostPort(validationRecords[0].AddressUsed.String(), validationRecords[0].Port)
 		names := certNames(leafCert)
 		errText := fmt.Sprintf(
 			"Incorrect validation certificate for %s challenge. "+
 				"Requested %s from %s. Received %d certificate(s), "+
 				"first certificate had names %q",
-			challenge.Type, identifier.Value, hostPort, len(certs), strings.Join(names, ", "))
+			challenge.Type, ident.Value, hostPort, len(certs), strings.Join(names, ", "))
 		return validationRecords, probs.Unauthorized(errText)
 	}
 
@@ -150,7 +150,7 @@ func TestTLSALPN01FailIP(t *testing.T) {
 		Value: net.JoinHostPort("127.0.0.1", strconv.Itoa(port)),
 	}, chall)
 	if prob == nil {
-		t.Fatalf("IdentifierType IP shouldn't have worked.")
+		t.Fatalf("IP identifier including a port shouldn't have worked.")
 	}
 	test.AssertEquals(t, prob.Type, probs.MalformedProblem)
 }
@@ -370,7 +370,7 @@ func TestTLSALPN01Success(t *testing.T) {
 
 	va, _ := setup(hs, 0, "", nil)
 
-	_, prob := va.validateChallenge(ctx, dnsi("localhost"), chall)
+	_, prob := va.validateChallenge(ctx, dnsi("localhost"), 1, chall)
 	if prob != nil {
 		t.Errorf("Validation failed: %v", prob)
 	}
@@ -382,13 +382,70 @@ func TestTLSALPN01Success(t *testing.T) {
 
 	va, _ = setup(hs, 0, "", nil)
 
-	_, prob = va.validateChallenge(ctx, dnsi("localhost"), chall)
+	_, prob = va.validateChallenge(ctx, dnsi("localhost"), 1, chall)
 	if prob != nil {
 		t.Errorf("Validation failed: %v", prob)
 	}
 	test.AssertEquals(t, test.CountCounterVec("oid", IdPeAcmeIdentifierV1Obsolete.String(), va.metrics.tlsALPNOIDCounter), 1)
 }
 
+// tlsalpn01SrvForIP returns a TLS-ALPN-01 server that expects the reverse DNS
+// name of ip in the SNI extension and answers with an acmeValidationV1
+// certificate whose SANs are the given IP addresses.
+func tlsalpn01SrvForIP(t *testing.T, chall core.Challenge, ip net.IP, sans ...net.IP) *httptest.Server {
+	template := tlsCertTemplate(nil)
+	template.IPAddresses = sans
+	shasum := sha256.Sum256([]byte(chall.ProvidedKeyAuthorization))
+	encHash, _ := asn1.Marshal(shasum[:])
+	template.ExtraExtensions = []pkix.Extension{{
+		Id:       IdPeAcmeIdentifier,
+		Critical: true,
+		Value:    encHash,
+	}}
+	certBytes, _ := x509.CreateCertificate(rand.Reader, template, template, &TheKey.PublicKey, &TheKey)
+	acmeCert := &tls.Certificate{
+		Certificate: [][]byte{certBytes},
+		PrivateKey:  &TheKey,
+	}
+	sni, err := reverseName(ip)
+	test.AssertNotError(t, err, "reverseName failed")
+	return tlsalpn01SrvWithCert(t, chall, IdPeAcmeIdentifier, []string{sni}, acmeCert, acmeCert, 0)
+}
+
+func TestTLSALPN01IP(t *testing.T) {
+	ip := net.ParseIP("127.0.0.1")
+	ident := identifier.ACMEIdentifier{Type: identifier.IP, Value: "127.0.0.1"}
+
+	chall := tlsalpnChallenge()
+	hs := tlsalpn01SrvForIP(t, chall, ip, ip)
+	va, _ := setup(hs, 0, "", nil)
+
+	records, prob := va.validateChallenge(ctx, ident, 1, chall)
+	test.Assert(t, prob == nil, fmt.Sprintf("validation failed: %v", prob))
+	test.AssertEquals(t, records[0].AddressUsed.String(), "127.0.0.1")
+	hs.Close()
+
+	// A certificate for a different IP address must not validate.
+	chall = tlsalpnChallenge()
+	hs = tlsalpn01SrvForIP(t, chall, ip, net.ParseIP("127.0.0.2"))
+	va, _ = setup(hs, 0, "", nil)
+
+	_, prob = va.validateChallenge(ctx, ident, 1, chall)
+	test.AssertNotNil(t, prob, "validation succeeded with the wrong IP address SAN")
+	test.AssertEquals(t, prob.Type, probs.UnauthorizedProblem)
+	hs.Close()
+}
+
+func TestReverseName(t *testing.T) {
+	name, err := reverseName(net.ParseIP("192.0.2.1"))
+	test.AssertNotError(t, err, "reverseName failed for IPv4")
+	test.AssertEquals(t, name, "1.2.0.192.in-addr.arpa")
+
+	name, err = reverseName(net.ParseIP("2001:db8::1"))
+	test.AssertNotError(t, err, "reverseName failed for IPv6")
+	test.AssertEquals(t, name, "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa")
+}
+
 func TestValidateTLSALPN01BadChallenge(t *testing.T) {
 	chall := tlsalpnChallenge()
 	chall2 := chall
@@ -528,7 +585,7 @@ func TestTLSALPN01TLS13(t *testing.T) {
 
 	va, _ := setup(hs, 0, "", nil)
 
-	_, prob := va.validateChallenge(ctx, dnsi("localhost"), chall)
+	_, prob := va.validateChallenge(ctx, dnsi("localhost"), 1, chall)
 	// Validation should not fail
 	if prob != nil {
 		t.Errorf("Validation failed: %v", prob)
@@ -13,6 +13,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/letsencrypt/boulder/canceled"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
+	corepb "github.com/letsencrypt/boulder/core/proto"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/features"
 	bgrpc "github.com/letsencrypt/boulder/grpc"
@@ -29,6 +31,7 @@ import (
 	"github.com/letsencrypt/boulder/metrics"
 	"github.com/letsencrypt/boulder/probs"
 	vapb "github.com/letsencrypt/boulder/va/proto"
+	"github.com/miekg/dns"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -66,11 +69,19 @@ var (
 	h2SettingsFrameErrRegex = regexp.MustCompile(`(?:net\/http\: HTTP\/1\.x transport connection broken: )?malformed HTTP response \"\\x00\\x00\\x[a-f0-9]{2}\\x04\\x00\\x00\\x00\\x00\\x00.*"`)
 )
 
-// RemoteVA wraps the core.ValidationAuthority interface and adds a field containing the address
+// RemoteClients combines the gRPC clients for the VA and CAA services exposed
+// by a remote VA, so that both validations and CAA checks can be performed
+// from the remote VA's vantage point.
+type RemoteClients interface {
+	vapb.VAClient
+	vapb.CAAClient
+}
+
+// RemoteVA wraps the RemoteClients interface and adds a field containing the address
 // of the remote gRPC server since the interface (and the underlying gRPC client) doesn't
 // provide a way to extract this metadata which is useful for debugging gRPC connection issues.
 type RemoteVA struct {
-	vapb.VAClient
+	RemoteClients
 	Address string
 }
 
@@ -78,8 +89,11 @@ type vaMetrics struct {
 	validationTime                      *prometheus.HistogramVec
 	localValidationTime                 *prometheus.HistogramVec
 	remoteValidationTime                *prometheus.HistogramVec
+	remoteCAACheckTime                  *prometheus.HistogramVec
 	remoteValidationFailures            prometheus.Counter
+	remoteCAAFailures                   prometheus.Counter
 	prospectiveRemoteValidationFailures prometheus.Counter
+	prospectiveRemoteCAAFailures        prometheus.Counter
 	tlsALPNOIDCounter                   *prometheus.CounterVec
 	http01Fallbacks                     prometheus.Counter
 	http01Redirects                     prometheus.Counter
@@ -112,18 +126,38 @@ func initMetrics(stats prometheus.Registerer) *vaMetrics {
 		},
 		[]string{"type", "result"})
 	stats.MustRegister(remoteValidationTime)
+	remoteCAACheckTime := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "remote_caa_check_time",
+			Help:    "Time taken to remotely check CAA",
+			Buckets: metrics.InternetFacingBuckets,
+		},
+		[]string{"result"})
+	stats.MustRegister(remoteCAACheckTime)
 	remoteValidationFailures := prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Name: "remote_validation_failures",
 			Help: "Number of validations failed due to remote VAs returning failure when consensus is enforced",
 		})
 	stats.MustRegister(remoteValidationFailures)
+	remoteCAAFailures := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "remote_caa_failures",
+			Help: "Number of CAA checks failed due to remote VAs returning failure when consensus is enforced",
+		})
+	stats.MustRegister(remoteCAAFailures)
 	prospectiveRemoteValidationFailures := prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Name: "prospective_remote_validation_failures",
 			Help: "Number of validations that would have failed due to remote VAs returning failure if consesus were enforced",
 		})
 	stats.MustRegister(prospectiveRemoteValidationFailures)
+	prospectiveRemoteCAAFailures := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "prospective_remote_caa_failures",
+			Help: "Number of CAA checks that would have failed due to remote VAs returning failure if consensus were enforced",
+		})
+	stats.MustRegister(prospectiveRemoteCAAFailures)
 	tlsALPNOIDCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "tls_alpn_oid_usage",
@@ -158,9 +192,12 @@ func initMetrics(stats prometheus.Registerer) *vaMetrics {
 	return &vaMetrics{
 		validationTime:                      validationTime,
 		remoteValidationTime:                remoteValidationTime,
+		remoteCAACheckTime:                  remoteCAACheckTime,
 		localValidationTime:                 localValidationTime,
 		remoteValidationFailures:            remoteValidationFailures,
+		remoteCAAFailures:                   remoteCAAFailures,
 		prospectiveRemoteValidationFailures: prospectiveRemoteValidationFailures,
+		prospectiveRemoteCAAFailures:        prospectiveRemoteCAAFailures,
 		tlsALPNOIDCounter:                   tlsALPNOIDCounter,
 		http01Fallbacks:                     http01Fallbacks,
 		http01Redirects:                     http01Redirects,
@@ -308,13 +345,14 @@ func detailedError(err error) *probs.ProblemDetails {
 // validate performs a challenge validation and, in parallel,
 // checks CAA and GSB for the identifier. If any of those steps fails, it
 // returns a ProblemDetails plus the validation records created during the
-// validation attempt.
+// validation attempt. The CAA records checked are returned in presentation
+// format, unless the challenge failed before the CAA check completed.
 func (va *ValidationAuthorityImpl) validate(
 	ctx context.Context,
 	identifier identifier.ACMEIdentifier,
 	regid int64,
 	challenge core.Challenge,
-) ([]core.ValidationRecord, *probs.ProblemDetails) {
+) ([]core.ValidationRecord, []string, *probs.ProblemDetails) {
 
 	// If the identifier is a wildcard domain we need to validate the base
 	// domain by removing the "*." wildcard prefix. We create a separate
@@ -328,30 +366,40 @@ func (va *ValidationAuthorityImpl) validate(
 	// va.checkCAA accepts wildcard identifiers and handles them appropriately so
 	// we can dispatch `checkCAA` with the provided `identifier` instead of
 	// `baseIdentifier`
-	ch := make(chan *probs.ProblemDetails, 1)
+	type caaCheck struct {
+		records []*dns.CAA
+		prob    *probs.ProblemDetails
+	}
+	ch := make(chan caaCheck, 1)
 	go func() {
 		params := &caaParams{
 			accountURIID:     regid,
 			validationMethod: string(challenge.Type),
 		}
-		ch <- va.checkCAA(ctx, identifier, params)
+		records, prob := va.checkCAAWithRecords(ctx, identifier, params)
+		ch <- caaCheck{records, prob}
 	}()
 
 	// TODO(#1292): send into another goroutine
-	validationRecords, err := va.validateChallenge(ctx, baseIdentifier, challenge)
+	validationRecords, err := va.validateChallenge(ctx, baseIdentifier, regid, challenge)
 	if err != nil {
-		return validationRecords, err
+		return validationRecords, nil, err
 	}
 
+	var caaRecords []string
 	for i := 0; i < cap(ch); i++ {
-		if extraProblem := <-ch; extraProblem != nil {
-			return validationRecords, extraProblem
+		result := <-ch
+		for _, record := range result.records {
+			caaRecords = append(caaRecords, record.String())
+		}
+		if result.prob != nil {
+			return validationRecords, caaRecords, result.prob
 		}
 	}
-	return validationRecords, nil
+	return validationRecords, caaRecords, nil
 }
 
-func (va *ValidationAuthorityImpl) validateChallenge(ctx context.Context, identifier identifier.ACMEIdentifier, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
+func (va *ValidationAuthorityImpl) validateChallenge(ctx context.Context, identifier identifier.ACMEIdentifier, regid int64, challenge core.Challenge) ([]core.ValidationRecord, *probs.ProblemDetails) {
 	if err := challenge.CheckConsistencyForValidation(); err != nil {
 		return nil, probs.Malformed("Challenge failed consistency check: %s", err)
 	}
@@ -362,6 +410,8 @@ func (va *ValidationAuthorityImpl) validateChallenge(ctx context.Context, identi
 		return va.validateDNS01(ctx, identifier, challenge)
 	case core.ChallengeTypeTLSALPN01:
 		return va.validateTLSALPN01(ctx, identifier, challenge)
+	case core.ChallengeTypeDNSAccount01:
+		return va.validateDNSAccount01(ctx, identifier, regid, challenge)
 	}
 	return nil, probs.Malformed("invalid challenge type %s", challenge.Type)
 }
@@ -373,11 +423,13 @@ func (va *ValidationAuthorityImpl) validateChallenge(ctx context.Context, identi
 // `PerformValidation` RPC is nil or a nil `ProblemDetails` instance it is
 // written directly to the `results` chan. If the err is a cancelled error it is
 // treated as a nil error. Otherwise the error/problem is written to the results
-// channel as-is.
+// channel as-is. Each result is also added to `record` before it is written to
+// the channel.
 func (va *ValidationAuthorityImpl) performRemoteValidation(
 	ctx context.Context,
 	req *vapb.PerformValidationRequest,
-	results chan *remoteValidationResult) {
+	results chan *remoteValidationResult,
+	record *remoteResultsRecord) {
 	for _, i := range rand.Perm(len(va.remoteVAs)) {
 		remoteVA := va.remoteVAs[i]
 		go func(rva RemoteVA, index int) {
@@ -395,24 +447,71 @@ func (va *ValidationAuthorityImpl) performRemoteValidation(
 				// This is a real error, not just a problem with the validation.
 				va.log.Errf("Remote VA %q.PerformValidation failed: %s", rva.Address, err)
 				result.Problem = probs.ServerInternal("Remote PerformValidation RPC failed")
-			} else if res.Problems != nil {
-				prob, err := bgrpc.PBToProblemDetails(res.Problems)
-				if err != nil {
-					va.log.Infof("Remote VA %q.PerformValidation returned malformed problem: %s", rva.Address, err)
-					result.Problem = probs.ServerInternal(
-						fmt.Sprintf("Remote PerformValidation RPC returned malformed result: %s", err))
-				} else {
-					va.log.Infof("Remote VA %q.PerformValidation returned problem: %s", rva.Address, prob)
-					result.Problem = prob
+			} else {
+				for _, recordPB := range res.Records {
+					record, err := bgrpc.PBToValidationRecord(recordPB)
+					if err != nil {
+						va.log.Infof("Remote VA %q.PerformValidation returned malformed record: %s", rva.Address, err)
+						break
+					}
+					result.Records = append(result.Records, record)
+				}
+				if res.Problems != nil {
+					prob, err := bgrpc.PBToProblemDetails(res.Problems)
+					if err != nil {
+						va.log.Infof("Remote VA %q.PerformValidation returned malformed problem: %s", rva.Address, err)
+						result.Problem = probs.ServerInternal(
+							fmt.Sprintf("Remote PerformValidation RPC returned malformed result: %s", err))
+					} else {
+						va.log.Infof("Remote VA %q.PerformValidation returned problem: %s", rva.Address, prob)
+						result.Problem = prob
+					}
 				}
 			}
+			record.add(result)
 			results <- result
 		}(remoteVA, i)
 	}
 }
 
+// remoteOperation describes what the remote VAs were asked to do, so that
+// processRemoteResults can report their results in its metrics and problems.
+type remoteOperation struct {
+	// timing observes how long the remote VAs took, labelled by result.
+	timing prometheus.ObserverVec
+	// prospectiveFailures counts operations that would have failed due to the
+	// remote VAs if their results were enforced.
+	prospectiveFailures prometheus.Counter
+	// name describes the operation in problems, which start "During
+	// secondary <name>: ".
+	name string
+	// rpc is the name of the RPC sent to the remote VAs.
+	rpc string
+}
+
+// remoteValidation returns the remoteOperation for validating a challenge of
+// challengeType.
+func (va *ValidationAuthorityImpl) remoteValidation(challengeType string) remoteOperation {
+	return remoteOperation{
+		timing:              va.metrics.remoteValidationTime.MustCurryWith(prometheus.Labels{"type": challengeType}),
+		prospectiveFailures: va.metrics.prospectiveRemoteValidationFailures,
+		name:                "validation",
+		rpc:                 "PerformValidation",
+	}
+}
+
+// remoteCAACheck returns the remoteOperation for checking CAA.
+func (va *ValidationAuthorityImpl) remoteCAACheck() remoteOperation {
+	return remoteOperation{
+		timing:              va.metrics.remoteCAACheckTime,
+		prospectiveFailures: va.metrics.prospectiveRemoteCAAFailures,
+		name:                "CAA checking",
+		rpc:                 "IsCAAValid",
+	}
+}
+
 // processRemoteResults evaluates a primary VA result, and a channel of remote
-// VA problems to produce a single overall validation result based on configured
+// VA problems to produce a single overall result of op based on configured
 // feature flags. The overall result is calculated based on the VA's configured
 // `maxRemoteFailures` value.
 //
@@ -431,6 +530,7 @@ func (va *ValidationAuthorityImpl) processRemoteResults(
 	domain string,
 	acctID int64,
 	challengeType string,
+	op remoteOperation,
 	primaryResult *probs.ProblemDetails,
 	remoteResultsChan chan *remoteValidationResult,
 	numRemoteVAs int) *probs.ProblemDetails {
@@ -439,8 +539,7 @@ func (va *ValidationAuthorityImpl) processRemoteResults(
 	start := va.clk.Now()
 
 	defer func() {
-		va.metrics.remoteValidationTime.With(prometheus.Labels{
-			"type":   challengeType,
+		op.timing.With(prometheus.Labels{
 			"result": state,
 		}).Observe(va.clk.Since(start).Seconds())
 	}()
@@ -477,7 +576,7 @@ func (va *ValidationAuthorityImpl) processRemoteResults(
 				return nil
 			} else if bad > va.maxRemoteFailures {
 				modifiedProblem := *result.Problem
-				modifiedProblem.Detail = "During secondary validation: " + firstProb.Detail
+				modifiedProblem.Detail = fmt.Sprintf("During secondary %s: %s", op.name, firstProb.Detail)
 				return &modifiedProblem
 			}
 		}
@@ -496,6 +595,7 @@ func (va *ValidationAuthorityImpl) processRemoteResults(
 		domain,
 		acctID,
 		challengeType,
+		op,
 		primaryResult,
 		remoteResults)
 
@@ -505,13 +605,13 @@ func (va *ValidationAuthorityImpl) processRemoteResults(
 		return nil
 	} else if bad > va.maxRemoteFailures {
 		modifiedProblem := *firstProb
-		modifiedProblem.Detail = "During secondary validation: " + firstProb.Detail
+		modifiedProblem.Detail = fmt.Sprintf("During secondary %s: %s", op.name, firstProb.Detail)
 		return &modifiedProblem
 	}
 
 	// This condition should not occur - it indicates the good/bad counts didn't
 	// meet either the required threshold or the maxRemoteFailures threshold.
-	return probs.ServerInternal("Too few remote PerformValidation RPC results")
+	return probs.ServerInternal(fmt.Sprintf("Too few remote %s RPC results", op.rpc))
 }
 
 // logRemoteValidationDifferentials is called by `processRemoteResults` when the
@@ -521,6 +621,7 @@ func (va *ValidationAuthorityImpl) logRemoteValidationDifferentials(
 	domain string,
 	acctID int64,
 	challengeType string,
+	op remoteOperation,
 	primaryResult *probs.ProblemDetails,
 	remoteResults []*remoteValidationResult) {
 
@@ -545,10 +646,10 @@ func (va *ValidationAuthorityImpl) logRemoteValidationDifferentials(
 	}
 
 	// If the primary result was OK and there were more failures than the allowed
-	// threshold increment a stat that indicates this overall validation will have
-	// failed if features.EnforceMultiVA is enabled.
+	// threshold increment a stat that indicates this overall operation will have
+	// failed if the remote results were enforced.
 	if primaryResult == nil && len(failures) > va.maxRemoteFailures {
-		va.metrics.prospectiveRemoteValidationFailures.Inc()
+		op.prospectiveFailures.Inc()
 	}
 
 	logOb := struct {
@@ -581,10 +682,35 @@ func (va *ValidationAuthorityImpl) logRemoteValidationDifferentials(
 }
 
 // remoteValidationResult is a struct that combines a problem details instance
-// (that may be nil) with the remote VA hostname that produced it.
+// (that may be nil) with the remote VA hostname that produced it, and the
+// validation records it returned.
 type remoteValidationResult struct {
 	VAHostname string
 	Problem    *probs.ProblemDetails
+	Records    []core.ValidationRecord `json:"-"`
+}
+
+// remoteResultsRecord records the result of each remote VA as it arrives.
+// PerformValidation returns every result recorded by the time it finishes,
+// whether it waited for the remote VAs, returned early once the overall result
+// was decided, or left them to be processed in the background.
+type remoteResultsRecord struct {
+	mu      sync.Mutex
+	results []*remoteValidationResult
+}
+
+// add records a remote VA's result.
+func (r *remoteResultsRecord) add(result *remoteValidationResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, result)
+}
+
+// seen returns the remote VA results recorded so far.
+func (r *remoteResultsRecord) seen() []*remoteValidationResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*remoteValidationResult(nil), r.results...)
 }
 
 // PerformValidation validates the challenge for the domain in the request.
@@ -602,9 +728,10 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 	vStart := va.clk.Now()
 
 	var remoteResults chan *remoteValidationResult
+	var remoteRecord remoteResultsRecord
 	if remoteVACount := len(va.remoteVAs); remoteVACount > 0 {
 		remoteResults = make(chan *remoteValidationResult, remoteVACount)
-		go va.performRemoteValidation(ctx, req, remoteResults)
+		go va.performRemoteValidation(ctx, req, remoteResults, &remoteRecord)
 	}
 
 	challenge, err := bgrpc.PBToChallenge(req.Challenge)
@@ -612,7 +739,7 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 		return nil, probs.ServerInternal("Challenge failed to deserialize")
 	}
 
-	records, prob := va.validate(ctx, identifier.DNSIdentifier(req.Domain), req.Authz.RegID, challenge)
+	records, caaRecords, prob := va.validate(ctx, identifier.ForName(req.Domain), req.Authz.RegID, challenge)
 	challenge.ValidationRecord = records
 	localValidationLatency := time.Since(vStart)
 
@@ -637,6 +764,7 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 					req.Domain,
 					req.Authz.RegID,
 					string(challenge.Type),
+					va.remoteValidation(string(challenge.Type)),
 					prob,
 					remoteResults,
 					len(va.remoteVAs))
@@ -650,6 +778,7 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 				req.Domain,
 				req.Authz.RegID,
 				string(challenge.Type),
+				va.remoteValidation(string(challenge.Type)),
 				prob,
 				remoteResults,
 				len(va.remoteVAs))
@@ -688,5 +817,25 @@ func (va *ValidationAuthorityImpl) PerformValidation(ctx context.Context, req *v
 
 	va.log.AuditObject("Validation result", logEvent)
 
-	return bgrpc.ValidationResultToPB(records, prob)
+	result, err := bgrpc.ValidationResultToPB(records, prob)
+	if err != nil {
+		return nil, err
+	}
+	result.CaaRecords = caaRecords
+	for _, remote := range remoteRecord.seen() {
+		remotePB := &corepb.RemoteValidationResult{VaHostname: &remote.VAHostname}
+		for _, record := range remote.Records {
+			recordPB, err := bgrpc.ValidationRecordToPB(record)
+			if err != nil {
+				return nil, err
+			}
+			remotePB.ValidationRecords = append(remotePB.ValidationRecords, recordPB)
+		}
+		remotePB.Problem, err = bgrpc.ProblemDetailsToPB(remote.Problem)
+		if err != nil {
+			return nil, err
+		}
+		result.RemoteResults = append(result.RemoteResults, remotePB)
+	}
+	return result, nil
 }
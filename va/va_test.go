@@ -154,7 +154,7 @@ func setup(srv *httptest.Server, maxRemoteFailures int, userAgent string, remote
 	return va, logger
 }
 
-func setupRemote(srv *httptest.Server, maxRemoteFailures int, userAgent string) (vapb.VAClient, *blog.Mock) {
+func setupRemote(srv *httptest.Server, maxRemoteFailures int, userAgent string) (RemoteClients, *blog.Mock) {
 	innerVA, mockLog := setup(srv, maxRemoteFailures, userAgent, nil)
 	res := localRemoteVA{
 		remote: *innerVA,
@@ -201,19 +201,23 @@ func httpMultiSrv(t *testing.T, token string, allowedUAs map[string]bool) *multi
 }
 
 // cancelledVA is a mock that always returns context.Canceled for
-// PerformValidation calls
+// PerformValidation and IsCAAValid calls
 type cancelledVA struct{}
 
 func (v cancelledVA) PerformValidation(_ context.Context, _ *vapb.PerformValidationRequest, _ ...grpc.CallOption) (*vapb.ValidationResult, error) {
 	return nil, context.Canceled
 }
 
+func (v cancelledVA) IsCAAValid(_ context.Context, _ *vapb.IsCAAValidRequest, _ ...grpc.CallOption) (*vapb.IsCAAValidResponse, error) {
+	return nil, context.Canceled
+}
+
 // brokenRemoteVA is a mock for the core.ValidationAuthority interface mocked to
 // always return errors.
 type brokenRemoteVA struct{}
 
 // errBrokenRemoteVA is the error returned by a brokenRemoteVA's
-// PerformValidation and IsSafeDomain functions.
+// PerformValidation, IsCAAValid and IsSafeDomain functions.
 var errBrokenRemoteVA = errors.New("brokenRemoteVA is broken")
 
 // PerformValidation returns errBrokenRemoteVA unconditionally
@@ -221,7 +225,12 @@ func (b brokenRemoteVA) PerformValidation(_ context.Context, _ *vapb.PerformVali
 	return nil, errBrokenRemoteVA
 }
 
-// localRemoteVA is a wrapper which fulfills the VAClient interface, but then
+// IsCAAValid returns errBrokenRemoteVA unconditionally
+func (b brokenRemoteVA) IsCAAValid(_ context.Context, _ *vapb.IsCAAValidRequest, _ ...grpc.CallOption) (*vapb.IsCAAValidResponse, error) {
+	return nil, errBrokenRemoteVA
+}
+
+// localRemoteVA is a wrapper which fulfills the RemoteClients interface, but then
 // forwards requests directly to its inner ValidationAuthorityImpl rather than
 // over the network. This lets a local in-memory mock VA act like a remote VA.
 type localRemoteVA struct {
@@ -232,10 +241,14 @@ func (lrva localRemoteVA) PerformValidation(ctx context.Context, req *vapb.Perfo
 	return lrva.remote.PerformValidation(ctx, req)
 }
 
+func (lrva localRemoteVA) IsCAAValid(ctx context.Context, req *vapb.IsCAAValidRequest, _ ...grpc.CallOption) (*vapb.IsCAAValidResponse, error) {
+	return lrva.remote.IsCAAValid(ctx, req)
+}
+
 func TestValidateMalformedChallenge(t *testing.T) {
 	va, _ := setup(nil, 0, "", nil)
 
-	_, prob := va.validateChallenge(ctx, dnsi("example.com"), createChallenge("fake-type-01"))
+	_, prob := va.validateChallenge(ctx, dnsi("example.com"), 1, createChallenge("fake-type-01"))
 
 	test.AssertEquals(t, prob.Type, probs.MalformedProblem)
 }
@@ -518,6 +531,15 @@ func TestMultiVA(t *testing.T) {
 				test.AssertEquals(t, *res.Problems.Detail, string(tc.ExpectedProb.Detail))
 			}
 
+			// When multi VA is enforced the remote results that were considered
+			// should be returned alongside the local result.
+			if tc.Features["EnforceMultiVA"] && res.Problems == nil {
+				test.Assert(t, len(res.RemoteResults) > 0, "expected remote results")
+				for _, remote := range res.RemoteResults {
+					test.Assert(t, remote.VaHostname != nil && *remote.VaHostname != "", "remote result missing VA hostname")
+				}
+			}
+
 			if tc.ExpectedLog != "" {
 				lines := mockLog.GetAllMatching(tc.ExpectedLog)
 				test.AssertEquals(t, len(lines), 1)
@@ -526,6 +548,34 @@ func TestMultiVA(t *testing.T) {
 	}
 }
 
+func TestPerformRemoteValidationRecordsResults(t *testing.T) {
+	remoteVAs := []RemoteVA{
+		{brokenRemoteVA{}, "broken"},
+		{cancelledVA{}, "cancelled"},
+	}
+	va, _ := setup(nil, 0, "", remoteVAs)
+
+	results := make(chan *remoteValidationResult, len(remoteVAs))
+	var record remoteResultsRecord
+	va.performRemoteValidation(ctx, createValidationRequest("localhost", core.ChallengeTypeHTTP01), results, &record)
+
+	// Every result sent on the channel has already been recorded, whether or not
+	// the channel is read
+	<-results
+	<-results
+	seen := record.seen()
+	test.AssertEquals(t, len(seen), 2)
+	problems := map[string]string{}
+	for _, result := range seen {
+		test.Assert(t, result.Problem != nil, "expected a problem from the remote VA")
+		problems[result.VAHostname] = result.Problem.Detail
+	}
+	test.AssertDeepEquals(t, problems, map[string]string{
+		"broken":    "Remote PerformValidation RPC failed",
+		"cancelled": "Remote PerformValidation RPC canceled",
+	})
+}
+
 func TestMultiVAEarlyReturn(t *testing.T) {
 	const (
 		remoteUA1 = "remote 1"
@@ -761,7 +811,7 @@ func TestLogRemoteValidationDifferentials(t *testing.T) {
 			mockLog.Clear()
 
 			localVA.logRemoteValidationDifferentials(
-				"example.com", 1999, "blorpus-01", tc.primaryResult, tc.remoteProbs)
+				"example.com", 1999, "blorpus-01", localVA.remoteValidation("blorpus-01"), tc.primaryResult, tc.remoteProbs)
 
 			lines := mockLog.GetAllMatching("remoteVADifferentials JSON=.*")
 			if tc.expectedLog != "" {
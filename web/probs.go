@@ -19,6 +19,7 @@ func problemDetailsForBoulderError(err *berrors.BoulderError, msg string) *probs
 		outProb = probs.NotFound(fmt.Sprintf("%s :: %s", msg, err))
 	case berrors.RateLimit:
 		outProb = probs.RateLimited(fmt.Sprintf("%s :: %s", msg, err))
+		outProb.RetryAfter = err.RetryAfter
 	case berrors.InternalServer:
 		// Internal server error messages may include sensitive data, so we do
 		// not include it.
@@ -41,6 +42,10 @@ func problemDetailsForBoulderError(err *berrors.BoulderError, msg string) *probs
 		outProb = probs.BadPublicKey(fmt.Sprintf("%s :: %s", msg, err))
 	case berrors.BadCSR:
 		outProb = probs.BadCSR(fmt.Sprintf("%s :: %s", msg, err))
+	case berrors.AutoRenewalCanceled:
+		outProb = probs.AutoRenewalCanceled(fmt.Sprintf("%s :: %s", msg, err))
+	case berrors.AutoRenewalCancellationInvalid:
+		outProb = probs.AutoRenewalCancellationInvalid(fmt.Sprintf("%s :: %s", msg, err))
 	default:
 		// Internal server error messages may include sensitive data, so we do
 		// not include it.
@@ -37,6 +37,8 @@ func TestProblemDetailsFromError(t *testing.T) {
 		{berrors.RateLimitError(detailMsg), 429, probs.RateLimitedProblem, fullDetail + ": see https://letsencrypt.org/docs/rate-limits/"},
 		{berrors.InvalidEmailError(detailMsg), 400, probs.InvalidEmailProblem, fullDetail},
 		{berrors.RejectedIdentifierError(detailMsg), 400, probs.RejectedIdentifierProblem, fullDetail},
+		{berrors.AutoRenewalCanceledError(detailMsg), 403, probs.AutoRenewalCanceledProblem, fullDetail},
+		{berrors.AutoRenewalCancellationInvalidError(detailMsg), 400, probs.AutoRenewalCancellationInvalidProblem, fullDetail},
 	}
 	for _, c := range testCases {
 		p := ProblemDetailsForError(c.err, errMsg)
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -19,6 +21,7 @@ import (
 //  - If the ProblemDetails provided is a ServerInternalProblem, audit logs the
 //    internal error.
 //  - Prefixes the Type field of the ProblemDetails with a namespace.
+//  - Sets a Retry-After header if the ProblemDetails has a RetryAfter.
 //  - Sends an HTTP response containing the error and an error code to the user.
 func SendError(
 	log blog.Logger,
@@ -71,6 +74,12 @@ func SendError(
 
 	// Write the JSON problem response
 	response.Header().Set("Content-Type", "application/problem+json")
+	if prob.RetryAfter > 0 {
+		// Round up, so that a client retrying exactly when told to isn't
+		// refused again.
+		seconds := int64(math.Ceil(prob.RetryAfter.Seconds()))
+		response.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	}
 	response.WriteHeader(code)
 	response.Write(problemDoc)
 }
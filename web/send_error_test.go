@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/identifier"
@@ -94,3 +95,22 @@ func TestSendErrorSubProbLogging(t *testing.T) {
 
 	test.AssertEquals(t, logEvent.Error, `400 :: malformed :: dfoop :: bad ["example.com :: malformed :: dfoop :: nop", "what about example.com :: malformed :: dfoop :: nah"]`)
 }
+
+func TestSendErrorRetryAfter(t *testing.T) {
+	rw := httptest.NewRecorder()
+	prob := ProblemDetailsForError(
+		berrors.RateLimitError("slow down").(*berrors.BoulderError).WithRetryAfter(90*time.Second+time.Millisecond),
+		"dfoop",
+	)
+	SendError(log.NewMock(), "namespace:test:", rw, &RequestEvent{}, prob, nil)
+	test.AssertEquals(t, rw.Code, 429)
+	// Retry-After is rounded up to a whole second
+	test.AssertEquals(t, rw.Header().Get("Retry-After"), "91")
+	test.AssertNotContains(t, rw.Body.String(), "etry")
+
+	// Errors without a RetryAfter don't have the header
+	rw = httptest.NewRecorder()
+	prob = ProblemDetailsForError(berrors.RateLimitError("slow down"), "dfoop")
+	SendError(log.NewMock(), "namespace:test:", rw, &RequestEvent{}, prob, nil)
+	test.AssertEquals(t, rw.Header().Get("Retry-After"), "")
+}
@@ -253,6 +253,34 @@ func (ra *MockRegistrationAuthority) FinalizeOrder(ctx context.Context, _ *rapb.
 	return nil, nil
 }
 
+func (ra *MockRegistrationAuthority) NewPreAuthorization(ctx context.Context, _ *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) CancelAutoRenewal(ctx context.Context, _ *rapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) AddRateLimitOverride(ctx context.Context, _ *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) GetRateLimitOverrides(ctx context.Context, _ *rapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) ExpireRateLimitOverride(ctx context.Context, _ *rapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) ReviewHeldOrder(ctx context.Context, _ *rapb.ReviewHeldOrderRequest) (*corepb.Order, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) RateLimitStatus(ctx context.Context, _ *rapb.RateLimitStatusRequest) (*rapb.RateLimitStatusResponse, error) {
+	return nil, nil
+}
+
 type mockPA struct{}
 
 func (pa *mockPA) ChallengesFor(identifier identifier.ACMEIdentifier) (challenges []core.Challenge, err error) {
@@ -271,6 +299,14 @@ func (pa *mockPA) ChallengeTypeEnabled(t core.AcmeChallenge) bool {
 	return true
 }
 
+func (pa *mockPA) ChallengeTypeAllowed(t core.AcmeChallenge, ident identifier.ACMEIdentifier) bool {
+	return true
+}
+
+func (pa *mockPA) ReviewRequired(ident identifier.ACMEIdentifier) bool {
+	return false
+}
+
 func makeBody(s string) io.ReadCloser {
 	return ioutil.NopCloser(strings.NewReader(s))
 }
@@ -909,6 +945,7 @@ func TestIssueCertificate(t *testing.T) {
 		nil,
 		noopCAA{},
 		0,
+		time.Minute,
 		ctp,
 		nil,
 		nil,
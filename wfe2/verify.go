@@ -3,6 +3,7 @@ package wfe2
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"encoding/json"
 	"errors"
@@ -14,12 +15,14 @@ import (
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
+	xed25519 "golang.org/x/crypto/ed25519"
 	"gopkg.in/square/go-jose.v2"
 
 	"github.com/letsencrypt/boulder/core"
 	berrors "github.com/letsencrypt/boulder/errors"
 	"github.com/letsencrypt/boulder/nonce"
 	"github.com/letsencrypt/boulder/probs"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/web"
 )
 
@@ -43,8 +46,10 @@ func sigAlgorithmForKey(key *jose.JSONWebKey) (jose.SignatureAlgorithm, error) {
 		case "P-521":
 			return jose.ES512, nil
 		}
+	case ed25519.PublicKey, xed25519.PublicKey:
+		return jose.EdDSA, nil
 	}
-	return "", errors.New("JWK contains unsupported key type (expected RSA, or ECDSA P-256, P-384, or P-521")
+	return "", errors.New("JWK contains unsupported key type (expected RSA, ECDSA P-256, P-384, or P-521, or Ed25519")
 }
 
 var supportedAlgs = map[string]bool{
@@ -52,6 +57,7 @@ var supportedAlgs = map[string]bool{
 	string(jose.ES256): true,
 	string(jose.ES384): true,
 	string(jose.ES512): true,
+	string(jose.EdDSA): true,
 }
 
 // Check that (1) there is a suitable algorithm for the provided key based on its
@@ -63,7 +69,7 @@ func checkAlgorithm(key *jose.JSONWebKey, parsedJWS *jose.JSONWebSignature) erro
 	sigHeaderAlg := parsedJWS.Signatures[0].Header.Algorithm
 	if !supportedAlgs[sigHeaderAlg] {
 		return fmt.Errorf(
-			"JWS signature header contains unsupported algorithm %q, expected one of RS256, ES256, ES384 or ES512",
+			"JWS signature header contains unsupported algorithm %q, expected one of RS256, ES256, ES384, ES512 or EdDSA",
 			parsedJWS.Signatures[0].Header.Algorithm,
 		)
 	}
@@ -776,3 +782,93 @@ func (wfe *WebFrontEndImpl) validKeyRollover(
 		NewKey: *jwk,
 	}, nil
 }
+
+// supportedEABAlgs are the MAC algorithms accepted for external account
+// binding JWS.
+var supportedEABAlgs = map[string]bool{
+	string(jose.HS256): true,
+	string(jose.HS384): true,
+	string(jose.HS512): true,
+}
+
+// validExternalAccountBinding checks the externalAccountBinding field of
+// a new-account request per RFC 8555 Section 7.3.4. It is assumed that the
+// outerJWS has already been validated using `validSelfAuthenticatedJWS` and
+// that accountKey is the JWK embedded in it. This function checks that:
+// 1) the binding is a well formed JWS using a supported MAC algorithm
+// 2) the binding has a "kid" header for a known, unrevoked external account key
+// 3) the binding has no "nonce" header
+// 4) the binding has the same "url" header as the outer JWS
+// 5) the binding verifies with the MAC key stored for the "kid"
+// 6) the binding payload is the account key of the outer JWS
+//
+// If the binding is valid the external account key ID is returned, otherwise
+// a problem is returned.
+func (wfe *WebFrontEndImpl) validExternalAccountBinding(
+	ctx context.Context,
+	outerJWS *jose.JSONWebSignature,
+	binding []byte,
+	accountKey *jose.JSONWebKey) (string, *probs.ProblemDetails) {
+	eabJWS, prob := wfe.parseJWS(binding)
+	if prob != nil {
+		return "", prob
+	}
+
+	// parseJWS defends against the incorrect number of signatures.
+	header := eabJWS.Signatures[0].Header
+	if !supportedEABAlgs[header.Algorithm] {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABAlgorithmInvalid"}).Inc()
+		return "", probs.BadSignatureAlgorithm(
+			"External account binding JWS header contains unsupported algorithm %q, expected one of HS256, HS384 or HS512",
+			header.Algorithm)
+	}
+	if header.KeyID == "" || header.JSONWebKey != nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABNoKeyID"}).Inc()
+		return "", probs.Malformed("External account binding JWS must contain a 'kid' header parameter and no 'jwk'")
+	}
+	if header.Nonce != "" {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABNonce"}).Inc()
+		return "", probs.Malformed("External account binding JWS must not contain a 'nonce' header parameter")
+	}
+
+	outerURL, _ := outerJWS.Signatures[0].Header.ExtraHeaders[jose.HeaderKey("url")].(string)
+	eabURL, ok := header.ExtraHeaders[jose.HeaderKey("url")].(string)
+	if !ok || eabURL != outerURL {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABMismatchedURL"}).Inc()
+		return "", probs.Malformed(fmt.Sprintf(
+			"External account binding JWS 'url' value %q does not match outer JWS 'url' value %q",
+			eabURL, outerURL))
+	}
+
+	eabKey, err := wfe.SA.GetExternalAccountKey(ctx, &sapb.ExternalAccountKeyID{KeyID: &header.KeyID})
+	if err != nil {
+		if berrors.Is(err, berrors.NotFound) {
+			wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABKeyIDNotFound"}).Inc()
+			return "", probs.Unauthorized(fmt.Sprintf("External account binding key ID %q is not known", header.KeyID))
+		}
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABKeyIDLookupFailed"}).Inc()
+		return "", probs.ServerInternal("Error retrieving external account binding key")
+	}
+	if eabKey.GetRevoked() != 0 {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABKeyIDRevoked"}).Inc()
+		return "", probs.Unauthorized(fmt.Sprintf("External account binding key ID %q has been revoked", header.KeyID))
+	}
+
+	payload, err := eabJWS.Verify(eabKey.HmacKey)
+	if err != nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABVerifyFailed"}).Inc()
+		return "", probs.Unauthorized("External account binding JWS verification error")
+	}
+
+	var boundKey jose.JSONWebKey
+	if err := json.Unmarshal(payload, &boundKey); err != nil || boundKey.Key == nil {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABPayloadUnmarshalFailed"}).Inc()
+		return "", probs.Malformed("External account binding JWS payload did not parse as a JWK")
+	}
+	if keysEqual, err := core.PublicKeysEqual(boundKey.Key, accountKey.Key); err != nil || !keysEqual {
+		wfe.stats.joseErrorCount.With(prometheus.Labels{"type": "EABWrongKey"}).Inc()
+		return "", probs.Malformed("External account binding JWS payload does not match the account key")
+	}
+
+	return header.KeyID, nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/letsencrypt/boulder/test"
 	"github.com/letsencrypt/boulder/web"
 
+	"golang.org/x/crypto/ed25519"
 	"gopkg.in/square/go-jose.v2"
 )
 
@@ -55,14 +56,16 @@ func keyAlgForKey(t *testing.T, key interface{}) string {
 	return ""
 }
 
-// pubKeyForKey returns the public key of an RSA/ECDSA private key provided as
-// argument.
+// pubKeyForKey returns the public key of an RSA/ECDSA/Ed25519 private key
+// provided as argument.
 func pubKeyForKey(t *testing.T, privKey interface{}) interface{} {
 	switch k := privKey.(type) {
 	case *rsa.PrivateKey:
 		return k.PublicKey
 	case *ecdsa.PrivateKey:
 		return k.PublicKey
+	case ed25519.PrivateKey:
+		return k.Public()
 	}
 	t.Fatalf("Unable to get public key for private key %#v", privKey)
 	return nil
@@ -187,7 +190,7 @@ func TestRejectsNone(t *testing.T) {
 	if err == nil {
 		t.Fatalf("checkAlgorithm did not reject JWS with alg: 'none'")
 	}
-	if err.Error() != "JWS signature header contains unsupported algorithm \"none\", expected one of RS256, ES256, ES384 or ES512" {
+	if err.Error() != "JWS signature header contains unsupported algorithm \"none\", expected one of RS256, ES256, ES384, ES512 or EdDSA" {
 		t.Fatalf("checkAlgorithm rejected JWS with alg: 'none', but for wrong reason: %#v", err)
 	}
 }
@@ -218,7 +221,7 @@ func TestRejectsHS256(t *testing.T) {
 	if err == nil {
 		t.Fatalf("checkAlgorithm did not reject JWS with alg: 'HS256'")
 	}
-	expected := "JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384 or ES512"
+	expected := "JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384, ES512 or EdDSA"
 	if err.Error() != expected {
 		t.Fatalf("checkAlgorithm rejected JWS with alg: 'none', but for wrong reason: got %q, wanted %q", err.Error(), expected)
 	}
@@ -241,7 +244,7 @@ func TestCheckAlgorithm(t *testing.T) {
 					},
 				},
 			},
-			"JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384 or ES512",
+			"JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384, ES512 or EdDSA",
 		},
 		{
 			jose.JSONWebKey{
@@ -256,7 +259,7 @@ func TestCheckAlgorithm(t *testing.T) {
 					},
 				},
 			},
-			"JWK contains unsupported key type (expected RSA, or ECDSA P-256, P-384, or P-521",
+			"JWK contains unsupported key type (expected RSA, ECDSA P-256, P-384, or P-521, or Ed25519",
 		},
 		{
 			jose.JSONWebKey{
@@ -364,6 +367,21 @@ func TestCheckAlgorithmSuccess(t *testing.T) {
 	if err != nil {
 		t.Errorf("ES256 key: Expected nil error, got '%s'", err)
 	}
+
+	err = checkAlgorithm(&jose.JSONWebKey{
+		Key: ed25519.PublicKey(make([]byte, ed25519.PublicKeySize)),
+	}, &jose.JSONWebSignature{
+		Signatures: []jose.Signature{
+			{
+				Header: jose.Header{
+					Algorithm: "EdDSA",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("EdDSA key: Expected nil error, got '%s'", err)
+	}
 }
 
 func TestValidPOSTRequest(t *testing.T) {
@@ -1180,7 +1198,7 @@ func TestValidJWSForKey(t *testing.T) {
 			JWK:  goodJWK,
 			ExpectedProblem: &probs.ProblemDetails{
 				Type:       probs.BadSignatureAlgorithmProblem,
-				Detail:     "JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384 or ES512",
+				Detail:     "JWS signature header contains unsupported algorithm \"HS256\", expected one of RS256, ES256, ES384, ES512 or EdDSA",
 				HTTPStatus: http.StatusBadRequest,
 			},
 			ErrorStatType: "JWSAlgorithmCheckFailed",
@@ -1451,6 +1469,10 @@ func TestValidSelfAuthenticatedPOST(t *testing.T) {
 
 	_, validKey, validJWSBody := signRequestEmbed(t, nil, "http://localhost/test", `{"test":"passed"}`, wfe.nonceService)
 
+	_, edPrivKey, err := ed25519.GenerateKey(nil)
+	test.AssertNotError(t, err, "Failed to generate Ed25519 key")
+	_, validEdKey, validEdJWSBody := signRequestEmbed(t, edPrivKey, "http://localhost/test", `{"test":"passed"}`, wfe.nonceService)
+
 	_, _, keyIDJWSBody := signRequestKeyID(t, 1, nil, "http://localhost/test", `{"test":"passed"}`, wfe.nonceService)
 
 	testCases := []struct {
@@ -1487,6 +1509,12 @@ func TestValidSelfAuthenticatedPOST(t *testing.T) {
 			ExpectedPayload: `{"test":"passed"}`,
 			ExpectedJWK:     validKey,
 		},
+		{
+			Name:            "Valid Ed25519 JWS",
+			Request:         makePostRequestWithPath("test", validEdJWSBody),
+			ExpectedPayload: `{"test":"passed"}`,
+			ExpectedJWK:     validEdKey,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"context"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
 	"net"
 	"net/http"
 	"strconv"
@@ -55,8 +57,13 @@ const (
 	rolloverPath      = "/acme/key-change"
 	newNoncePath      = "/acme/new-nonce"
 	newOrderPath      = "/acme/new-order"
+	newAuthzPath      = "/acme/new-authz"
 	orderPath         = "/acme/order/"
 	finalizeOrderPath = "/acme/finalize/"
+	renewalInfoPath   = "/acme/renewal-info/"
+	ordersPath        = "/acme/orders/"
+	starCertPath      = "/acme/star-cert/"
+	rateLimitsPath    = "/acme/rate-limits/"
 
 	getAPIPrefix       = "/get/"
 	getOrderPath       = getAPIPrefix + "order/"
@@ -102,6 +109,21 @@ type WebFrontEndImpl struct {
 	// "website" field.
 	DirectoryWebsite string
 
+	// ExternalAccountRequired causes new-account requests without a valid
+	// external account binding (RFC 8555 Section 7.3.4) to be rejected. It is
+	// also advertised in the /directory response's "meta" element.
+	ExternalAccountRequired bool
+
+	// CertificateProfiles maps the names of the certificate profiles new orders
+	// may select to their descriptions. It is advertised in the /directory
+	// response's "meta" element.
+	CertificateProfiles map[string]string
+
+	// AutoRenewal is advertised in the /directory response's "meta" element
+	// if STAR orders are supported. New orders requesting auto-renewal are
+	// rejected if it is nil.
+	AutoRenewal *AutoRenewalMeta
+
 	// Allowed prefix for legacy accounts used by verify.go's `lookupJWK`.
 	// See `cmd/boulder-wfe2/main.go`'s comment on the configuration field
 	// `LegacyKeyIDPrefix` for more information.
@@ -366,11 +388,15 @@ func (wfe *WebFrontEndImpl) Handler(stats prometheus.Registerer) http.Handler {
 	wfe.HandleFunc(m, revokeCertPath, wfe.RevokeCertificate, "POST")
 	wfe.HandleFunc(m, rolloverPath, wfe.KeyRollover, "POST")
 	wfe.HandleFunc(m, newOrderPath, wfe.NewOrder, "POST")
+	wfe.HandleFunc(m, newAuthzPath, wfe.NewAuthorization, "POST")
 	wfe.HandleFunc(m, finalizeOrderPath, wfe.FinalizeOrder, "POST")
+	wfe.HandleFunc(m, ordersPath, wfe.Orders, "POST")
+	wfe.HandleFunc(m, rateLimitsPath, wfe.RateLimits, "POST")
 
 	// GETable and POST-as-GETable ACME endpoints
 	wfe.HandleFunc(m, directoryPath, wfe.Directory, "GET", "POST")
 	wfe.HandleFunc(m, newNoncePath, wfe.Nonce, "GET", "POST")
+	wfe.HandleFunc(m, renewalInfoPath, wfe.RenewalInfo, "GET")
 	// POST-as-GETable ACME endpoints
 	// TODO(@cpu): After November 1st, 2020 support for "GET" to the following
 	// endpoints will be removed, leaving only POST-as-GET support.
@@ -378,6 +404,7 @@ func (wfe *WebFrontEndImpl) Handler(stats prometheus.Registerer) http.Handler {
 	wfe.HandleFunc(m, authzv2Path, wfe.Authorization, "GET", "POST")
 	wfe.HandleFunc(m, challengev2Path, wfe.Challenge, "GET", "POST")
 	wfe.HandleFunc(m, certPath, wfe.Certificate, "GET", "POST")
+	wfe.HandleFunc(m, starCertPath, wfe.StarCertificate, "GET", "POST")
 	// Boulder-specific GET-able resource endpoints
 	wfe.HandleFunc(m, getOrderPath, wfe.GetOrder, "GET")
 	wfe.HandleFunc(m, getAuthzv2Path, wfe.Authorization, "GET")
@@ -442,11 +469,13 @@ func (wfe *WebFrontEndImpl) Directory(
 	response http.ResponseWriter,
 	request *http.Request) {
 	directoryEndpoints := map[string]interface{}{
-		"newAccount": newAcctPath,
-		"newNonce":   newNoncePath,
-		"revokeCert": revokeCertPath,
-		"newOrder":   newOrderPath,
-		"keyChange":  rolloverPath,
+		"newAccount":  newAcctPath,
+		"newNonce":    newNoncePath,
+		"revokeCert":  revokeCertPath,
+		"newOrder":    newOrderPath,
+		"newAuthz":    newAuthzPath,
+		"keyChange":   rolloverPath,
+		"renewalInfo": renewalInfoPath,
 	}
 
 	if request.Method == http.MethodPost {
@@ -483,6 +512,21 @@ func (wfe *WebFrontEndImpl) Directory(
 	if wfe.DirectoryWebsite != "" {
 		metaMap["website"] = wfe.DirectoryWebsite
 	}
+	// The "meta" directory entry may also include a boolean indicating that
+	// new accounts must be bound to an external account
+	if wfe.ExternalAccountRequired {
+		metaMap["externalAccountRequired"] = true
+	}
+	// The "meta" directory entry may also include the certificate profiles new
+	// orders can select, keyed by name
+	if len(wfe.CertificateProfiles) > 0 {
+		metaMap["profiles"] = wfe.CertificateProfiles
+	}
+	// The "meta" directory entry may also describe the STAR orders that are
+	// supported
+	if wfe.AutoRenewal != nil {
+		metaMap["auto-renewal"] = wfe.AutoRenewal
+	}
 	directoryEndpoints["meta"] = metaMap
 
 	response.Header().Set("Content-Type", "application/json")
@@ -545,20 +589,28 @@ func (wfe *WebFrontEndImpl) NewAccount(
 	response http.ResponseWriter,
 	request *http.Request) {
 
-	// NewAccount uses `validSelfAuthenticatedPOST` instead of
+	// NewAccount uses `validSelfAuthenticatedJWS` instead of
 	// `validPOSTforAccount` because there is no account to authenticate against
-	// until after it is created!
-	body, key, prob := wfe.validSelfAuthenticatedPOST(ctx, request, logEvent)
+	// until after it is created! We parse the JWS ourselves rather than using
+	// `validSelfAuthenticatedPOST` because an external account binding must be
+	// checked against the outer JWS.
+	jws, prob := wfe.parseJWSRequest(request)
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+	body, key, prob := wfe.validSelfAuthenticatedJWS(ctx, jws, request, logEvent)
 	if prob != nil {
-		// validSelfAuthenticatedPOST handles its own setting of logEvent.Errors
+		// validSelfAuthenticatedJWS handles its own setting of logEvent.Errors
 		wfe.sendError(response, logEvent, prob, nil)
 		return
 	}
 
 	var accountCreateRequest struct {
-		Contact              *[]string `json:"contact"`
-		TermsOfServiceAgreed bool      `json:"termsOfServiceAgreed"`
-		OnlyReturnExisting   bool      `json:"onlyReturnExisting"`
+		Contact                *[]string       `json:"contact"`
+		TermsOfServiceAgreed   bool            `json:"termsOfServiceAgreed"`
+		OnlyReturnExisting     bool            `json:"onlyReturnExisting"`
+		ExternalAccountBinding json.RawMessage `json:"externalAccountBinding"`
 	}
 
 	err := json.Unmarshal(body, &accountCreateRequest)
@@ -580,7 +632,7 @@ func (wfe *WebFrontEndImpl) NewAccount(
 			web.RelativeEndpoint(request, fmt.Sprintf("%s%d", acctPath, acct.ID)))
 		logEvent.Requester = acct.ID
 
-		prepAccountForDisplay(&acct)
+		prepAccountForDisplay(request, &acct)
 
 		err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, acct)
 		if err != nil {
@@ -614,6 +666,20 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		return
 	}
 
+	var externalAccountID string
+	if len(accountCreateRequest.ExternalAccountBinding) > 0 {
+		externalAccountID, prob = wfe.validExternalAccountBinding(
+			ctx, jws, accountCreateRequest.ExternalAccountBinding, key)
+		if prob != nil {
+			wfe.sendError(response, logEvent, prob, nil)
+			return
+		}
+	} else if wfe.ExternalAccountRequired {
+		wfe.sendError(response, logEvent, probs.ExternalAccountRequired(
+			"New accounts must include an externalAccountBinding"), nil)
+		return
+	}
+
 	ip, err := extractRequesterIP(request)
 	if err != nil {
 		wfe.sendError(
@@ -630,6 +696,8 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		Agreement: wfe.SubscriberAgreementURL,
 		Key:       key,
 		InitialIP: ip,
+
+		ExternalAccountID: externalAccountID,
 	})
 	if err != nil {
 		if berrors.Is(err, berrors.Duplicate) {
@@ -660,7 +728,7 @@ func (wfe *WebFrontEndImpl) NewAccount(
 		response.Header().Add("Link", link(wfe.SubscriberAgreementURL, "terms-of-service"))
 	}
 
-	prepAccountForDisplay(&acct)
+	prepAccountForDisplay(request, &acct)
 
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusCreated, acct)
 	if err != nil {
@@ -902,7 +970,7 @@ func (wfe *WebFrontEndImpl) revokeCertByKeyID(
 			return nil
 		}
 		// Otherwise check if the account, while not the owner, has equivalent authorizations
-		valid, err := wfe.acctHoldsAuthorizations(ctx, acct.ID, parsedCertificate.DNSNames)
+		valid, err := wfe.acctHoldsAuthorizations(ctx, acct.ID, core.UniqueLowerNamesAndIPs(parsedCertificate.DNSNames, parsedCertificate.IPAddresses))
 		if err != nil {
 			return probs.ServerInternal("Failed to retrieve authorizations for names in certificate")
 		}
@@ -1091,8 +1159,13 @@ func (wfe *WebFrontEndImpl) Challenge(
 // prepAccountForDisplay takes a core.Registration and mutates it to be ready
 // for display in a JSON response. Primarily it papers over legacy ACME v1
 // features or non-standard details internal to Boulder we don't want clients to
-// rely on.
-func prepAccountForDisplay(acct *core.Registration) {
+// rely on. It also populates the account's orders URL, which is relative to
+// the given request.
+func prepAccountForDisplay(request *http.Request, acct *core.Registration) {
+	// RFC 8555 Section 7.1.2 requires the account object to include the URL of
+	// the account's orders list.
+	acct.Orders = web.RelativeEndpoint(request, fmt.Sprintf("%s%d", ordersPath, acct.ID))
+
 	// Zero out the account ID so that it isn't marshalled. RFC 8555 specifies
 	// using the Location header for learning the account ID.
 	acct.ID = 0
@@ -1104,6 +1177,10 @@ func prepAccountForDisplay(acct *core.Registration) {
 	// account/registration is a V1 notion so we strip it here in the WFE2 before
 	// returning the account.
 	acct.Agreement = ""
+
+	// The external account key ID is internal bookkeeping. RFC 8555 doesn't
+	// define a field for it in the account object.
+	acct.ExternalAccountID = ""
 }
 
 // prepChallengeForDisplay takes a core.Challenge and prepares it for display to
@@ -1321,7 +1398,7 @@ func (wfe *WebFrontEndImpl) Account(
 		response.Header().Add("Link", link(wfe.SubscriberAgreementURL, "terms-of-service"))
 	}
 
-	prepAccountForDisplay(currAcct)
+	prepAccountForDisplay(request, currAcct)
 
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, currAcct)
 	if err != nil {
@@ -1607,6 +1684,19 @@ func (wfe *WebFrontEndImpl) Certificate(ctx context.Context, logEvent *web.Reque
 		return
 	}
 
+	wfe.writeCertificate(response, logEvent, request, cert, serial, requestedChain)
+}
+
+// writeCertificate writes a PEM encoded certificate to the response, followed
+// by the requested certificate chain if the WFE is configured with
+// certificateChains.
+func (wfe *WebFrontEndImpl) writeCertificate(
+	response http.ResponseWriter,
+	logEvent *web.RequestEvent,
+	request *http.Request,
+	cert core.Certificate,
+	serial string,
+	requestedChain int) {
 	leafPEM := pem.EncodeToMemory(&pem.Block{
 		Type:  "CERTIFICATE",
 		Bytes: cert.DER,
@@ -1684,11 +1774,199 @@ func (wfe *WebFrontEndImpl) Certificate(ctx context.Context, logEvent *web.Reque
 	response.Header().Set("Content-Length", strconv.Itoa(len(responsePEM)))
 	response.Header().Set("Content-Type", "application/pem-certificate-chain")
 	response.WriteHeader(http.StatusOK)
-	if _, err = response.Write(responsePEM); err != nil {
+	if _, err := response.Write(responsePEM); err != nil {
 		wfe.log.Warningf("Could not write response: %s", err)
 	}
 }
 
+// StarCertificate is used by clients, and by third parties the client has
+// delegated to, to fetch the current certificate of a STAR order, as described
+// in RFC 8739 Section 3.3. Unauthenticated GET requests are only allowed if the
+// order was created with allow-certificate-get.
+func (wfe *WebFrontEndImpl) StarCertificate(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
+	var requesterAccount *core.Registration
+	if request.Method == http.MethodPost {
+		acct, prob := wfe.validPOSTAsGETForAccount(request, ctx, logEvent)
+		if prob != nil {
+			wfe.sendError(response, logEvent, prob, nil)
+			return
+		}
+		requesterAccount = acct
+	}
+
+	// Path prefix is stripped, so this should be like "<account ID>/<order ID>"
+	fields := strings.SplitN(request.URL.Path, "/", 2)
+	if len(fields) != 2 {
+		wfe.sendError(response, logEvent, probs.NotFound("Invalid request path"), nil)
+		return
+	}
+	acctID, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.Malformed("Invalid account ID"), err)
+		return
+	}
+	orderID, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.Malformed("Invalid order ID"), err)
+		return
+	}
+
+	useV2Authzs := true
+	order, err := wfe.SA.GetOrder(ctx, &sapb.OrderRequest{Id: &orderID, UseV2Authorizations: &useV2Authzs})
+	if err != nil {
+		if berrors.Is(err, berrors.NotFound) {
+			wfe.sendError(response, logEvent, probs.NotFound(fmt.Sprintf("No order for ID %d", orderID)), err)
+			return
+		}
+		wfe.sendError(response, logEvent, probs.ServerInternal(fmt.Sprintf("Failed to retrieve order for ID %d", orderID)), err)
+		return
+	}
+	if *order.RegistrationID != acctID ||
+		(requesterAccount != nil && *order.RegistrationID != requesterAccount.ID) {
+		wfe.sendError(response, logEvent, probs.NotFound(fmt.Sprintf("No order found for account ID %d", acctID)), nil)
+		return
+	}
+
+	autoRenewal := order.AutoRenewal
+	if autoRenewal == nil || autoRenewal.GetCurrentSerial() == "" {
+		wfe.sendError(response, logEvent, probs.NotFound(fmt.Sprintf("No STAR certificate for order ID %d", orderID)), nil)
+		return
+	}
+	if requesterAccount == nil && !autoRenewal.GetAllowCertificateGet() {
+		wfe.sendError(response, logEvent, probs.MethodNotAllowed(), nil)
+		return
+	}
+	if autoRenewal.GetCanceled() {
+		wfe.sendError(response, logEvent, probs.AutoRenewalCanceled("Auto-renewal of order ID %d has been canceled", orderID), nil)
+		return
+	}
+
+	serial := autoRenewal.GetCurrentSerial()
+	logEvent.Extra["RequestedSerial"] = serial
+	cert, err := wfe.SA.GetCertificate(ctx, serial)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Failed to retrieve certificate"), err)
+		return
+	}
+	wfe.writeCertificate(response, logEvent, request, cert, serial, 0)
+}
+
+// renewalInfoRetryAfter is the value of the Retry-After header sent with
+// renewalInfo responses, indicating how long clients should wait before
+// checking the suggested renewal window for a certificate again.
+const renewalInfoRetryAfter = 6 * time.Hour
+
+// parseCertID parses a unique certificate identifier as described in
+// draft-ietf-acme-ari: the base64url encoded keyIdentifier of the certificate's
+// Authority Key Identifier extension and the base64url encoded DER bytes of its
+// serial number, separated by a period. It returns the decoded keyIdentifier
+// and the serial number in the string form used by the SA.
+func parseCertID(certID string) ([]byte, string, error) {
+	parts := strings.Split(certID, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, "", errors.New("certificate identifier must be two base64url encoded values separated by a period")
+	}
+	akid, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid authority key identifier: %s", err)
+	}
+	serialBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid serial number: %s", err)
+	}
+	serial := core.SerialToString(new(big.Int).SetBytes(serialBytes))
+	if !core.ValidSerial(serial) {
+		return nil, "", fmt.Errorf("invalid serial number: %s", serial)
+	}
+	return akid, serial, nil
+}
+
+// certForCertID returns the certificate and parsed certificate identified by
+// the given unique certificate identifier (see parseCertID). A
+// berrors.NotFoundError is returned if there is no such certificate or if its
+// Authority Key Identifier doesn't match the one in the identifier.
+func (wfe *WebFrontEndImpl) certForCertID(ctx context.Context, certID string) (core.Certificate, *x509.Certificate, error) {
+	akid, serial, err := parseCertID(certID)
+	if err != nil {
+		return core.Certificate{}, nil, berrors.MalformedError("%s", err)
+	}
+	cert, err := wfe.SA.GetCertificate(ctx, serial)
+	if err != nil {
+		return core.Certificate{}, nil, err
+	}
+	parsedCert, err := x509.ParseCertificate(cert.DER)
+	if err != nil {
+		return core.Certificate{}, nil, berrors.InternalServerError(
+			"unable to parse Boulder issued certificate with serial %#v: %s", serial, err)
+	}
+	if !bytes.Equal(parsedCert.AuthorityKeyId, akid) {
+		return core.Certificate{}, nil, berrors.NotFoundError(
+			"certificate with serial %#v has a different authority key identifier", serial)
+	}
+	return cert, parsedCert, nil
+}
+
+// renewalInfoForCert returns the suggested renewal window for the given
+// certificate. An override set by an administrator takes precedence, followed
+// by an immediate window for revoked certificates. Otherwise the window is
+// computed from the certificate's validity period.
+func (wfe *WebFrontEndImpl) renewalInfoForCert(ctx context.Context, serial string, cert *x509.Certificate) (core.RenewalInfo, error) {
+	override, err := wfe.SA.GetRenewalInfoOverride(ctx, &sapb.Serial{Serial: &serial})
+	if err == nil {
+		return core.RenewalInfo{
+			SuggestedWindow: core.SuggestedWindow{
+				Start: time.Unix(0, override.GetWindowStart()).UTC(),
+				End:   time.Unix(0, override.GetWindowEnd()).UTC(),
+			},
+		}, nil
+	} else if !berrors.Is(err, berrors.NotFound) {
+		return core.RenewalInfo{}, err
+	}
+
+	status, err := wfe.SA.GetCertificateStatus(ctx, serial)
+	if err != nil {
+		return core.RenewalInfo{}, err
+	}
+	if status.Status == core.OCSPStatusRevoked {
+		return core.RenewalInfoImmediate(wfe.clk.Now()), nil
+	}
+	return core.RenewalInfoSimple(cert.NotBefore, cert.NotAfter), nil
+}
+
+// RenewalInfo is used by clients to find out when they should renew a
+// certificate, as described in draft-ietf-acme-ari. The request path is the
+// unique certificate identifier of the certificate (see parseCertID).
+func (wfe *WebFrontEndImpl) RenewalInfo(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
+	// Path prefix is stripped, so this should be like "<akid>.<serial>"
+	certID := request.URL.Path
+	_, parsedCert, err := wfe.certForCertID(ctx, certID)
+	if err != nil {
+		if berrors.Is(err, berrors.Malformed) {
+			wfe.sendError(response, logEvent, probs.Malformed("Invalid certificate identifier: %s", err), err)
+		} else if berrors.Is(err, berrors.NotFound) {
+			wfe.sendError(response, logEvent, probs.NotFound("Certificate not found"), err)
+		} else {
+			wfe.sendError(response, logEvent, probs.ServerInternal("Failed to retrieve certificate"), err)
+		}
+		return
+	}
+	serial := core.SerialToString(parsedCert.SerialNumber)
+	logEvent.Extra["RequestedSerial"] = serial
+
+	renewalInfo, err := wfe.renewalInfoForCert(ctx, serial, parsedCert)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Failed to compute renewal window"), err)
+		return
+	}
+
+	response.Header().Set("Retry-After", fmt.Sprintf("%d", int(renewalInfoRetryAfter.Seconds())))
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, renewalInfo)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Failed to marshal renewal info"), err)
+		return
+	}
+}
+
 // Issuer obtains the issuer certificate used by this instance of Boulder.
 func (wfe *WebFrontEndImpl) Issuer(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
 	// TODO Content negotiation
@@ -1868,7 +2146,7 @@ func (wfe *WebFrontEndImpl) KeyRollover(
 		return
 	}
 
-	prepAccountForDisplay(&updatedAcct)
+	prepAccountForDisplay(request, &updatedAcct)
 
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, updatedAcct)
 	if err != nil {
@@ -1876,24 +2154,46 @@ func (wfe *WebFrontEndImpl) KeyRollover(
 	}
 }
 
+// AutoRenewalMeta is the "auto-renewal" field of the /directory response's
+// "meta" element, described in RFC 8739 Section 3.1.3. Durations are in
+// seconds.
+type AutoRenewalMeta struct {
+	MinLifetime         int64 `json:"min-lifetime"`
+	MaxDuration         int64 `json:"max-duration"`
+	AllowCertificateGet bool  `json:"allow-certificate-get,omitempty"`
+}
+
+// autoRenewalJSON is the "auto-renewal" field of STAR orders, described in RFC
+// 8739 Section 3.1.1. The lifetime is in seconds.
+type autoRenewalJSON struct {
+	StartDate           *time.Time `json:"start-date,omitempty"`
+	EndDate             time.Time  `json:"end-date"`
+	Lifetime            int64      `json:"lifetime"`
+	LifetimeAdjust      int64      `json:"lifetime-adjust,omitempty"`
+	AllowCertificateGet bool       `json:"allow-certificate-get,omitempty"`
+}
+
 type orderJSON struct {
-	Status         core.AcmeStatus             `json:"status"`
-	Expires        time.Time                   `json:"expires"`
-	Identifiers    []identifier.ACMEIdentifier `json:"identifiers"`
-	Authorizations []string                    `json:"authorizations"`
-	Finalize       string                      `json:"finalize"`
-	Certificate    string                      `json:"certificate,omitempty"`
-	Error          *probs.ProblemDetails       `json:"error,omitempty"`
+	Status          core.AcmeStatus             `json:"status"`
+	Expires         time.Time                   `json:"expires"`
+	Identifiers     []identifier.ACMEIdentifier `json:"identifiers"`
+	Authorizations  []string                    `json:"authorizations"`
+	Finalize        string                      `json:"finalize"`
+	Certificate     string                      `json:"certificate,omitempty"`
+	Error           *probs.ProblemDetails       `json:"error,omitempty"`
+	Profile         string                      `json:"profile,omitempty"`
+	AutoRenewal     *autoRenewalJSON            `json:"auto-renewal,omitempty"`
+	StarCertificate string                      `json:"star-certificate,omitempty"`
 }
 
 // orderToOrderJSON converts a *corepb.Order instance into an orderJSON struct
 // that is returned in HTTP API responses. It will convert the order names to
-// DNS type identifiers and additionally create absolute URLs for the finalize
+// DNS or IP type identifiers and additionally create absolute URLs for the finalize
 // URL and the ceritificate URL as appropriate.
 func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corepb.Order) orderJSON {
 	idents := make([]identifier.ACMEIdentifier, len(order.Names))
 	for i, name := range order.Names {
-		idents[i] = identifier.ACMEIdentifier{Type: identifier.DNS, Value: name}
+		idents[i] = identifier.ForName(name)
 	}
 	finalizeURL := web.RelativeEndpoint(request,
 		fmt.Sprintf("%s%d/%d", finalizeOrderPath, *order.RegistrationID, *order.Id))
@@ -1902,6 +2202,7 @@ func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corep
 		Expires:     time.Unix(0, *order.Expires).UTC(),
 		Identifiers: idents,
 		Finalize:    finalizeURL,
+		Profile:     order.GetCertProfile(),
 	}
 	// If there is an order error, prefix its type with the V2 namespace
 	if order.Error != nil {
@@ -1916,7 +2217,21 @@ func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corep
 	for _, v2ID := range order.V2Authorizations {
 		respObj.Authorizations = append(respObj.Authorizations, web.RelativeEndpoint(request, fmt.Sprintf("%s%d", authzv2Path, v2ID)))
 	}
-	if respObj.Status == core.StatusValid {
+	// STAR orders have a star-certificate URL that always serves their current
+	// certificate, instead of a certificate URL.
+	if ar := order.AutoRenewal; ar != nil {
+		startDate := time.Unix(0, ar.GetStartDate()).UTC()
+		respObj.AutoRenewal = &autoRenewalJSON{
+			StartDate:           &startDate,
+			EndDate:             time.Unix(0, ar.GetEndDate()).UTC(),
+			Lifetime:            int64(time.Duration(ar.GetLifetime()).Seconds()),
+			AllowCertificateGet: ar.GetAllowCertificateGet(),
+		}
+		if ar.GetCurrentSerial() != "" {
+			respObj.StarCertificate = web.RelativeEndpoint(request,
+				fmt.Sprintf("%s%d/%d", starCertPath, *order.RegistrationID, *order.Id))
+		}
+	} else if respObj.Status == core.StatusValid {
 		certURL := web.RelativeEndpoint(request,
 			fmt.Sprintf("%s%s", certPath, *order.CertificateSerial))
 		respObj.Certificate = certURL
@@ -1924,6 +2239,55 @@ func (wfe *WebFrontEndImpl) orderToOrderJSON(request *http.Request, order *corep
 	return respObj
 }
 
+// validReplacement checks that a new order for the given names by the given
+// account may replace the certificate with the given unique certificate
+// identifier (see parseCertID). The certificate must belong to the account,
+// share at least one identifier with the order, and not already have been
+// replaced or be claimed by another order. It returns the serial of the
+// certificate being replaced. The claim itself is only taken, atomically, when
+// the SA creates the order.
+func (wfe *WebFrontEndImpl) validReplacement(
+	ctx context.Context,
+	acct *core.Registration,
+	names []string,
+	certID string) (string, *probs.ProblemDetails, error) {
+	cert, parsedCert, err := wfe.certForCertID(ctx, certID)
+	if err != nil {
+		if berrors.Is(err, berrors.Malformed) || berrors.Is(err, berrors.NotFound) {
+			return "", probs.Malformed("Invalid replaces field: %s", err), err
+		}
+		return "", probs.ServerInternal("Failed to retrieve certificate to be replaced"), err
+	}
+	if cert.RegistrationID != acct.ID {
+		return "", probs.Unauthorized("Account in use did not issue the certificate to be replaced"), nil
+	}
+
+	certNames := make(map[string]bool)
+	for _, name := range core.UniqueLowerNamesAndIPs(parsedCert.DNSNames, parsedCert.IPAddresses) {
+		certNames[name] = true
+	}
+	var shared bool
+	for _, name := range core.UniqueLowerNames(names) {
+		if certNames[name] {
+			shared = true
+			break
+		}
+	}
+	if !shared {
+		return "", probs.Malformed("NewOrder request did not include any identifiers from the certificate to be replaced"), nil
+	}
+
+	serial := core.SerialToString(parsedCert.SerialNumber)
+	exists, err := wfe.SA.ReplacementOrderExists(ctx, &sapb.Serial{Serial: &serial})
+	if err != nil {
+		return "", probs.ServerInternal("Failed to check for existing replacement orders"), err
+	}
+	if exists.GetExists() {
+		return "", probs.Conflict(fmt.Sprintf("Certificate %s has already been replaced or is being replaced by another order", serial)), nil
+	}
+	return serial, nil, nil
+}
+
 // NewOrder is used by clients to create a new order object from a CSR
 func (wfe *WebFrontEndImpl) NewOrder(
 	ctx context.Context,
@@ -1938,12 +2302,17 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		return
 	}
 
-	// We only allow specifying Identifiers in a new order request - if the
-	// `notBefore` and/or `notAfter` fields described in Section 7.4 of acme-08
-	// are sent we return a probs.Malformed as we do not support them
+	// We only allow specifying Identifiers, the certificate being replaced,
+	// the certificate profile and STAR auto-renewal parameters in a new order
+	// request - if the `notBefore` and/or `notAfter` fields described in Section
+	// 7.4 of acme-08 are sent we return a probs.Malformed as we do not support
+	// them
 	var newOrderRequest struct {
 		Identifiers         []identifier.ACMEIdentifier `json:"identifiers"`
 		NotBefore, NotAfter string
+		Replaces            string           `json:"replaces"`
+		Profile             string           `json:"profile"`
+		AutoRenewal         *autoRenewalJSON `json:"auto-renewal"`
 	}
 	err := json.Unmarshal(body, &newOrderRequest)
 	if err != nil {
@@ -1962,13 +2331,33 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		return
 	}
 
-	// Collect up all of the DNS identifier values into a []string for subsequent
-	// layers to process. We reject anything with a non-DNS type identifier here.
+	// Collect up all of the DNS and IP identifier values into a []string for
+	// subsequent layers to process. Subsequent layers tell the two types apart
+	// by whether the value parses as an IP address, so we reject DNS type
+	// identifiers with IP address values, IP type identifiers with any other
+	// value, and identifiers of any other type here.
 	names := make([]string, len(newOrderRequest.Identifiers))
 	for i, ident := range newOrderRequest.Identifiers {
-		if ident.Type != identifier.DNS {
+		switch ident.Type {
+		case identifier.DNS:
+			if net.ParseIP(ident.Value) != nil {
+				wfe.sendError(response, logEvent,
+					probs.Malformed("NewOrder request included DNS type identifier with IP address value %q, use an IP type identifier",
+						ident.Value),
+					nil)
+				return
+			}
+		case identifier.IP:
+			if net.ParseIP(ident.Value) == nil {
+				wfe.sendError(response, logEvent,
+					probs.Malformed("NewOrder request included IP type identifier with invalid IP address value %q",
+						ident.Value),
+					nil)
+				return
+			}
+		default:
 			wfe.sendError(response, logEvent,
-				probs.Malformed("NewOrder request included invalid non-DNS type identifier: type %q, value %q",
+				probs.Malformed("NewOrder request included invalid non-DNS, non-IP type identifier: type %q, value %q",
 					ident.Type, ident.Value),
 				nil)
 			return
@@ -1976,11 +2365,58 @@ func (wfe *WebFrontEndImpl) NewOrder(
 		names[i] = ident.Value
 	}
 
+	var replaces *string
+	if newOrderRequest.Replaces != "" {
+		serial, prob, err := wfe.validReplacement(ctx, acct, names, newOrderRequest.Replaces)
+		if prob != nil {
+			wfe.sendError(response, logEvent, prob, err)
+			return
+		}
+		logEvent.Extra["Replaces"] = serial
+		replaces = &serial
+	}
+
+	var profile *string
+	if newOrderRequest.Profile != "" {
+		if _, ok := wfe.CertificateProfiles[newOrderRequest.Profile]; !ok {
+			wfe.sendError(response, logEvent,
+				probs.Malformed("NewOrder request included unknown certificate profile %q", newOrderRequest.Profile), nil)
+			return
+		}
+		logEvent.Extra["Profile"] = newOrderRequest.Profile
+		profile = &newOrderRequest.Profile
+	}
+
+	var autoRenewal *corepb.AutoRenewal
+	if newOrderRequest.AutoRenewal != nil {
+		if replaces != nil || profile != nil {
+			wfe.sendError(response, logEvent,
+				probs.Malformed("NewOrder request for auto-renewal cannot include replaces or profile"), nil)
+			return
+		}
+		var prob *probs.ProblemDetails
+		autoRenewal, prob = wfe.autoRenewalForNewOrder(newOrderRequest.AutoRenewal)
+		if prob != nil {
+			wfe.sendError(response, logEvent, prob, nil)
+			return
+		}
+		logEvent.Extra["AutoRenewal"] = true
+	}
+
 	order, err := wfe.RA.NewOrder(ctx, &rapb.NewOrderRequest{
 		RegistrationID: &acct.ID,
 		Names:          names,
+		Replaces:       replaces,
+		CertProfile:    profile,
+		AutoRenewal:    autoRenewal,
 	})
 	if err != nil {
+		// Another order may have claimed the certificate being replaced since
+		// validReplacement checked it.
+		if replaces != nil && berrors.Is(err, berrors.Duplicate) {
+			wfe.sendError(response, logEvent, probs.Conflict(fmt.Sprintf("Certificate %s has already been replaced or is being replaced by another order", *replaces)), err)
+			return
+		}
 		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error creating new order"), err)
 		return
 	}
@@ -1998,6 +2434,134 @@ func (wfe *WebFrontEndImpl) NewOrder(
 	}
 }
 
+// autoRenewalForNewOrder checks the "auto-renewal" field of a new order request
+// and converts it for the RA, which checks the requested parameters against
+// its policy.
+func (wfe *WebFrontEndImpl) autoRenewalForNewOrder(req *autoRenewalJSON) (*corepb.AutoRenewal, *probs.ProblemDetails) {
+	if wfe.AutoRenewal == nil {
+		return nil, probs.Malformed("NewOrder request included auto-renewal, which is not supported")
+	}
+	if req.EndDate.IsZero() {
+		return nil, probs.Malformed("NewOrder request auto-renewal did not specify an end-date")
+	}
+	if req.Lifetime <= 0 {
+		return nil, probs.Malformed("NewOrder request auto-renewal did not specify a positive lifetime")
+	}
+	// Certificates are never pre-dated, so a lifetime-adjust can't be honored.
+	if req.LifetimeAdjust != 0 {
+		return nil, probs.Malformed("NewOrder request auto-renewal lifetime-adjust is not supported")
+	}
+	if req.AllowCertificateGet && !wfe.AutoRenewal.AllowCertificateGet {
+		return nil, probs.Malformed("NewOrder request auto-renewal allow-certificate-get is not supported")
+	}
+	var startDate int64
+	if req.StartDate != nil {
+		startDate = req.StartDate.UnixNano()
+	}
+	endDate := req.EndDate.UnixNano()
+	lifetime := int64(time.Duration(req.Lifetime) * time.Second)
+	return &corepb.AutoRenewal{
+		StartDate:           &startDate,
+		EndDate:             &endDate,
+		Lifetime:            &lifetime,
+		AllowCertificateGet: &req.AllowCertificateGet,
+	}, nil
+}
+
+// NewAuthorization is used by clients to create an authorization for a single
+// identifier ahead of creating an order, as described in RFC 8555 Section
+// 7.4.1 ("Pre-authorization").
+func (wfe *WebFrontEndImpl) NewAuthorization(
+	ctx context.Context,
+	logEvent *web.RequestEvent,
+	response http.ResponseWriter,
+	request *http.Request) {
+	body, _, acct, prob := wfe.validPOSTForAccount(request, ctx, logEvent)
+	addRequesterHeader(response, logEvent.Requester)
+	if prob != nil {
+		// validPOSTForAccount handles its own setting of logEvent.Errors
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+
+	var newAuthzRequest struct {
+		Identifier identifier.ACMEIdentifier `json:"identifier"`
+	}
+	err := json.Unmarshal(body, &newAuthzRequest)
+	if err != nil {
+		wfe.sendError(response, logEvent,
+			probs.Malformed("Unable to unmarshal NewAuthorization request body"), err)
+		return
+	}
+
+	ident := newAuthzRequest.Identifier
+	if ident.Value == "" {
+		wfe.sendError(response, logEvent,
+			probs.Malformed("NewAuthorization request did not specify an identifier"), nil)
+		return
+	}
+	switch ident.Type {
+	case identifier.DNS:
+		if net.ParseIP(ident.Value) != nil {
+			wfe.sendError(response, logEvent,
+				probs.Malformed("NewAuthorization request included DNS type identifier with IP address value %q, use an IP type identifier",
+					ident.Value),
+				nil)
+			return
+		}
+		// RFC 8555 Section 7.4.1: the identifier in a pre-authorization request
+		// is the exact identifier to be included in the authorization, so
+		// pre-authorization can't be used for wildcard domain names.
+		if strings.Contains(ident.Value, "*") {
+			wfe.sendError(response, logEvent,
+				probs.Malformed("NewAuthorization request included wildcard identifier %q, wildcard identifiers cannot be pre-authorized",
+					ident.Value),
+				nil)
+			return
+		}
+	case identifier.IP:
+		if net.ParseIP(ident.Value) == nil {
+			wfe.sendError(response, logEvent,
+				probs.Malformed("NewAuthorization request included IP type identifier with invalid IP address value %q",
+					ident.Value),
+				nil)
+			return
+		}
+	default:
+		wfe.sendError(response, logEvent,
+			probs.Malformed("NewAuthorization request included invalid non-DNS, non-IP type identifier: type %q, value %q",
+				ident.Type, ident.Value),
+			nil)
+		return
+	}
+
+	identType := string(ident.Type)
+	authzPB, err := wfe.RA.NewPreAuthorization(ctx, &rapb.NewPreAuthorizationRequest{
+		RegistrationID:  &acct.ID,
+		IdentifierType:  &identType,
+		IdentifierValue: &ident.Value,
+	})
+	if err != nil {
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Error creating new authorization"), err)
+		return
+	}
+	authz, err := bgrpc.PBToAuthz(authzPB)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error creating new authorization"), err)
+		return
+	}
+	logEvent.Created = authz.ID
+
+	response.Header().Set("Location", urlForAuthz(authz, request))
+
+	wfe.prepAuthorizationForDisplay(request, &authz)
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusCreated, authz)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshaling authz"), err)
+		return
+	}
+}
+
 // GetOrder is used to retrieve a existing order object
 func (wfe *WebFrontEndImpl) GetOrder(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
 	if features.Enabled(features.MandatoryPOSTAsGET) && request.Method != http.MethodPost && !requiredStale(request, logEvent) {
@@ -2006,14 +2570,33 @@ func (wfe *WebFrontEndImpl) GetOrder(ctx context.Context, logEvent *web.RequestE
 	}
 
 	var requesterAccount *core.Registration
-	// Any POSTs to the Order endpoint should be POST-as-GET requests. There are
-	// no POSTs with a body allowed for this endpoint.
+	var cancelAutoRenewal bool
+	// Any POSTs to the Order endpoint should be POST-as-GET requests, apart from
+	// requests to cancel the auto-renewal of a STAR order, described in RFC 8739
+	// Section 3.1.2.
 	if request.Method == http.MethodPost {
-		acct, prob := wfe.validPOSTAsGETForAccount(request, ctx, logEvent)
+		body, _, acct, prob := wfe.validPOSTForAccount(request, ctx, logEvent)
 		if prob != nil {
 			wfe.sendError(response, logEvent, prob, nil)
 			return
 		}
+		if len(body) == 0 {
+			logEvent.Method = "POST-as-GET"
+		} else {
+			var update struct {
+				Status core.AcmeStatus `json:"status"`
+			}
+			err := json.Unmarshal(body, &update)
+			if err != nil || update.Status == "" {
+				wfe.sendError(response, logEvent, probs.Malformed("POST-as-GET requests must have an empty payload"), err)
+				return
+			}
+			if update.Status != core.StatusCanceled {
+				wfe.sendError(response, logEvent, probs.Malformed("Order status can only be updated to %q", core.StatusCanceled), nil)
+				return
+			}
+			cancelAutoRenewal = true
+		}
 		requesterAccount = acct
 	}
 
@@ -2065,6 +2648,25 @@ func (wfe *WebFrontEndImpl) GetOrder(ctx context.Context, logEvent *web.RequestE
 		return
 	}
 
+	if cancelAutoRenewal {
+		_, err := wfe.RA.CancelAutoRenewal(ctx, &rapb.CancelAutoRenewalRequest{
+			OrderID:        &orderID,
+			RegistrationID: &requesterAccount.ID,
+		})
+		if err != nil {
+			wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Unable to cancel auto-renewal"), err)
+			return
+		}
+		canceled := true
+		canceledStatus := string(core.StatusCanceled)
+		order.AutoRenewal.Canceled = &canceled
+		order.Status = &canceledStatus
+	}
+
+	if order.GetStatus() == string(core.StatusProcessing) {
+		response.Header().Set("Retry-After", fmt.Sprintf("%d", int(orderRetryAfter.Seconds())))
+	}
+
 	respObj := wfe.orderToOrderJSON(request, order)
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, respObj)
 	if err != nil {
@@ -2073,6 +2675,153 @@ func (wfe *WebFrontEndImpl) GetOrder(ctx context.Context, logEvent *web.RequestE
 	}
 }
 
+// ordersPerPage is the maximum number of order URLs included in a single page
+// of an account's orders list.
+const ordersPerPage = 100
+
+// ordersList is the orders list object described in RFC 8555 Section 7.1.2.1.
+type ordersList struct {
+	Orders []string `json:"orders"`
+}
+
+// Orders is used by clients to list the URLs of their account's unexpired
+// orders. The list is paginated: if there are more orders than fit on one page
+// a Link header with relation "next" points at the following page.
+func (wfe *WebFrontEndImpl) Orders(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
+	acct, prob := wfe.validPOSTAsGETForAccount(request, ctx, logEvent)
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+
+	// Path prefix is stripped, so this should be like "<account ID>" for the
+	// first page, or "<account ID>/<cursor>" for subsequent pages, where the
+	// cursor is the ID of the last order on the previous page.
+	fields := strings.SplitN(request.URL.Path, "/", 2)
+	acctID, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.Malformed("Invalid account ID"), err)
+		return
+	}
+	var cursor int64
+	if len(fields) == 2 {
+		cursor, err = strconv.ParseInt(fields[1], 10, 64)
+		if err != nil || cursor < 0 {
+			wfe.sendError(response, logEvent, probs.Malformed("Invalid orders list cursor"), err)
+			return
+		}
+	}
+	if acctID != acct.ID {
+		wfe.sendError(response, logEvent, probs.Unauthorized("Account in use did not match the requested orders list"), nil)
+		return
+	}
+
+	// Ask for one more order than fits on a page to find out whether there is a
+	// next page.
+	now := wfe.clk.Now().UnixNano()
+	limit := int64(ordersPerPage + 1)
+	orderIDs, err := wfe.SA.GetOrdersForAccount(ctx, &sapb.GetOrdersForAccountRequest{
+		AcctID:  &acct.ID,
+		Now:     &now,
+		AfterID: &cursor,
+		Limit:   &limit,
+	})
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Failed to retrieve orders"), err)
+		return
+	}
+
+	ids := orderIDs.Ids
+	if len(ids) > ordersPerPage {
+		ids = ids[:ordersPerPage]
+		nextURL := web.RelativeEndpoint(request,
+			fmt.Sprintf("%s%d/%d", ordersPath, acct.ID, ids[len(ids)-1]))
+		response.Header().Add("Link", link(nextURL, "next"))
+	}
+
+	respObj := ordersList{Orders: make([]string, len(ids))}
+	for i, id := range ids {
+		respObj.Orders[i] = web.RelativeEndpoint(request, fmt.Sprintf("%s%d/%d", orderPath, acct.ID, id))
+	}
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, respObj)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshaling orders list"), err)
+		return
+	}
+}
+
+// rateLimitStatus is the status of one rate limit in a rateLimitsStatus.
+type rateLimitStatus struct {
+	Limit     string    `json:"limit"`
+	Key       string    `json:"key,omitempty"`
+	Usage     int64     `json:"usage"`
+	Threshold int64     `json:"threshold"`
+	Reset     time.Time `json:"reset"`
+}
+
+// rateLimitsStatus is the response object of the rate limits resource.
+type rateLimitsStatus struct {
+	Limits []rateLimitStatus `json:"limits"`
+}
+
+// RateLimits is used by clients to see how close their account is to the rate
+// limits applied to it. The account-scoped limits are always reported, and the
+// limits applied to a domain name are reported too if the name is included in
+// the URL.
+func (wfe *WebFrontEndImpl) RateLimits(ctx context.Context, logEvent *web.RequestEvent, response http.ResponseWriter, request *http.Request) {
+	acct, prob := wfe.validPOSTAsGETForAccount(request, ctx, logEvent)
+	if prob != nil {
+		wfe.sendError(response, logEvent, prob, nil)
+		return
+	}
+
+	// Path prefix is stripped, so this should be like "<account ID>" or
+	// "<account ID>/<domain name>".
+	fields := strings.SplitN(request.URL.Path, "/", 2)
+	acctID, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.Malformed("Invalid account ID"), err)
+		return
+	}
+	if acctID != acct.ID {
+		wfe.sendError(response, logEvent, probs.Unauthorized("Account in use did not match the requested rate limits"), nil)
+		return
+	}
+	var name string
+	if len(fields) == 2 {
+		name = fields[1]
+		if name == "" {
+			wfe.sendError(response, logEvent, probs.Malformed("Invalid domain name"), nil)
+			return
+		}
+	}
+
+	status, err := wfe.RA.RateLimitStatus(ctx, &rapb.RateLimitStatusRequest{
+		RegistrationID: &acct.ID,
+		Name:           &name,
+	})
+	if err != nil {
+		wfe.sendError(response, logEvent, web.ProblemDetailsForError(err, "Unable to get rate limit status"), err)
+		return
+	}
+
+	respObj := rateLimitsStatus{Limits: make([]rateLimitStatus, len(status.Limits))}
+	for i, limit := range status.Limits {
+		respObj.Limits[i] = rateLimitStatus{
+			Limit:     limit.GetLimit(),
+			Key:       limit.GetKey(),
+			Usage:     limit.GetUsage(),
+			Threshold: limit.GetThreshold(),
+			Reset:     time.Unix(0, limit.GetResetAt()).UTC(),
+		}
+	}
+	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, respObj)
+	if err != nil {
+		wfe.sendError(response, logEvent, probs.ServerInternal("Error marshaling rate limit status"), err)
+		return
+	}
+}
+
 // FinalizeOrder is used to request issuance for a existing order object.
 // Most processing of the order details is handled by the RA but
 // we do attempt to throw away requests with invalid CSRs here.
@@ -2184,6 +2933,11 @@ func (wfe *WebFrontEndImpl) FinalizeOrder(ctx context.Context, logEvent *web.Req
 	orderURL := web.RelativeEndpoint(request,
 		fmt.Sprintf("%s%d/%d", orderPath, acct.ID, *updatedOrder.Id))
 	response.Header().Set("Location", orderURL)
+	// Issuance may continue in the background after the RA returns, in which
+	// case the client should poll the order until it leaves processing.
+	if updatedOrder.GetStatus() == string(core.StatusProcessing) {
+		response.Header().Set("Retry-After", fmt.Sprintf("%d", int(orderRetryAfter.Seconds())))
+	}
 
 	respObj := wfe.orderToOrderJSON(request, updatedOrder)
 	err = wfe.writeJsonResponse(response, logEvent, http.StatusOK, respObj)
@@ -2193,6 +2947,11 @@ func (wfe *WebFrontEndImpl) FinalizeOrder(ctx context.Context, logEvent *web.Req
 	}
 }
 
+// orderRetryAfter is the value of the Retry-After header sent with orders that
+// are still processing, suggesting how long the client should wait before
+// polling the order again.
+const orderRetryAfter = 3 * time.Second
+
 func extractRequesterIP(req *http.Request) (net.IP, error) {
 	ip := net.ParseIP(req.Header.Get("X-Real-IP"))
 	if ip != nil {
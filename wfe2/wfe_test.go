@@ -9,6 +9,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
@@ -25,6 +26,7 @@ import (
 	"time"
 
 	"github.com/jmhodges/clock"
+	"golang.org/x/crypto/ed25519"
 	jose "gopkg.in/square/go-jose.v2"
 
 	"github.com/letsencrypt/boulder/core"
@@ -40,6 +42,7 @@ import (
 	"github.com/letsencrypt/boulder/probs"
 	rapb "github.com/letsencrypt/boulder/ra/proto"
 	"github.com/letsencrypt/boulder/revocation"
+	sapb "github.com/letsencrypt/boulder/sa/proto"
 	"github.com/letsencrypt/boulder/test"
 	"github.com/letsencrypt/boulder/web"
 )
@@ -264,6 +267,8 @@ func (ra *MockRegistrationAuthority) NewOrder(ctx context.Context, req *rapb.New
 		Names:            req.Names,
 		Status:           &status,
 		V2Authorizations: []int64{1},
+		CertProfile:      req.CertProfile,
+		AutoRenewal:      req.AutoRenewal,
 	}, nil
 }
 
@@ -273,6 +278,85 @@ func (ra *MockRegistrationAuthority) FinalizeOrder(ctx context.Context, req *rap
 	return req.Order, nil
 }
 
+func (ra *MockRegistrationAuthority) NewPreAuthorization(ctx context.Context, req *rapb.NewPreAuthorizationRequest) (*corepb.Authorization, error) {
+	if *req.IdentifierValue == "ratelimited.com" {
+		return nil, berrors.RateLimitError("too many currently pending authorizations")
+	}
+	id := "1"
+	zero := int64(0)
+	status := string(core.StatusPending)
+	challType := string(core.ChallengeTypeHTTP01)
+	token := "token"
+	return &corepb.Authorization{
+		Id:             &id,
+		Identifier:     req.IdentifierValue,
+		IdentifierType: req.IdentifierType,
+		RegistrationID: req.RegistrationID,
+		Status:         &status,
+		Expires:        &zero,
+		Challenges: []*corepb.Challenge{
+			{
+				Type:   &challType,
+				Status: &status,
+				Token:  &token,
+			},
+		},
+	}, nil
+}
+
+func (ra *MockRegistrationAuthority) CancelAutoRenewal(ctx context.Context, req *rapb.CancelAutoRenewalRequest) (*corepb.Empty, error) {
+	// Only order 11 is a valid STAR order
+	if *req.OrderID != 11 {
+		return nil, berrors.AutoRenewalCancellationInvalidError("order %d is not an auto-renewal order", *req.OrderID)
+	}
+	return &corepb.Empty{}, nil
+}
+
+func (ra *MockRegistrationAuthority) AddRateLimitOverride(ctx context.Context, _ *corepb.RateLimitOverride) (*corepb.RateLimitOverride, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) GetRateLimitOverrides(ctx context.Context, _ *rapb.GetRateLimitOverridesRequest) (*corepb.RateLimitOverrides, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) ExpireRateLimitOverride(ctx context.Context, _ *rapb.ExpireRateLimitOverrideRequest) (*corepb.Empty, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) ReviewHeldOrder(ctx context.Context, _ *rapb.ReviewHeldOrderRequest) (*corepb.Order, error) {
+	return nil, nil
+}
+
+func (ra *MockRegistrationAuthority) RateLimitStatus(ctx context.Context, req *rapb.RateLimitStatusRequest) (*rapb.RateLimitStatusResponse, error) {
+	status := func(limit, key string, usage, threshold int64, resetAt time.Time) *rapb.RateLimitStatus {
+		resetAtNS := resetAt.UnixNano()
+		return &rapb.RateLimitStatus{
+			Limit:     &limit,
+			Key:       &key,
+			Usage:     &usage,
+			Threshold: &threshold,
+			ResetAt:   &resetAtNS,
+		}
+	}
+	resp := &rapb.RateLimitStatusResponse{
+		Limits: []*rapb.RateLimitStatus{
+			status("newOrdersPerAccount", "", 3, 300, time.Date(2020, 10, 18, 3, 0, 0, 0, time.UTC)),
+		},
+	}
+	switch req.GetName() {
+	case "":
+	case "www.example.com":
+		resp.Limits = append(resp.Limits,
+			status("certificatesPerName", "example.com", 50, 50, time.Date(2020, 10, 25, 0, 0, 0, 0, time.UTC)))
+	case "ratelimited.com":
+		return nil, berrors.RateLimitError("too many rate limit status requests").(*berrors.BoulderError).WithRetryAfter(90 * time.Second)
+	default:
+		return nil, berrors.RejectedIdentifierError("rejected %q", req.GetName())
+	}
+	return resp, nil
+}
+
 func makeBody(s string) io.ReadCloser {
 	return ioutil.NopCloser(strings.NewReader(s))
 }
@@ -319,6 +403,7 @@ var testKeyPolicy = goodkey.KeyPolicy{
 	AllowRSA:           true,
 	AllowECDSANISTP256: true,
 	AllowECDSANISTP384: true,
+	AllowEd25519:       true,
 }
 
 var ctx = context.Background()
@@ -712,6 +797,9 @@ func TestDirectory(t *testing.T) {
 		name         string
 		caaIdent     string
 		website      string
+		eabRequired  bool
+		profiles     map[string]string
+		autoRenewal  *AutoRenewalMeta
 		expectedJSON string
 		request      *http.Request
 	}{
@@ -726,6 +814,8 @@ func TestDirectory(t *testing.T) {
   "newNonce": "http://localhost:4300/acme/new-nonce",
   "newAccount": "http://localhost:4300/acme/new-acct",
   "newOrder": "http://localhost:4300/acme/new-order",
+  "newAuthz": "http://localhost:4300/acme/new-authz",
+  "renewalInfo": "http://localhost:4300/acme/renewal-info/",
   "revokeCert": "http://localhost:4300/acme/revoke-cert",
   "AAAAAAAAAAA": "https://community.letsencrypt.org/t/adding-random-entries-to-the-directory/33417"
 }`,
@@ -748,6 +838,72 @@ func TestDirectory(t *testing.T) {
   "newAccount": "http://localhost:4300/acme/new-acct",
   "newNonce": "http://localhost:4300/acme/new-nonce",
   "newOrder": "http://localhost:4300/acme/new-order",
+  "newAuthz": "http://localhost:4300/acme/new-authz",
+  "renewalInfo": "http://localhost:4300/acme/renewal-info/",
+  "revokeCert": "http://localhost:4300/acme/revoke-cert"
+}`,
+		},
+		{
+			name:        "standard GET, external account binding required",
+			eabRequired: true,
+			request:     getReq,
+			expectedJSON: `{
+  "AAAAAAAAAAA": "https://community.letsencrypt.org/t/adding-random-entries-to-the-directory/33417",
+  "keyChange": "http://localhost:4300/acme/key-change",
+  "meta": {
+    "externalAccountRequired": true,
+    "termsOfService": "http://example.invalid/terms"
+  },
+  "newAccount": "http://localhost:4300/acme/new-acct",
+  "newNonce": "http://localhost:4300/acme/new-nonce",
+  "newOrder": "http://localhost:4300/acme/new-order",
+  "newAuthz": "http://localhost:4300/acme/new-authz",
+  "renewalInfo": "http://localhost:4300/acme/renewal-info/",
+  "revokeCert": "http://localhost:4300/acme/revoke-cert"
+}`,
+		},
+		{
+			name:     "standard GET, certificate profiles",
+			profiles: map[string]string{"classic": "The default profile", "short-lived": "Six day validity"},
+			request:  getReq,
+			expectedJSON: `{
+  "AAAAAAAAAAA": "https://community.letsencrypt.org/t/adding-random-entries-to-the-directory/33417",
+  "keyChange": "http://localhost:4300/acme/key-change",
+  "meta": {
+    "profiles": {
+      "classic": "The default profile",
+      "short-lived": "Six day validity"
+    },
+    "termsOfService": "http://example.invalid/terms"
+  },
+  "newAccount": "http://localhost:4300/acme/new-acct",
+  "newNonce": "http://localhost:4300/acme/new-nonce",
+  "newOrder": "http://localhost:4300/acme/new-order",
+  "newAuthz": "http://localhost:4300/acme/new-authz",
+  "renewalInfo": "http://localhost:4300/acme/renewal-info/",
+  "revokeCert": "http://localhost:4300/acme/revoke-cert"
+}`,
+		},
+		{
+			name:        "standard GET, auto-renewal",
+			autoRenewal: &AutoRenewalMeta{MinLifetime: 86400, MaxDuration: 2592000, AllowCertificateGet: true},
+			request:     getReq,
+			expectedJSON: `{
+  "AAAAAAAAAAA": "https://community.letsencrypt.org/t/adding-random-entries-to-the-directory/33417",
+  "keyChange": "http://localhost:4300/acme/key-change",
+  "meta": {
+    "auto-renewal": {
+      "min-lifetime": 86400,
+      "max-duration": 2592000,
+      "allow-certificate-get": true
+    },
+    "termsOfService": "http://example.invalid/terms"
+  },
+  "newAccount": "http://localhost:4300/acme/new-acct",
+  "newNonce": "http://localhost:4300/acme/new-nonce",
+  "newOrder": "http://localhost:4300/acme/new-order",
+  "newAuthz": "http://localhost:4300/acme/new-authz",
+  "renewalInfo": "http://localhost:4300/acme/renewal-info/",
   "revokeCert": "http://localhost:4300/acme/revoke-cert"
 }`,
 		},
@@ -769,6 +925,8 @@ func TestDirectory(t *testing.T) {
   "newAccount": "http://localhost/acme/new-acct",
   "newNonce": "http://localhost/acme/new-nonce",
   "newOrder": "http://localhost/acme/new-order",
+  "newAuthz": "http://localhost/acme/new-authz",
+  "renewalInfo": "http://localhost/acme/renewal-info/",
   "revokeCert": "http://localhost/acme/revoke-cert"
 }`,
 		},
@@ -779,6 +937,9 @@ func TestDirectory(t *testing.T) {
 			// Configure a caaIdentity and website for the /directory meta based on the tc
 			wfe.DirectoryCAAIdentity = tc.caaIdent // "Radiant Lock"
 			wfe.DirectoryWebsite = tc.website      //"zombo.com"
+			wfe.ExternalAccountRequired = tc.eabRequired
+			wfe.CertificateProfiles = tc.profiles
+			wfe.AutoRenewal = tc.autoRenewal
 			responseWriter := httptest.NewRecorder()
 			// Serve the /directory response for this request into a recorder
 			mux.ServeHTTP(responseWriter, tc.request)
@@ -810,6 +971,8 @@ func TestRelativeDirectory(t *testing.T) {
 		fmt.Fprintf(expected, `"newNonce":"%s/acme/new-nonce",`, hostname)
 		fmt.Fprintf(expected, `"newAccount":"%s/acme/new-acct",`, hostname)
 		fmt.Fprintf(expected, `"newOrder":"%s/acme/new-order",`, hostname)
+		fmt.Fprintf(expected, `"newAuthz":"%s/acme/new-authz",`, hostname)
+		fmt.Fprintf(expected, `"renewalInfo":"%s/acme/renewal-info/",`, hostname)
 		fmt.Fprintf(expected, `"revokeCert":"%s/acme/revoke-cert",`, hostname)
 		fmt.Fprintf(expected, `"AAAAAAAAAAA":"https://community.letsencrypt.org/t/adding-random-entries-to-the-directory/33417",`)
 		fmt.Fprintf(expected, `"meta":{"termsOfService":"http://example.invalid/terms"}`)
@@ -966,6 +1129,11 @@ func TestHTTPMethods(t *testing.T) {
 			Path:    certPath,
 			Allowed: getOrPost,
 		},
+		{
+			Name:    "STAR certificate path should be GET or POST only",
+			Path:    starCertPath,
+			Allowed: getOrPost,
+		},
 		{
 			Name:    "RevokeCert path should be POST only",
 			Path:    revokeCertPath,
@@ -991,6 +1159,11 @@ func TestHTTPMethods(t *testing.T) {
 			Path:    newOrderPath,
 			Allowed: postOnly,
 		},
+		{
+			Name:    "New authz path should be POST only",
+			Path:    newAuthzPath,
+			Allowed: postOnly,
+		},
 		// TODO(@cpu): Remove GET order support, support only POST-as-GET
 		{
 			Name:    "Order path should be GET or POST only",
@@ -1299,6 +1472,7 @@ func TestNewECDSAAccount(t *testing.T) {
 		},
 		"initialIp": "",
 		"createdAt": "0001-01-01T00:00:00Z",
+		"orders": "http://localhost/acme/orders/3",
 		"status": ""
 		}`)
 	test.AssertEquals(t, responseWriter.Header().Get("Location"), "http://localhost/acme/acct/3")
@@ -1481,6 +1655,7 @@ func TestNewAccount(t *testing.T) {
 		],
 		"initialIp": "",
 		"createdAt": "0001-01-01T00:00:00Z",
+		"orders": "http://localhost/acme/orders/1",
 		"status": "valid"
 	}`)
 }
@@ -1530,10 +1705,129 @@ func TestNewAccountNoID(t *testing.T) {
 		],
 		"initialIp": "1.1.1.1",
 		"createdAt": "0001-01-01T00:00:00Z",
+		"orders": "http://localhost/acme/orders/1",
 		"status": ""
 	}`)
 }
 
+// recordingNewRegRA is a MockRegistrationAuthority that remembers the last
+// registration it was asked to create.
+type recordingNewRegRA struct {
+	MockRegistrationAuthority
+	lastReg core.Registration
+}
+
+func (ra *recordingNewRegRA) NewRegistration(ctx context.Context, acct core.Registration) (core.Registration, error) {
+	ra.lastReg = acct
+	return ra.MockRegistrationAuthority.NewRegistration(ctx, acct)
+}
+
+// signExternalAccountBinding creates an external account binding JWS for the
+// provided account key, signed with the given MAC key and key ID and with the
+// given URL header. The JWS is returned in serialized string form.
+func signExternalAccountBinding(t *testing.T, keyID string, macKey []byte, url string, acctKey *jose.JSONWebKey) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{
+			Key:       macKey,
+			Algorithm: jose.HS256,
+		},
+		&jose.SignerOptions{
+			// go-jose only adds a "kid" header for asymmetric signing keys
+			ExtraHeaders: map[jose.HeaderKey]interface{}{
+				"kid": keyID,
+				"url": url,
+			},
+		})
+	test.AssertNotError(t, err, "Failed to make EAB signer")
+	payload, err := json.Marshal(acctKey)
+	test.AssertNotError(t, err, "Failed to marshal account key")
+	jws, err := signer.Sign(payload)
+	test.AssertNotError(t, err, "Failed to sign EAB")
+	return jws.FullSerialize()
+}
+
+func TestNewAccountExternalAccountBinding(t *testing.T) {
+	wfe, _ := setupWFE(t)
+	ra := &recordingNewRegRA{}
+	wfe.RA = ra
+	wfe.ExternalAccountRequired = true
+
+	key := loadKey(t, []byte(test2KeyPrivatePEM))
+	acctJWK := &jose.JSONWebKey{Key: key.Public()}
+	otherJWK := &jose.JSONWebKey{Key: loadKey(t, []byte(test3KeyPrivatePEM)).Public()}
+	signedURL := fmt.Sprintf("http://localhost%s", newAcctPath)
+
+	withEAB := func(eab string) string {
+		return fmt.Sprintf(`{"contact":["mailto:person@mail.com"],"termsOfServiceAgreed":true,"externalAccountBinding":%s}`, eab)
+	}
+
+	testCases := []struct {
+		name         string
+		payload      string
+		expectedCode int
+		expectedProb string
+	}{
+		{
+			name:         "No binding when required",
+			payload:      `{"contact":["mailto:person@mail.com"],"termsOfServiceAgreed":true}`,
+			expectedCode: http.StatusForbidden,
+			expectedProb: `{"type":"` + probs.V2ErrorNS + `externalAccountRequired","detail":"New accounts must include an externalAccountBinding","status":403}`,
+		},
+		{
+			name:         "Unknown key ID",
+			payload:      withEAB(signExternalAccountBinding(t, "kid-unknown", mocks.ExternalAccountHMACKey, signedURL, acctJWK)),
+			expectedCode: http.StatusForbidden,
+			expectedProb: `{"type":"` + probs.V2ErrorNS + `unauthorized","detail":"External account binding key ID \"kid-unknown\" is not known","status":403}`,
+		},
+		{
+			name:         "Revoked key ID",
+			payload:      withEAB(signExternalAccountBinding(t, mocks.RevokedExternalAccountKeyID, mocks.ExternalAccountHMACKey, signedURL, acctJWK)),
+			expectedCode: http.StatusForbidden,
+			expectedProb: `{"type":"` + probs.V2ErrorNS + `unauthorized","detail":"External account binding key ID \"kid-revoked\" has been revoked","status":403}`,
+		},
+		{
+			name:         "Wrong MAC key",
+			payload:      withEAB(signExternalAccountBinding(t, mocks.ExternalAccountKeyID, []byte("not the right MAC key at all, no sir"), signedURL, acctJWK)),
+			expectedCode: http.StatusForbidden,
+			expectedProb: `{"type":"` + probs.V2ErrorNS + `unauthorized","detail":"External account binding JWS verification error","status":403}`,
+		},
+		{
+			name:         "Mismatched URL",
+			payload:      withEAB(signExternalAccountBinding(t, mocks.ExternalAccountKeyID, mocks.ExternalAccountHMACKey, "http://localhost/acme/key-change", acctJWK)),
+			expectedCode: http.StatusBadRequest,
+			expectedProb: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"External account binding JWS 'url' value \"http://localhost/acme/key-change\" does not match outer JWS 'url' value \"http://localhost/acme/new-acct\"","status":400}`,
+		},
+		{
+			name:         "Binding for a different account key",
+			payload:      withEAB(signExternalAccountBinding(t, mocks.ExternalAccountKeyID, mocks.ExternalAccountHMACKey, signedURL, otherJWK)),
+			expectedCode: http.StatusBadRequest,
+			expectedProb: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"External account binding JWS payload does not match the account key","status":400}`,
+		},
+		{
+			name:         "Valid binding",
+			payload:      withEAB(signExternalAccountBinding(t, mocks.ExternalAccountKeyID, mocks.ExternalAccountHMACKey, signedURL, acctJWK)),
+			expectedCode: http.StatusCreated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ra.lastReg = core.Registration{}
+			_, _, body := signRequestEmbed(t, key, signedURL, tc.payload, wfe.nonceService)
+			responseWriter := httptest.NewRecorder()
+			wfe.NewAccount(ctx, newRequestEvent(), responseWriter, makePostRequestWithPath(newAcctPath, body))
+			test.AssertEquals(t, responseWriter.Code, tc.expectedCode)
+			if tc.expectedProb != "" {
+				test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.expectedProb)
+				return
+			}
+			test.AssertEquals(t, ra.lastReg.ExternalAccountID, mocks.ExternalAccountKeyID)
+			// The external account key ID must not be shown to the client
+			test.AssertNotContains(t, responseWriter.Body.String(), mocks.ExternalAccountKeyID)
+		})
+	}
+}
+
 func TestGetAuthorization(t *testing.T) {
 	wfe, _ := setupWFE(t)
 
@@ -2134,6 +2428,7 @@ func TestDeactivateAccount(t *testing.T) {
 		  ],
 		  "initialIp": "",
 		  "createdAt": "0001-01-01T00:00:00Z",
+		  "orders": "http://localhost/acme/orders/1",
 		  "status": "deactivated"
 		}`)
 
@@ -2155,6 +2450,7 @@ func TestDeactivateAccount(t *testing.T) {
 		  ],
 		  "initialIp": "",
 		  "createdAt": "0001-01-01T00:00:00Z",
+		  "orders": "http://localhost/acme/orders/1",
 		  "status": "deactivated"
 		}`)
 
@@ -2198,6 +2494,33 @@ func TestNewOrder(t *testing.T) {
 	}
 	`
 
+	dnsIdentifierWithIPBody := `
+	{
+		"Identifiers": [
+		  {"type": "dns", "value": "not-example.com"},
+			{"type": "dns", "value": "64.112.117.1"}
+		]
+	}
+	`
+
+	invalidIPIdentifierBody := `
+	{
+		"Identifiers": [
+		  {"type": "dns", "value": "not-example.com"},
+			{"type": "ip",  "value": "www.not-example.com"}
+		]
+	}
+	`
+
+	validIPOrderBody := `
+	{
+		"Identifiers": [
+		  {"type": "dns", "value": "not-example.com"},
+			{"type": "ip",  "value": "64.112.117.1"},
+			{"type": "ip",  "value": "2602:80a:6000::1"}
+		]
+	}`
+
 	validOrderBody := `
 	{
 		"Identifiers": [
@@ -2206,6 +2529,9 @@ func TestNewOrder(t *testing.T) {
 		]
 	}`
 
+	wfe.CertificateProfiles = map[string]string{"short-lived": "Six day validity"}
+	wfe.AutoRenewal = &AutoRenewalMeta{MinLifetime: 86400, MaxDuration: 2592000}
+
 	testCases := []struct {
 		Name            string
 		Request         *http.Request
@@ -2241,7 +2567,17 @@ func TestNewOrder(t *testing.T) {
 		{
 			Name:         "POST, invalid identifier in payload",
 			Request:      signAndPost(t, targetPath, signedURL, nonDNSIdentifierBody, 1, wfe.nonceService),
-			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request included invalid non-DNS type identifier: type \"fakeID\", value \"www.i-am-21.com\"","status":400}`,
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request included invalid non-DNS, non-IP type identifier: type \"fakeID\", value \"www.i-am-21.com\"","status":400}`,
+		},
+		{
+			Name:         "POST, DNS identifier with IP address value in payload",
+			Request:      signAndPost(t, targetPath, signedURL, dnsIdentifierWithIPBody, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request included DNS type identifier with IP address value \"64.112.117.1\", use an IP type identifier","status":400}`,
+		},
+		{
+			Name:         "POST, IP identifier with non-IP address value in payload",
+			Request:      signAndPost(t, targetPath, signedURL, invalidIPIdentifierBody, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request included IP type identifier with invalid IP address value \"www.not-example.com\"","status":400}`,
 		},
 		{
 			Name:         "POST, notAfter and notBefore in payload",
@@ -2265,6 +2601,92 @@ func TestNewOrder(t *testing.T) {
 						"finalize": "http://localhost/acme/finalize/1/1"
 					}`,
 		},
+		{
+			Name:         "POST, unknown certificate profile in payload",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "profile":"long-lived"}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request included unknown certificate profile \"long-lived\"","status":400}`,
+		},
+		{
+			Name:    "POST, good payload with certificate profile",
+			Request: signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "profile":"short-lived"}`, 1, wfe.nonceService),
+			ExpectedBody: `
+					{
+						"status": "pending",
+						"expires": "1970-01-01T00:00:00Z",
+						"identifiers": [
+							{ "type": "dns", "value": "not-example.com"}
+						],
+						"authorizations": [
+							"http://localhost/acme/authz-v3/1"
+						],
+						"finalize": "http://localhost/acme/finalize/1/1",
+						"profile": "short-lived"
+					}`,
+		},
+		{
+			Name:         "POST, auto-renewal without end-date",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "auto-renewal":{"lifetime":345600}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request auto-renewal did not specify an end-date","status":400}`,
+		},
+		{
+			Name:         "POST, auto-renewal without lifetime",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "auto-renewal":{"end-date":"2015-04-04T05:00:00Z"}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request auto-renewal did not specify a positive lifetime","status":400}`,
+		},
+		{
+			Name:         "POST, auto-renewal with lifetime-adjust",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "auto-renewal":{"end-date":"2015-04-04T05:00:00Z","lifetime":345600,"lifetime-adjust":3600}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request auto-renewal lifetime-adjust is not supported","status":400}`,
+		},
+		{
+			Name:         "POST, auto-renewal with unsupported allow-certificate-get",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "auto-renewal":{"end-date":"2015-04-04T05:00:00Z","lifetime":345600,"allow-certificate-get":true}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request auto-renewal allow-certificate-get is not supported","status":400}`,
+		},
+		{
+			Name:         "POST, auto-renewal with certificate profile",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "profile":"short-lived", "auto-renewal":{"end-date":"2015-04-04T05:00:00Z","lifetime":345600}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request for auto-renewal cannot include replaces or profile","status":400}`,
+		},
+		{
+			Name:    "POST, good payload with auto-renewal",
+			Request: signAndPost(t, targetPath, signedURL, `{"identifiers":[{"type": "dns", "value": "not-example.com"}], "auto-renewal":{"start-date":"2015-03-04T05:00:00Z","end-date":"2015-04-04T05:00:00Z","lifetime":345600}}`, 1, wfe.nonceService),
+			ExpectedBody: `
+					{
+						"status": "pending",
+						"expires": "1970-01-01T00:00:00Z",
+						"identifiers": [
+							{ "type": "dns", "value": "not-example.com"}
+						],
+						"authorizations": [
+							"http://localhost/acme/authz-v3/1"
+						],
+						"finalize": "http://localhost/acme/finalize/1/1",
+						"auto-renewal": {
+							"start-date": "2015-03-04T05:00:00Z",
+							"end-date": "2015-04-04T05:00:00Z",
+							"lifetime": 345600
+						}
+					}`,
+		},
+		{
+			Name:    "POST, good payload with IP identifiers",
+			Request: signAndPost(t, targetPath, signedURL, validIPOrderBody, 1, wfe.nonceService),
+			ExpectedBody: `
+					{
+						"status": "pending",
+						"expires": "1970-01-01T00:00:00Z",
+						"identifiers": [
+							{ "type": "dns", "value": "not-example.com"},
+							{ "type": "ip", "value": "64.112.117.1"},
+							{ "type": "ip", "value": "2602:80a:6000::1"}
+						],
+						"authorizations": [
+							"http://localhost/acme/authz-v3/1"
+						],
+						"finalize": "http://localhost/acme/finalize/1/1"
+					}`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2386,9 +2808,12 @@ func TestFinalizeOrder(t *testing.T) {
 			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `orderNotReady","detail":"Order's status (\"pending\") is not acceptable for finalization","status":403}`,
 		},
 		{
-			Name:            "Good CSR, Ready Order",
-			Request:         signAndPost(t, "1/8", "http://localhost/1/8", goodCertCSRPayload, 1, wfe.nonceService),
-			ExpectedHeaders: map[string]string{"Location": "http://localhost/acme/order/1/8"},
+			Name:    "Good CSR, Ready Order",
+			Request: signAndPost(t, "1/8", "http://localhost/1/8", goodCertCSRPayload, 1, wfe.nonceService),
+			ExpectedHeaders: map[string]string{
+				"Location":    "http://localhost/acme/order/1/8",
+				"Retry-After": "3",
+			},
 			ExpectedBody: `
 {
   "status": "processing",
@@ -2444,6 +2869,13 @@ func TestKeyRollover(t *testing.T) {
 	newJWKJSON, err := jose.JSONWebKey{Key: newKeyPriv.Public()}.MarshalJSON()
 	test.AssertNotError(t, err, "Failed to marshal JWK JSON")
 
+	// Ed25519 example key from RFC 8037 Appendix A.2, unknown to the mock SA.
+	edSeed, err := base64.RawURLEncoding.DecodeString("nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A")
+	test.AssertNotError(t, err, "Failed to decode Ed25519 seed")
+	newEdKeyPriv := ed25519.NewKeyFromSeed(edSeed)
+	newEdJWKJSON, err := jose.JSONWebKey{Key: newEdKeyPriv.Public()}.MarshalJSON()
+	test.AssertNotError(t, err, "Failed to marshal Ed25519 JWK JSON")
+
 	wfe.KeyRollover(ctx, newRequestEvent(), responseWriter, makePostRequestWithPath("", "{}"))
 	test.AssertUnmarshaledEquals(t,
 		responseWriter.Body.String(),
@@ -2502,10 +2934,26 @@ func TestKeyRollover(t *testing.T) {
 		     ],
 		     "initialIp": "",
 		     "createdAt": "0001-01-01T00:00:00Z",
+		     "orders": "http://localhost/acme/orders/1",
 		     "status": "valid"
 		   }`,
 			NewKey: newKeyPriv,
 		},
+		{
+			Name:    "Valid key rollover request, Ed25519 key",
+			Payload: `{"oldKey":` + test1KeyPublicJSON + `,"account":"http://localhost/acme/acct/1"}`,
+			ExpectedResponse: `{
+		     "key": ` + string(newEdJWKJSON) + `,
+		     "contact": [
+		       "mailto:person@mail.com"
+		     ],
+		     "initialIp": "",
+		     "createdAt": "0001-01-01T00:00:00Z",
+		     "orders": "http://localhost/acme/orders/1",
+		     "status": "valid"
+		   }`,
+			NewKey: newEdKeyPriv,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2544,63 +2992,172 @@ func TestKeyRolloverMismatchedJWSURLs(t *testing.T) {
 		}`)
 }
 
-func TestGetOrder(t *testing.T) {
+func TestNewAuthorization(t *testing.T) {
 	wfe, _ := setupWFE(t)
+	responseWriter := httptest.NewRecorder()
 
-	makeGet := func(path string) *http.Request {
-		return &http.Request{URL: &url.URL{Path: path}, Method: "GET"}
-	}
-
-	makePost := func(keyID int64, path, body string) *http.Request {
-		_, _, jwsBody := signRequestKeyID(t, keyID, nil, fmt.Sprintf("http://localhost/%s", path), body, wfe.nonceService)
-		return makePostRequestWithPath(path, jwsBody)
-	}
+	targetHost := "localhost"
+	targetPath := "new-authz"
+	signedURL := fmt.Sprintf("http://%s/%s", targetHost, targetPath)
 
 	testCases := []struct {
-		Name     string
-		Request  *http.Request
-		Response string
-		Endpoint string
+		Name             string
+		Request          *http.Request
+		ExpectedBody     string
+		ExpectedLocation string
 	}{
 		{
-			Name:     "Good request",
-			Request:  makeGet("1/1"),
-			Response: `{"status": "valid","expires": "1970-01-01T00:00:00.9466848Z","identifiers":[{"type":"dns", "value":"example.com"}], "authorizations":["http://localhost/acme/authz-v3/1"],"finalize":"http://localhost/acme/finalize/1/1","certificate":"http://localhost/acme/cert/serial"}`,
+			Name:         "POST, with an invalid JWS body",
+			Request:      makePostRequestWithPath("hi", "hi"),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Parse error reading JWS","status":400}`,
 		},
 		{
-			Name:     "404 request",
-			Request:  makeGet("1/2"),
-			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"No order for ID 2", "status":404}`,
+			Name:         "POST, properly signed JWS, payload isn't valid",
+			Request:      signAndPost(t, targetPath, signedURL, "foo", 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Request payload did not parse as JSON","status":400}`,
 		},
 		{
-			Name:     "Invalid request path",
-			Request:  makeGet("asd"),
-			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid request path","status":404}`,
+			Name:         "POST, no identifier in payload",
+			Request:      signAndPost(t, targetPath, signedURL, "{}", 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewAuthorization request did not specify an identifier","status":400}`,
 		},
 		{
-			Name:     "Invalid account ID",
-			Request:  makeGet("asd/asd"),
-			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid account ID","status":400}`,
+			Name:         "POST, invalid identifier type in payload",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"fakeID","value":"www.i-am-21.com"}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewAuthorization request included invalid non-DNS, non-IP type identifier: type \"fakeID\", value \"www.i-am-21.com\"","status":400}`,
 		},
 		{
-			Name:     "Invalid order ID",
-			Request:  makeGet("1/asd"),
-			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid order ID","status":400}`,
+			Name:         "POST, DNS identifier with IP address value in payload",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"dns","value":"64.112.117.1"}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewAuthorization request included DNS type identifier with IP address value \"64.112.117.1\", use an IP type identifier","status":400}`,
 		},
 		{
-			Name:     "Real request, wrong account",
-			Request:  makeGet("2/1"),
-			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"No order found for account ID 2", "status":404}`,
+			Name:         "POST, IP identifier with non-IP address value in payload",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"ip","value":"not-example.com"}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewAuthorization request included IP type identifier with invalid IP address value \"not-example.com\"","status":400}`,
 		},
 		{
-			Name:     "Internal error request",
-			Request:  makeGet("1/3"),
-			Response: `{"type":"` + probs.V2ErrorNS + `serverInternal","detail":"Failed to retrieve order for ID 3","status":500}`,
+			Name:         "POST, wildcard identifier in payload",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"dns","value":"*.not-example.com"}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewAuthorization request included wildcard identifier \"*.not-example.com\", wildcard identifiers cannot be pre-authorized","status":400}`,
 		},
 		{
-			Name:     "Invalid POST-as-GET",
-			Request:  makePost(1, "1/1", "{}"),
-			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"POST-as-GET requests must have an empty payload", "status":400}`,
+			Name:         "POST, pending authorizations rate limit exceeded",
+			Request:      signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"dns","value":"ratelimited.com"}}`, 1, wfe.nonceService),
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `rateLimited","detail":"Error creating new authorization :: too many currently pending authorizations: see https://letsencrypt.org/docs/rate-limits/","status":429}`,
+		},
+		{
+			Name:    "POST, good payload",
+			Request: signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"dns","value":"not-example.com"}}`, 1, wfe.nonceService),
+			ExpectedBody: `
+					{
+						"identifier": {"type": "dns", "value": "not-example.com"},
+						"status": "pending",
+						"expires": "1970-01-01T00:00:00Z",
+						"challenges": [
+							{
+								"type": "http-01",
+								"status": "pending",
+								"url": "http://localhost/acme/chall-v3/1/7TyhFQ",
+								"token": "token"
+							}
+						]
+					}`,
+			ExpectedLocation: "http://localhost/acme/authz-v3/1",
+		},
+		{
+			Name:    "POST, good payload with IP identifier",
+			Request: signAndPost(t, targetPath, signedURL, `{"identifier":{"type":"ip","value":"64.112.117.1"}}`, 1, wfe.nonceService),
+			ExpectedBody: `
+					{
+						"identifier": {"type": "ip", "value": "64.112.117.1"},
+						"status": "pending",
+						"expires": "1970-01-01T00:00:00Z",
+						"challenges": [
+							{
+								"type": "http-01",
+								"status": "pending",
+								"url": "http://localhost/acme/chall-v3/1/7TyhFQ",
+								"token": "token"
+							}
+						]
+					}`,
+			ExpectedLocation: "http://localhost/acme/authz-v3/1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			responseWriter = httptest.NewRecorder()
+
+			wfe.NewAuthorization(ctx, newRequestEvent(), responseWriter, tc.Request)
+			test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.ExpectedBody)
+			if tc.ExpectedLocation != "" {
+				test.AssertEquals(t, responseWriter.Code, http.StatusCreated)
+				test.AssertEquals(t, responseWriter.Header().Get("Location"), tc.ExpectedLocation)
+			}
+		})
+	}
+}
+
+func TestGetOrder(t *testing.T) {
+	wfe, _ := setupWFE(t)
+
+	makeGet := func(path string) *http.Request {
+		return &http.Request{URL: &url.URL{Path: path}, Method: "GET"}
+	}
+
+	makePost := func(keyID int64, path, body string) *http.Request {
+		_, _, jwsBody := signRequestKeyID(t, keyID, nil, fmt.Sprintf("http://localhost/%s", path), body, wfe.nonceService)
+		return makePostRequestWithPath(path, jwsBody)
+	}
+
+	testCases := []struct {
+		Name       string
+		Request    *http.Request
+		Response   string
+		Endpoint   string
+		RetryAfter string
+	}{
+		{
+			Name:     "Good request",
+			Request:  makeGet("1/1"),
+			Response: `{"status": "valid","expires": "1970-01-01T00:00:00.9466848Z","identifiers":[{"type":"dns", "value":"example.com"}], "authorizations":["http://localhost/acme/authz-v3/1"],"finalize":"http://localhost/acme/finalize/1/1","certificate":"http://localhost/acme/cert/serial"}`,
+		},
+		{
+			Name:     "404 request",
+			Request:  makeGet("1/2"),
+			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"No order for ID 2", "status":404}`,
+		},
+		{
+			Name:     "Invalid request path",
+			Request:  makeGet("asd"),
+			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid request path","status":404}`,
+		},
+		{
+			Name:     "Invalid account ID",
+			Request:  makeGet("asd/asd"),
+			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid account ID","status":400}`,
+		},
+		{
+			Name:     "Invalid order ID",
+			Request:  makeGet("1/asd"),
+			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid order ID","status":400}`,
+		},
+		{
+			Name:     "Real request, wrong account",
+			Request:  makeGet("2/1"),
+			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"No order found for account ID 2", "status":404}`,
+		},
+		{
+			Name:     "Internal error request",
+			Request:  makeGet("1/3"),
+			Response: `{"type":"` + probs.V2ErrorNS + `serverInternal","detail":"Failed to retrieve order for ID 3","status":500}`,
+		},
+		{
+			Name:     "Invalid POST-as-GET",
+			Request:  makePost(1, "1/1", "{}"),
+			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"POST-as-GET requests must have an empty payload", "status":400}`,
 		},
 		{
 			Name:     "Valid POST-as-GET, wrong account",
@@ -2628,6 +3185,37 @@ func TestGetOrder(t *testing.T) {
 			Request:  makePost(1, "1/9", ""),
 			Response: `{"status": "valid","expires": "1970-01-01T00:00:00.9466848Z","identifiers":[{"type":"dns", "value":"example.com"}], "authorizations":["http://localhost/acme/authz-v3/1"],"finalize":"http://localhost/acme/finalize/1/9","certificate":"http://localhost/acme/cert/serial"}`,
 		},
+		{
+			Name:       "Processing order",
+			Request:    makePost(1, "1/10", ""),
+			Response:   `{"status": "processing","expires": "1970-01-01T00:00:00.9466848Z","identifiers":[{"type":"dns", "value":"example.com"}], "authorizations":["http://localhost/acme/authz-v3/1"],"finalize":"http://localhost/acme/finalize/1/10"}`,
+			RetryAfter: "3",
+		},
+		{
+			Name:     "STAR order",
+			Request:  makePost(1, "1/11", ""),
+			Response: `{"status": "valid","expires": "1970-01-01T00:00:00.9466848Z","identifiers":[{"type":"dns", "value":"example.com"}], "authorizations":["http://localhost/acme/authz-v3/1"],"finalize":"http://localhost/acme/finalize/1/11","auto-renewal":{"start-date":"1969-12-31T00:00:00Z","end-date":"1970-01-31T00:00:00Z","lifetime":604800,"allow-certificate-get":true},"star-certificate":"http://localhost/acme/star-cert/1/11"}`,
+		},
+		{
+			Name:     "Pending STAR order",
+			Request:  makePost(1, "1/12", ""),
+			Response: `{"status": "pending","expires": "1970-01-01T00:00:00.9466848Z","identifiers":[{"type":"dns", "value":"example.com"}], "authorizations":["http://localhost/acme/authz-v3/1"],"finalize":"http://localhost/acme/finalize/1/12","auto-renewal":{"start-date":"1970-01-01T00:00:00Z","end-date":"1970-01-31T00:00:00Z","lifetime":604800}}`,
+		},
+		{
+			Name:     "Cancel STAR order",
+			Request:  makePost(1, "1/11", `{"status":"canceled"}`),
+			Response: `{"status": "canceled","expires": "1970-01-01T00:00:00.9466848Z","identifiers":[{"type":"dns", "value":"example.com"}], "authorizations":["http://localhost/acme/authz-v3/1"],"finalize":"http://localhost/acme/finalize/1/11","auto-renewal":{"start-date":"1969-12-31T00:00:00Z","end-date":"1970-01-31T00:00:00Z","lifetime":604800,"allow-certificate-get":true},"star-certificate":"http://localhost/acme/star-cert/1/11"}`,
+		},
+		{
+			Name:     "Cancel non-STAR order",
+			Request:  makePost(1, "1/1", `{"status":"canceled"}`),
+			Response: `{"type":"` + probs.V2ErrorNS + `autoRenewalCancellationInvalid","detail":"Unable to cancel auto-renewal :: order 1 is not an auto-renewal order","status":400}`,
+		},
+		{
+			Name:     "Invalid order status update",
+			Request:  makePost(1, "1/11", `{"status":"deactivated"}`),
+			Response: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Order status can only be updated to \"canceled\"","status":400}`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2639,6 +3227,84 @@ func TestGetOrder(t *testing.T) {
 				wfe.GetOrder(ctx, newRequestEvent(), responseWriter, tc.Request)
 			}
 			test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.Response)
+			test.AssertEquals(t, responseWriter.Header().Get("Retry-After"), tc.RetryAfter)
+		})
+	}
+}
+
+func TestStarCertificate(t *testing.T) {
+	wfe, _ := setupWFE(t)
+	mux := wfe.Handler(metrics.NoopRegisterer)
+
+	makeGet := func(path string) *http.Request {
+		return &http.Request{URL: &url.URL{Path: starCertPath + path}, Method: "GET"}
+	}
+	makePost := func(keyID int64, path string) *http.Request {
+		_, _, jwsBody := signRequestKeyID(t, keyID, nil, fmt.Sprintf("http://localhost%s%s", starCertPath, path), "", wfe.nonceService)
+		return makePostRequestWithPath(starCertPath+path, jwsBody)
+	}
+
+	certPemBytes, _ := ioutil.ReadFile("test/178.crt")
+	chainPemBytes, err := ioutil.ReadFile("../test/test-ca2.pem")
+	test.AssertNotError(t, err, "Error reading ../test/test-ca2.pem")
+	expectedCert := append(certPemBytes, append([]byte("\n"), chainPemBytes...)...)
+
+	testCases := []struct {
+		Name            string
+		Request         *http.Request
+		ExpectedStatus  int
+		ExpectedCert    []byte
+		ExpectedProblem string
+	}{
+		{
+			Name:           "Unauthenticated GET",
+			Request:        makeGet("1/11"),
+			ExpectedStatus: http.StatusOK,
+			ExpectedCert:   expectedCert,
+		},
+		{
+			Name:           "POST-as-GET",
+			Request:        makePost(1, "1/11"),
+			ExpectedStatus: http.StatusOK,
+			ExpectedCert:   expectedCert,
+		},
+		{
+			Name:            "POST-as-GET, wrong account",
+			Request:         makePost(1, "2/11"),
+			ExpectedStatus:  http.StatusNotFound,
+			ExpectedProblem: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"No order found for account ID 2","status":404}`,
+		},
+		{
+			Name:            "Order without a STAR certificate yet",
+			Request:         makePost(1, "1/12"),
+			ExpectedStatus:  http.StatusNotFound,
+			ExpectedProblem: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"No STAR certificate for order ID 12","status":404}`,
+		},
+		{
+			Name:            "Not a STAR order",
+			Request:         makeGet("1/1"),
+			ExpectedStatus:  http.StatusNotFound,
+			ExpectedProblem: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"No STAR certificate for order ID 1","status":404}`,
+		},
+		{
+			Name:            "Unknown order",
+			Request:         makeGet("1/2"),
+			ExpectedStatus:  http.StatusNotFound,
+			ExpectedProblem: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"No order for ID 2","status":404}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			responseWriter := httptest.NewRecorder()
+			mux.ServeHTTP(responseWriter, tc.Request)
+			test.AssertEquals(t, responseWriter.Code, tc.ExpectedStatus)
+			if tc.ExpectedProblem != "" {
+				test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.ExpectedProblem)
+				return
+			}
+			test.AssertEquals(t, responseWriter.Header().Get("Content-Type"), "application/pem-certificate-chain")
+			test.AssertByteEquals(t, responseWriter.Body.Bytes(), tc.ExpectedCert)
 		})
 	}
 }
@@ -3225,12 +3891,14 @@ func TestPrepAccountForDisplay(t *testing.T) {
 	}
 
 	// Prep the account for display.
-	prepAccountForDisplay(acct)
+	prepAccountForDisplay(&http.Request{Host: "localhost"}, acct)
 
 	// The Agreement should always be cleared.
 	test.AssertEquals(t, acct.Agreement, "")
 	// The ID field should be zeroed.
 	test.AssertEquals(t, acct.ID, int64(0))
+	// The orders URL should be populated using the account ID.
+	test.AssertEquals(t, acct.Orders, "http://localhost/acme/orders/1987")
 }
 
 func TestGETAPIAuthz(t *testing.T) {
@@ -3326,3 +3994,399 @@ func TestGetAPIAndMandatoryPOSTAsGET(t *testing.T) {
 	wfe.Certificate(context.Background(), event, resp, req)
 	test.AssertEquals(t, resp.Code, 200)
 }
+
+// certIDForCert returns the draft-ietf-acme-ari unique certificate identifier
+// for the given certificate.
+func certIDForCert(cert *x509.Certificate) string {
+	return base64.RawURLEncoding.EncodeToString(cert.AuthorityKeyId) + "." +
+		base64.RawURLEncoding.EncodeToString(cert.SerialNumber.Bytes())
+}
+
+type mockSAWithRenewalInfo struct {
+	mocks.StorageAuthority
+	override *sapb.RenewalInfoOverride
+	revoked  bool
+	replaced bool
+}
+
+func (sa *mockSAWithRenewalInfo) GetRenewalInfoOverride(_ context.Context, req *sapb.Serial) (*sapb.RenewalInfoOverride, error) {
+	if sa.override == nil {
+		return nil, berrors.NotFoundError("no renewal info override for %s", req.GetSerial())
+	}
+	return sa.override, nil
+}
+
+func (sa *mockSAWithRenewalInfo) GetCertificateStatus(ctx context.Context, serial string) (core.CertificateStatus, error) {
+	if sa.revoked {
+		return core.CertificateStatus{Status: core.OCSPStatusRevoked}, nil
+	}
+	return sa.StorageAuthority.GetCertificateStatus(ctx, serial)
+}
+
+func (sa *mockSAWithRenewalInfo) ReplacementOrderExists(_ context.Context, _ *sapb.Serial) (*sapb.Exists, error) {
+	return &sapb.Exists{Exists: &sa.replaced}, nil
+}
+
+func TestRenewalInfo(t *testing.T) {
+	wfe, fc := setupWFE(t)
+	mux := wfe.Handler(metrics.NoopRegisterer)
+
+	cert, err := core.LoadCert("test/238.crt")
+	test.AssertNotError(t, err, "failed to load test/238.crt")
+	certID := certIDForCert(cert)
+
+	overrideStart := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	overrideEnd := overrideStart.Add(2 * time.Hour)
+	overrideStartNano := overrideStart.UnixNano()
+	overrideEndNano := overrideEnd.UnixNano()
+
+	simple := core.RenewalInfoSimple(cert.NotBefore, cert.NotAfter)
+	immediate := core.RenewalInfoImmediate(fc.Now())
+	windowJSON := func(start, end time.Time) string {
+		return fmt.Sprintf(`{"suggestedWindow":{"start":%q,"end":%q}}`,
+			start.UTC().Format(time.RFC3339Nano), end.UTC().Format(time.RFC3339Nano))
+	}
+	notFound := `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Certificate not found","status":404}`
+
+	testCases := []struct {
+		Name           string
+		Path           string
+		SA             *mockSAWithRenewalInfo
+		ExpectedStatus int
+		ExpectedBody   string
+	}{
+		{
+			Name:           "Valid certificate",
+			Path:           certID,
+			SA:             &mockSAWithRenewalInfo{},
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   windowJSON(simple.SuggestedWindow.Start, simple.SuggestedWindow.End),
+		},
+		{
+			Name:           "Revoked certificate",
+			Path:           certID,
+			SA:             &mockSAWithRenewalInfo{revoked: true},
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   windowJSON(immediate.SuggestedWindow.Start, immediate.SuggestedWindow.End),
+		},
+		{
+			Name: "Overridden certificate",
+			Path: certID,
+			SA: &mockSAWithRenewalInfo{
+				revoked: true,
+				override: &sapb.RenewalInfoOverride{
+					WindowStart: &overrideStartNano,
+					WindowEnd:   &overrideEndNano,
+				},
+			},
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   windowJSON(overrideStart, overrideEnd),
+		},
+		{
+			Name:           "Malformed certificate identifier",
+			Path:           "not-a-cert-id",
+			SA:             &mockSAWithRenewalInfo{},
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid certificate identifier: ` +
+				`certificate identifier must be two base64url encoded values separated by a period","status":400}`,
+		},
+		{
+			Name:           "Unknown serial",
+			Path:           base64.RawURLEncoding.EncodeToString(cert.AuthorityKeyId) + ".AP8",
+			SA:             &mockSAWithRenewalInfo{},
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   notFound,
+		},
+		{
+			Name:           "Mismatched authority key identifier",
+			Path:           "AQID." + base64.RawURLEncoding.EncodeToString(cert.SerialNumber.Bytes()),
+			SA:             &mockSAWithRenewalInfo{},
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedBody:   notFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.SA.StorageAuthority = *mocks.NewStorageAuthority(fc)
+			wfe.SA = tc.SA
+			responseWriter := httptest.NewRecorder()
+			mux.ServeHTTP(responseWriter, &http.Request{
+				Method: http.MethodGet,
+				URL:    &url.URL{Path: renewalInfoPath + tc.Path},
+			})
+			test.AssertEquals(t, responseWriter.Code, tc.ExpectedStatus)
+			test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.ExpectedBody)
+			if tc.ExpectedStatus == http.StatusOK {
+				test.AssertEquals(t, responseWriter.Header().Get("Retry-After"), "21600")
+			}
+		})
+	}
+}
+
+func TestNewOrderReplaces(t *testing.T) {
+	wfe, fc := setupWFE(t)
+	test2JWK := loadKey(t, []byte(test2KeyPrivatePEM))
+
+	targetPath := "new-order"
+	signedURL := fmt.Sprintf("http://localhost/%s", targetPath)
+
+	cert, err := core.LoadCert("test/238.crt")
+	test.AssertNotError(t, err, "failed to load test/238.crt")
+	certID := certIDForCert(cert)
+
+	orderBody := func(name, replaces string) string {
+		return fmt.Sprintf(`{"identifiers":[{"type":"dns","value":%q}],"replaces":%q}`, name, replaces)
+	}
+	_, _, otherAcctJWS := signRequestKeyID(t, 2, test2JWK, signedURL, orderBody("bad.example.com", certID), wfe.nonceService)
+
+	testCases := []struct {
+		Name             string
+		Replaced         bool
+		Request          *http.Request
+		ExpectedStatus   int
+		ExpectedBody     string
+		ExpectedReplaces string
+	}{
+		{
+			Name:             "Valid replacement",
+			Request:          signAndPost(t, targetPath, signedURL, orderBody("bad.example.com", certID), 1, wfe.nonceService),
+			ExpectedStatus:   http.StatusCreated,
+			ExpectedReplaces: "0000000000000000000000000000000000ee",
+		},
+		{
+			Name:           "Malformed certificate identifier",
+			Request:        signAndPost(t, targetPath, signedURL, orderBody("bad.example.com", "!!"), 1, wfe.nonceService),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody: `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid replaces field: ` +
+				`certificate identifier must be two base64url encoded values separated by a period","status":400}`,
+		},
+		{
+			Name:           "Certificate owned by another account",
+			Request:        makePostRequestWithPath(targetPath, otherAcctJWS),
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `unauthorized","detail":"Account in use did not issue the certificate to be replaced","status":403}`,
+		},
+		{
+			Name:           "No shared identifiers",
+			Request:        signAndPost(t, targetPath, signedURL, orderBody("not-example.com", certID), 1, wfe.nonceService),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `malformed","detail":"NewOrder request did not include any identifiers from the certificate to be replaced","status":400}`,
+		},
+		{
+			Name:           "Already replaced",
+			Replaced:       true,
+			Request:        signAndPost(t, targetPath, signedURL, orderBody("bad.example.com", certID), 1, wfe.nonceService),
+			ExpectedStatus: http.StatusConflict,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Certificate 0000000000000000000000000000000000ee has already been replaced or is being replaced by another order","status":409}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			wfe.SA = &mockSAWithRenewalInfo{
+				StorageAuthority: *mocks.NewStorageAuthority(fc),
+				replaced:         tc.Replaced,
+			}
+			responseWriter := httptest.NewRecorder()
+			requestEvent := newRequestEvent()
+			wfe.NewOrder(ctx, requestEvent, responseWriter, tc.Request)
+			test.AssertEquals(t, responseWriter.Code, tc.ExpectedStatus)
+			if tc.ExpectedBody != "" {
+				test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.ExpectedBody)
+			}
+			if tc.ExpectedReplaces != "" {
+				test.AssertEquals(t, requestEvent.Extra["Replaces"], tc.ExpectedReplaces)
+			}
+		})
+	}
+}
+
+// mockSAWithOrders is a mock SA where account 1 has orders with IDs 1 through
+// numOrders.
+type mockSAWithOrders struct {
+	mocks.StorageAuthority
+	numOrders int64
+}
+
+func (sa *mockSAWithOrders) GetOrdersForAccount(_ context.Context, req *sapb.GetOrdersForAccountRequest) (*sapb.OrderIDs, error) {
+	var ids []int64
+	if req.GetAcctID() != 1 {
+		return &sapb.OrderIDs{}, nil
+	}
+	for id := req.GetAfterID() + 1; id <= sa.numOrders && int64(len(ids)) < req.GetLimit(); id++ {
+		ids = append(ids, id)
+	}
+	return &sapb.OrderIDs{Ids: ids}, nil
+}
+
+func TestOrders(t *testing.T) {
+	wfe, fc := setupWFE(t)
+	mux := wfe.Handler(metrics.NoopRegisterer)
+	wfe.SA = &mockSAWithOrders{
+		StorageAuthority: *mocks.NewStorageAuthority(fc),
+		numOrders:        ordersPerPage + 5,
+	}
+
+	makePost := func(keyID int64, key interface{}, path, body string) *http.Request {
+		_, _, jwsBody := signRequestKeyID(t, keyID, key, fmt.Sprintf("http://localhost%s", path), body, wfe.nonceService)
+		return makePostRequestWithPath(path, jwsBody)
+	}
+	orderURLs := func(first, last int) []string {
+		var urls []string
+		for id := first; id <= last; id++ {
+			urls = append(urls, fmt.Sprintf("http://localhost/acme/order/1/%d", id))
+		}
+		return urls
+	}
+
+	// The first page should be full and link to the next page
+	responseWriter := httptest.NewRecorder()
+	mux.ServeHTTP(responseWriter, makePost(1, nil, "/acme/orders/1", ""))
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	var list ordersList
+	err := json.Unmarshal(responseWriter.Body.Bytes(), &list)
+	test.AssertNotError(t, err, "Failed to unmarshal orders list")
+	test.AssertDeepEquals(t, list.Orders, orderURLs(1, ordersPerPage))
+	test.AssertContains(t, strings.Join(responseWriter.Header()["Link"], ", "),
+		fmt.Sprintf(`<http://localhost/acme/orders/1/%d>;rel="next"`, ordersPerPage))
+
+	// The last page should hold the remaining orders and no next link
+	responseWriter = httptest.NewRecorder()
+	mux.ServeHTTP(responseWriter, makePost(1, nil, fmt.Sprintf("/acme/orders/1/%d", ordersPerPage), ""))
+	test.AssertEquals(t, responseWriter.Code, http.StatusOK)
+	err = json.Unmarshal(responseWriter.Body.Bytes(), &list)
+	test.AssertNotError(t, err, "Failed to unmarshal orders list")
+	test.AssertDeepEquals(t, list.Orders, orderURLs(ordersPerPage+1, ordersPerPage+5))
+	test.AssertNotContains(t, strings.Join(responseWriter.Header()["Link"], ", "), `rel="next"`)
+
+	testCases := []struct {
+		Name           string
+		Request        *http.Request
+		ExpectedStatus int
+		ExpectedBody   string
+	}{
+		{
+			Name:           "Account with no orders",
+			Request:        makePost(2, loadKey(t, []byte(test2KeyPrivatePEM)), "/acme/orders/2", ""),
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"orders":[]}`,
+		},
+		{
+			Name:           "Another account's orders",
+			Request:        makePost(2, loadKey(t, []byte(test2KeyPrivatePEM)), "/acme/orders/1", ""),
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `unauthorized","detail":"Account in use did not match the requested orders list","status":403}`,
+		},
+		{
+			Name:           "Invalid account ID",
+			Request:        makePost(1, nil, "/acme/orders/one", ""),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid account ID","status":400}`,
+		},
+		{
+			Name:           "Invalid cursor",
+			Request:        makePost(1, nil, "/acme/orders/1/-1", ""),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid orders list cursor","status":400}`,
+		},
+		{
+			Name:           "POST with a body",
+			Request:        makePost(1, nil, "/acme/orders/1", "{}"),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `malformed","detail":"POST-as-GET requests must have an empty payload","status":400}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			responseWriter := httptest.NewRecorder()
+			mux.ServeHTTP(responseWriter, tc.Request)
+			test.AssertEquals(t, responseWriter.Code, tc.ExpectedStatus)
+			test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.ExpectedBody)
+		})
+	}
+}
+
+func TestRateLimits(t *testing.T) {
+	wfe, _ := setupWFE(t)
+	mux := wfe.Handler(metrics.NoopRegisterer)
+
+	makePost := func(keyID int64, key interface{}, path, body string) *http.Request {
+		_, _, jwsBody := signRequestKeyID(t, keyID, key, fmt.Sprintf("http://localhost%s", path), body, wfe.nonceService)
+		return makePostRequestWithPath(path, jwsBody)
+	}
+
+	testCases := []struct {
+		Name               string
+		Request            *http.Request
+		ExpectedStatus     int
+		ExpectedBody       string
+		ExpectedRetryAfter string
+	}{
+		{
+			Name:           "Account limits",
+			Request:        makePost(1, nil, "/acme/rate-limits/1", ""),
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"limits":[
+				{"limit":"newOrdersPerAccount","usage":3,"threshold":300,"reset":"2020-10-18T03:00:00Z"}
+			]}`,
+		},
+		{
+			Name:           "Account and domain limits",
+			Request:        makePost(1, nil, "/acme/rate-limits/1/www.example.com", ""),
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody: `{"limits":[
+				{"limit":"newOrdersPerAccount","usage":3,"threshold":300,"reset":"2020-10-18T03:00:00Z"},
+				{"limit":"certificatesPerName","key":"example.com","usage":50,"threshold":50,"reset":"2020-10-25T00:00:00Z"}
+			]}`,
+		},
+		{
+			Name:           "Rejected domain name",
+			Request:        makePost(1, nil, "/acme/rate-limits/1/bad.example", ""),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `rejectedIdentifier","detail":"Unable to get rate limit status :: rejected \"bad.example\"","status":400}`,
+		},
+		{
+			Name:               "Rate limited",
+			Request:            makePost(1, nil, "/acme/rate-limits/1/ratelimited.com", ""),
+			ExpectedStatus:     http.StatusTooManyRequests,
+			ExpectedBody:       `{"type":"` + probs.V2ErrorNS + `rateLimited","detail":"Unable to get rate limit status :: too many rate limit status requests: see https://letsencrypt.org/docs/rate-limits/","status":429}`,
+			ExpectedRetryAfter: "90",
+		},
+		{
+			Name:           "Empty domain name",
+			Request:        makePost(1, nil, "/acme/rate-limits/1/", ""),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid domain name","status":400}`,
+		},
+		{
+			Name:           "Another account's limits",
+			Request:        makePost(2, loadKey(t, []byte(test2KeyPrivatePEM)), "/acme/rate-limits/1", ""),
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `unauthorized","detail":"Account in use did not match the requested rate limits","status":403}`,
+		},
+		{
+			Name:           "Invalid account ID",
+			Request:        makePost(1, nil, "/acme/rate-limits/one", ""),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `malformed","detail":"Invalid account ID","status":400}`,
+		},
+		{
+			Name:           "POST with a body",
+			Request:        makePost(1, nil, "/acme/rate-limits/1", "{}"),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedBody:   `{"type":"` + probs.V2ErrorNS + `malformed","detail":"POST-as-GET requests must have an empty payload","status":400}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			responseWriter := httptest.NewRecorder()
+			mux.ServeHTTP(responseWriter, tc.Request)
+			test.AssertEquals(t, responseWriter.Code, tc.ExpectedStatus)
+			test.AssertUnmarshaledEquals(t, responseWriter.Body.String(), tc.ExpectedBody)
+			test.AssertEquals(t, responseWriter.Header().Get("Retry-After"), tc.ExpectedRetryAfter)
+		})
+	}
+}